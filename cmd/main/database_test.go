@@ -0,0 +1,77 @@
+package main
+
+import (
+	"net"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		name    string
+		base    time.Duration
+		attempt int
+		want    time.Duration // upper bound; the jittered delay is at least half of it
+	}{
+		{name: "first retry", base: time.Second, attempt: 0, want: time.Second},
+		{name: "doubles", base: time.Second, attempt: 1, want: 2 * time.Second},
+		{name: "doubles again", base: time.Second, attempt: 3, want: 8 * time.Second},
+		{name: "capped", base: time.Second, attempt: 10, want: dbConnectMaxDelay},
+		{name: "large base capped", base: time.Minute, attempt: 0, want: dbConnectMaxDelay},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := 0; i < 50; i++ {
+				got := retryDelay(tt.base, tt.attempt)
+				if got < tt.want/2 || got > tt.want {
+					t.Fatalf("retryDelay(%v, %d) = %v, want between %v and %v", tt.base, tt.attempt, got, tt.want/2, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestInitDatabase_RetriesThenFails(t *testing.T) {
+	// A server that hangs up on every connection, counting them
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	var attempts atomic.Int32
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			attempts.Add(1)
+			conn.Close()
+		}
+	}()
+
+	t.Setenv("DB_HOST", "127.0.0.1")
+	t.Setenv("DB_PORT", strconv.Itoa(ln.Addr().(*net.TCPAddr).Port))
+	t.Setenv("DB_CONNECT_MAX_RETRIES", "2")
+	t.Setenv("DB_CONNECT_RETRY_INTERVAL", "10ms")
+
+	begin := time.Now()
+	db, err := initDatabase(defaultDBMaxIdleConns)
+	if err == nil {
+		t.Fatalf("initDatabase() = %v, want an error", db)
+	}
+	if !strings.Contains(err.Error(), "after 3 attempts") {
+		t.Errorf("error = %q, want it to report 3 attempts", err)
+	}
+	// database/sql may redial within one attempt after a dropped connection
+	if got := attempts.Load(); got < 3 {
+		t.Errorf("server saw %d connections, want at least one per attempt", got)
+	}
+	if elapsed := time.Since(begin); elapsed > 5*time.Second {
+		t.Errorf("initDatabase took %v, want the short retry interval to apply", elapsed)
+	}
+}
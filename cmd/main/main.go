@@ -4,9 +4,13 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"math/rand/v2"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
@@ -16,18 +20,32 @@ import (
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	appdto "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/usecases/category"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/usecases/portfolio"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/usecases/portfolio_link"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/usecases/project"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/usecases/project_collaborator"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/usecases/section"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/usecases/section_content"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/usecases/title"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/usecases/trash"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/usecases/user"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/events"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/logging"
-	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/entities"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/portfolioviews"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/dbhealth"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/migrations"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/repositories"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/searchindex"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/projectviews"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/prometheus"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/storage"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/interfaces/controllers"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/interfaces/dto/request"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/interfaces/middleware"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/interfaces/routes"
 )
 
 func main() {
@@ -36,145 +54,393 @@ func main() {
 		log.Println("No .env file found, using system environment variables")
 	}
 
+	// Initialize log files (shared by the process logger and the audit loggers)
+	logConfig, err := logging.ConfigFromEnv(os.Getenv)
+	if err != nil {
+		log.Fatalf("Invalid logging configuration: %v", err)
+	}
+	logWriters, err := logging.OpenWriters(logConfig)
+	if err != nil {
+		log.Fatalf("Failed to initialize logging: %v", err)
+	}
+	defer logWriters.Close()
+	log.SetOutput(logWriters.Writer(logging.LogApp))
+
 	// Initialize database
-	db, err := initDatabase()
+	dbMaxIdleConns := getEnvInt("DB_MAX_IDLE_CONNS", defaultDBMaxIdleConns)
+	db, err := initDatabase(dbMaxIdleConns)
 	if err != nil {
 		log.Fatalf("Failed to initialize database: %v", err)
 	}
 
+	// Retry reads once and answer 503 on connection failures (database failover)
+	dbMonitor := dbhealth.NewMonitor(db, dbMaxIdleConns, getEnvDuration("DB_RECOVERY_WINDOW", dbhealth.DefaultRecoveryWindow))
+	if err := dbhealth.RegisterCallbacks(db, dbMonitor); err != nil {
+		log.Fatalf("Failed to initialize database: %v", err)
+	}
+
 	// Run migrations
-	if err := runMigrations(db); err != nil {
+	searchConfig := getEnv("SEARCH_TEXT_CONFIG", searchindex.DefaultConfig)
+	if err := migrations.Migrate(db, searchConfig); err != nil {
 		log.Fatalf("Failed to run migrations: %v", err)
 	}
 
+	if sqlDB, err := db.DB(); err == nil {
+		if err := prometheus.RegisterDatabaseStats(sqlDB, "portfolio"); err != nil {
+			log.Printf("⚠️  Database pool metrics unavailable: %v", err)
+		}
+	}
+
 	// 1. Create Repositories (inject DB)
+	// Every repository reading section contents in order follows the same column switch
+	readContentPosition := getEnv("SECTION_CONTENT_READ_POSITION", "false") == "true"
 	userRepo := repositories.NewUserRepository(db)
-	portfolioRepo := repositories.NewPortfolioRepository(db)
+	portfolioRepo := repositories.NewPortfolioRepository(db, readContentPosition)
 	categoryRepo := repositories.NewCategoryRepository(db)
-	sectionRepo := repositories.NewSectionRepository(db)
-	projectRepo := repositories.NewProjectRepository(db)
-	sectionContentRepo := repositories.NewSectionContentRepository(db)
+	sectionRepo := repositories.NewSectionRepository(db, searchConfig, readContentPosition)
+	projectRepo := repositories.NewProjectRepository(db, searchConfig)
+	sectionContentRepo := repositories.NewSectionContentRepository(db, readContentPosition)
+	sectionContentRevisionRepo := repositories.NewSectionContentRevisionRepository(db)
+	portfolioLinkRepo := repositories.NewPortfolioLinkRepository(db)
+	userSettingsRepo := repositories.NewUserSettingsRepository(db)
+	skillEndorsementRepo := repositories.NewSkillEndorsementRepository(db)
+	titleRepo := repositories.NewTitleRepository(db)
+	trashRepo := repositories.NewTrashRepository(db)
+	projectViewRepo := repositories.NewProjectViewRepository(db)
+	portfolioViewRepo := repositories.NewPortfolioViewRepository(db)
+	projectCollaboratorRepo := repositories.NewProjectCollaboratorRepository(db)
+	// Units of work spanning the portfolio, category and section repositories
+	txManager := repositories.NewTransactionManager(db)
 
 	// 2. Create Services (inject config/clients)
-	auditLogger := logging.NewAuditLogger()
 	metricsCollector := prometheus.NewMetricsCollector()
+	projectViewBuffer := projectviews.NewBuffer()
+	portfolioViewBuffer := portfolioviews.NewBuffer()
+	changeEventBus := events.NewBus(getEnvInt("EVENT_STREAMS_PER_USER", events.DefaultMaxStreamsPerUser), metricsCollector)
+	// Audited mutations are also published to the owner's change event streams
+	auditLogger := events.NewPublishingAuditLogger(logging.NewAuditLogger(logWriters), changeEventBus)
 
 	// 3. Create Use Cases (inject repositories & services)
 	// Portfolio use cases
 	createPortfolioUC := portfolio.NewCreatePortfolioUseCase(portfolioRepo, auditLogger, metricsCollector)
 	getPortfolioUC := portfolio.NewGetPortfolioUseCase(portfolioRepo)
-	getPortfolioPublicUC := portfolio.NewGetPortfolioPublicUseCase(portfolioRepo)
+	getPortfolioPublicUC := portfolio.NewGetPortfolioPublicUseCase(portfolioRepo, portfolioLinkRepo)
+	getPortfolioPublicBySlugUC := portfolio.NewGetPortfolioPublicBySlugUseCase(portfolioRepo, portfolioLinkRepo)
 	listPortfoliosUC := portfolio.NewListPortfoliosUseCase(portfolioRepo)
+	searchPublicPortfoliosUC := portfolio.NewSearchPublicPortfoliosUseCase(portfolioRepo)
 	updatePortfolioUC := portfolio.NewUpdatePortfolioUseCase(portfolioRepo, auditLogger, metricsCollector)
+	patchPortfolioUC := portfolio.NewPatchPortfolioUseCase(portfolioRepo, auditLogger, metricsCollector)
 	deletePortfolioUC := portfolio.NewDeletePortfolioUseCase(portfolioRepo, auditLogger, metricsCollector)
+	clonePortfolioUC := portfolio.NewClonePortfolioUseCase(portfolioRepo, auditLogger, metricsCollector)
+	exportPortfolioUC := portfolio.NewExportPortfolioUseCase(portfolioRepo, auditLogger)
+	setPortfolioPublishedUC := portfolio.NewSetPortfolioPublishedUseCase(portfolioRepo, auditLogger)
+	importPortfolioUC := portfolio.NewImportPortfolioUseCase(portfolioRepo, auditLogger, metricsCollector, getEnvList("CUSTOM_CSS_ALLOWED_ORIGINS"))
+	listDeletedPortfoliosUC := portfolio.NewListDeletedPortfoliosUseCase(portfolioRepo)
+	restorePortfolioUC := portfolio.NewRestorePortfolioUseCase(portfolioRepo, trashRepo, auditLogger)
+	purgePortfolioUC := portfolio.NewPurgePortfolioUseCase(portfolioRepo, trashRepo, auditLogger)
+	getPortfolioStructuredDataUC := portfolio.NewGetPortfolioStructuredDataUseCase(portfolioRepo, projectRepo, userRepo, portfolioLinkRepo)
+	getPortfolioCompletenessUC := portfolio.NewGetPortfolioCompletenessUseCase(portfolioRepo, categoryRepo, projectRepo, sectionRepo, sectionContentRepo)
+	getPortfolioAccessibilityReportUC := portfolio.NewGetPortfolioAccessibilityReportUseCase(portfolioRepo, projectRepo, sectionRepo, sectionContentRepo)
+	updatePortfolioCustomCSSUC := portfolio.NewUpdatePortfolioCustomCSSUseCase(portfolioRepo, auditLogger, getEnvList("CUSTOM_CSS_ALLOWED_ORIGINS"))
+	getPortfolioAvailabilityUC := portfolio.NewGetPortfolioAvailabilityUseCase(portfolioRepo)
+	getPortfolioTOCUC := portfolio.NewGetPortfolioTOCUseCase(portfolioRepo, sectionRepo)
+	searchPortfolioUC := portfolio.NewSearchPortfolioUseCase(portfolioRepo, projectRepo, sectionRepo)
+	getPortfolioAnalyticsUC := portfolio.NewGetPortfolioAnalyticsUseCase(portfolioRepo, portfolioViewRepo, auditLogger)
+	recordPortfolioViewUC := portfolio.NewRecordPortfolioViewUseCase(portfolioViewBuffer, getEnv("ENDORSEMENT_IP_SALT", ""))
+	flushPortfolioViewsUC := portfolio.NewFlushPortfolioViewsUseCase(portfolioViewBuffer, portfolioViewRepo)
 
 	// Category use cases
 	createCategoryUC := category.NewCreateCategoryUseCase(categoryRepo, portfolioRepo, auditLogger, metricsCollector)
 	getCategoryUC := category.NewGetCategoryUseCase(categoryRepo, portfolioRepo, auditLogger)
-	getCategoryPublicUC := category.NewGetCategoryPublicUseCase(categoryRepo)
+	getCategoryPublicUC := category.NewGetCategoryPublicUseCase(categoryRepo, portfolioRepo)
 	listCategoriesUC := category.NewListCategoriesUseCase(categoryRepo)
 	updateCategoryUC := category.NewUpdateCategoryUseCase(categoryRepo, portfolioRepo, auditLogger, metricsCollector)
+	patchCategoryUC := category.NewPatchCategoryUseCase(categoryRepo, portfolioRepo, auditLogger, metricsCollector)
 	updateCategoryPositionUC := category.NewUpdateCategoryPositionUseCase(categoryRepo, portfolioRepo, auditLogger)
-	bulkReorderCategoriesUC := category.NewBulkReorderCategoriesUseCase(categoryRepo, portfolioRepo, auditLogger)
+	bulkReorderCategoriesUC := category.NewBulkReorderCategoriesUseCase(categoryRepo, portfolioRepo, txManager, auditLogger)
 	deleteCategoryUC := category.NewDeleteCategoryUseCase(categoryRepo, portfolioRepo, auditLogger, metricsCollector)
+	getCategoryDetailUC := category.NewGetCategoryDetailUseCase(categoryRepo, auditLogger)
+	swapCategoryPositionsUC := category.NewSwapCategoryPositionsUseCase(categoryRepo, portfolioRepo, auditLogger)
 
 	// Section use cases
 	createSectionUC := section.NewCreateSectionUseCase(sectionRepo, portfolioRepo, auditLogger, metricsCollector)
 	getSectionUC := section.NewGetSectionUseCase(sectionRepo, portfolioRepo, auditLogger)
-	getSectionPublicUC := section.NewGetSectionPublicUseCase(sectionRepo)
+	getSectionPublicUC := section.NewGetSectionPublicUseCase(sectionRepo, portfolioRepo)
 	listSectionsUC := section.NewListSectionsUseCase(sectionRepo)
 	updateSectionUC := section.NewUpdateSectionUseCase(sectionRepo, portfolioRepo, auditLogger, metricsCollector)
 	updateSectionPositionUC := section.NewUpdateSectionPositionUseCase(sectionRepo, portfolioRepo, auditLogger)
-	bulkReorderSectionsUC := section.NewBulkReorderSectionsUseCase(sectionRepo, portfolioRepo, auditLogger)
+	bulkReorderSectionsUC := section.NewBulkReorderSectionsUseCase(sectionRepo, portfolioRepo, txManager, auditLogger)
 	deleteSectionUC := section.NewDeleteSectionUseCase(sectionRepo, portfolioRepo, auditLogger, metricsCollector)
+	listSectionsByTypeUC := section.NewListSectionsByTypeUseCase(sectionRepo, portfolioRepo)
+	swapSectionPositionsUC := section.NewSwapSectionPositionsUseCase(sectionRepo, portfolioRepo, auditLogger)
 
 	// Project use cases
-	createProjectUC := project.NewCreateProjectUseCase(projectRepo, categoryRepo, auditLogger, metricsCollector)
-	getProjectUC := project.NewGetProjectUseCase(projectRepo, categoryRepo, auditLogger)
-	getProjectPublicUC := project.NewGetProjectPublicUseCase(projectRepo)
-	listProjectsUC := project.NewListProjectsUseCase(projectRepo)
+	createProjectUC := project.NewCreateProjectUseCase(projectRepo, categoryRepo, userSettingsRepo, auditLogger, metricsCollector)
+	getProjectUC := project.NewGetProjectUseCase(projectRepo, projectViewRepo, projectCollaboratorRepo, auditLogger)
+	getProjectPublicUC := project.NewGetProjectPublicUseCase(projectRepo, portfolioRepo, skillEndorsementRepo, projectCollaboratorRepo, projectViewBuffer)
+	listProjectsUC := project.NewListProjectsUseCase(projectRepo, projectViewRepo)
 	updateProjectUC := project.NewUpdateProjectUseCase(projectRepo, categoryRepo, auditLogger)
+	patchProjectUC := project.NewPatchProjectUseCase(projectRepo, categoryRepo, auditLogger)
 	deleteProjectUC := project.NewDeleteProjectUseCase(projectRepo, categoryRepo, auditLogger)
+	searchPublicProjectsUC := project.NewSearchPublicProjectsUseCase(projectRepo)
+	endorseProjectSkillUC := project.NewEndorseProjectSkillUseCase(projectRepo, portfolioRepo, skillEndorsementRepo, auditLogger, getEnv("ENDORSEMENT_IP_SALT", ""))
+	getProjectEndorsementsUC := project.NewGetProjectEndorsementsUseCase(projectRepo, portfolioRepo, skillEndorsementRepo)
+	compareProjectsUC := project.NewCompareProjectsUseCase(projectRepo)
+	bulkReorderProjectsUC := project.NewBulkReorderProjectsUseCase(projectRepo, categoryRepo, portfolioRepo, auditLogger)
+	swapProjectPositionsUC := project.NewSwapProjectPositionsUseCase(projectRepo, categoryRepo, portfolioRepo, auditLogger)
+	purgeEndorsementVotesUC := project.NewPurgeEndorsementVotesUseCase(skillEndorsementRepo)
+	flushProjectViewsUC := project.NewFlushProjectViewsUseCase(projectViewBuffer, projectViewRepo)
+	purgeProjectViewsUC := project.NewPurgeProjectViewsUseCase(projectViewRepo)
 
 	// Section content use cases
 	createSectionContentUC := section_content.NewCreateSectionContentUseCase(sectionContentRepo, sectionRepo, portfolioRepo, auditLogger)
-	updateSectionContentUC := section_content.NewUpdateSectionContentUseCase(sectionContentRepo, sectionRepo, portfolioRepo, auditLogger)
+	revisionPolicy := revisionPolicyFromEnv()
+	updateSectionContentUC := section_content.NewUpdateSectionContentUseCase(sectionContentRepo, sectionRepo, portfolioRepo, sectionContentRevisionRepo, txManager, auditLogger, revisionPolicy)
 	updateSectionContentOrderUC := section_content.NewUpdateSectionContentOrderUseCase(sectionContentRepo, sectionRepo, portfolioRepo, auditLogger)
 	deleteSectionContentUC := section_content.NewDeleteSectionContentUseCase(sectionContentRepo, sectionRepo, portfolioRepo, auditLogger)
-	getSectionContentPublicUC := section_content.NewGetSectionContentPublicUseCase(sectionContentRepo)
-	listSectionContentsBySectionUC := section_content.NewListSectionContentsBySectionUseCase(sectionContentRepo)
+	getSectionContentPublicUC := section_content.NewGetSectionContentPublicUseCase(sectionContentRepo, sectionRepo, portfolioRepo)
+	listSectionContentsBySectionUC := section_content.NewListSectionContentsBySectionUseCase(sectionContentRepo, sectionRepo, portfolioRepo)
+	listSectionContentRevisionsUC := section_content.NewListSectionContentRevisionsUseCase(sectionContentRepo, sectionRepo, portfolioRepo, sectionContentRevisionRepo)
+	getSectionContentRevisionUC := section_content.NewGetSectionContentRevisionUseCase(sectionContentRepo, sectionRepo, portfolioRepo, sectionContentRevisionRepo)
+	revertSectionContentRevisionUC := section_content.NewRevertSectionContentRevisionUseCase(sectionContentRepo, sectionRepo, portfolioRepo, sectionContentRevisionRepo, txManager, auditLogger, revisionPolicy)
+
+	// Portfolio link use cases
+	createPortfolioLinkUC := portfolio_link.NewCreatePortfolioLinkUseCase(portfolioLinkRepo, portfolioRepo, auditLogger)
+	listPortfolioLinksUC := portfolio_link.NewListPortfolioLinksUseCase(portfolioLinkRepo, portfolioRepo)
+	updatePortfolioLinkUC := portfolio_link.NewUpdatePortfolioLinkUseCase(portfolioLinkRepo, portfolioRepo, auditLogger)
+	reorderPortfolioLinksUC := portfolio_link.NewReorderPortfolioLinksUseCase(portfolioLinkRepo, portfolioRepo, auditLogger)
+	deletePortfolioLinkUC := portfolio_link.NewDeletePortfolioLinkUseCase(portfolioLinkRepo, portfolioRepo, auditLogger)
+
+	// Project collaborator use cases
+	createProjectCollaboratorUC := project_collaborator.NewCreateProjectCollaboratorUseCase(projectCollaboratorRepo, projectRepo, auditLogger)
+	listProjectCollaboratorsUC := project_collaborator.NewListProjectCollaboratorsUseCase(projectCollaboratorRepo, projectRepo)
+	updateProjectCollaboratorUC := project_collaborator.NewUpdateProjectCollaboratorUseCase(projectCollaboratorRepo, projectRepo, auditLogger)
+	reorderProjectCollaboratorsUC := project_collaborator.NewReorderProjectCollaboratorsUseCase(projectCollaboratorRepo, projectRepo, auditLogger)
+	deleteProjectCollaboratorUC := project_collaborator.NewDeleteProjectCollaboratorUseCase(projectCollaboratorRepo, projectRepo, auditLogger)
 
 	// User use cases
 	getCurrentUserUC := user.NewGetCurrentUserUseCase(userRepo)
 	updateCurrentUserUC := user.NewUpdateCurrentUserUseCase(userRepo, auditLogger)
+	getUserSettingsUC := user.NewGetUserSettingsUseCase(userSettingsRepo)
+	updateUserSettingsUC := user.NewUpdateUserSettingsUseCase(userSettingsRepo, portfolioRepo, auditLogger)
+	updateProjectDefaultsUC := user.NewUpdateProjectDefaultsUseCase(userSettingsRepo, categoryRepo, auditLogger)
+	resolveDefaultPortfolioUC := user.NewResolveDefaultPortfolioUseCase(userSettingsRepo, portfolioRepo)
+	getBootstrapUC := user.NewGetBootstrapUseCase(userRepo, userSettingsRepo, portfolioRepo, changeEventBus)
+
+	// Title use cases
+	checkTitleAvailabilityUC := title.NewCheckTitleAvailabilityUseCase(titleRepo, portfolioRepo, categoryRepo)
+
+	// Trash use cases
+	findDeletedItemUC := trash.NewFindDeletedItemUseCase(trashRepo)
+	purgeExpiredTrashUC := trash.NewPurgeExpiredTrashUseCase(trashRepo, getEnvInt("TRASH_RETENTION_DAYS", trash.DefaultRetentionDays))
 
 	// 4. Create Controllers (inject use cases)
+	// Stored image paths stay relative; the base (API domain or CDN) only applies when serving
+	assetURLs := storage.NewPublicURLBuilder(getEnv("PUBLIC_ASSET_BASE_URL", ""))
+
 	portfolioController := controllers.NewPortfolioController(
-		createPortfolioUC, getPortfolioUC, getPortfolioPublicUC,
-		listPortfoliosUC, updatePortfolioUC, deletePortfolioUC,
-		categoryRepo, sectionRepo,
+		createPortfolioUC, getPortfolioUC, getPortfolioPublicUC, getPortfolioPublicBySlugUC,
+		listPortfoliosUC, searchPublicPortfoliosUC, updatePortfolioUC, patchPortfolioUC, deletePortfolioUC, clonePortfolioUC, exportPortfolioUC, setPortfolioPublishedUC, importPortfolioUC,
+		listDeletedPortfoliosUC, restorePortfolioUC, purgePortfolioUC,
+		getPortfolioStructuredDataUC, getPortfolioCompletenessUC, getPortfolioAccessibilityReportUC,
+		updatePortfolioCustomCSSUC, getPortfolioAvailabilityUC, getPortfolioTOCUC, searchPortfolioUC,
+		getPortfolioAnalyticsUC, recordPortfolioViewUC, findDeletedItemUC,
+		categoryRepo, sectionRepo, assetURLs,
 	)
 
 	categoryController := controllers.NewCategoryController(
 		createCategoryUC, getCategoryUC, getCategoryPublicUC,
-		listCategoriesUC, updateCategoryUC, updateCategoryPositionUC,
-		bulkReorderCategoriesUC, deleteCategoryUC,
+		listCategoriesUC, updateCategoryUC, patchCategoryUC, updateCategoryPositionUC,
+		bulkReorderCategoriesUC, deleteCategoryUC, getCategoryDetailUC,
+		swapCategoryPositionsUC, findDeletedItemUC,
 	)
 
 	sectionController := controllers.NewSectionController(
 		createSectionUC, getSectionUC, getSectionPublicUC,
 		listSectionsUC, updateSectionUC, updateSectionPositionUC,
-		bulkReorderSectionsUC, deleteSectionUC,
+		bulkReorderSectionsUC, deleteSectionUC, listSectionsByTypeUC,
+		swapSectionPositionsUC, findDeletedItemUC,
 	)
 
 	projectController := controllers.NewProjectController(
 		createProjectUC, getProjectUC, getProjectPublicUC,
-		listProjectsUC, updateProjectUC, deleteProjectUC,
-		projectRepo,
+		listProjectsUC, updateProjectUC, patchProjectUC, deleteProjectUC,
+		searchPublicProjectsUC, endorseProjectSkillUC, getProjectEndorsementsUC, compareProjectsUC,
+		bulkReorderProjectsUC, swapProjectPositionsUC, findDeletedItemUC, projectRepo, assetURLs,
 	)
 
 	sectionContentController := controllers.NewSectionContentController(
 		createSectionContentUC, updateSectionContentUC, updateSectionContentOrderUC,
 		deleteSectionContentUC, getSectionContentPublicUC, listSectionContentsBySectionUC,
+		listSectionContentRevisionsUC, getSectionContentRevisionUC, revertSectionContentRevisionUC,
+		findDeletedItemUC,
 	)
 
-	userController := controllers.NewUserController(getCurrentUserUC, updateCurrentUserUC)
-	healthController := controllers.NewHealthController(db)
+	portfolioLinkController := controllers.NewPortfolioLinkController(
+		createPortfolioLinkUC, listPortfolioLinksUC, updatePortfolioLinkUC,
+		reorderPortfolioLinksUC, deletePortfolioLinkUC, findDeletedItemUC,
+	)
+	projectCollaboratorController := controllers.NewProjectCollaboratorController(
+		createProjectCollaboratorUC, listProjectCollaboratorsUC, updateProjectCollaboratorUC,
+		reorderProjectCollaboratorsUC, deleteProjectCollaboratorUC,
+	)
+
+	userController := controllers.NewUserController(
+		getCurrentUserUC, updateCurrentUserUC,
+		getUserSettingsUC, updateUserSettingsUC, updateProjectDefaultsUC, resolveDefaultPortfolioUC,
+		getBootstrapUC,
+	)
+	healthLogDir := ""
+	if logConfig.Sink == logging.SinkFile {
+		healthLogDir = logConfig.Dir
+	}
+	healthController := controllers.NewHealthController(db, dbMonitor, healthLogDir)
+	eventController := controllers.NewEventController(changeEventBus)
+	titleController := controllers.NewTitleController(checkTitleAvailabilityUC)
 
 	// 5. Create Middleware (inject services)
 	// TODO: Create real auth provider instead of nil
-	authMiddleware := middleware.NewAuthMiddleware(nil)
+	authMiddleware := middleware.NewAuthMiddleware(nil, nil, controllers.RespondError)
+	heavyOpsLimiter := middleware.NewConcurrencyLimiter(getEnvInt("HEAVY_OPERATIONS_PER_USER", middleware.DefaultMaxHeavyOperationsPerUser), metricsCollector, controllers.RespondError)
+	rateLimitStore := middleware.NewMemoryRateLimitStore()
+	typingChecksLimiter := middleware.NewRateLimiter(
+		"typing_checks",
+		getEnvInt("TYPING_CHECKS_PER_SECOND", middleware.DefaultTypingChecksPerSecond),
+		getEnvInt("TYPING_CHECKS_BURST", middleware.DefaultTypingChecksBurst),
+		middleware.RateLimitByUser, rateLimitStore, metricsCollector, controllers.RespondError,
+	)
+	userLimiter := middleware.NewRateLimiter(
+		"user",
+		getEnvInt("RATE_LIMIT_USER_RPS", middleware.DefaultUserRequestsPerSecond),
+		getEnvInt("RATE_LIMIT_USER_BURST", middleware.DefaultUserRequestsBurst),
+		middleware.RateLimitByUser, rateLimitStore, metricsCollector, controllers.RespondError,
+	)
+	publicLimiter := middleware.NewRateLimiter(
+		"public",
+		getEnvInt("RATE_LIMIT_PUBLIC_RPS", middleware.DefaultPublicRequestsPerSecond),
+		getEnvInt("RATE_LIMIT_PUBLIC_BURST", middleware.DefaultPublicRequestsBurst),
+		middleware.RateLimitByClientIP, rateLimitStore, metricsCollector, controllers.RespondError,
+	)
+
+	// Background jobs (stopped when shutdown starts)
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	go runPeriodically(jobsCtx, "database health check", getEnvDuration("DB_HEALTH_CHECK_INTERVAL", 5*time.Second), dbMonitor.Check)
+	go runPeriodically(jobsCtx, "endorsement vote purge", time.Hour, func(ctx context.Context) error {
+		deleted, err := purgeEndorsementVotesUC.Execute(ctx)
+		if err == nil && deleted > 0 {
+			log.Printf("🧹 Purged %d expired endorsement votes", deleted)
+		}
+		return err
+	})
+	go runPeriodically(jobsCtx, "project view flush", getEnvDuration("PROJECT_VIEW_FLUSH_INTERVAL", time.Minute), func(ctx context.Context) error {
+		_, err := flushProjectViewsUC.Execute(ctx)
+		return err
+	})
+	go runPeriodically(jobsCtx, "portfolio view flush", getEnvDuration("PORTFOLIO_VIEW_FLUSH_INTERVAL", time.Minute), func(ctx context.Context) error {
+		_, err := flushPortfolioViewsUC.Execute(ctx)
+		return err
+	})
+	go runPeriodically(jobsCtx, "project view purge", time.Hour, func(ctx context.Context) error {
+		_, err := purgeProjectViewsUC.Execute(ctx)
+		return err
+	})
+	go runPeriodically(jobsCtx, "trash purge", time.Hour, func(ctx context.Context) error {
+		purged, err := purgeExpiredTrashUC.Execute(ctx)
+		if err == nil && purged > 0 {
+			log.Printf("🧹 Purged %d expired trash rows", purged)
+		}
+		return err
+	})
+	go runPeriodically(jobsCtx, "search index check", time.Hour, func(ctx context.Context) error {
+		missing, err := searchindex.CountMissing(ctx, db)
+		if err != nil {
+			return err
+		}
+		for table, count := range missing {
+			metricsCollector.SetSearchVectorsMissing(table, count)
+			if count > 0 {
+				log.Printf("⚠️  %d %s rows have no search vector; run cmd/rebuild-search-index", count, table)
+			}
+		}
+		return nil
+	})
 
 	// Setup and start server
 	router := setupRouter(
 		authMiddleware,
+		heavyOpsLimiter,
+		typingChecksLimiter,
+		userLimiter,
+		publicLimiter,
+		getEnvList("TRUSTED_PROXIES"),
+		dbMonitor,
+		metricsCollector,
 		portfolioController,
 		categoryController,
 		sectionController,
 		projectController,
 		sectionContentController,
+		portfolioLinkController,
+		projectCollaboratorController,
 		userController,
+		eventController,
+		titleController,
 		healthController,
 	)
-	startServer(router, db)
+	// Views buffered since the last flush are written once requests have drained
+	flushRemainingViews := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if _, err := flushProjectViewsUC.Execute(ctx); err != nil {
+			log.Printf("⚠️  Final project view flush failed: %v", err)
+		}
+		if _, err := flushPortfolioViewsUC.Execute(ctx); err != nil {
+			log.Printf("⚠️  Final portfolio view flush failed: %v", err)
+		}
+	}
+	startServer(router, db, flushRemainingViews, changeEventBus.Close, stopJobs)
 }
 
-func initDatabase() (*gorm.DB, error) {
+// defaultDBMaxIdleConns is the default idle connection limit of the pool (restored after the
+// monitor discards idle connections)
+const defaultDBMaxIdleConns = 10
+
+// dbConnectMaxDelay caps the wait between two connection attempts
+const dbConnectMaxDelay = 30 * time.Second
+
+// initDatabase connects to Postgres, retrying DB_CONNECT_MAX_RETRIES times with exponential
+// backoff from DB_CONNECT_RETRY_INTERVAL (the database often starts after the API in compose setups)
+func initDatabase(maxIdleConns int) (*gorm.DB, error) {
 	dsn := fmt.Sprintf(
-		"host=%s user=%s password=%s dbname=%s port=%s sslmode=%s",
+		"host=%s user=%s password=%s dbname=%s port=%s sslmode=%s connect_timeout=%d",
 		getEnv("DB_HOST", "localhost"),
 		getEnv("DB_USER", "postgres"),
 		getEnv("DB_PASSWORD", "postgres"),
 		getEnv("DB_NAME", "portfolio"),
 		getEnv("DB_PORT", "5432"),
 		getEnv("DB_SSLMODE", "disable"),
+		getEnvInt("DB_CONNECT_TIMEOUT_SECONDS", 5),
 	)
 
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
-		Logger: logger.Default.LogMode(logger.Info),
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	maxRetries := getEnvInt("DB_CONNECT_MAX_RETRIES", 5)
+	retryInterval := getEnvDuration("DB_CONNECT_RETRY_INTERVAL", time.Second)
+
+	var db *gorm.DB
+	var err error
+	for attempt := 0; ; attempt++ {
+		db, err = gorm.Open(postgres.Open(dsn), &gorm.Config{
+			Logger: logger.Default.LogMode(logger.Info),
+		})
+		if err == nil {
+			break
+		}
+		if attempt >= maxRetries {
+			return nil, fmt.Errorf("failed to connect to database after %d attempts: %w", attempt+1, err)
+		}
+
+		delay := retryDelay(retryInterval, attempt)
+		log.Printf("⚠️  Database connection attempt %d/%d failed, retrying in %s: %v", attempt+1, maxRetries+1, delay.Round(time.Millisecond), err)
+		time.Sleep(delay)
 	}
 
 	sqlDB, err := db.DB()
@@ -183,153 +449,367 @@ func initDatabase() (*gorm.DB, error) {
 	}
 
 	// Configure connection pool
-	sqlDB.SetMaxIdleConns(10)
-	sqlDB.SetMaxOpenConns(100)
-	sqlDB.SetConnMaxLifetime(time.Hour)
-	sqlDB.SetConnMaxIdleTime(10 * time.Minute)
+	// Short idle times limit how many stale connections survive a failover
+	sqlDB.SetMaxIdleConns(maxIdleConns)
+	sqlDB.SetMaxOpenConns(getEnvInt("DB_MAX_OPEN_CONNS", 100))
+	sqlDB.SetConnMaxLifetime(getEnvDuration("DB_CONN_MAX_LIFETIME", time.Hour))
+	sqlDB.SetConnMaxIdleTime(getEnvDuration("DB_CONN_MAX_IDLE_TIME", 2*time.Minute))
 
 	log.Println("✅ Database connected successfully")
 	return db, nil
 }
 
-func runMigrations(db *gorm.DB) error {
-	log.Println("Running database migrations...")
-
-	err := db.AutoMigrate(
-		&entities.UserRecord{},
-		&entities.PortfolioRecord{},
-		&entities.CategoryRecord{},
-		&entities.SectionRecord{},
-		&entities.ProjectRecord{},
-		&entities.SectionContentRecord{},
-	)
-
-	if err != nil {
-		return fmt.Errorf("migration failed: %w", err)
+// retryDelay returns the wait before retry number attempt+1: base doubled per attempt, capped
+// at dbConnectMaxDelay, with jitter so restarted replicas don't retry in lockstep
+func retryDelay(base time.Duration, attempt int) time.Duration {
+	delay := base
+	for i := 0; i < attempt && delay < dbConnectMaxDelay; i++ {
+		delay *= 2
 	}
-
-	log.Println("✅ Migrations completed successfully")
-	return nil
+	if delay > dbConnectMaxDelay {
+		delay = dbConnectMaxDelay
+	}
+	// Somewhere between half and all of the delay
+	return delay/2 + rand.N(delay/2+1)
 }
 
 func setupRouter(
 	authMiddleware *middleware.AuthMiddleware,
+	heavyOpsLimiter *middleware.ConcurrencyLimiter,
+	typingChecksLimiter *middleware.RateLimiter,
+	userLimiter *middleware.RateLimiter,
+	publicLimiter *middleware.RateLimiter,
+	trustedProxies []string,
+	dbAvailability middleware.DatabaseAvailability,
+	metricsCollector contracts.MetricsCollector,
 	portfolioCtrl *controllers.PortfolioController,
 	categoryCtrl *controllers.CategoryController,
 	sectionCtrl *controllers.SectionController,
 	projectCtrl *controllers.ProjectController,
 	sectionContentCtrl *controllers.SectionContentController,
+	portfolioLinkCtrl *controllers.PortfolioLinkController,
+	projectCollaboratorCtrl *controllers.ProjectCollaboratorController,
 	userCtrl *controllers.UserController,
+	eventCtrl *controllers.EventController,
+	titleCtrl *controllers.TitleController,
 	healthCtrl *controllers.HealthController,
 ) *gin.Engine {
-	// Set Gin mode
-	if getEnv("GIN_MODE", "debug") == "release" {
-		gin.SetMode(gin.ReleaseMode)
+	// Set Gin mode (before the engine is created: debug mode logs every route as it is added)
+	gin.SetMode(ginModeFromEnv())
+
+	router := gin.New()
+
+	// First of all, so the startup route check reads handler chains without logging or counting requests
+	router.Use(authMiddleware.RouteProbe())
+	router.Use(gin.Logger(), gin.Recovery())
+
+	// Client IPs (rate limits, endorsement and view dedup) come from X-Forwarded-For only
+	// when the connection is from one of these proxies; by default none is trusted
+	if err := router.SetTrustedProxies(trustedProxies); err != nil {
+		log.Fatalf("Invalid TRUSTED_PROXIES: %v", err)
 	}
 
-	router := gin.Default()
+	// Report binding errors with the field names clients send
+	request.UseJSONFieldNames()
+
+	// Request count and latency per route and status
+	router.Use(middleware.NewMetricsMiddleware(metricsCollector).Collect())
+
+	// Tag every request with an ID (echoed in X-Request-ID, recorded in audit entries)
+	router.Use(middleware.RequestID())
 
 	// CORS middleware
 	router.Use(corsMiddleware())
 
+	// Compress JSON/text responses above the size threshold; static files are streamed as-is
+	router.Use(middleware.Compression(getEnvInt("COMPRESSION_MIN_SIZE", middleware.DefaultCompressionMinSize), metricsCollector, "/uploads/"))
+
+	// Fail writes fast while the database is unreachable
+	router.Use(middleware.RequireDatabaseForWrites(dbAvailability))
+
 	// Health endpoints (no auth)
 	router.GET("/health", healthCtrl.Health)
 	router.GET("/health/db", healthCtrl.DatabaseHealth)
+	router.GET("/healthz", healthCtrl.Liveness)
+	router.GET("/readyz", healthCtrl.Readiness)
+	router.GET("/ready", healthCtrl.Readiness) // Alias of /readyz kept for existing probes
+
+	// Prometheus scrape endpoint (no auth middleware, no rate limit; optional Basic Auth)
+	router.GET("/metrics", append(metricsAuth(), gin.WrapH(prometheus.Handler()))...)
 
 	// API routes
+	// Owner-scoped routes are registered on groups created by authMiddleware.Protected,
+	// which attaches both authentication and the userID guard
+	// Every owner-scoped route is also rate limited per user
+	userLimit := userLimiter.Limit()
 	api := router.Group("/api")
 	{
 		// Portfolio routes
 		portfolios := api.Group("/portfolios")
 		{
-			portfolios.Use(authMiddleware.Authenticate()).POST("/own", portfolioCtrl.Create)
-			portfolios.Use(authMiddleware.Authenticate()).GET("/own", portfolioCtrl.List)
-			portfolios.Use(authMiddleware.Authenticate()).GET("/own/:id", portfolioCtrl.GetByID)
-			portfolios.Use(authMiddleware.Authenticate()).PUT("/own/:id", portfolioCtrl.Update)
-			portfolios.Use(authMiddleware.Authenticate()).DELETE("/own/:id", portfolioCtrl.Delete)
-
-			portfolios.GET("/public/:id", portfolioCtrl.GetPublicByID)
-			portfolios.GET("/id/:id", portfolioCtrl.GetPublicByID)
-			portfolios.GET("/public/:id/categories", portfolioCtrl.GetPublicCategories)
-			portfolios.GET("/public/:id/sections", portfolioCtrl.GetPublicSections)
+			own := authMiddleware.Protected(portfolios, "/own", userLimit)
+			own.POST("", portfolioCtrl.Create)
+			own.GET("", portfolioCtrl.List)
+			own.GET("/check-title", typingChecksLimiter.Limit(), titleCtrl.CheckTitle(appdto.TitleResourcePortfolios))
+			own.GET("/trash", portfolioCtrl.Trash)
+			own.POST("/import", heavyOpsLimiter.Limit("portfolio_import", 1), portfolioCtrl.Import)
+			own.GET("/:id", portfolioCtrl.GetByID)
+			own.PUT("/:id", portfolioCtrl.Update)
+			own.PATCH("/:id", portfolioCtrl.Patch)
+			own.PATCH("/:id/publish", portfolioCtrl.Publish)
+			own.DELETE("/:id", portfolioCtrl.Delete)
+			own.POST("/:id/clone", heavyOpsLimiter.Limit("portfolio_clone", 1), portfolioCtrl.Clone)
+			own.GET("/:id/export", heavyOpsLimiter.Limit("portfolio_export", 1), portfolioCtrl.Export)
+			own.POST("/:id/restore", heavyOpsLimiter.Limit("portfolio_restore", 1), portfolioCtrl.Restore)
+			own.DELETE("/:id/purge", portfolioCtrl.Purge)
+			own.GET("/:id/analytics", portfolioCtrl.GetAnalytics)
+			own.GET("/:id/completeness", heavyOpsLimiter.Limit("portfolio_completeness", 1), portfolioCtrl.GetCompleteness)
+			own.GET("/:id/custom-css", portfolioCtrl.GetCustomCSS)
+			own.PUT("/:id/custom-css", portfolioCtrl.UpdateCustomCSS)
+			own.GET("/:id/accessibility-report", heavyOpsLimiter.Limit("portfolio_accessibility_report", 1), portfolioCtrl.GetAccessibilityReport)
+			own.GET("/:id/links", portfolioLinkCtrl.List)
+			own.POST("/:id/links", portfolioLinkCtrl.Create)
+			own.POST("/:id/links/reorder", portfolioLinkCtrl.Reorder)
+			own.PUT("/:id/links/:linkId", portfolioLinkCtrl.Update)
+			own.DELETE("/:id/links/:linkId", portfolioLinkCtrl.Delete)
 		}
 
 		// Category routes
 		categories := api.Group("/categories")
 		{
-			categories.Use(authMiddleware.Authenticate()).POST("/own", categoryCtrl.Create)
-			categories.Use(authMiddleware.Authenticate()).GET("/own", categoryCtrl.List)
-			categories.Use(authMiddleware.Authenticate()).GET("/own/:id", categoryCtrl.GetByID)
-			categories.Use(authMiddleware.Authenticate()).PUT("/own/:id", categoryCtrl.Update)
-			categories.Use(authMiddleware.Authenticate()).DELETE("/own/:id", categoryCtrl.Delete)
-			categories.Use(authMiddleware.Authenticate()).POST("/own/reorder", categoryCtrl.BulkReorder)
-
-			categories.GET("/public/:id", categoryCtrl.GetPublicByID)
-			categories.GET("/id/:id", categoryCtrl.GetPublicByID)
-			categories.GET("/portfolio/:portfolioId", categoryCtrl.List)
-			categories.GET("/portfolio/:portfolioId/projects", categoryCtrl.GetPublicProjects)
+			own := authMiddleware.Protected(categories, "/own", userLimit)
+			own.POST("", categoryCtrl.Create)
+			own.GET("", categoryCtrl.List)
+			own.GET("/check-title", typingChecksLimiter.Limit(), titleCtrl.CheckTitle(appdto.TitleResourceCategories))
+			own.GET("/:id", categoryCtrl.GetByID)
+			own.GET("/:id/detail", categoryCtrl.GetDetail)
+			own.PUT("/:id", categoryCtrl.Update)
+			own.PATCH("/:id", categoryCtrl.Patch)
+			own.DELETE("/:id", categoryCtrl.Delete)
+			own.POST("/reorder", categoryCtrl.BulkReorder)
+			own.POST("/swap", categoryCtrl.Swap)
 		}
 
 		// Section routes
 		sections := api.Group("/sections")
 		{
-			sections.Use(authMiddleware.Authenticate()).POST("/own", sectionCtrl.Create)
-			sections.Use(authMiddleware.Authenticate()).GET("/own", sectionCtrl.List)
-			sections.Use(authMiddleware.Authenticate()).GET("/own/:id", sectionCtrl.GetByID)
-			sections.Use(authMiddleware.Authenticate()).PUT("/own/:id", sectionCtrl.Update)
-			sections.Use(authMiddleware.Authenticate()).DELETE("/own/:id", sectionCtrl.Delete)
-			sections.Use(authMiddleware.Authenticate()).POST("/own/reorder", sectionCtrl.BulkReorder)
-
-			sections.GET("/public/:id", sectionCtrl.GetPublicByID)
-			sections.GET("/id/:id", sectionCtrl.GetPublicByID)
-			sections.GET("/portfolio/:portfolioId", sectionCtrl.List)
-			sections.GET("/public/:id/contents", sectionCtrl.GetPublicSectionContents)
+			own := authMiddleware.Protected(sections, "/own", userLimit)
+			own.POST("", sectionCtrl.Create)
+			own.GET("", sectionCtrl.List)
+			own.GET("/check-title", typingChecksLimiter.Limit(), titleCtrl.CheckTitle(appdto.TitleResourceSections))
+			own.GET("/type/:type", sectionCtrl.ListByType)
+			own.GET("/:id", sectionCtrl.GetByID)
+			own.PUT("/:id", sectionCtrl.Update)
+			own.DELETE("/:id", sectionCtrl.Delete)
+			own.POST("/reorder", sectionCtrl.BulkReorder)
+			own.POST("/swap", sectionCtrl.Swap)
 		}
 
 		// Project routes
 		projects := api.Group("/projects")
 		{
-			projects.Use(authMiddleware.Authenticate()).POST("/own", projectCtrl.Create)
-			projects.Use(authMiddleware.Authenticate()).GET("/own", projectCtrl.List)
-			projects.Use(authMiddleware.Authenticate()).GET("/own/:id", projectCtrl.GetByID)
-			projects.Use(authMiddleware.Authenticate()).PUT("/own/:id", projectCtrl.Update)
-			projects.Use(authMiddleware.Authenticate()).DELETE("/own/:id", projectCtrl.Delete)
-
-			projects.GET("/public/:id", projectCtrl.GetPublicByID)
-			projects.GET("/category/:categoryId", projectCtrl.GetByCategory)
-			projects.GET("/search/skills", projectCtrl.SearchBySkills)
-			projects.GET("/search/client", projectCtrl.SearchByClient)
+			own := authMiddleware.Protected(projects, "/own", userLimit)
+			own.POST("", projectCtrl.Create)
+			own.GET("", projectCtrl.List)
+			own.GET("/compare", projectCtrl.Compare)
+			own.GET("/check-title", typingChecksLimiter.Limit(), titleCtrl.CheckTitle(appdto.TitleResourceProjects))
+			own.GET("/:id", projectCtrl.GetByID)
+			own.PUT("/:id", projectCtrl.Update)
+			own.PATCH("/:id", projectCtrl.Patch)
+			own.DELETE("/:id", projectCtrl.Delete)
+			own.PATCH("/reorder", projectCtrl.BulkReorder)
+			own.POST("/swap", projectCtrl.Swap)
+			own.GET("/:id/endorsements", projectCtrl.GetEndorsements)
+			own.GET("/:id/collaborators", projectCollaboratorCtrl.List)
+			own.POST("/:id/collaborators", projectCollaboratorCtrl.Create)
+			own.POST("/:id/collaborators/reorder", projectCollaboratorCtrl.Reorder)
+			own.PUT("/:id/collaborators/:collaboratorId", projectCollaboratorCtrl.Update)
+			own.DELETE("/:id/collaborators/:collaboratorId", projectCollaboratorCtrl.Delete)
+
+			// Public write, kept out of the GET-only public route table
+			projects.POST("/public/:id/skills/:skill/endorse", publicLimiter.Limit(), projectCtrl.EndorseSkill)
 		}
 
 		// Section Content routes
 		sectionContents := api.Group("/section-contents")
 		{
-			sectionContents.Use(authMiddleware.Authenticate()).POST("/own", sectionContentCtrl.Create)
-			sectionContents.Use(authMiddleware.Authenticate()).PUT("/own/:id", sectionContentCtrl.Update)
-			sectionContents.Use(authMiddleware.Authenticate()).PATCH("/own/:id/order", sectionContentCtrl.UpdateOrder)
-			sectionContents.Use(authMiddleware.Authenticate()).DELETE("/own/:id", sectionContentCtrl.Delete)
-
-			sectionContents.GET("/:id", sectionContentCtrl.GetByID)
-			sectionContents.GET("/sections/:sectionId/contents", sectionContentCtrl.ListBySection)
+			own := authMiddleware.Protected(sectionContents, "/own", userLimit)
+			own.POST("", sectionContentCtrl.Create)
+			own.PUT("/:id", sectionContentCtrl.Update)
+			own.PATCH("/:id/order", sectionContentCtrl.UpdateOrder)
+			own.DELETE("/:id", sectionContentCtrl.Delete)
+			own.GET("/:id/revisions", sectionContentCtrl.ListRevisions)
+			own.GET("/:id/revisions/:revId", sectionContentCtrl.GetRevision)
+			own.POST("/:id/revisions/:revId/revert", sectionContentCtrl.RevertRevision)
 		}
 
 		// User routes
 		users := api.Group("/users")
 		{
-			users.Use(authMiddleware.Authenticate()).GET("/me", userCtrl.GetMe)
-			users.Use(authMiddleware.Authenticate()).PUT("/me", userCtrl.UpdateMe)
+			me := authMiddleware.Protected(users, "/me", userLimit)
+			me.GET("", userCtrl.GetMe)
+			me.PUT("", userCtrl.UpdateMe)
+			me.GET("/settings", userCtrl.GetSettings)
+			me.PATCH("/settings", userCtrl.UpdateSettings)
+			me.GET("/bootstrap", userCtrl.GetBootstrap)
+			me.GET("/settings/project-defaults", userCtrl.GetProjectDefaults)
+			me.PATCH("/settings/project-defaults", userCtrl.UpdateProjectDefaults)
+		}
+
+		// Change event routes (multi-tab sync)
+		eventRoutes := api.Group("/events")
+		{
+			own := authMiddleware.Protected(eventRoutes, "/own", userLimit)
+			own.GET("/stream", eventCtrl.Stream)
 		}
+
+		// Public routes are generated from a single table into the unversioned group
+		// (current clients) and the /v1 and /v2 groups, so the versions can't diverge.
+		// The /own API stays unversioned since we control the frontend.
+		// Every public route is rate limited per client IP.
+		publicRoutes := publicRouteTable(portfolioCtrl, categoryCtrl, sectionCtrl, projectCtrl, sectionContentCtrl, userCtrl)
+		registerRoutes(api, publicRoutes, publicLimiter.Limit())
+		registerRoutes(api.Group("/v1", middleware.APIVersion(middleware.APIVersionV1)), publicRoutes, publicLimiter.Limit())
+		registerRoutes(api.Group("/v2", middleware.APIVersion(middleware.APIVersionV2)), publicRoutes, publicLimiter.Limit())
+	}
+
+	// Fail fast if an owner-scoped route was registered without auth + userID guard
+	if err := authMiddleware.AssertProtected(router); err != nil {
+		log.Fatalf("Route table check failed: %v", err)
 	}
+	logRouteDiagnostics(router.Routes())
 
 	log.Println("✅ Routes configured successfully")
 	return router
 }
 
+// ginModeFromEnv picks Gin's mode from APP_ENV: release in production, test in test and debug
+// otherwise. An explicit, valid GIN_MODE still wins
+func ginModeFromEnv() string {
+	switch mode := getEnv("GIN_MODE", ""); mode {
+	case gin.DebugMode, gin.ReleaseMode, gin.TestMode:
+		return mode
+	case "":
+	default:
+		log.Printf("⚠️  Invalid GIN_MODE %q, using APP_ENV", mode)
+	}
+
+	switch getEnv("APP_ENV", "development") {
+	case "production":
+		return gin.ReleaseMode
+	case "test":
+		return gin.TestMode
+	}
+	return gin.DebugMode
+}
+
+// logRouteDiagnostics logs the route count per group, the full route table when LOG_LEVEL=debug,
+// and a warning for every difference with the expected-routes manifest
+func logRouteDiagnostics(mounted gin.RoutesInfo) {
+	counts := routes.Summarize(mounted)
+	summary := make([]string, len(routes.Groups))
+	for i, group := range routes.Groups {
+		summary[i] = fmt.Sprintf("%s=%d", group, counts[group])
+	}
+	log.Printf("📋 Routes mounted: total=%d %s", len(mounted), strings.Join(summary, " "))
+
+	if getEnv("LOG_LEVEL", "info") == "debug" {
+		for _, route := range mounted {
+			log.Printf("   [%s] %s %s -> %s", routes.GroupOf(route.Path), route.Method, route.Path, route.Handler)
+		}
+	}
+
+	unexpected, missing := routes.Compare(mounted, routes.Manifest())
+	for _, entry := range unexpected {
+		log.Printf("⚠️  Route %s is mounted but missing from internal/interfaces/routes/manifest.txt", entry)
+	}
+	for _, entry := range missing {
+		log.Printf("⚠️  Route %s is listed in internal/interfaces/routes/manifest.txt but was not mounted", entry)
+	}
+}
+
+// routeSpec describes a single route registration
+type routeSpec struct {
+	method  string
+	path    string
+	handler gin.HandlerFunc
+}
+
+// publicRouteTable lists the unauthenticated API routes (relative to /api or /api/<version>)
+func publicRouteTable(
+	portfolioCtrl *controllers.PortfolioController,
+	categoryCtrl *controllers.CategoryController,
+	sectionCtrl *controllers.SectionController,
+	projectCtrl *controllers.ProjectController,
+	sectionContentCtrl *controllers.SectionContentController,
+	userCtrl *controllers.UserController,
+) []routeSpec {
+	return []routeSpec{
+		// Portfolio routes
+		{http.MethodGet, "/portfolios/public", portfolioCtrl.ListPublic},
+		{http.MethodGet, "/portfolios/public/:id", portfolioCtrl.GetPublicByID},
+		{http.MethodGet, "/portfolios/public/slug/:slug", portfolioCtrl.GetPublicBySlug},
+		{http.MethodGet, "/portfolios/id/:id", portfolioCtrl.GetPublicByID},
+		{http.MethodGet, "/portfolios/public/:id/categories", portfolioCtrl.GetPublicCategories},
+		{http.MethodGet, "/portfolios/public/:id/sections", portfolioCtrl.GetPublicSections},
+		{http.MethodGet, "/portfolios/public/:id/jsonld", portfolioCtrl.GetPublicJSONLD},
+		{http.MethodGet, "/portfolios/public/:id/availability", portfolioCtrl.GetPublicAvailability},
+		{http.MethodHead, "/portfolios/public/:id/availability", portfolioCtrl.GetPublicAvailability},
+		{http.MethodGet, "/portfolios/public/:id/toc", portfolioCtrl.GetPublicTOC},
+		{http.MethodGet, "/portfolios/public/:id/search", portfolioCtrl.SearchPublic},
+
+		// Category routes
+		{http.MethodGet, "/categories/public/:id", categoryCtrl.GetPublicByID},
+		{http.MethodGet, "/categories/id/:id", categoryCtrl.GetPublicByID},
+		{http.MethodGet, "/categories/portfolio/:portfolioId", portfolioCtrl.GetPublicCategories},
+		{http.MethodGet, "/categories/portfolio/:portfolioId/projects", categoryCtrl.GetPublicProjects},
+
+		// Section routes
+		{http.MethodGet, "/sections/public/:id", sectionCtrl.GetPublicByID},
+		{http.MethodGet, "/sections/id/:id", sectionCtrl.GetPublicByID},
+		{http.MethodGet, "/sections/portfolio/:portfolioId", portfolioCtrl.GetPublicSections},
+		{http.MethodGet, "/sections/public/:id/contents", sectionCtrl.GetPublicSectionContents},
+
+		// Project routes
+		{http.MethodGet, "/projects/public/search", projectCtrl.SearchPublic},
+		{http.MethodGet, "/projects/public/:id", projectCtrl.GetPublicByID},
+		{http.MethodGet, "/projects/category/:categoryId", projectCtrl.GetByCategory},
+		{http.MethodGet, "/projects/search/skills", projectCtrl.SearchBySkills},
+		{http.MethodGet, "/projects/search/client", projectCtrl.SearchByClient},
+
+		// Section Content routes
+		{http.MethodGet, "/section-contents/:id", sectionContentCtrl.GetByID},
+		{http.MethodGet, "/section-contents/sections/:sectionId/contents", sectionContentCtrl.ListBySection},
+
+		// User routes
+		{http.MethodGet, "/users/public/:userId/default-portfolio", userCtrl.GetPublicDefaultPortfolio},
+	}
+}
+
+// registerRoutes registers every route of the table on group, behind the given middleware
+func registerRoutes(group *gin.RouterGroup, routes []routeSpec, before ...gin.HandlerFunc) {
+	for _, route := range routes {
+		handlers := append(append([]gin.HandlerFunc{}, before...), route.handler)
+		group.Handle(route.method, route.path, handlers...)
+	}
+}
+
+// metricsAuth returns the Basic Auth guard of /metrics when PROMETHEUS_AUTH_USER and
+// PROMETHEUS_AUTH_PASSWORD are both set, nothing otherwise
+func metricsAuth() []gin.HandlerFunc {
+	user, password := os.Getenv("PROMETHEUS_AUTH_USER"), os.Getenv("PROMETHEUS_AUTH_PASSWORD")
+	if user == "" || password == "" {
+		return nil
+	}
+	return []gin.HandlerFunc{gin.BasicAuth(gin.Accounts{user: password})}
+}
+
 func corsMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		c.Writer.Header().Set("Access-Control-Allow-Origin", "*")
 		c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
-		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, accept, origin, Cache-Control, X-Requested-With")
+		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, accept, origin, Cache-Control, X-Requested-With, X-Request-ID")
+		c.Writer.Header().Set("Access-Control-Expose-Headers", "X-Request-ID, ETag, Retry-After")
 		c.Writer.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS, GET, PUT, DELETE, PATCH")
 
 		if c.Request.Method == "OPTIONS" {
@@ -341,11 +821,17 @@ func corsMiddleware() gin.HandlerFunc {
 	}
 }
 
-func startServer(router *gin.Engine, db *gorm.DB) {
+// startServer runs the HTTP server until SIGINT/SIGTERM, then shuts it down gracefully
+// onShutdown hooks run as soon as shutdown starts (e.g. ending long-lived streams so
+// in-flight requests can drain); onDrained runs after that, before the database is closed.
+// Requests still running after SHUTDOWN_TIMEOUT have their context cancelled, which aborts
+// their database queries, and their connections are closed.
+func startServer(router *gin.Engine, db *gorm.DB, onDrained func(), onShutdown ...func()) {
 	port := getEnv("PORT", "8000")
-	srv := &http.Server{
-		Addr:    ":" + port,
-		Handler: router,
+	srv, cancelRequests := newServer(router, ":"+port)
+	defer cancelRequests()
+	for _, hook := range onShutdown {
+		srv.RegisterOnShutdown(hook)
 	}
 
 	// Start server in goroutine
@@ -362,12 +848,10 @@ func startServer(router *gin.Engine, db *gorm.DB) {
 	<-quit
 	log.Println("🛑 Shutting down server...")
 
-	// Graceful shutdown with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
+	shutdownServer(srv, cancelRequests, getEnvDuration("SHUTDOWN_TIMEOUT", 15*time.Second))
 
-	if err := srv.Shutdown(ctx); err != nil {
-		log.Fatal("Server forced to shutdown:", err)
+	if onDrained != nil {
+		onDrained()
 	}
 
 	// Close database connection
@@ -379,6 +863,110 @@ func startServer(router *gin.Engine, db *gorm.DB) {
 	log.Println("✅ Server exited gracefully")
 }
 
+// newServer returns the HTTP server of handler on addr, and the function cancelling the
+// context of every request it serves
+func newServer(handler http.Handler, addr string) (*http.Server, context.CancelFunc) {
+	// Parent of every request context, cancelled when draining takes too long
+	baseCtx, cancelRequests := context.WithCancel(context.Background())
+	srv := &http.Server{
+		Addr:        addr,
+		Handler:     handler,
+		BaseContext: func(net.Listener) context.Context { return baseCtx },
+	}
+	return srv, cancelRequests
+}
+
+// shutdownServer stops accepting connections and waits up to timeout for in-flight requests;
+// the ones still running then have their context cancelled and their connections closed
+func shutdownServer(srv *http.Server, cancelRequests context.CancelFunc, timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	if err := srv.Shutdown(ctx); err != nil {
+		log.Printf("⚠️  Requests still running after the shutdown timeout, cancelling them: %v", err)
+		cancelRequests()
+		_ = srv.Close()
+	}
+}
+
+// runPeriodically runs job every interval (first run right away) until ctx is cancelled
+// Failures are logged and retried on the next tick.
+func runPeriodically(ctx context.Context, name string, interval time.Duration, job func(ctx context.Context) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := job(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("⚠️  %s failed: %v", name, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// revisionPolicyFromEnv reads the section content revision settings
+// SECTION_CONTENT_MAX_REVISIONS (count) and SECTION_CONTENT_REVISION_INTERVAL (duration, e.g. "5m")
+func revisionPolicyFromEnv() section_content.RevisionPolicy {
+	policy := section_content.DefaultRevisionPolicy()
+
+	policy.MaxRevisions = getEnvInt("SECTION_CONTENT_MAX_REVISIONS", policy.MaxRevisions)
+
+	if value := getEnv("SECTION_CONTENT_REVISION_INTERVAL", ""); value != "" {
+		if interval, err := time.ParseDuration(value); err == nil && interval >= 0 {
+			policy.CoalesceWindow = interval
+		} else {
+			log.Printf("⚠️  Invalid SECTION_CONTENT_REVISION_INTERVAL %q, using %s", value, policy.CoalesceWindow)
+		}
+	}
+
+	return policy
+}
+
+// getEnvInt reads a positive integer environment variable, falling back to defaultValue
+func getEnvInt(key string, defaultValue int) int {
+	value := getEnv(key, "")
+	if value == "" {
+		return defaultValue
+	}
+
+	parsed, err := strconv.Atoi(value)
+	if err != nil || parsed <= 0 {
+		log.Printf("⚠️  Invalid %s %q, using %d", key, value, defaultValue)
+		return defaultValue
+	}
+	return parsed
+}
+
+// getEnvDuration reads a positive duration environment variable (e.g. "30s"), falling back to defaultValue
+func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	value := getEnv(key, "")
+	if value == "" {
+		return defaultValue
+	}
+
+	parsed, err := time.ParseDuration(value)
+	if err != nil || parsed <= 0 {
+		log.Printf("⚠️  Invalid %s %q, using %s", key, value, defaultValue)
+		return defaultValue
+	}
+	return parsed
+}
+
+// getEnvList reads a comma-separated environment variable, skipping empty items
+func getEnvList(key string) []string {
+	var items []string
+	for _, item := range strings.Split(getEnv(key, ""), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
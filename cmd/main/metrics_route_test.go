@@ -0,0 +1,71 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func scrapeMetrics(t *testing.T, handler http.Handler, user, password string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	if user != "" {
+		req.SetBasicAuth(user, password)
+	}
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	return w
+}
+
+func TestMetricsRoute(t *testing.T) {
+	router := newTestRouter(t)
+
+	// One request so the per-route families have a sample
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	w = scrapeMetrics(t, router, "", "")
+	if w.Code != http.StatusOK {
+		t.Fatalf("GET /metrics without credentials = %d, want %d", w.Code, http.StatusOK)
+	}
+	if ct := w.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/plain") {
+		t.Errorf("Content-Type = %q, want the text exposition format", ct)
+	}
+
+	body := w.Body.String()
+	for _, want := range []string{
+		"# TYPE http_requests_total counter",
+		"# TYPE http_request_duration_seconds histogram",
+		"# TYPE portfolios_created_total counter",
+		`http_requests_total{method="GET",path="/healthz",status="200"}`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("scrape is missing %q", want)
+		}
+	}
+}
+
+func TestMetricsRoute_BasicAuth(t *testing.T) {
+	t.Setenv("PROMETHEUS_AUTH_USER", "scraper")
+	t.Setenv("PROMETHEUS_AUTH_PASSWORD", "secret")
+	router := newTestRouter(t)
+
+	tests := []struct {
+		name           string
+		user, password string
+		wantStatus     int
+	}{
+		{name: "no credentials", wantStatus: http.StatusUnauthorized},
+		{name: "wrong password", user: "scraper", password: "nope", wantStatus: http.StatusUnauthorized},
+		{name: "valid credentials", user: "scraper", password: "secret", wantStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if w := scrapeMetrics(t, router, tt.user, tt.password); w.Code != tt.wantStatus {
+				t.Errorf("GET /metrics = %d, want %d", w.Code, tt.wantStatus)
+			}
+		})
+	}
+}
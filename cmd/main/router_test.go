@@ -0,0 +1,145 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/prometheus"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/interfaces/controllers"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/interfaces/middleware"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/interfaces/routes"
+	"github.com/gin-gonic/gin"
+)
+
+// testMetrics is shared: the collector registers its metrics globally once
+var testMetrics = prometheus.NewMetricsCollector()
+
+type databaseUp struct{}
+
+func (databaseUp) Available() bool { return true }
+
+// newTestRouter builds the production router on controllers without use cases, so requests
+// only exercise what runs before a handler reaches one (routing, middleware, parameter checks);
+// a handler that gets further panics into a 500
+func newTestRouter(t *testing.T) *gin.Engine {
+	t.Helper()
+	t.Setenv("GIN_MODE", gin.TestMode)
+	gin.DefaultWriter = io.Discard
+	gin.DefaultErrorWriter = io.Discard
+
+	limiter := middleware.NewRateLimiter("test", 1000, 100000, middleware.RateLimitByClientIP,
+		middleware.NewMemoryRateLimitStore(), nil, controllers.RespondError)
+	return setupRouter(
+		middleware.NewAuthMiddleware(nil, nil, controllers.RespondError),
+		middleware.NewConcurrencyLimiter(1, nil, controllers.RespondError),
+		limiter, limiter, limiter,
+		nil,
+		databaseUp{},
+		testMetrics,
+		&controllers.PortfolioController{},
+		&controllers.CategoryController{},
+		&controllers.SectionController{},
+		&controllers.ProjectController{},
+		&controllers.SectionContentController{},
+		&controllers.PortfolioLinkController{},
+		&controllers.ProjectCollaboratorController{},
+		&controllers.UserController{},
+		&controllers.EventController{},
+		&controllers.TitleController{},
+		&controllers.HealthController{},
+	)
+}
+
+// fillParams replaces the parameters of a route path with value
+func fillParams(path, value string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, ":") {
+			segments[i] = value
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+var apiVersionPrefixes = []string{"/api", "/api/v1", "/api/v2"}
+
+func TestPublicRoutesServedPerVersion(t *testing.T) {
+	router := newTestRouter(t)
+	mounted := make(map[string]bool)
+	for _, route := range router.Routes() {
+		mounted[route.Method+" "+route.Path] = true
+	}
+
+	for _, prefix := range apiVersionPrefixes {
+		for _, route := range publicRouteTable(nil, nil, nil, nil, nil, nil) {
+			t.Run(route.method+" "+prefix+route.path, func(t *testing.T) {
+				if !mounted[route.method+" "+prefix+route.path] {
+					t.Fatalf("route not mounted under %s", prefix)
+				}
+
+				w := httptest.NewRecorder()
+				router.ServeHTTP(w, httptest.NewRequest(route.method, prefix+fillParams(route.path, "not-an-id"), nil))
+				if w.Code == http.StatusUnauthorized || w.Code == http.StatusForbidden {
+					t.Fatalf("status = %d: public route requires authentication", w.Code)
+				}
+			})
+		}
+	}
+}
+
+func TestPublicRouteErrorsPerVersion(t *testing.T) {
+	router := newTestRouter(t)
+
+	tests := []struct {
+		prefix   string
+		wantCode string // empty for the v1 envelope, which has no code
+	}{
+		{prefix: "/api", wantCode: apperrors.CodeValidationInvalidID},
+		{prefix: "/api/v1"},
+		{prefix: "/api/v2", wantCode: apperrors.CodeValidationInvalidID},
+	}
+
+	for _, tt := range tests {
+		for _, path := range []string{"/portfolios/public/x", "/categories/portfolio/x", "/sections/portfolio/x"} {
+			t.Run(tt.prefix+path, func(t *testing.T) {
+				w := httptest.NewRecorder()
+				router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.prefix+path, nil))
+
+				if w.Code != http.StatusBadRequest {
+					t.Fatalf("status = %d, want 400 (%s)", w.Code, w.Body.String())
+				}
+				var body map[string]interface{}
+				if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+					t.Fatalf("decode response: %v", err)
+				}
+				if body["error"] == nil {
+					t.Errorf("body = %v, want an error message", body)
+				}
+				code, hasCode := body["code"]
+				if tt.wantCode == "" && hasCode {
+					t.Errorf("v1 body has code %v, want the error field only", code)
+				}
+				if tt.wantCode != "" && code != tt.wantCode {
+					t.Errorf("code = %v, want %s", code, tt.wantCode)
+				}
+			})
+		}
+	}
+}
+
+// The route manifest doubles as a test: adding or removing a route without updating
+// internal/interfaces/routes/manifest.txt fails here instead of only warning at startup
+func TestRoutesMatchManifest(t *testing.T) {
+	unexpected, missing := routes.Compare(newTestRouter(t).Routes(), routes.Manifest())
+	for _, entry := range unexpected {
+		t.Errorf("route %s is mounted but missing from the manifest", entry)
+	}
+	for _, entry := range missing {
+		t.Errorf("route %s is in the manifest but not mounted", entry)
+	}
+}
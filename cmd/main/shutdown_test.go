@@ -0,0 +1,123 @@
+package main
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// serveSlow starts a server on a free port whose handler signals started and then runs wait
+func serveSlow(t *testing.T, wait func(r *http.Request)) (*http.Server, func(), string, chan struct{}) {
+	t.Helper()
+	started := make(chan struct{}, 1)
+	srv, cancelRequests := newServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		started <- struct{}{}
+		wait(r)
+		_, _ = io.WriteString(w, "done")
+	}), "")
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	go func() { _ = srv.Serve(ln) }()
+	t.Cleanup(func() { _ = srv.Close() })
+
+	return srv, cancelRequests, "http://" + ln.Addr().String(), started
+}
+
+type result struct {
+	status int
+	body   string
+	err    error
+}
+
+func get(url string) <-chan result {
+	done := make(chan result, 1)
+	go func() {
+		resp, err := http.Get(url)
+		if err != nil {
+			done <- result{err: err}
+			return
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		done <- result{status: resp.StatusCode, body: string(body), err: err}
+	}()
+	return done
+}
+
+func TestShutdownServer_DrainsInFlightRequests(t *testing.T) {
+	release := make(chan struct{})
+	srv, cancelRequests, url, started := serveSlow(t, func(*http.Request) { <-release })
+
+	slow := get(url)
+	<-started
+
+	stopped := make(chan struct{})
+	go func() {
+		shutdownServer(srv, cancelRequests, 5*time.Second)
+		close(stopped)
+	}()
+
+	// The listener closes as soon as shutdown starts
+	addr := url[len("http://"):]
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		conn, err := net.Dial("tcp", addr)
+		if err != nil {
+			break
+		}
+		conn.Close()
+		if time.Now().After(deadline) {
+			t.Fatal("new connections still accepted after shutdown started")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	select {
+	case <-stopped:
+		t.Fatal("shutdown returned before the in-flight request finished")
+	default:
+	}
+
+	close(release)
+	res := <-slow
+	if res.err != nil || res.status != http.StatusOK || res.body != "done" {
+		t.Errorf("in-flight request = %d %q (%v), want 200 \"done\"", res.status, res.body, res.err)
+	}
+
+	select {
+	case <-stopped:
+	case <-time.After(2 * time.Second):
+		t.Fatal("shutdown didn't return after the last request finished")
+	}
+}
+
+func TestShutdownServer_CancelsStragglers(t *testing.T) {
+	cancelled := make(chan error, 1)
+	srv, cancelRequests, url, started := serveSlow(t, func(r *http.Request) {
+		<-r.Context().Done()
+		cancelled <- r.Context().Err()
+	})
+
+	get(url)
+	<-started
+
+	begin := time.Now()
+	shutdownServer(srv, cancelRequests, 50*time.Millisecond)
+	if elapsed := time.Since(begin); elapsed > 2*time.Second {
+		t.Errorf("shutdown took %v, want about the 50ms timeout", elapsed)
+	}
+
+	select {
+	case err := <-cancelled:
+		if err == nil {
+			t.Error("request context done without an error")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("the straggler's context was not cancelled")
+	}
+}
@@ -0,0 +1,114 @@
+// Command migrate applies, rolls back and lists the versioned database migrations.
+// The API applies pending migrations on startup; this is for deployments that migrate
+// ahead of a rollout and for undoing the last migrations by hand.
+//
+//	go run ./cmd/migrate up        # same as an API start: schema sync, pending migrations, triggers
+//	go run ./cmd/migrate down [n]  # roll back the last n migrations (default 1)
+//	go run ./cmd/migrate status    # list every migration and when it was applied
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+
+	"github.com/joho/godotenv"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/migrations"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/searchindex"
+)
+
+const usage = "usage: migrate up | down [n] | status"
+
+func main() {
+	if len(os.Args) < 2 {
+		log.Fatal(usage)
+	}
+
+	if err := godotenv.Load(); err != nil {
+		log.Println("No .env file found, using system environment variables")
+	}
+
+	db, err := openDatabase()
+	if err != nil {
+		log.Fatalf("Failed to initialize database: %v", err)
+	}
+
+	switch os.Args[1] {
+	case "up":
+		if err := migrations.Migrate(db, getEnv("SEARCH_TEXT_CONFIG", searchindex.DefaultConfig)); err != nil {
+			log.Fatalf("Failed to run migrations: %v", err)
+		}
+
+	case "down":
+		n := 1
+		if len(os.Args) > 2 {
+			n, err = strconv.Atoi(os.Args[2])
+			if err != nil || n < 1 {
+				log.Fatalf("down takes a positive number of migrations, got %q", os.Args[2])
+			}
+		}
+		rolledBack, err := migrations.NewRunner(db, migrations.All).Down(n)
+		for _, id := range rolledBack {
+			log.Printf("✅ Rolled back %s", id)
+		}
+		if err != nil {
+			log.Fatalf("Rollback stopped: %v", err)
+		}
+		if len(rolledBack) == 0 {
+			log.Println("No applied migrations to roll back")
+		}
+
+	case "status":
+		statuses, err := migrations.NewRunner(db, migrations.All).Status()
+		if err != nil {
+			log.Fatalf("Failed to read migration status: %v", err)
+		}
+		for _, status := range statuses {
+			state := "pending"
+			if status.AppliedAt != nil {
+				state = "applied " + status.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			if !status.Known {
+				state += " (not defined in this build)"
+			}
+			fmt.Printf("%-45s %s\n", status.ID, state)
+		}
+
+	default:
+		log.Fatal(usage)
+	}
+}
+
+// openDatabase connects with the same DB_* variables as the API
+func openDatabase() (*gorm.DB, error) {
+	dsn := fmt.Sprintf(
+		"host=%s user=%s password=%s dbname=%s port=%s sslmode=%s",
+		getEnv("DB_HOST", "localhost"),
+		getEnv("DB_USER", "postgres"),
+		getEnv("DB_PASSWORD", "postgres"),
+		getEnv("DB_NAME", "portfolio"),
+		getEnv("DB_PORT", "5432"),
+		getEnv("DB_SSLMODE", "disable"),
+	)
+
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Warn),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	return db, nil
+}
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
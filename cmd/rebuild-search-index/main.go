@@ -0,0 +1,112 @@
+// Command rebuild-search-index recomputes the search vectors of existing rows.
+// Run it once after the search index rollout and whenever SEARCH_TEXT_CONFIG changes;
+// new and edited rows are kept current by the triggers the API sets up on startup.
+//
+//	go run ./cmd/rebuild-search-index [-table=projects] [-after-id=0] [-batch-size=500]
+//
+// An interrupted run logs the -table and -after-id to resume from.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/joho/godotenv"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/searchindex"
+)
+
+func main() {
+	table := flag.String("table", "", "only rebuild this table (default: every indexed table)")
+	afterID := flag.Uint("after-id", 0, "resume after this ID (requires -table)")
+	batchSize := flag.Int("batch-size", 500, "rows updated per statement")
+	flag.Parse()
+
+	if err := godotenv.Load(); err != nil {
+		log.Println("No .env file found, using system environment variables")
+	}
+
+	if *batchSize <= 0 {
+		log.Fatalf("-batch-size must be positive")
+	}
+	if *afterID > 0 && *table == "" {
+		log.Fatalf("-after-id requires -table")
+	}
+
+	indexes := searchindex.Indexes
+	if *table != "" {
+		index, ok := searchindex.Lookup(*table)
+		if !ok {
+			log.Fatalf("Table %q has no search index", *table)
+		}
+		indexes = []searchindex.Index{index}
+	}
+
+	db, err := openDatabase()
+	if err != nil {
+		log.Fatalf("Failed to initialize database: %v", err)
+	}
+
+	// Triggers first: rows written during the rebuild must already use the current config
+	config := getEnv("SEARCH_TEXT_CONFIG", searchindex.DefaultConfig)
+	if err := searchindex.EnsureTriggers(db, config); err != nil {
+		log.Fatalf("Failed to set up search index triggers: %v", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	for _, index := range indexes {
+		log.Printf("Rebuilding %s search vectors (config %s)...", index.Table, config)
+
+		lastID := uint(*afterID)
+		rebuilt, err := searchindex.Rebuild(ctx, db, index, config, lastID, *batchSize, func(id uint, rebuilt int64) {
+			lastID = id
+			log.Printf("  %s: %d rows rebuilt, last id %d", index.Table, rebuilt, id)
+		})
+		if err != nil {
+			log.Fatalf("Rebuild of %s stopped after %d rows: %v (resume with -table=%s -after-id=%d)",
+				index.Table, rebuilt, err, index.Table, lastID)
+		}
+
+		log.Printf("✅ %s: %d rows rebuilt", index.Table, rebuilt)
+		*afterID = 0
+	}
+}
+
+// openDatabase connects with the same DB_* variables as the API
+func openDatabase() (*gorm.DB, error) {
+	dsn := fmt.Sprintf(
+		"host=%s user=%s password=%s dbname=%s port=%s sslmode=%s",
+		getEnv("DB_HOST", "localhost"),
+		getEnv("DB_USER", "postgres"),
+		getEnv("DB_PASSWORD", "postgres"),
+		getEnv("DB_NAME", "portfolio"),
+		getEnv("DB_PORT", "5432"),
+		getEnv("DB_SSLMODE", "disable"),
+	)
+
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Warn),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	return db, nil
+}
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
@@ -0,0 +1,39 @@
+// Package actor carries the identity that performed a request through the context,
+// so persistence and audit code can record who made a change.
+package actor
+
+import "context"
+
+// Credential discriminators
+const (
+	CredentialJWT          = "jwt"
+	CredentialAPIKeyPrefix = "apikey:"
+)
+
+// APIKeyCredential is the credential discriminator of the API key named name
+func APIKeyCredential(name string) string {
+	return CredentialAPIKeyPrefix + name
+}
+
+type contextKey struct{}
+
+// Format combines an internal user ID and the credential used into a single value,
+// e.g. "<userID>/jwt" or "<userID>/apikey:<name>"
+// User IDs never contain "/" (see middleware.RequireUserID), so the value is unambiguous.
+func Format(userID, credential string) string {
+	if credential == "" {
+		return userID
+	}
+	return userID + "/" + credential
+}
+
+// WithActor returns a copy of ctx carrying the formatted actor
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, contextKey{}, actor)
+}
+
+// FromContext returns the actor stored in ctx, or "" when the request is unauthenticated
+func FromContext(ctx context.Context) string {
+	actor, _ := ctx.Value(contextKey{}).(string)
+	return actor
+}
@@ -0,0 +1,248 @@
+// Package apperrors defines typed application errors.
+// Each error carries a stable machine-readable code plus the parameters needed
+// to render a localized message; the English message is kept for logs and as
+// the fallback rendering.
+package apperrors
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Kind classifies an application error so the transport layer can pick a status code
+type Kind string
+
+const (
+	// KindValidation is invalid input from the client
+	KindValidation Kind = "validation"
+
+	// KindRateLimited is a request refused because a usage limit was reached
+	KindRateLimited Kind = "rate_limited"
+
+	// KindUnprocessable is a well-formed request that cannot be applied as a whole
+	KindUnprocessable Kind = "unprocessable"
+
+	// KindGone is a request for an item the caller deleted (still in the soft-delete trash)
+	KindGone Kind = "gone"
+
+	// KindConflict is a request clashing with existing data, such as a title already in use
+	KindConflict Kind = "conflict"
+
+	// KindTooLarge is a request body over the size accepted by the endpoint
+	KindTooLarge Kind = "too_large"
+
+	// KindUnauthenticated is a request without valid credentials
+	KindUnauthenticated Kind = "unauthenticated"
+)
+
+// Error codes. Codes are part of the API contract: never rename them, only add new ones.
+const (
+	// Validation
+	CodeValidationRequired      = "VALIDATION_REQUIRED"
+	CodeValidationMin           = "VALIDATION_MIN"
+	CodeValidationMax           = "VALIDATION_MAX"
+	CodeValidationURL           = "VALIDATION_URL"
+	CodeValidationOneOf         = "VALIDATION_ONEOF"
+	CodeValidationInvalid       = "VALIDATION_INVALID"
+	CodeValidationInteger       = "VALIDATION_INTEGER"
+	CodeValidationMalformedBody = "VALIDATION_MALFORMED_BODY"
+	CodeValidationInvalidID     = "VALIDATION_INVALID_ID"
+
+	// Duplicate titles
+	CodePortfolioDuplicateTitle = "PORTFOLIO_DUPLICATE_TITLE"
+	CodeSectionDuplicateTitle   = "SECTION_DUPLICATE_TITLE"
+
+	// Portfolio links
+	CodePortfolioLinkInvalid = "PORTFOLIO_LINK_INVALID"
+	CodePortfolioLinkLimit   = "PORTFOLIO_LINK_LIMIT"
+
+	// Project collaborators
+	CodeProjectCollaboratorInvalid = "PROJECT_COLLABORATOR_INVALID"
+	CodeProjectCollaboratorLimit   = "PROJECT_COLLABORATOR_LIMIT"
+
+	// Portfolio import
+	CodePortfolioImportVersion = "PORTFOLIO_IMPORT_VERSION"
+	CodePortfolioImportLimit   = "PORTFOLIO_IMPORT_LIMIT"
+
+	// Skill endorsements
+	CodeEndorsementLimit = "ENDORSEMENT_LIMIT"
+
+	// Reordering
+	CodeReorderMixedParents      = "REORDER_MIXED_PARENTS"
+	CodeReorderMixedCategories   = "REORDER_MIXED_CATEGORIES"
+	CodeReorderDuplicateItem     = "REORDER_DUPLICATE_ITEM"
+	CodeReorderDuplicatePosition = "REORDER_DUPLICATE_POSITION"
+
+	// Category deletion
+	CodeCategoryMoveTargetSelf           = "CATEGORY_MOVE_TARGET_SELF"
+	CodeCategoryMoveTargetOtherPortfolio = "CATEGORY_MOVE_TARGET_OTHER_PORTFOLIO"
+
+	// Deleted items
+	CodeResourceDeleted = "RESOURCE_DELETED"
+
+	// Authentication and ownership
+	CodeUnauthenticated = "UNAUTHENTICATED"
+	CodeAccessDenied    = "ACCESS_DENIED"
+
+	// Missing items: owner routes addressing one item use the code of its resource
+	CodeNotFound               = "NOT_FOUND"
+	CodePortfolioNotFound      = "PORTFOLIO_NOT_FOUND"
+	CodeCategoryNotFound       = "CATEGORY_NOT_FOUND"
+	CodeProjectNotFound        = "PROJECT_NOT_FOUND"
+	CodeSectionNotFound        = "SECTION_NOT_FOUND"
+	CodeSectionContentNotFound = "SECTION_CONTENT_NOT_FOUND"
+	CodePortfolioLinkNotFound  = "PORTFOLIO_LINK_NOT_FOUND"
+
+	// References (foreign keys) to items that don't exist
+	CodeReferenceNotFound = "REFERENCE_NOT_FOUND"
+
+	// Limits and availability
+	CodeRateLimited                 = "RATE_LIMITED"
+	CodeTooManyConcurrentOperations = "TOO_MANY_CONCURRENT_OPERATIONS"
+	CodeTooManyEventStreams         = "TOO_MANY_EVENT_STREAMS"
+	CodeDatabaseUnavailable         = "DATABASE_UNAVAILABLE"
+	CodeServiceUnavailable          = "SERVICE_UNAVAILABLE"
+
+	// Codes derived from the status of errors without a specific code
+	CodeBadRequest      = "BAD_REQUEST"
+	CodePayloadTooLarge = "PAYLOAD_TOO_LARGE"
+	CodeConflict        = "CONFLICT"
+	CodeNotImplemented  = "NOT_IMPLEMENTED"
+	CodeInternal        = "INTERNAL_ERROR"
+)
+
+// Error is an application error with a code and message parameters
+type Error struct {
+	Kind    Kind
+	Code    string
+	Message string
+	Params  map[string]interface{}
+	Details map[string]interface{} // Structured data returned to the client with the message
+	Err     error
+}
+
+// Error returns the English message
+func (e *Error) Error() string {
+	if e.Err != nil && e.Message == "" {
+		return e.Err.Error()
+	}
+	return e.Message
+}
+
+// Unwrap returns the underlying cause, if any
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// New creates an application error
+func New(kind Kind, code, message string, params map[string]interface{}) *Error {
+	return &Error{Kind: kind, Code: code, Message: message, Params: params}
+}
+
+// Required creates a validation error for a missing field
+func Required(field, message string) *Error {
+	return New(KindValidation, CodeValidationRequired, message, map[string]interface{}{"field": field})
+}
+
+// MixedParents creates the error for a reorder whose items belong to several portfolios
+// groups maps each portfolio ID to the IDs of the submitted items it holds.
+func MixedParents(resource string, groups map[uint][]uint) *Error {
+	err := New(KindUnprocessable, CodeReorderMixedParents,
+		resource+" from different portfolios cannot be reordered together; send one reorder per portfolio",
+		map[string]interface{}{"resource": resource})
+	err.Details = map[string]interface{}{"portfolios": groups}
+	return err
+}
+
+// MixedCategories creates the error for a project reorder whose items belong to several categories
+// groups maps each category ID to the IDs of the submitted items it holds.
+func MixedCategories(resource string, groups map[uint][]uint) *Error {
+	err := New(KindUnprocessable, CodeReorderMixedCategories,
+		resource+" from different categories cannot be reordered together; send one reorder per category",
+		map[string]interface{}{"resource": resource})
+	err.Details = map[string]interface{}{"categories": groups}
+	return err
+}
+
+// DuplicateTitle creates the conflict error for a title already used by another resource of the same scope
+// The details name the resource and the field so clients don't have to match the message.
+func DuplicateTitle(code, resource, title, message string) *Error {
+	err := New(KindConflict, code, message, map[string]interface{}{"title": title})
+	err.Details = map[string]interface{}{
+		"reason":   "duplicate_title",
+		"resource": resource,
+		"field":    "title",
+	}
+	return err
+}
+
+// DuplicateReorderItem creates the validation error for an item listed twice in a reorder
+func DuplicateReorderItem(id uint) *Error {
+	return New(KindValidation, CodeReorderDuplicateItem,
+		"item listed more than once in the reorder", map[string]interface{}{"id": id})
+}
+
+// DuplicateReorderPosition creates the validation error for two items of a reorder given the same position
+func DuplicateReorderPosition(position uint) *Error {
+	return New(KindValidation, CodeReorderDuplicatePosition,
+		"position assigned to more than one item in the reorder", map[string]interface{}{"position": position})
+}
+
+// Unauthenticated creates the error for a request without valid credentials
+// message says what is wrong with them, for logs and clients without a localized catalog.
+func Unauthenticated(message string) *Error {
+	return New(KindUnauthenticated, CodeUnauthenticated, message, nil)
+}
+
+// RateLimited creates the error for a request over a rate limit
+func RateLimited() *Error {
+	return New(KindRateLimited, CodeRateLimited, "too many requests, slow down", nil)
+}
+
+// TooManyConcurrentOperations creates the error for a heavy operation over the user's budget
+// running lists what the user is currently running, so the client can tell what to wait for.
+func TooManyConcurrentOperations(running interface{}) *Error {
+	err := New(KindRateLimited, CodeTooManyConcurrentOperations,
+		"too many concurrent operations, wait for a running one to finish", nil)
+	err.Details = map[string]interface{}{"running": running}
+	return err
+}
+
+// PayloadTooLarge creates the error for a request body over maxBytes
+func PayloadTooLarge(maxBytes int64) *Error {
+	return New(KindTooLarge, CodePayloadTooLarge,
+		fmt.Sprintf("request body cannot exceed %d bytes", maxBytes), map[string]interface{}{"max": maxBytes})
+}
+
+// ImportLimit creates the error for an import document with more entries of a kind than allowed
+func ImportLimit(kind string, count, max int) *Error {
+	err := New(KindUnprocessable, CodePortfolioImportLimit,
+		fmt.Sprintf("an import can have at most %d %s", max, kind), map[string]interface{}{"kind": kind, "max": max})
+	err.Details = map[string]interface{}{
+		"kind":  kind,
+		"count": count,
+		"max":   max,
+	}
+	return err
+}
+
+// Deleted creates the error for an item the caller soft-deleted, with when it was deleted
+func Deleted(resource string, id uint, deletedAt time.Time) *Error {
+	err := New(KindGone, CodeResourceDeleted, resource+" was deleted", map[string]interface{}{"resource": resource})
+	err.Details = map[string]interface{}{
+		"resource":   resource,
+		"id":         id,
+		"deleted_at": deletedAt,
+	}
+	return err
+}
+
+// As returns the application error wrapped in err, if any
+func As(err error) (*Error, bool) {
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		return appErr, true
+	}
+	return nil, false
+}
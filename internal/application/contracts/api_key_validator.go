@@ -0,0 +1,11 @@
+package contracts
+
+// APIKeyValidator defines the contract for resolving API keys
+// API keys are the long-lived credentials of scripts and integrations acting for a user
+// Infrastructure implements this; without one, API keys are refused
+type APIKeyValidator interface {
+	// ValidateAPIKey validates an API key
+	// Input: API key string
+	// Output: user ID of the key's owner and the name the owner gave the key
+	ValidateAPIKey(key string) (userID, name string, err error)
+}
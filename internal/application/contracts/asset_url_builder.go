@@ -0,0 +1,14 @@
+package contracts
+
+// AssetURLBuilder defines the contract for turning stored asset paths into public URLs
+// Images are stored as relative paths (/uploads/...), so moving them behind a CDN or
+// another domain only changes the builder's configuration, never the stored data.
+// Infrastructure implements this (e.g., a configured base URL or CDN prefix)
+type AssetURLBuilder interface {
+	// AbsoluteURL returns the absolute public URL of a stored path
+	// Paths that are already absolute URLs are returned unchanged
+	AbsoluteURL(path string) string
+
+	// BaseURL returns the public base URL assets are served from ("" when paths stay relative)
+	BaseURL() string
+}
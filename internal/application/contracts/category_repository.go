@@ -15,12 +15,16 @@ type CategoryRepository interface {
 	// GetByID retrieves a category by its ID (basic info only)
 	GetByID(ctx context.Context, id uint) (*dto2.CategoryDTO, error)
 
-	// GetByIDs retrieves multiple categories by their IDs
+	// GetByIDs retrieves multiple categories by their IDs (ordered by ID)
 	GetByIDs(ctx context.Context, ids []uint) ([]dto2.CategoryDTO, error)
 
 	// GetByPortfolioID retrieves all categories for a specific portfolio (ordered by position)
 	GetByPortfolioID(ctx context.Context, portfolioID uint) ([]dto2.CategoryDTO, error)
 
+	// GetOwnerCategoryDetail retrieves a category owned (through its portfolio) by ownerID
+	// with one page of its projects and their main images, in a constant number of queries
+	GetOwnerCategoryDetail(ctx context.Context, input dto2.CategoryDetailInput) (*dto2.CategoryDetailDTO, error)
+
 	// GetByOwnerID retrieves all categories owned by a specific user with pagination
 	// Returns the list of categories, total count, and any error
 	GetByOwnerID(ctx context.Context, ownerID string, pagination dto2.PaginationDTO) ([]dto2.CategoryDTO, int64, error)
@@ -28,12 +32,22 @@ type CategoryRepository interface {
 	// Update updates an existing category
 	Update(ctx context.Context, input dto2.UpdateCategoryInput) error
 
+	// Patch updates only the provided fields of a category
+	Patch(ctx context.Context, input dto2.PatchCategoryInput) error
+
 	// UpdatePosition updates only the position field of a category
 	UpdatePosition(ctx context.Context, id uint, position uint) error
 
+	// SwapPositions exchanges the positions of two categories of the same portfolio in one transaction
+	SwapPositions(ctx context.Context, firstID, secondID uint) error
+
 	// BulkUpdatePositions updates positions for multiple categories in a transaction
 	BulkUpdatePositions(ctx context.Context, input dto2.BulkUpdateCategoryPositionsInput) error
 
 	// Delete deletes a category by its ID (cascade deletes projects)
 	Delete(ctx context.Context, id uint) error
+
+	// DeleteMovingProjects moves the projects of a category to targetID, suffixing titles already
+	// used there, then deletes the emptied category, in one transaction. Returns the moved project IDs
+	DeleteMovingProjects(ctx context.Context, id, targetID uint) ([]uint, error)
 }
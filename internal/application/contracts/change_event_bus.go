@@ -0,0 +1,36 @@
+package contracts
+
+import "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+
+// ChangeEventBus fans out change events to the live streams of the user they belong to
+type ChangeEventBus interface {
+	// Publish records the event for the user and delivers it to their open streams
+	Publish(userID string, event dto.ChangeEventDTO)
+
+	// Subscribe opens a stream of the user's events, replaying those after lastEventID
+	// (0 replays nothing). Returns ErrTooManyChangeStreams when the user is at the cap
+	// and ErrChangeEventBusClosed once the bus is shutting down.
+	Subscribe(userID string, lastEventID uint64) (ChangeSubscription, error)
+
+	// Recent returns up to limit of the user's buffered events, most recent first
+	Recent(userID string, limit int) []dto.ChangeEventDTO
+
+	// Close ends every open stream and rejects new ones (server shutdown)
+	Close()
+}
+
+// ChangeSubscription is one open stream of change events
+type ChangeSubscription interface {
+	// Replay returns the buffered events missed since the Last-Event-ID
+	Replay() []dto.ChangeEventDTO
+
+	// Resync reports that events were missed beyond what the buffer holds,
+	// so the client should refetch everything instead of relying on the replay
+	Resync() bool
+
+	// Events delivers live events; it is closed on shutdown or when the subscriber falls behind
+	Events() <-chan dto.ChangeEventDTO
+
+	// Close releases the stream (safe to call more than once)
+	Close()
+}
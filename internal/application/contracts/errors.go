@@ -0,0 +1,29 @@
+package contracts
+
+import "errors"
+
+// ErrUniqueCandidatesExhausted is returned by repositories when every generated
+// candidate for a unique identifier (slug suffix, token, key) collided with an
+// existing row. It is transient from the client's point of view (503).
+var ErrUniqueCandidatesExhausted = errors.New("could not generate a unique identifier, please retry")
+
+// ErrPortfolioLinkLimitReached is returned when a portfolio already has the maximum number of links
+var ErrPortfolioLinkLimitReached = errors.New("portfolio link limit reached")
+
+// ErrProjectCollaboratorLimitReached is returned when a project already has the maximum number of collaborators
+var ErrProjectCollaboratorLimitReached = errors.New("project collaborator limit reached")
+
+// ErrTooManyChangeStreams is returned by ChangeEventBus.Subscribe when the user
+// already has the maximum number of open streams
+var ErrTooManyChangeStreams = errors.New("too many open event streams")
+
+// ErrChangeEventBusClosed is returned by ChangeEventBus.Subscribe during shutdown
+var ErrChangeEventBusClosed = errors.New("event stream is shutting down")
+
+// ErrDatabaseUnavailable wraps repository errors caused by a lost or refused database
+// connection (failover, restart). It is transient from the client's point of view (503).
+var ErrDatabaseUnavailable = errors.New("database temporarily unavailable, please retry")
+
+// ErrReferenceNotFound wraps repository errors caused by a foreign key pointing at a row
+// that doesn't exist (or was purged meanwhile). It is a client error (400).
+var ErrReferenceNotFound = errors.New("referenced item does not exist")
@@ -30,4 +30,20 @@ type MetricsCollector interface {
 	// HTTP metrics
 	RecordHttpDuration(method, path string, status int, duration float64)
 	IncrementHttpRequests(method, path string, status int)
+
+	// Heavy operation limiter metrics
+	AddHeavyOperationsInFlight(operation string, delta int)
+	IncrementHeavyOperationRejections(operation string)
+
+	// Rate limiter metrics
+	IncrementRateLimitRejections(limiter string)
+
+	// Response compression metrics
+	AddCompressedResponse(originalBytes, encodedBytes int)
+
+	// Change event stream metrics
+	AddEventStreamConnections(delta int)
+
+	// Search index metrics
+	SetSearchVectorsMissing(table string, count int64)
 }
@@ -0,0 +1,30 @@
+package contracts
+
+import (
+	"context"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// PortfolioLinkRepository defines the interface for portfolio link data persistence
+type PortfolioLinkRepository interface {
+	// Create creates a new link, failing with ErrPortfolioLinkLimitReached when the
+	// portfolio already has maxLinks live links (checked atomically with the insert).
+	// Also returns the portfolio's live link count including the new link
+	Create(ctx context.Context, input dto.CreatePortfolioLinkInput, maxLinks int) (*dto.PortfolioLinkDTO, int64, error)
+
+	// GetByID retrieves a link by its ID
+	GetByID(ctx context.Context, id uint) (*dto.PortfolioLinkDTO, error)
+
+	// GetByPortfolioID retrieves all links of a portfolio (ordered by position)
+	GetByPortfolioID(ctx context.Context, portfolioID uint) ([]dto.PortfolioLinkDTO, error)
+
+	// Update updates an existing link
+	Update(ctx context.Context, input dto.UpdatePortfolioLinkInput) error
+
+	// BulkUpdatePositions updates the positions of links of a portfolio in a transaction
+	BulkUpdatePositions(ctx context.Context, portfolioID uint, items []dto.BulkUpdatePositionItem) error
+
+	// Delete deletes a link by its ID
+	Delete(ctx context.Context, id uint) error
+}
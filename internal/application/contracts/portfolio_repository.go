@@ -15,16 +15,59 @@ type PortfolioRepository interface {
 	// GetByID retrieves a portfolio by its ID
 	GetByID(ctx context.Context, id uint) (*dto.PortfolioDTO, error)
 
+	// GetBySlug retrieves a live portfolio by its slug
+	GetBySlug(ctx context.Context, slug string) (*dto.PortfolioDTO, error)
+
+	// IsPublished reports whether a live, published portfolio exists, in a single query without loading it
+	IsPublished(ctx context.Context, id uint) (bool, error)
+
 	// GetByOwnerID retrieves all portfolios owned by a specific user with pagination
 	// Returns the list of portfolios, total count, and any error
 	GetByOwnerID(ctx context.Context, ownerID string, pagination dto.PaginationDTO) ([]dto.PortfolioDTO, int64, error)
 
+	// SearchPublic lists the live, published portfolios of every owner, newest first, optionally filtered
+	// by input.Query on title and description. Returns the page and the total count
+	SearchPublic(ctx context.Context, input dto.SearchPublicPortfoliosInput) ([]dto.PortfolioDTO, int64, error)
+
+	// GetSummariesByOwnerID retrieves every portfolio of a user with its category, section and project counts
+	GetSummariesByOwnerID(ctx context.Context, ownerID string) ([]dto.PortfolioSummaryDTO, error)
+
 	// Update updates an existing portfolio
 	Update(ctx context.Context, input dto.UpdatePortfolioInput) error
 
+	// Patch updates only the provided fields of a portfolio
+	Patch(ctx context.Context, input dto.PatchPortfolioInput) error
+
+	// Clone deep-copies a portfolio and its live children in one transaction, under the first
+	// free numbered title of the owner's portfolios
+	Clone(ctx context.Context, id uint) (*dto.PortfolioCloneDTO, error)
+
+	// Export loads a live portfolio with all its live children in one consistent snapshot
+	Export(ctx context.Context, id uint) (*dto.PortfolioExportDTO, error)
+
+	// Import creates a portfolio owned by ownerID with all the children of an export document in
+	// one transaction, under the first free numbered title of the owner's portfolios
+	Import(ctx context.Context, ownerID string, export *dto.PortfolioExportDTO, sanitizedCSS string) (*dto.PortfolioImportDTO, error)
+
+	// SetPublished publishes a portfolio or turns it back into a draft
+	SetPublished(ctx context.Context, id uint, published bool) error
+
+	// SetCustomCSS stores the raw and sanitized custom stylesheet of a portfolio
+	SetCustomCSS(ctx context.Context, id uint, raw, sanitized string) error
+
 	// Delete deletes a portfolio by its ID
 	Delete(ctx context.Context, id uint) error
 
+	// GetDeletedByOwnerID lists the soft-deleted portfolios of a user, most recently deleted first
+	GetDeletedByOwnerID(ctx context.Context, ownerID string) ([]dto.DeletedPortfolioDTO, error)
+
+	// Restore brings back a soft-deleted portfolio with the children deleted along with it.
+	// A title taken in the meantime gets a " (restored)" suffix
+	Restore(ctx context.Context, id uint) (*dto.PortfolioRestoreDTO, error)
+
+	// Purge permanently deletes a soft-deleted portfolio; its children go with it (ON DELETE CASCADE)
+	Purge(ctx context.Context, id uint) error
+
 	// CheckTitleDuplicate checks if a portfolio title already exists for a user
 	// excludeID is used when updating to exclude the current portfolio from the check (pass 0 when creating)
 	CheckTitleDuplicate(ctx context.Context, title, ownerID string, excludeID uint) (bool, error)
@@ -0,0 +1,33 @@
+package contracts
+
+import (
+	"context"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// PortfolioViewRecorder buffers public portfolio views in memory until the next flush
+// Record must stay cheap: it is called on every public portfolio read.
+type PortfolioViewRecorder interface {
+	// Record counts one view of a portfolio by a visitor (an opaque hash) at the given time;
+	// repeats of the same visitor and portfolio within the same UTC hour are not counted.
+	// Reports whether the view was counted
+	Record(portfolioID uint, visitor string, at time.Time) bool
+
+	// Drain returns the buffered views (one batch per portfolio and day) and empties the buffer
+	Drain() []dto.PortfolioViewBatchDTO
+
+	// Requeue puts back batches a failed flush could not write
+	Requeue(batches []dto.PortfolioViewBatchDTO)
+}
+
+// PortfolioViewRepository defines the contract for portfolio view data access
+type PortfolioViewRepository interface {
+	// Flush adds the batches to the daily view counts; views of purged portfolios are dropped
+	Flush(ctx context.Context, batches []dto.PortfolioViewBatchDTO) error
+
+	// GetDays retrieves the daily view counts of a portfolio between from and to (inclusive),
+	// ordered by day; days without views are left out
+	GetDays(ctx context.Context, portfolioID uint, from, to time.Time) ([]dto.PortfolioViewDayDTO, error)
+}
@@ -0,0 +1,30 @@
+package contracts
+
+import (
+	"context"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// ProjectCollaboratorRepository defines the interface for project collaborator data persistence
+type ProjectCollaboratorRepository interface {
+	// Create creates a new collaborator, failing with ErrProjectCollaboratorLimitReached when the
+	// project already has maxCollaborators live collaborators (checked atomically with the insert).
+	// Also returns the project's live collaborator count including the new one
+	Create(ctx context.Context, input dto.CreateProjectCollaboratorInput, maxCollaborators int) (*dto.ProjectCollaboratorDTO, int64, error)
+
+	// GetByID retrieves a collaborator by its ID
+	GetByID(ctx context.Context, id uint) (*dto.ProjectCollaboratorDTO, error)
+
+	// GetByProjectID retrieves all collaborators of a project (ordered by position)
+	GetByProjectID(ctx context.Context, projectID uint) ([]dto.ProjectCollaboratorDTO, error)
+
+	// Update updates an existing collaborator
+	Update(ctx context.Context, input dto.UpdateProjectCollaboratorInput) error
+
+	// BulkUpdatePositions updates the positions of collaborators of a project in a transaction
+	BulkUpdatePositions(ctx context.Context, projectID uint, items []dto.BulkUpdatePositionItem) error
+
+	// Delete deletes a collaborator by its ID
+	Delete(ctx context.Context, id uint) error
+}
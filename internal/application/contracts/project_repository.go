@@ -14,24 +14,56 @@ type ProjectRepository interface {
 	// GetByID retrieves a project by its ID
 	GetByID(ctx context.Context, id uint) (*dto2.ProjectDTO, error)
 
-	// GetByIDs retrieves multiple projects by their IDs
+	// GetByIDWithContext retrieves a project with its category and portfolio context
+	// in a single joined query
+	GetByIDWithContext(ctx context.Context, id uint) (*dto2.ProjectDTO, error)
+
+	// GetContextByIDs retrieves the category and portfolio context of several projects
+	// in a single joined query, keyed by project ID
+	GetContextByIDs(ctx context.Context, ids []uint) (map[uint]dto2.ProjectContextDTO, error)
+
+	// GetByIDs retrieves multiple projects by their IDs (ordered by ID)
 	GetByIDs(ctx context.Context, ids []uint) ([]dto2.ProjectDTO, error)
 
-	// GetByCategoryID retrieves all projects for a specific category (ordered by ID)
+	// GetByCategoryID retrieves all visible (not hidden) projects for a specific category of a published portfolio (ordered by position, then ID)
 	GetByCategoryID(ctx context.Context, categoryID uint) ([]dto2.ProjectDTO, error)
 
-	// GetByOwnerID retrieves all projects owned by a specific user with pagination
-	GetByOwnerID(ctx context.Context, ownerID string, pagination dto2.PaginationDTO) ([]dto2.ProjectDTO, int64, error)
+	// GetByPortfolioID retrieves all projects of a portfolio across its categories, hidden ones included
+	// (ordered by category position, then project position and ID)
+	GetByPortfolioID(ctx context.Context, portfolioID uint) ([]dto2.ProjectDTO, error)
 
-	// SearchBySkills retrieves projects matching ANY of the specified skills
+	// GetByOwnerIDFiltered retrieves the projects owned by a specific user matching every set
+	// field of filter, with pagination, in the given dto2.ProjectListSort* order (newest first when empty).
+	// The returned total counts the filtered projects
+	GetByOwnerIDFiltered(ctx context.Context, ownerID string, filter dto2.ProjectFilter, pagination dto2.PaginationDTO, sort string) ([]dto2.ProjectDTO, int64, error)
+
+	// SearchBySkills retrieves visible projects of published portfolios matching ANY of the specified skills
 	SearchBySkills(ctx context.Context, skills []string) ([]dto2.ProjectDTO, error)
 
-	// SearchByClient retrieves projects by client name (case-insensitive partial match)
+	// SearchByClient retrieves visible projects of published portfolios by client name (case-insensitive partial match)
 	SearchByClient(ctx context.Context, client string) ([]dto2.ProjectDTO, error)
 
+	// SearchPublic searches the visible projects of all live, published portfolios with their context attached
+	// The returned total stops at input.TotalCap+1 so callers can tell the count was capped.
+	SearchPublic(ctx context.Context, input dto2.SearchPublicProjectsInput) ([]dto2.ProjectDTO, int64, error)
+
+	// SearchInPortfolio retrieves up to limit live, visible projects of a portfolio matching query through
+	// their title, description, client or skills, best matches first
+	SearchInPortfolio(ctx context.Context, portfolioID uint, query string, limit int) ([]dto2.ProjectDTO, error)
+
 	// Update updates an existing project
 	Update(ctx context.Context, input dto2.UpdateProjectInput) error
 
+	// Patch updates only the provided fields of a project; a category change appends it to
+	// the new category's positions
+	Patch(ctx context.Context, input dto2.PatchProjectInput) error
+
+	// SwapPositions exchanges the positions of two projects of the same category in one transaction
+	SwapPositions(ctx context.Context, firstID, secondID uint) error
+
+	// BulkUpdatePositions updates positions for multiple projects of one category in a transaction
+	BulkUpdatePositions(ctx context.Context, input dto2.BulkUpdateProjectPositionsInput) error
+
 	// Delete deletes a project by its ID
 	Delete(ctx context.Context, id uint) error
 }
@@ -0,0 +1,35 @@
+package contracts
+
+import (
+	"context"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// ProjectViewRecorder buffers public project views in memory until the next flush
+// Record must stay cheap: it is called on every public project read.
+type ProjectViewRecorder interface {
+	// Record counts one view of a project at the given time
+	Record(projectID uint, at time.Time)
+
+	// Drain returns the buffered views (one batch per project and day) and empties the buffer
+	Drain() []dto.ProjectViewBatchDTO
+
+	// Requeue puts back batches a failed flush could not write
+	Requeue(batches []dto.ProjectViewBatchDTO)
+}
+
+// ProjectViewRepository defines the contract for project view data access
+type ProjectViewRepository interface {
+	// Flush adds the batches to the daily view counts and moves the last view timestamps
+	// forward, touching each project row at most once; views of purged projects are dropped
+	Flush(ctx context.Context, batches []dto.ProjectViewBatchDTO) error
+
+	// GetStats retrieves the view stats of several projects, keyed by project ID
+	// (projects without views are included with zero values)
+	GetStats(ctx context.Context, projectIDs []uint) (map[uint]dto.ProjectViewStatsDTO, error)
+
+	// PurgeDaysBefore deletes the daily view counts of days before the given date
+	PurgeDaysBefore(ctx context.Context, day time.Time) (int64, error)
+}
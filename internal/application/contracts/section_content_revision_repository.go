@@ -0,0 +1,22 @@
+package contracts
+
+import (
+	"context"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// SectionContentRevisionRepository defines the interface for section content revision persistence
+type SectionContentRevisionRepository interface {
+	// Create records a revision and evicts the oldest ones beyond maxRevisions for the same content
+	Create(ctx context.Context, input dto.CreateSectionContentRevisionInput, maxRevisions int) (*dto.SectionContentRevisionDTO, error)
+
+	// GetByID retrieves a revision of a specific section content
+	GetByID(ctx context.Context, sectionContentID uint, id uint) (*dto.SectionContentRevisionDTO, error)
+
+	// GetLatest retrieves the most recent revision of a section content (nil when there is none)
+	GetLatest(ctx context.Context, sectionContentID uint) (*dto.SectionContentRevisionDTO, error)
+
+	// ListBySectionContentID retrieves all revisions of a section content (newest first)
+	ListBySectionContentID(ctx context.Context, sectionContentID uint) ([]dto.SectionContentRevisionDTO, error)
+}
@@ -15,18 +15,23 @@ type SectionRepository interface {
 	// GetByID retrieves a section by its ID (basic info only)
 	GetByID(ctx context.Context, id uint) (*dto2.SectionDTO, error)
 
-	// GetByIDs retrieves multiple sections by their IDs
+	// GetByIDs retrieves multiple sections by their IDs (ordered by ID)
 	GetByIDs(ctx context.Context, ids []uint) ([]dto2.SectionDTO, error)
 
 	// GetByPortfolioID retrieves all sections for a specific portfolio (ordered by position)
 	GetByPortfolioID(ctx context.Context, portfolioID uint) ([]dto2.SectionDTO, error)
 
+	// SearchInPortfolio retrieves up to limit live sections of a portfolio matching query through
+	// their title, description or contents, best matches first
+	SearchInPortfolio(ctx context.Context, portfolioID uint, query string, limit int) ([]dto2.SectionSearchHitDTO, error)
+
 	// GetByOwnerID retrieves all sections owned by a specific user with pagination
 	// Returns the list of sections, total count, and any error
 	GetByOwnerID(ctx context.Context, ownerID string, pagination dto2.PaginationDTO) ([]dto2.SectionDTO, int64, error)
 
-	// GetByType retrieves all sections of a specific type
-	GetByType(ctx context.Context, sectionType string) ([]dto2.SectionDTO, error)
+	// GetByType retrieves an owner's sections of a specific type with pagination, optionally within one portfolio
+	// Returns the list of sections, total count, and any error
+	GetByType(ctx context.Context, input dto2.ListSectionsByTypeInput) ([]dto2.SectionDTO, int64, error)
 
 	// Update updates an existing section
 	Update(ctx context.Context, input dto2.UpdateSectionInput) error
@@ -34,12 +39,19 @@ type SectionRepository interface {
 	// UpdatePosition updates only the position field of a section
 	UpdatePosition(ctx context.Context, id uint, position uint) error
 
+	// SwapPositions exchanges the positions of two sections of the same portfolio in one transaction
+	SwapPositions(ctx context.Context, firstID, secondID uint) error
+
 	// BulkUpdatePositions updates positions for multiple sections in a transaction
 	BulkUpdatePositions(ctx context.Context, input dto2.BulkUpdateSectionPositionsInput) error
 
 	// Delete deletes a section by its ID (cascade deletes section contents)
 	Delete(ctx context.Context, id uint) error
 
+	// GetSlugRedirects maps the former slugs of a portfolio's live sections (see the slug history)
+	// to their current slug, leaving out former slugs taken by a live section
+	GetSlugRedirects(ctx context.Context, portfolioID uint) (map[string]string, error)
+
 	// CheckTitleDuplicate checks if a section title already exists for a portfolio
 	// excludeID is used when updating to exclude the current section from the check (pass 0 when creating)
 	CheckTitleDuplicate(ctx context.Context, title string, portfolioID uint, excludeID uint) (bool, error)
@@ -0,0 +1,22 @@
+package contracts
+
+import (
+	"context"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// SkillEndorsementRepository defines the contract for skill endorsement data access
+type SkillEndorsementRepository interface {
+	// Endorse records a visitor vote and increments the skill count in one transaction
+	// A repeated vote (same project, skill, IP hash and day) changes nothing; a vote past
+	// the project's daily limit is refused. Concurrent votes never lose increments.
+	Endorse(ctx context.Context, input dto.EndorseSkillVoteInput) (*dto.EndorseSkillResultDTO, error)
+
+	// GetCounts retrieves the endorsement counts of several projects, keyed by project ID then skill
+	GetCounts(ctx context.Context, projectIDs []uint) (map[uint]map[string]uint, error)
+
+	// PurgeVotesBefore deletes the dedup votes of days before the given date
+	PurgeVotesBefore(ctx context.Context, day time.Time) (int64, error)
+}
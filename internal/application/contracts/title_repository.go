@@ -0,0 +1,14 @@
+package contracts
+
+import (
+	"context"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// TitleRepository looks up titles within their parent scope for availability checks
+type TitleRepository interface {
+	// FindTitleVariants returns the live titles of the input's scope that equal input.Title or are
+	// suffixed variants of it ("Title (2)"...), mapped to their IDs. input.ExcludeID is left out
+	FindTitleVariants(ctx context.Context, input dto.CheckTitleInput) (map[string]uint, error)
+}
@@ -0,0 +1,12 @@
+package contracts
+
+import "context"
+
+// TransactionManager runs several repository calls as one unit of work
+// This is a contract in the application layer that the infrastructure layer must implement
+type TransactionManager interface {
+	// WithinTransaction runs fn in a database transaction, committed when fn returns nil and
+	// rolled back otherwise. Repository calls made with the ctx passed to fn join the
+	// transaction; a nested call reuses the outer transaction instead of starting one.
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
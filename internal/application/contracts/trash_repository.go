@@ -0,0 +1,18 @@
+package contracts
+
+import (
+	"context"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// TrashRepository looks up and expires soft-deleted items
+type TrashRepository interface {
+	// FindDeleted returns the item if it is soft-deleted and owned by ownerID, nil when it is live,
+	// owned by someone else or never existed
+	FindDeleted(ctx context.Context, resource string, id uint, ownerID string) (*dto.DeletedItemDTO, error)
+
+	// PurgeDeletedBefore permanently deletes the rows soft-deleted before cutoff and returns how many
+	PurgeDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error)
+}
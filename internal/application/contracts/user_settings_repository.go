@@ -0,0 +1,27 @@
+package contracts
+
+import (
+	"context"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// UserSettingsRepository defines the contract for account-level settings data access
+// The application depends on this to persist and retrieve user settings
+// Infrastructure implements this (e.g., PostgreSQL)
+type UserSettingsRepository interface {
+	// Get retrieves the settings of a user
+	// Input: user ID
+	// Output: settings DTO (defaults when the user never saved settings)
+	Get(ctx context.Context, userID string) (*dto.UserSettingsDTO, error)
+
+	// SetDefaultPortfolio sets or clears (nil) the user's default portfolio
+	// Input: user ID, portfolio ID
+	// Output: error if the write fails
+	SetDefaultPortfolio(ctx context.Context, userID string, portfolioID *uint) error
+
+	// SetProjectDefaults replaces the user's new-project defaults
+	// Input: user ID, defaults
+	// Output: error if the write fails
+	SetProjectDefaults(ctx context.Context, userID string, defaults dto.ProjectDefaultsDTO) error
+}
@@ -0,0 +1,564 @@
+// Package customcss sanitizes the custom CSS users attach to their public portfolio.
+// It is a small, conservative parser: anything it does not positively recognize as
+// safe is dropped and reported, rather than passed through.
+package customcss
+
+import (
+	"net/url"
+	"strings"
+	"unicode"
+)
+
+// MaxSize is the largest custom stylesheet accepted, in bytes
+const MaxSize = 50 * 1024
+
+// Removal kinds
+const (
+	RemovedImport     = "import"      // @import
+	RemovedAtRule     = "at_rule"     // at-rules outside the allowed set (@charset, @namespace, ...)
+	RemovedURL        = "url"         // url() pointing at a non-allowed origin or scheme
+	RemovedExpression = "expression"  // IE expression() / javascript: values
+	RemovedBehavior   = "behavior"    // behavior / -moz-binding
+	RemovedMarkup     = "markup"      // "<" anywhere, which could close the <style> element
+	RemovedMalformed  = "malformed"   // unbalanced or incomplete constructs
+	RemovedNested     = "nested_rule" // nested rules inside declaration blocks
+)
+
+// Removal is a construct stripped by the sanitizer
+type Removal struct {
+	Kind    string
+	Snippet string // The offending source, trimmed and truncated
+}
+
+// Result is the sanitized stylesheet and what was removed from it
+type Result struct {
+	CSS     string
+	Removed []Removal
+}
+
+// Options configures the sanitizer
+type Options struct {
+	// AllowedOrigins are the hosts absolute url()s may point at (e.g. "fonts.gstatic.com")
+	// Relative URLs are always allowed; they resolve against the portfolio's own origin.
+	AllowedOrigins []string
+}
+
+// Block at-rules whose content is a list of rules; every other at-rule is removed
+var ruleListAtRules = map[string]bool{
+	"media":             true,
+	"supports":          true,
+	"container":         true,
+	"layer":             true,
+	"keyframes":         true,
+	"-webkit-keyframes": true,
+}
+
+// Block at-rules whose content is a list of declarations
+var declarationAtRules = map[string]bool{
+	"font-face": true,
+	"page":      true,
+}
+
+// Properties that execute code in some browsers
+var blockedProperties = map[string]bool{
+	"behavior":     true,
+	"-moz-binding": true,
+}
+
+// Sanitize parses css and returns the safe subset, normalized one declaration per line
+// The caller enforces MaxSize before calling; Sanitize itself accepts any length.
+func Sanitize(css string, opts Options) Result {
+	p := &parser{
+		src:     stripComments(css),
+		allowed: make(map[string]bool, len(opts.AllowedOrigins)),
+	}
+	for _, origin := range opts.AllowedOrigins {
+		if origin = strings.ToLower(strings.TrimSpace(origin)); origin != "" {
+			p.allowed[origin] = true
+		}
+	}
+
+	var out strings.Builder
+	p.parseRules(&out, "", false)
+
+	return Result{CSS: out.String(), Removed: p.removed}
+}
+
+type parser struct {
+	src     string
+	pos     int
+	allowed map[string]bool
+	removed []Removal
+}
+
+func (p *parser) remove(kind, snippet string) {
+	snippet = strings.Join(strings.Fields(snippet), " ")
+	if len(snippet) > 80 {
+		snippet = snippet[:77] + "..."
+	}
+	p.removed = append(p.removed, Removal{Kind: kind, Snippet: snippet})
+}
+
+// parseRules reads rules until EOF (top level) or the closing brace of the enclosing block
+func (p *parser) parseRules(out *strings.Builder, indent string, nested bool) {
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.src) {
+			if nested {
+				p.remove(RemovedMalformed, "unclosed block")
+			}
+			return
+		}
+		if p.src[p.pos] == '}' {
+			if nested {
+				p.pos++
+				return
+			}
+			// Stray closing brace at the top level
+			p.remove(RemovedMalformed, "}")
+			p.pos++
+			continue
+		}
+		if p.src[p.pos] == '@' {
+			p.parseAtRule(out, indent)
+			continue
+		}
+		p.parseStyleRule(out, indent)
+	}
+}
+
+// parseAtRule handles an at-rule starting at the current '@'
+func (p *parser) parseAtRule(out *strings.Builder, indent string) {
+	start := p.pos
+	p.pos++ // '@'
+	nameStart := p.pos
+	for p.pos < len(p.src) && isIdentChar(p.src[p.pos]) {
+		p.pos++
+	}
+	name := strings.ToLower(p.src[nameStart:p.pos])
+
+	prelude, stop := p.readUntil(";{")
+	prelude = strings.TrimSpace(prelude)
+	source := p.src[start:p.pos]
+
+	if stop != '{' {
+		// Statement at-rule (@import, @charset, @namespace, ...) or unterminated input
+		if stop == ';' {
+			p.pos++
+		}
+		if name == "import" {
+			p.remove(RemovedImport, source)
+		} else {
+			p.remove(RemovedAtRule, source)
+		}
+		return
+	}
+	p.pos++ // '{'
+
+	if strings.Contains(prelude, "<") {
+		p.remove(RemovedMarkup, source)
+		p.skipBlock()
+		return
+	}
+
+	switch {
+	case ruleListAtRules[name]:
+		var inner strings.Builder
+		p.parseRules(&inner, indent+"  ", true)
+		if inner.Len() > 0 {
+			out.WriteString(indent + "@" + name + " " + prelude + " {\n" + inner.String() + indent + "}\n")
+		}
+	case declarationAtRules[name]:
+		declarations := p.parseDeclarations(indent + "  ")
+		if declarations != "" {
+			header := "@" + name
+			if prelude != "" {
+				header += " " + prelude
+			}
+			out.WriteString(indent + header + " {\n" + declarations + indent + "}\n")
+		}
+	default:
+		p.skipBlock()
+		p.remove(RemovedAtRule, "@"+name+" "+prelude)
+	}
+}
+
+// parseStyleRule handles "selector { declarations }"
+func (p *parser) parseStyleRule(out *strings.Builder, indent string) {
+	start := p.pos
+	selector, stop := p.readUntil("{};")
+	if stop != '{' {
+		// A selector without a block
+		if stop == ';' {
+			p.pos++
+		}
+		if stop == '}' && p.pos == start {
+			return
+		}
+		p.remove(RemovedMalformed, p.src[start:p.pos])
+		return
+	}
+	p.pos++ // '{'
+
+	selector = strings.Join(strings.Fields(selector), " ")
+	if strings.Contains(selector, "<") || strings.Contains(unescape(selector), "<") {
+		p.remove(RemovedMarkup, selector)
+		p.skipBlock()
+		return
+	}
+
+	declarations := p.parseDeclarations(indent + "  ")
+	if declarations != "" {
+		out.WriteString(indent + selector + " {\n" + declarations + indent + "}\n")
+	}
+}
+
+// parseDeclarations reads a declaration block up to and including its closing brace
+func (p *parser) parseDeclarations(indent string) string {
+	var out strings.Builder
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.src) {
+			p.remove(RemovedMalformed, "unclosed block")
+			return out.String()
+		}
+		if p.src[p.pos] == '}' {
+			p.pos++
+			return out.String()
+		}
+		if p.src[p.pos] == ';' {
+			p.pos++
+			continue
+		}
+
+		start := p.pos
+		declaration, stop := p.readUntil(";{}")
+		if stop == '{' {
+			// Nested rule (CSS nesting): not supported, drop it whole
+			p.pos++
+			p.skipBlock()
+			p.remove(RemovedNested, p.src[start:p.pos])
+			continue
+		}
+		if stop == ';' {
+			p.pos++
+		}
+
+		if clean, ok := p.checkDeclaration(declaration); ok {
+			out.WriteString(indent + clean + ";\n")
+		}
+	}
+}
+
+// checkDeclaration validates "property: value", returning it normalized
+func (p *parser) checkDeclaration(declaration string) (string, bool) {
+	colon := strings.IndexByte(declaration, ':')
+	if colon <= 0 {
+		p.remove(RemovedMalformed, declaration)
+		return "", false
+	}
+	property := strings.ToLower(strings.TrimSpace(declaration[:colon]))
+	value := strings.TrimSpace(declaration[colon+1:])
+
+	// Escapes can hide keywords ("expr\65 ssion"), so checks run on the decoded text too
+	decodedProperty := strings.ToLower(unescape(property))
+	decodedValue := strings.ToLower(unescape(value))
+
+	switch {
+	case property == "" || value == "" || !isPropertyName(property):
+		p.remove(RemovedMalformed, declaration)
+		return "", false
+	case strings.Contains(value, "<") || strings.Contains(decodedValue, "<"):
+		p.remove(RemovedMarkup, declaration)
+		return "", false
+	case blockedProperties[decodedProperty]:
+		p.remove(RemovedBehavior, declaration)
+		return "", false
+	case strings.Contains(compact(decodedValue), "expression(") || strings.Contains(compact(decodedValue), "javascript:"):
+		p.remove(RemovedExpression, declaration)
+		return "", false
+	}
+
+	for _, target := range urlTargets(decodedValue) {
+		if !p.urlAllowed(target) {
+			p.remove(RemovedURL, declaration)
+			return "", false
+		}
+	}
+	// image-set("x.png") and @font-face src: "x" take bare strings as URLs
+	if strings.Contains(decodedValue, "image-set(") || strings.Contains(decodedValue, "-webkit-image-set(") {
+		for _, target := range quotedStrings(decodedValue) {
+			if !p.urlAllowed(target) {
+				p.remove(RemovedURL, declaration)
+				return "", false
+			}
+		}
+	}
+
+	return property + ": " + value, true
+}
+
+// urlAllowed reports whether a url() target stays on an allowed origin
+func (p *parser) urlAllowed(target string) bool {
+	target = strings.TrimSpace(target)
+	if target == "" || strings.HasPrefix(target, "#") {
+		return true
+	}
+	// Browsers read "\" as "/" and drop tabs and newlines, so "/\evil.com" is protocol-relative
+	if strings.ContainsFunc(target, func(r rune) bool { return r == '\\' || unicode.IsControl(r) }) {
+		return false
+	}
+	if strings.HasPrefix(target, "data:image/") && !strings.HasPrefix(target, "data:image/svg") {
+		return true
+	}
+	if strings.HasPrefix(target, "//") {
+		target = "https:" + target
+	}
+
+	parsed, err := url.Parse(target)
+	if err != nil {
+		return false
+	}
+	if parsed.Scheme == "" && parsed.Host == "" {
+		// Relative path on the portfolio's own origin
+		return !strings.Contains(target, ":")
+	}
+	if parsed.Scheme != "https" && parsed.Scheme != "http" {
+		return false
+	}
+	return p.allowed[strings.ToLower(parsed.Hostname())]
+}
+
+// readUntil advances to the first of stops outside strings and parentheses, returning the text
+// read and the stop character found (0 at EOF). The stop character itself is not consumed.
+func (p *parser) readUntil(stops string) (string, byte) {
+	start := p.pos
+	depth := 0
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		switch {
+		case c == '\\':
+			p.pos += 2
+			continue
+		case c == '"' || c == '\'':
+			p.skipString(c)
+			continue
+		case c == '(':
+			depth++
+		case c == ')':
+			if depth > 0 {
+				depth--
+			}
+		case depth == 0 && strings.IndexByte(stops, c) >= 0:
+			return p.src[start:p.pos], c
+		}
+		p.pos++
+	}
+	if p.pos > len(p.src) {
+		p.pos = len(p.src)
+	}
+	return p.src[start:p.pos], 0
+}
+
+// skipBlock advances past the brace closing the current block (the opening one is consumed)
+func (p *parser) skipBlock() {
+	depth := 1
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		switch c {
+		case '\\':
+			p.pos += 2
+			continue
+		case '"', '\'':
+			p.skipString(c)
+			continue
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				p.pos++
+				return
+			}
+		}
+		p.pos++
+	}
+	p.pos = len(p.src)
+}
+
+// skipString advances past a quoted string starting at the current quote
+func (p *parser) skipString(quote byte) {
+	p.pos++
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		if c == '\\' {
+			p.pos += 2
+			continue
+		}
+		p.pos++
+		if c == quote || c == '\n' {
+			return
+		}
+	}
+	p.pos = len(p.src)
+}
+
+func (p *parser) skipSpace() {
+	for p.pos < len(p.src) && isSpace(p.src[p.pos]) {
+		p.pos++
+	}
+}
+
+// stripComments removes /* */ comments outside strings
+func stripComments(css string) string {
+	var out strings.Builder
+	out.Grow(len(css))
+	for i := 0; i < len(css); i++ {
+		c := css[i]
+		switch {
+		case c == '\\' && i+1 < len(css):
+			out.WriteByte(c)
+			out.WriteByte(css[i+1])
+			i++
+		case c == '"' || c == '\'':
+			j := i + 1
+			for j < len(css) && css[j] != c && css[j] != '\n' {
+				if css[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			if j >= len(css) {
+				j = len(css) - 1
+			}
+			out.WriteString(css[i : j+1])
+			i = j
+		case c == '/' && i+1 < len(css) && css[i+1] == '*':
+			end := strings.Index(css[i+2:], "*/")
+			if end < 0 {
+				return out.String()
+			}
+			out.WriteByte(' ')
+			i += end + 3
+		default:
+			out.WriteByte(c)
+		}
+	}
+	return out.String()
+}
+
+// unescape decodes CSS escapes ("\65 " or "\e") so checks see the real characters
+func unescape(s string) string {
+	if !strings.Contains(s, "\\") {
+		return s
+	}
+	var out strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 >= len(s) {
+			out.WriteByte(s[i])
+			continue
+		}
+		j := i + 1
+		for j < len(s) && j < i+7 && isHex(s[j]) {
+			j++
+		}
+		if j == i+1 {
+			// Escaped literal character
+			out.WriteByte(s[j])
+			i = j
+			continue
+		}
+		var r rune
+		for _, h := range s[i+1 : j] {
+			r = r*16 + rune(hexValue(byte(h)))
+		}
+		if r == 0 || r > unicode.MaxRune {
+			r = unicode.ReplacementChar
+		}
+		out.WriteRune(r)
+		if j < len(s) && isSpace(s[j]) {
+			j++ // A single whitespace terminates a hex escape
+		}
+		i = j - 1
+	}
+	return out.String()
+}
+
+// urlTargets extracts the targets of every url(...) in a (decoded, lowercased) value
+func urlTargets(value string) []string {
+	var targets []string
+	compacted := compact(value)
+	for {
+		idx := strings.Index(compacted, "url(")
+		if idx < 0 {
+			return targets
+		}
+		rest := compacted[idx+4:]
+		end := strings.IndexByte(rest, ')')
+		if end < 0 {
+			end = len(rest)
+		}
+		target := strings.Trim(rest[:end], `"'`)
+		targets = append(targets, target)
+		compacted = rest[end:]
+	}
+}
+
+// quotedStrings returns the contents of the quoted strings in a value
+func quotedStrings(value string) []string {
+	var strs []string
+	for i := 0; i < len(value); i++ {
+		if value[i] != '"' && value[i] != '\'' {
+			continue
+		}
+		end := strings.IndexByte(value[i+1:], value[i])
+		if end < 0 {
+			break
+		}
+		strs = append(strs, value[i+1:i+1+end])
+		i += end + 1
+	}
+	return strs
+}
+
+// compact removes whitespace, so "url ( x )" and "expression (" are still found
+func compact(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+func isPropertyName(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !isIdentChar(s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func isIdentChar(c byte) bool {
+	return c == '-' || c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c >= 0x80
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+}
+
+func isHex(c byte) bool {
+	return c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+}
+
+func hexValue(c byte) int {
+	switch {
+	case c >= '0' && c <= '9':
+		return int(c - '0')
+	case c >= 'a' && c <= 'f':
+		return int(c-'a') + 10
+	default:
+		return int(c-'A') + 10
+	}
+}
@@ -0,0 +1,80 @@
+package customcss
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSanitize_URLs(t *testing.T) {
+	opts := Options{AllowedOrigins: []string{"fonts.gstatic.com"}}
+
+	tests := []struct {
+		name    string
+		value   string
+		allowed bool
+	}{
+		{name: "relative path", value: `url(/images/bg.png)`, allowed: true},
+		{name: "fragment", value: `url(#gradient)`, allowed: true},
+		{name: "allowed origin", value: `url("https://fonts.gstatic.com/font.woff2")`, allowed: true},
+		{name: "raster data URI", value: `url(data:image/png;base64,iVBORw0KGgo=)`, allowed: true},
+		{name: "other origin", value: `url(https://evil.com/x.png)`, allowed: false},
+		{name: "protocol-relative", value: `url(//evil.com/x.png)`, allowed: false},
+		{name: "uppercase protocol-relative", value: `URL(//EVIL.COM/x.png)`, allowed: false},
+		{name: "backslash after slash", value: `url(/\evil.com/x.png)`, allowed: false},
+		{name: "escaped backslash", value: `url("\\evil.com/x.png")`, allowed: false},
+		{name: "escaped backslashes", value: `url("\\\\evil.com/x.png")`, allowed: false},
+		{name: "escaped slash", value: `url(/\/evil.com/x.png)`, allowed: false},
+		{name: "hex escaped slash", value: `url(/\2f evil.com/x.png)`, allowed: false},
+		{name: "tab between slashes", value: `url("/\9/evil.com/x.png")`, allowed: false},
+		{name: "newline between slashes", value: `url("/\a/evil.com/x.png")`, allowed: false},
+		{name: "http other origin", value: `url(http://evil.com/x.png)`, allowed: false},
+		{name: "javascript scheme", value: `url(javascript:alert(1))`, allowed: false},
+		{name: "escaped javascript scheme", value: `url(\6a avascript:alert(1))`, allowed: false},
+		{name: "svg data URI", value: `url(data:image/svg+xml;utf8,x)`, allowed: false},
+		{name: "image-set bare string", value: `image-set("//evil.com/x.png" 1x)`, allowed: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Sanitize(".hero { background-image: "+tt.value+"; }", opts)
+
+			kept := strings.Contains(result.CSS, "background-image")
+			if kept != tt.allowed {
+				t.Fatalf("kept = %v, want %v (css %q, removed %+v)", kept, tt.allowed, result.CSS, result.Removed)
+			}
+			if !tt.allowed && len(result.Removed) != 1 {
+				t.Errorf("removed %+v, want exactly one removal", result.Removed)
+			}
+		})
+	}
+}
+
+func TestSanitize_Removals(t *testing.T) {
+	tests := []struct {
+		name string
+		css  string
+		kind string
+	}{
+		{name: "import", css: `@import url(https://evil.com/x.css);`, kind: RemovedImport},
+		{name: "expression", css: `a { width: expression(alert(1)); }`, kind: RemovedExpression},
+		{name: "escaped expression", css: `a { width: expr\65 ssion(alert(1)); }`, kind: RemovedExpression},
+		{name: "behavior", css: `a { behavior: url(x.htc); }`, kind: RemovedBehavior},
+		{name: "style close", css: `a { content: "</style><script>"; }`, kind: RemovedMarkup},
+		{name: "escaped style close", css: `a { content: "\3c /style>"; }`, kind: RemovedMarkup},
+		{name: "unknown at-rule", css: `@namespace svg url(http://www.w3.org/2000/svg);`, kind: RemovedAtRule},
+		{name: "unclosed block", css: `a { color: red;`, kind: RemovedMalformed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Sanitize(tt.css, Options{})
+
+			if len(result.Removed) == 0 || result.Removed[0].Kind != tt.kind {
+				t.Fatalf("removed %+v, want a %q removal", result.Removed, tt.kind)
+			}
+			if strings.Contains(strings.ToLower(result.CSS), "evil") || strings.Contains(result.CSS, "<") {
+				t.Errorf("unsafe output %q", result.CSS)
+			}
+		})
+	}
+}
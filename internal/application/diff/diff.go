@@ -0,0 +1,83 @@
+// Package diff compares application DTOs field by field, for comparison views and change records.
+// It is pure: no I/O, no context, deterministic output order.
+package diff
+
+import "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+
+// Strings compares two string sets: Added holds the values only in right, Removed those only in left
+// Order follows the inputs and duplicates are collapsed; both slices are empty (never nil) when equal.
+func Strings(left, right []string) dto.SetChangeDTO {
+	change := dto.SetChangeDTO{Added: []string{}, Removed: []string{}}
+
+	inLeft := make(map[string]bool, len(left))
+	for _, value := range left {
+		inLeft[value] = true
+	}
+	inRight := make(map[string]bool, len(right))
+	for _, value := range right {
+		inRight[value] = true
+	}
+
+	for _, value := range right {
+		if !inLeft[value] {
+			change.Added = append(change.Added, value)
+			inLeft[value] = true // Collapse duplicates
+		}
+	}
+	for _, value := range left {
+		if !inRight[value] {
+			change.Removed = append(change.Removed, value)
+			inRight[value] = true
+		}
+	}
+
+	return change
+}
+
+// Projects compares two projects; only fields that differ are listed
+func Projects(left, right dto.ProjectDTO) dto.ProjectDiffDTO {
+	result := dto.ProjectDiffDTO{
+		LeftID:  left.ID,
+		RightID: right.ID,
+		Fields:  []dto.FieldChangeDTO{},
+		Skills:  Strings(left.Skills, right.Skills),
+		Images:  Strings(left.Images, right.Images),
+	}
+
+	addString := func(field, l, r string) {
+		if l != r {
+			result.Fields = append(result.Fields, dto.FieldChangeDTO{Field: field, Left: l, Right: r})
+		}
+	}
+	addOptional := func(field string, l, r *string) {
+		if !equalOptional(l, r) {
+			result.Fields = append(result.Fields, dto.FieldChangeDTO{Field: field, Left: l, Right: r})
+		}
+	}
+
+	addString("title", left.Title, right.Title)
+	addString("description", left.Description, right.Description)
+	addOptional("client", left.Client, right.Client)
+	addOptional("link", left.Link, right.Link)
+	addOptional("main_image", left.MainImage, right.MainImage)
+	if left.CategoryID != right.CategoryID {
+		result.Fields = append(result.Fields, dto.FieldChangeDTO{Field: "category_id", Left: left.CategoryID, Right: right.CategoryID})
+	}
+	if left.Hidden != right.Hidden {
+		result.Fields = append(result.Fields, dto.FieldChangeDTO{Field: "hidden", Left: left.Hidden, Right: right.Hidden})
+	}
+
+	return result
+}
+
+// equalOptional treats nil and "" alike: both mean "not set"
+func equalOptional(l, r *string) bool {
+	var lv, rv string
+	if l != nil {
+		lv = *l
+	}
+	if r != nil {
+		rv = *r
+	}
+	return lv == rv
+}
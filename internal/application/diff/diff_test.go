@@ -0,0 +1,93 @@
+package diff
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+func TestStrings(t *testing.T) {
+	tests := []struct {
+		name        string
+		left, right []string
+		wantAdded   []string
+		wantRemoved []string
+	}{
+		{name: "equal", left: []string{"go", "sql"}, right: []string{"sql", "go"}, wantAdded: []string{}, wantRemoved: []string{}},
+		{name: "both empty", wantAdded: []string{}, wantRemoved: []string{}},
+		{name: "added in input order", left: []string{"go"}, right: []string{"vue", "go", "css"}, wantAdded: []string{"vue", "css"}, wantRemoved: []string{}},
+		{name: "removed in input order", left: []string{"php", "go", "perl"}, right: []string{"go"}, wantAdded: []string{}, wantRemoved: []string{"php", "perl"}},
+		{name: "duplicates collapsed", left: []string{"a", "a"}, right: []string{"b", "b"}, wantAdded: []string{"b"}, wantRemoved: []string{"a"}},
+		{name: "case sensitive", left: []string{"Go"}, right: []string{"go"}, wantAdded: []string{"go"}, wantRemoved: []string{"Go"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Strings(tt.left, tt.right)
+			if !reflect.DeepEqual(got.Added, tt.wantAdded) || !reflect.DeepEqual(got.Removed, tt.wantRemoved) {
+				t.Errorf("Strings(%v, %v) = +%v -%v, want +%v -%v", tt.left, tt.right, got.Added, got.Removed, tt.wantAdded, tt.wantRemoved)
+			}
+		})
+	}
+}
+
+func TestProjects(t *testing.T) {
+	strPtr := func(s string) *string { return &s }
+	base := dto.ProjectDTO{
+		ID: 1, Title: "Shop", Description: "A shop", Client: strPtr("Acme"), CategoryID: 10,
+		Skills: []string{"go"}, Images: []string{"/uploads/a.png"},
+	}
+
+	tests := []struct {
+		name       string
+		change     func(p *dto.ProjectDTO)
+		wantFields []dto.FieldChangeDTO
+	}{
+		{name: "identical", change: func(p *dto.ProjectDTO) {}, wantFields: []dto.FieldChangeDTO{}},
+		{
+			name:       "strings",
+			change:     func(p *dto.ProjectDTO) { p.Title, p.Description = "Store", "A store" },
+			wantFields: []dto.FieldChangeDTO{{Field: "title", Left: "Shop", Right: "Store"}, {Field: "description", Left: "A shop", Right: "A store"}},
+		},
+		{
+			name:       "optional set and unset",
+			change:     func(p *dto.ProjectDTO) { p.Client, p.Link = nil, strPtr("https://shop.example.com") },
+			wantFields: []dto.FieldChangeDTO{{Field: "client", Left: base.Client, Right: (*string)(nil)}, {Field: "link", Left: (*string)(nil), Right: strPtr("https://shop.example.com")}},
+		},
+		{name: "empty optional equals unset", change: func(p *dto.ProjectDTO) { p.Link, p.MainImage = strPtr(""), strPtr("") }, wantFields: []dto.FieldChangeDTO{}},
+		{
+			name:       "category and visibility",
+			change:     func(p *dto.ProjectDTO) { p.CategoryID, p.Hidden = 11, true },
+			wantFields: []dto.FieldChangeDTO{{Field: "category_id", Left: uint(10), Right: uint(11)}, {Field: "hidden", Left: false, Right: true}},
+		},
+		{name: "ID, position and timestamps are not compared", change: func(p *dto.ProjectDTO) { p.ID, p.Position = 2, 5 }, wantFields: []dto.FieldChangeDTO{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			right := base
+			right.Skills = append([]string(nil), base.Skills...)
+			tt.change(&right)
+
+			got := Projects(base, right)
+			if got.LeftID != base.ID || got.RightID != right.ID {
+				t.Errorf("IDs = %d, %d, want %d, %d", got.LeftID, got.RightID, base.ID, right.ID)
+			}
+			if !reflect.DeepEqual(got.Fields, tt.wantFields) {
+				t.Errorf("Fields = %+v, want %+v", got.Fields, tt.wantFields)
+			}
+		})
+	}
+
+	// Skill and image sets are diffed separately
+	right := base
+	right.Skills, right.Images = []string{"go", "vue"}, nil
+	got := Projects(base, right)
+	if !reflect.DeepEqual(got.Skills.Added, []string{"vue"}) || len(got.Skills.Removed) != 0 {
+		t.Errorf("Skills = %+v, want vue added", got.Skills)
+	}
+	if !reflect.DeepEqual(got.Images.Removed, []string{"/uploads/a.png"}) || len(got.Images.Added) != 0 {
+		t.Errorf("Images = %+v, want the image removed", got.Images)
+	}
+}
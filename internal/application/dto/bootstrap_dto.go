@@ -0,0 +1,42 @@
+package dto
+
+import "time"
+
+// ============================================================================
+// Bootstrap DTOs (Application Layer)
+// ============================================================================
+
+// Bootstrap section names
+const (
+	BootstrapSectionUser       = "user"
+	BootstrapSectionSettings   = "settings"
+	BootstrapSectionPortfolios = "portfolios"
+	BootstrapSectionActivity   = "activity"
+)
+
+// PortfolioSummaryDTO is a portfolio with the number of items it holds
+type PortfolioSummaryDTO struct {
+	ID         uint
+	Title      string
+	UpdatedAt  time.Time
+	Categories int64
+	Sections   int64
+	Projects   int64
+}
+
+// BootstrapSectionStatusDTO tells when a bootstrap section was read and whether it failed
+// A failed section leaves its data empty; the other sections are still returned.
+type BootstrapSectionStatusDTO struct {
+	FetchedAt time.Time
+	Err       error
+}
+
+// BootstrapDTO is everything the app shell needs after login, read in one go
+type BootstrapDTO struct {
+	User       *UserDTO
+	Settings   *UserSettingsDTO
+	Portfolios []PortfolioSummaryDTO
+	Activity   []ChangeEventDTO // Most recent first
+
+	Sections map[string]BootstrapSectionStatusDTO
+}
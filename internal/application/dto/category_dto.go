@@ -16,6 +16,8 @@ type CategoryDTO struct {
 	PortfolioID uint
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	CreatedBy   string // Actor that created the entity (see application/actor)
+	UpdatedBy   string // Actor that last updated the entity
 }
 
 // CreateCategoryInput is the input for creating a category
@@ -36,10 +38,31 @@ type UpdateCategoryInput struct {
 	OwnerID     string // For authorization check
 }
 
-// ListCategoriesInput is the input for listing categories by portfolio
+// PatchCategoryInput is the input for a partial category update
+// Nil fields keep their stored value; an empty description clears it.
+type PatchCategoryInput struct {
+	ID          uint
+	Title       *string
+	Description *string
+	Position    *uint
+	OwnerID     string // For authorization check
+}
+
+// DeleteCategoryInput is the input for deleting a category
+type DeleteCategoryInput struct {
+	ID      uint
+	OwnerID string // For authorization check
+	// MoveProjectsTo keeps the projects by moving them to this category before the delete;
+	// nil cascades the delete to them
+	MoveProjectsTo *uint
+	// AllowOtherPortfolio lets MoveProjectsTo be a category of another portfolio of the owner
+	AllowOtherPortfolio bool
+}
+
+// ListCategoriesInput is the input for listing the categories of an owner
 type ListCategoriesInput struct {
-	PortfolioID uint
-	Pagination  PaginationDTO
+	OwnerID    string
+	Pagination PaginationDTO
 }
 
 // ListCategoriesOutput is the output for listing categories
@@ -54,8 +77,42 @@ type BulkUpdatePositionItem struct {
 	Position uint
 }
 
+// SwapPositionsInput is the input for exchanging the positions of two items under the same parent
+type SwapPositionsInput struct {
+	FirstID  uint
+	SecondID uint
+	OwnerID  string // For authorization check
+}
+
 // BulkUpdateCategoryPositionsInput is the input for bulk updating category positions
 type BulkUpdateCategoryPositionsInput struct {
 	Items   []BulkUpdatePositionItem
 	OwnerID string // For authorization check
 }
+
+// CategoryDetailInput is the input for the owner dashboard category detail
+type CategoryDetailInput struct {
+	CategoryID uint
+	OwnerID    string
+	Pagination PaginationDTO // Pagination of the category's projects
+}
+
+// CategoryDetailProjectDTO is the list-level view of a project inside a category detail
+type CategoryDetailProjectDTO struct {
+	ID        uint
+	Title     string
+	MainImage *string
+	Skills    []string
+	Client    *string
+	Position  uint
+	Hidden    bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// CategoryDetailDTO is a category with one page of its projects
+type CategoryDetailDTO struct {
+	Category   CategoryDTO
+	Projects   []CategoryDetailProjectDTO
+	Pagination PaginatedResultDTO
+}
@@ -0,0 +1,20 @@
+package dto
+
+import "time"
+
+// Change event actions
+const (
+	ChangeActionCreated = "created"
+	ChangeActionUpdated = "updated"
+	ChangeActionDeleted = "deleted"
+)
+
+// ChangeEventDTO is a notification that a user's content changed (multi-tab sync)
+// It only says what changed; clients refetch the resource to get its data.
+type ChangeEventDTO struct {
+	ID         uint64 // Monotonic within the process, used as the SSE event ID
+	Resource   string // Entity name as audited ("portfolio", "project"...)
+	ResourceID uint
+	Action     string
+	UpdatedAt  time.Time
+}
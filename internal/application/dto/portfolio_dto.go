@@ -11,9 +11,26 @@ type PortfolioDTO struct {
 	ID          uint
 	Title       string
 	Description string
+	Slug        string
 	OwnerID     string
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	CreatedBy   string // Actor that created the entity (see application/actor)
+	UpdatedBy   string // Actor that last updated the entity
+
+	// IsPublished makes the portfolio and everything in it visible through the public routes
+	IsPublished bool
+
+	// EndorsementsEnabled lets visitors endorse the skills of the portfolio's projects
+	EndorsementsEnabled bool
+
+	// CustomCSS is the stylesheet as written by the owner (owner-facing only);
+	// CustomCSSSanitized is the version served publicly
+	CustomCSS          string
+	CustomCSSSanitized string
+
+	// Links is only populated by public reads (GetPortfolioPublicUseCase)
+	Links []PortfolioLinkDTO
 }
 
 // CreatePortfolioInput is the input for creating a portfolio
@@ -29,6 +46,53 @@ type UpdatePortfolioInput struct {
 	Title       string
 	Description string
 	OwnerID     string // For authorization check
+
+	EndorsementsEnabled *bool // nil keeps the current setting
+
+	// RegenerateSlug replaces the slug with one made from the (new) title; the slug is kept otherwise
+	RegenerateSlug bool
+}
+
+// PatchPortfolioInput is the input for a partial portfolio update
+// Nil fields keep their stored value; an empty description clears it.
+type PatchPortfolioInput struct {
+	ID                  uint
+	Title               *string
+	Description         *string
+	EndorsementsEnabled *bool
+	OwnerID             string // For authorization check
+
+	// RegenerateSlug replaces the slug with one made from the (new) title; the slug is kept otherwise
+	RegenerateSlug bool
+}
+
+// SetPortfolioPublishedInput is the input for publishing a portfolio or turning it back into a draft
+type SetPortfolioPublishedInput struct {
+	PortfolioID uint
+	OwnerID     string // For authorization check
+	Published   bool
+}
+
+// UpdatePortfolioCustomCSSInput is the input for setting a portfolio's custom stylesheet
+type UpdatePortfolioCustomCSSInput struct {
+	PortfolioID uint
+	OwnerID     string // For authorization check
+	CSS         string // Empty removes the stylesheet
+	DryRun      bool   // Sanitize and report without saving
+}
+
+// PortfolioCustomCSSOutput is a custom stylesheet with what the sanitizer stripped from it
+type PortfolioCustomCSSOutput struct {
+	CSS          string
+	SanitizedCSS string
+	Removed      []CSSRemovalDTO
+	Saved        bool
+}
+
+// CSSRemovalDTO is a construct stripped from a custom stylesheet
+type CSSRemovalDTO struct {
+	Kind    string
+	Snippet string
 }
 
 // ListPortfoliosInput is the input for listing portfolios
@@ -43,6 +107,79 @@ type ListPortfoliosOutput struct {
 	Pagination PaginatedResultDTO
 }
 
+// ClonePortfolioInput is the input for deep-copying a portfolio
+type ClonePortfolioInput struct {
+	PortfolioID uint
+	OwnerID     string // For authorization check
+}
+
+// PortfolioCloneDTO is a freshly cloned portfolio with the number of children copied
+type PortfolioCloneDTO struct {
+	Portfolio PortfolioDTO
+	SourceID  uint
+	Copied    PortfolioChildCountsDTO
+}
+
+// PortfolioChildCountsDTO counts the child rows of a portfolio copied by a clone or brought back by a restore, per kind
+type PortfolioChildCountsDTO struct {
+	Links                int
+	Categories           int
+	Projects             int
+	ProjectCollaborators int
+	Sections             int
+	SectionContents      int
+}
+
+// RestorePortfolioInput is the input for bringing a portfolio back from the trash
+type RestorePortfolioInput struct {
+	PortfolioID uint
+	OwnerID     string // For authorization check
+}
+
+// DeletedPortfolioDTO is a soft-deleted portfolio, as listed in the owner's trash
+// Each entry is one delete batch: the portfolio and the children deleted along with it,
+// which are what a restore brings back.
+type DeletedPortfolioDTO struct {
+	Portfolio   PortfolioDTO
+	DeletedAt   time.Time
+	BatchID     string // Empty for portfolios deleted before batches existed
+	DeletedWith PortfolioChildCountsDTO
+}
+
+// PortfolioRestoreDTO is a portfolio brought back from the trash with the children restored along with it
+type PortfolioRestoreDTO struct {
+	Portfolio   PortfolioDTO
+	RenamedFrom string // Title before the restore, when it collided with a live portfolio; empty otherwise
+	Restored    PortfolioChildCountsDTO
+}
+
+// SearchPublicPortfoliosInput is the input for the public portfolio discovery list
+type SearchPublicPortfoliosInput struct {
+	Query      string // Free text matched against title and description (case-insensitive); empty lists all
+	Pagination PaginationDTO
+}
+
+// SearchPortfolioInput is the input for searching the projects and sections of one public portfolio
+type SearchPortfolioInput struct {
+	PortfolioID uint
+	Query       string // Every word must match, as a word prefix
+}
+
+// PortfolioSearchOutput groups the matches of a portfolio search per kind, best matches first
+type PortfolioSearchOutput struct {
+	Projects []ProjectDTO
+	Sections []SectionSearchHitDTO
+}
+
+// PortfolioStructuredDataOutput is everything needed to describe a public portfolio
+// as schema.org structured data (JSON-LD)
+type PortfolioStructuredDataOutput struct {
+	Portfolio PortfolioDTO
+	OwnerName string
+	Projects  []ProjectDTO
+	Links     []PortfolioLinkDTO
+}
+
 // ============================================================================
 // Common DTOs (Pagination)
 // ============================================================================
@@ -59,3 +196,71 @@ type PaginatedResultDTO struct {
 	Page  int
 	Limit int
 }
+
+// ============================================================================
+// Portfolio Completeness DTOs
+// ============================================================================
+
+// CompletenessCheckDTO is the outcome of a single completeness rule
+type CompletenessCheckDTO struct {
+	Name         string
+	Passed       bool
+	Weight       int
+	Message      string
+	ResourceType string
+	ResourceIDs  []uint
+}
+
+// PortfolioCompletenessOutput is the completeness score of a portfolio with its checklist
+type PortfolioCompletenessOutput struct {
+	PortfolioID uint
+	Score       int
+	Checks      []CompletenessCheckDTO
+}
+
+// ============================================================================
+// Portfolio Accessibility DTOs
+// ============================================================================
+
+// AccessibilityReportInput is the input for linting a portfolio for accessibility
+// Colors are the theme the portfolio is rendered with (hex); empty ones are not checked
+type AccessibilityReportInput struct {
+	PortfolioID     uint
+	OwnerID         string
+	TextColor       string
+	BackgroundColor string
+	AccentColor     string
+}
+
+// AccessibilityFindingDTO is a single accessibility problem
+type AccessibilityFindingDTO struct {
+	Name         string
+	Severity     string
+	Message      string
+	ResourceType string
+	ResourceIDs  []uint
+	Values       map[string]string
+}
+
+// AccessibilityReportOutput is the list of accessibility findings of a portfolio
+type AccessibilityReportOutput struct {
+	PortfolioID uint
+	Findings    []AccessibilityFindingDTO
+}
+
+// ============================================================================
+// Portfolio Availability DTOs
+// ============================================================================
+
+// Public availability statuses of a portfolio
+const (
+	PortfolioAvailabilityPublished = "published"
+	PortfolioAvailabilityPrivate   = "private" // Reserved: drafts are reported as not_found
+	PortfolioAvailabilityNotFound  = "not_found"
+)
+
+// PortfolioAvailabilityDTO tells the public frontend how a portfolio route will resolve
+type PortfolioAvailabilityDTO struct {
+	Status        string
+	RequiresToken bool
+}
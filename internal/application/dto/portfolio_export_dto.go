@@ -0,0 +1,107 @@
+package dto
+
+// PortfolioExportVersion is the version of the portfolio export document
+// Bump it when a field is renamed, removed or changes meaning; added fields keep the version.
+const PortfolioExportVersion = 1
+
+// ExportPortfolioInput is the input for exporting a portfolio
+type ExportPortfolioInput struct {
+	PortfolioID uint
+	OwnerID     string // For authorization check
+}
+
+// PortfolioExportDTO is a live portfolio with all its live children, in position order
+// IDs are left out: they only make sense on the exporting instance.
+type PortfolioExportDTO struct {
+	Title               string
+	Description         string
+	Slug                string
+	EndorsementsEnabled bool
+	CustomCSS           string
+	Links               []PortfolioLinkExportDTO
+	Categories          []CategoryExportDTO
+	Sections            []SectionExportDTO
+}
+
+// PortfolioLinkExportDTO is an exported portfolio link
+type PortfolioLinkExportDTO struct {
+	Kind     string
+	Label    string
+	URL      string
+	Position uint
+}
+
+// CategoryExportDTO is an exported category with its projects
+type CategoryExportDTO struct {
+	Title       string
+	Description *string
+	Position    uint
+	Projects    []ProjectExportDTO
+}
+
+// ProjectExportDTO is an exported project with its collaborators
+// MainImage and Images are the stored image paths (relative to the asset base URL unless absolute).
+type ProjectExportDTO struct {
+	Title         string
+	Description   string
+	MainImage     *string
+	Images        []string
+	Skills        []string
+	Client        *string
+	Link          *string
+	Position      uint
+	Hidden        bool
+	Collaborators []ProjectCollaboratorExportDTO
+}
+
+// ProjectCollaboratorExportDTO is an exported project collaborator
+type ProjectCollaboratorExportDTO struct {
+	Name     string
+	Role     string
+	URL      *string
+	Position uint
+}
+
+// SectionExportDTO is an exported section with its contents
+type SectionExportDTO struct {
+	Title       string
+	Slug        string
+	Description *string
+	Type        string
+	Position    uint
+	Contents    []SectionContentExportDTO
+}
+
+// SectionContentExportDTO is an exported section content
+type SectionContentExportDTO struct {
+	Type     string
+	Content  *string
+	Position uint
+}
+
+// ImportPortfolioInput is the input for importing a portfolio from an export document
+type ImportPortfolioInput struct {
+	OwnerID       string
+	ExportVersion int
+	// SourceBaseURL is the base_url of the document, LocalBaseURL the one of this instance;
+	// relative image paths are rewritten against the source when they differ
+	SourceBaseURL string
+	LocalBaseURL  string
+	Portfolio     PortfolioExportDTO
+}
+
+// PortfolioImportDTO is a freshly imported portfolio with the number of children created
+// and the document entries left out
+type PortfolioImportDTO struct {
+	Portfolio      PortfolioDTO
+	Created        PortfolioChildCountsDTO
+	ExternalImages int
+	Skipped        []ImportSkipDTO
+}
+
+// ImportSkipDTO is a document entry the import left out
+// Path locates it in the document, e.g. "categories[1].projects[0]".
+type ImportSkipDTO struct {
+	Path   string
+	Reason string
+}
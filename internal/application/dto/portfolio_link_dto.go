@@ -0,0 +1,55 @@
+package dto
+
+import "time"
+
+// ============================================================================
+// PortfolioLink DTOs (Application Layer)
+// ============================================================================
+
+// PortfolioLinkDTO represents a portfolio contact/social link in the application layer
+type PortfolioLinkDTO struct {
+	ID          uint
+	PortfolioID uint
+	Kind        string
+	Label       string
+	URL         string // Address for the email kind
+	Position    uint
+	OwnerID     string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// CreatePortfolioLinkInput is the input for creating a portfolio link
+// Position 0 appends the link after the existing ones
+type CreatePortfolioLinkInput struct {
+	PortfolioID uint
+	Kind        string
+	Label       string
+	URL         string
+	Position    uint
+	OwnerID     string
+}
+
+// CreatePortfolioLinkOutput is the created link with the quota warnings the create triggered
+type CreatePortfolioLinkOutput struct {
+	Link     PortfolioLinkDTO
+	Warnings []QuotaWarningDTO
+}
+
+// UpdatePortfolioLinkInput is the input for updating a portfolio link
+type UpdatePortfolioLinkInput struct {
+	ID          uint
+	PortfolioID uint
+	Kind        string
+	Label       string
+	URL         string
+	Position    uint
+	OwnerID     string // For authorization check
+}
+
+// ReorderPortfolioLinksInput is the input for reordering the links of a portfolio
+type ReorderPortfolioLinksInput struct {
+	PortfolioID uint
+	Items       []BulkUpdatePositionItem
+	OwnerID     string
+}
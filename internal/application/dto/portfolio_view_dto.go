@@ -0,0 +1,51 @@
+package dto
+
+import "time"
+
+// ============================================================================
+// Portfolio View DTOs (Application Layer)
+// ============================================================================
+
+// PortfolioViewBatchDTO is the buffered views of a portfolio on one day, written by the view flusher
+type PortfolioViewBatchDTO struct {
+	PortfolioID uint
+	Day         time.Time // UTC date
+	Views       uint
+}
+
+// RecordPortfolioViewInput is a public read of a portfolio page
+type RecordPortfolioViewInput struct {
+	PortfolioID uint
+	ClientIP    string
+	UserAgent   string
+}
+
+// Portfolio analytics ranges
+const (
+	PortfolioAnalyticsDefaultDays = 30  // Days returned when no range is given, today included
+	PortfolioAnalyticsMaxDays     = 366 // Longest range a single request may cover
+)
+
+// PortfolioAnalyticsInput is the input for reading the daily views of a portfolio
+// Zero From/To default to the last PortfolioAnalyticsDefaultDays days ending today.
+type PortfolioAnalyticsInput struct {
+	PortfolioID uint
+	OwnerID     string
+	From        time.Time // UTC date, inclusive
+	To          time.Time // UTC date, inclusive
+}
+
+// PortfolioViewDayDTO is the number of counted views of a portfolio on one day
+type PortfolioViewDayDTO struct {
+	Day   time.Time
+	Views uint
+}
+
+// PortfolioAnalyticsDTO is the daily views of a portfolio over a range, one entry per day
+type PortfolioAnalyticsDTO struct {
+	PortfolioID uint
+	From        time.Time
+	To          time.Time
+	Days        []PortfolioViewDayDTO // Days without views are included with zero
+	Total       uint
+}
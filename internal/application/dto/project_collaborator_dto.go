@@ -0,0 +1,55 @@
+package dto
+
+import "time"
+
+// ============================================================================
+// ProjectCollaborator DTOs (Application Layer)
+// ============================================================================
+
+// ProjectCollaboratorDTO represents a person credited on a project in the application layer
+type ProjectCollaboratorDTO struct {
+	ID        uint
+	ProjectID uint
+	Name      string
+	Role      string
+	URL       *string
+	Position  uint
+	OwnerID   string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// CreateProjectCollaboratorInput is the input for crediting a collaborator on a project
+// Position 0 appends the collaborator after the existing ones
+type CreateProjectCollaboratorInput struct {
+	ProjectID uint
+	Name      string
+	Role      string
+	URL       *string
+	Position  uint
+	OwnerID   string
+}
+
+// CreateProjectCollaboratorOutput is the created collaborator with the quota warnings the create triggered
+type CreateProjectCollaboratorOutput struct {
+	Collaborator ProjectCollaboratorDTO
+	Warnings     []QuotaWarningDTO
+}
+
+// UpdateProjectCollaboratorInput is the input for updating a project collaborator
+type UpdateProjectCollaboratorInput struct {
+	ID        uint
+	ProjectID uint
+	Name      string
+	Role      string
+	URL       *string
+	Position  uint
+	OwnerID   string // For authorization check
+}
+
+// ReorderProjectCollaboratorsInput is the input for reordering the collaborators of a project
+type ReorderProjectCollaboratorsInput struct {
+	ProjectID uint
+	Items     []BulkUpdatePositionItem
+	OwnerID   string
+}
@@ -16,10 +16,78 @@ type ProjectDTO struct {
 	Skills      []string
 	Client      *string
 	Link        *string
+	Position    uint
 	CategoryID  uint
 	OwnerID     string
+	Hidden      bool // Left out of public reads (e.g. a project under NDA)
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	CreatedBy   string // Actor that created the entity (see application/actor)
+	UpdatedBy   string // Actor that last updated the entity
+
+	// Context is only populated by context-aware reads (GetByIDWithContext, GetContextByIDs)
+	Context *ProjectContextDTO
+
+	// Endorsements is only populated by the public read, when the portfolio allows endorsements
+	// (skill -> count, skills without endorsements are omitted)
+	Endorsements map[string]uint
+
+	// DefaultedFields is only populated on create: request fields filled from the owner's project defaults
+	DefaultedFields []string
+
+	// Collaborators is only populated by detail reads (own and public), ordered by position
+	Collaborators []ProjectCollaboratorDTO
+
+	// Views is only populated by owner reads (list and detail), never by public ones
+	Views *ProjectViewStatsDTO
+}
+
+// ProjectViewStatsDTO is the public view activity of a project, as last flushed
+type ProjectViewStatsDTO struct {
+	LastViewedAt *time.Time // nil when the project was never viewed
+	Last30Days   uint       // Views over the last ProjectViewWindowDays days, today included
+}
+
+// ProjectViewWindowDays is the rolling window of the owner-facing view count
+const ProjectViewWindowDays = 30
+
+// ProjectViewBatchDTO is the buffered views of a project on one day, written by the view flusher
+type ProjectViewBatchDTO struct {
+	ProjectID    uint
+	Day          time.Time // UTC date
+	Views        uint
+	LastViewedAt time.Time
+}
+
+// ProjectDiffDTO is the field-by-field difference between two projects (see application/diff)
+type ProjectDiffDTO struct {
+	LeftID  uint
+	RightID uint
+	Fields  []FieldChangeDTO // Scalar fields that differ
+	Skills  SetChangeDTO
+	Images  SetChangeDTO // Matched by path
+}
+
+// FieldChangeDTO is a scalar field with different values on each side (nil for an unset optional)
+type FieldChangeDTO struct {
+	Field string
+	Left  interface{}
+	Right interface{}
+}
+
+// SetChangeDTO is the difference between two sets of values
+type SetChangeDTO struct {
+	Added   []string // Only on the right
+	Removed []string // Only on the left
+}
+
+// ProjectContextDTO carries the category and portfolio a project belongs to (breadcrumbs)
+type ProjectContextDTO struct {
+	CategoryID      uint
+	CategoryTitle   string
+	CategoryOwnerID string // For authorization checks only, never exposed publicly
+	PortfolioID     uint
+	PortfolioTitle  string
 }
 
 // CreateProjectInput is the input for creating a project
@@ -32,6 +100,8 @@ type CreateProjectInput struct {
 	Client      *string
 	Link        *string
 	CategoryID  uint
+	PortfolioID uint // Resolves CategoryID from the owner's pinned category when CategoryID is 0
+	Hidden      bool
 	OwnerID     string
 }
 
@@ -45,17 +115,81 @@ type UpdateProjectInput struct {
 	Skills      []string
 	Client      *string
 	Link        *string
+	Hidden      *bool  // nil keeps the current setting
 	OwnerID     string // For authorization check
 }
 
+// PatchProjectInput is the input for a partial project update
+// Nil fields keep their stored value; an empty main image, client or link clears it.
+// A new CategoryID moves the project to the end of that category.
+type PatchProjectInput struct {
+	ID          uint
+	Title       *string
+	Description *string
+	MainImage   *string
+	Images      *[]string
+	Skills      *[]string
+	Client      *string
+	Link        *string
+	CategoryID  *uint
+	Hidden      *bool
+	OwnerID     string // For authorization check
+}
+
+// BulkUpdateProjectPositionsInput is the input for reordering projects of a category
+type BulkUpdateProjectPositionsInput struct {
+	Items   []BulkUpdatePositionItem
+	OwnerID string // For authorization check
+}
+
 // ListProjectsInput is the input for listing projects
 type ListProjectsInput struct {
-	OwnerID    string
-	Pagination PaginationDTO
+	OwnerID        string
+	Pagination     PaginationDTO
+	IncludeContext bool   // Attach category/portfolio context to each project
+	Sort           string // ProjectListSortRecent (default) or ProjectListSortLeastViewed
+	Filter         ProjectFilter
 }
 
+// ProjectFilter narrows the own project list; zero fields don't filter, set ones are combined with AND
+type ProjectFilter struct {
+	CategoryID uint
+	Client     string // Case-insensitive partial match
+	Skill      string // Case-insensitive membership in the skills
+}
+
+// Own project list sort orders
+const (
+	ProjectListSortRecent      = "recent"
+	ProjectListSortLeastViewed = "least_viewed" // Fewest views over the window first, then oldest last view
+)
+
 // ListProjectsOutput is the output for listing projects
 type ListProjectsOutput struct {
 	Projects   []ProjectDTO
 	Pagination PaginatedResultDTO
 }
+
+// Public project search sort orders
+const (
+	ProjectSearchSortRecent   = "recent"
+	ProjectSearchSortRelevant = "relevant"
+)
+
+// SearchPublicProjectsInput is the input for searching projects across all public portfolios
+type SearchPublicProjectsInput struct {
+	Query      string // Free text matched against title, description, client and skills
+	Skill      string // Exact (case-insensitive) skill filter
+	Sort       string // ProjectSearchSortRecent (default) or ProjectSearchSortRelevant
+	Pagination PaginationDTO
+	TotalCap   int64 // Counting stops past this many matches
+}
+
+// SearchPublicProjectsOutput is the output for a public project search
+// Projects always carry their Context. When TotalCapped is set, Total is the cap
+// and the real number of matches is higher.
+type SearchPublicProjectsOutput struct {
+	Projects    []ProjectDTO
+	Pagination  PaginatedResultDTO
+	TotalCapped bool
+}
@@ -0,0 +1,12 @@
+package dto
+
+// ============================================================================
+// Quota DTOs (Application Layer)
+// ============================================================================
+
+// QuotaWarningDTO reports a quota a successful write pushed past its soft threshold
+type QuotaWarningDTO struct {
+	Quota string
+	Usage int64 // Usage after the write
+	Limit int64
+}
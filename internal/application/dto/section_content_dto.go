@@ -17,6 +17,8 @@ type SectionContentDTO struct {
 	OwnerID   string
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	CreatedBy string // Actor that created the entity (see application/actor)
+	UpdatedBy string // Actor that last updated the entity
 }
 
 // CreateSectionContentInput is the input for creating a section content
@@ -38,3 +40,26 @@ type UpdateSectionContentInput struct {
 	ImageID *uint
 	OwnerID string // For authorization check
 }
+
+// SectionContentRevisionDTO represents a previous state of a section content
+// SizeDelta is the size change compared to the previous (older) revision
+type SectionContentRevisionDTO struct {
+	ID               uint
+	SectionContentID uint
+	Type             string
+	Content          *string
+	ImageID          *uint
+	Size             int
+	SizeDelta        int
+	OwnerID          string
+	CreatedAt        time.Time
+}
+
+// CreateSectionContentRevisionInput is the input for recording a section content revision
+type CreateSectionContentRevisionInput struct {
+	SectionContentID uint
+	Type             string
+	Content          *string
+	ImageID          *uint
+	OwnerID          string
+}
@@ -10,6 +10,7 @@ import "time"
 type SectionDTO struct {
 	ID          uint
 	Title       string
+	Slug        string // Anchor generated from the title, unique within the portfolio
 	Description *string
 	Type        string // Optional: could be NavBar, HomePageSection, etc.
 	Position    uint
@@ -17,6 +18,14 @@ type SectionDTO struct {
 	PortfolioID uint
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	CreatedBy   string // Actor that created the entity (see application/actor)
+	UpdatedBy   string // Actor that last updated the entity
+}
+
+// SectionSearchHitDTO is a section matching a portfolio search, by its own text or its contents
+type SectionSearchHitDTO struct {
+	Section    SectionDTO
+	ContentIDs []uint // Matching contents of the section, in display order
 }
 
 // CreateSectionInput is the input for creating a section
@@ -39,9 +48,17 @@ type UpdateSectionInput struct {
 	OwnerID     string // For authorization check
 }
 
-// ListSectionsInput is the input for listing sections by portfolio
+// ListSectionsInput is the input for listing the sections of an owner
 type ListSectionsInput struct {
-	PortfolioID uint
+	OwnerID    string
+	Pagination PaginationDTO
+}
+
+// ListSectionsByTypeInput is the input for listing the caller's sections of one type
+type ListSectionsByTypeInput struct {
+	OwnerID     string
+	Type        string
+	PortfolioID *uint // nil lists across every portfolio of the owner
 	Pagination  PaginationDTO
 }
 
@@ -51,6 +68,12 @@ type ListSectionsOutput struct {
 	Pagination PaginatedResultDTO
 }
 
+// PortfolioTOCDTO is the table of contents of a public portfolio
+type PortfolioTOCDTO struct {
+	Sections  []SectionDTO      // Ordered by position
+	Redirects map[string]string // Former slug -> current slug
+}
+
 // BulkUpdateSectionPositionsInput is the input for bulk updating section positions
 type BulkUpdateSectionPositionsInput struct {
 	Items   []BulkUpdatePositionItem
@@ -0,0 +1,42 @@
+package dto
+
+import "time"
+
+// SkillEndorsementDTO is the endorsement count of one project skill
+type SkillEndorsementDTO struct {
+	Skill string
+	Count uint
+}
+
+// EndorseSkillInput is the input for endorsing a project skill as a visitor
+type EndorseSkillInput struct {
+	ProjectID uint
+	Skill     string
+	ClientIP  string // Hashed before storage, never persisted as-is
+}
+
+// EndorseSkillVoteInput is what the repository records for one endorsement
+type EndorseSkillVoteInput struct {
+	ProjectID  uint
+	Skill      string    // Canonical spelling from the project
+	IPHash     string    // Salted SHA-256 of the visitor IP
+	Day        time.Time // UTC date the vote counts for
+	DailyLimit int64     // Votes a project accepts per day across all skills
+}
+
+// EndorseSkillResultDTO is the outcome of an endorsement
+// Counted is false when the visitor already endorsed this skill today (the request is idempotent).
+type EndorseSkillResultDTO struct {
+	Skill        string
+	Count        uint
+	Counted      bool
+	ProjectVotes int64 // Votes the project received today, including this one
+	LimitReached bool  // The vote was refused because of DailyLimit
+}
+
+// ProjectEndorsementsDTO is the owner view of a project's endorsements
+type ProjectEndorsementsDTO struct {
+	ProjectID           uint
+	EndorsementsEnabled bool
+	Skills              []SkillEndorsementDTO // Every project skill, zero counts included
+}
@@ -0,0 +1,30 @@
+package dto
+
+// ============================================================================
+// Title availability DTOs (Application Layer)
+// ============================================================================
+
+// Resources whose titles can be checked, with the parent that scopes them
+const (
+	TitleResourcePortfolios = "portfolios" // Scoped by owner
+	TitleResourceCategories = "categories" // Scoped by portfolio
+	TitleResourceSections   = "sections"   // Scoped by portfolio
+	TitleResourceProjects   = "projects"   // Scoped by category
+)
+
+// CheckTitleInput is the input for checking whether a title is free within its parent
+type CheckTitleInput struct {
+	Resource  string
+	Title     string
+	ParentID  uint // Portfolio (categories, sections) or category (projects); unused for portfolios
+	ExcludeID uint // The item being edited, so its own title doesn't conflict
+	OwnerID   string
+}
+
+// TitleAvailabilityDTO is the result of a title check
+// ConflictingID and Suggested are only set when the title is taken
+type TitleAvailabilityDTO struct {
+	Available     bool
+	ConflictingID *uint
+	Suggested     *string
+}
@@ -0,0 +1,24 @@
+package dto
+
+import "time"
+
+// ============================================================================
+// Trash DTOs (Application Layer)
+// ============================================================================
+
+// Resources kept in the soft-delete trash (the names used by the audit log)
+const (
+	TrashResourcePortfolio      = "portfolio"
+	TrashResourceCategory       = "category"
+	TrashResourceSection        = "section"
+	TrashResourceProject        = "project"
+	TrashResourceSectionContent = "section_content"
+	TrashResourcePortfolioLink  = "portfolio_link"
+)
+
+// DeletedItemDTO is a soft-deleted item of the caller
+type DeletedItemDTO struct {
+	Resource  string
+	ID        uint
+	DeletedAt time.Time
+}
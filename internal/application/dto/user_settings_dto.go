@@ -0,0 +1,42 @@
+package dto
+
+import "time"
+
+// UserSettingsDTO represents the account-level settings of a user
+type UserSettingsDTO struct {
+	UserID             string
+	DefaultPortfolioID *uint // Explicitly chosen default portfolio, nil when unset
+	ProjectDefaults    ProjectDefaultsDTO
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+// UpdateUserSettingsInput is the input for updating the current user's settings
+type UpdateUserSettingsInput struct {
+	UserID             string
+	DefaultPortfolioID *uint // nil clears the explicit default
+}
+
+// DefaultPortfolioDTO is the portfolio a user's profile resolves to
+// Explicit is false when it was picked automatically (the user's only portfolio)
+type DefaultPortfolioDTO struct {
+	Portfolio PortfolioDTO
+	Explicit  bool
+}
+
+// ProjectDefaultsDTO holds the values applied to new projects when the request omits them
+type ProjectDefaultsDTO struct {
+	Client     *string       // nil: no default client
+	Skills     []string      // empty: no default skills
+	Categories map[uint]uint // Pinned category per portfolio (portfolio ID -> category ID)
+}
+
+// UpdateProjectDefaultsInput is the input for updating the current user's project defaults
+// Nil fields keep their stored value; an empty client, skill list or category map clears it,
+// and a zero category ID unpins that portfolio.
+type UpdateProjectDefaultsInput struct {
+	UserID     string
+	Client     *string
+	Skills     []string
+	Categories map[uint]uint
+}
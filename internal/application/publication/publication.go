@@ -0,0 +1,22 @@
+// Package publication holds the draft rule shared by the public reads: a portfolio that isn't
+// published, and everything in it, is reported as not found outside the owner routes.
+package publication
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+)
+
+// Require returns a "<resource> not found" error unless the portfolio is live and published
+func Require(ctx context.Context, portfolioRepo contracts.PortfolioRepository, portfolioID uint, resource string) error {
+	published, err := portfolioRepo.IsPublished(ctx, portfolioID)
+	if err != nil {
+		return fmt.Errorf("failed to get %s: %w", resource, err)
+	}
+	if !published {
+		return fmt.Errorf("%s not found", resource)
+	}
+	return nil
+}
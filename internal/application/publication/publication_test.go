@@ -0,0 +1,47 @@
+package publication
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+)
+
+// visibilityRepo publishes portfolio 1, keeps portfolio 2 a draft and fails on any other
+type visibilityRepo struct{ contracts.PortfolioRepository }
+
+func (visibilityRepo) IsPublished(_ context.Context, id uint) (bool, error) {
+	if id > 2 {
+		return false, errors.New("connection reset")
+	}
+	return id == 1, nil
+}
+
+func TestRequire(t *testing.T) {
+	tests := []struct {
+		name        string
+		portfolioID uint
+		wantErr     string // substring of the error; empty when none is expected
+	}{
+		{name: "published", portfolioID: 1},
+		{name: "draft reads as missing", portfolioID: 2, wantErr: "section not found"},
+		{name: "lookup failure", portfolioID: 3, wantErr: "failed to get section: connection reset"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Require(context.Background(), visibilityRepo{}, tt.portfolioID, "section")
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Require = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Require = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
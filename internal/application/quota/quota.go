@@ -0,0 +1,35 @@
+// Package quota decides when a write should warn that a user is getting close to a hard limit.
+// It is pure: callers pass the usage their enforcement query already measured.
+package quota
+
+import "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+
+// Quota names, part of the API contract (returned in warnings)
+const (
+	PortfolioLinks       = "portfolio_links"
+	ProjectCollaborators = "project_collaborators"
+)
+
+// SoftThresholdPercent is the share of a limit past which writes return a warning
+const SoftThresholdPercent = 80
+
+// Check returns a warning when usage (after the write) is past the soft threshold of limit, nil otherwise.
+// A limit of 0 or less means unlimited.
+func Check(name string, usage, limit int64) *dto.QuotaWarningDTO {
+	if limit <= 0 || usage*100 <= limit*SoftThresholdPercent {
+		return nil
+	}
+
+	return &dto.QuotaWarningDTO{Quota: name, Usage: usage, Limit: limit}
+}
+
+// Collect gathers the non-nil warnings
+func Collect(warnings ...*dto.QuotaWarningDTO) []dto.QuotaWarningDTO {
+	var collected []dto.QuotaWarningDTO
+	for _, warning := range warnings {
+		if warning != nil {
+			collected = append(collected, *warning)
+		}
+	}
+	return collected
+}
@@ -0,0 +1,51 @@
+package quota
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name         string
+		usage, limit int64
+		wantWarning  bool
+	}{
+		{name: "well below", usage: 2, limit: 10},
+		{name: "exactly at the threshold", usage: 8, limit: 10},
+		{name: "just above the threshold", usage: 9, limit: 10, wantWarning: true},
+		{name: "at the limit", usage: 10, limit: 10, wantWarning: true},
+		{name: "just below on a large limit", usage: 800, limit: 1000},
+		{name: "just above on a large limit", usage: 801, limit: 1000, wantWarning: true},
+		{name: "unlimited", usage: 1000, limit: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Check(PortfolioLinks, tt.usage, tt.limit)
+			if !tt.wantWarning {
+				if got != nil {
+					t.Errorf("Check(%d, %d) = %+v, want no warning", tt.usage, tt.limit, got)
+				}
+				return
+			}
+			want := &dto.QuotaWarningDTO{Quota: PortfolioLinks, Usage: tt.usage, Limit: tt.limit}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("Check(%d, %d) = %+v, want %+v", tt.usage, tt.limit, got, want)
+			}
+		})
+	}
+}
+
+func TestCollect(t *testing.T) {
+	if got := Collect(nil, nil); got != nil {
+		t.Errorf("Collect(nil, nil) = %v, want nil", got)
+	}
+
+	warning := dto.QuotaWarningDTO{Quota: ProjectCollaborators, Usage: 5, Limit: 5}
+	if got := Collect(nil, &warning); !reflect.DeepEqual(got, []dto.QuotaWarningDTO{warning}) {
+		t.Errorf("Collect = %v, want only the non-nil warning", got)
+	}
+}
@@ -0,0 +1,21 @@
+// Package requestid carries the ID of the HTTP request being served through the context,
+// so every audit entry written while serving it can be correlated.
+package requestid
+
+import "context"
+
+// Header is the HTTP header the ID is read from and echoed in
+const Header = "X-Request-ID"
+
+type contextKey struct{}
+
+// WithRequestID returns a copy of ctx carrying the request ID
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the request ID stored in ctx, or "" outside an HTTP request
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(contextKey{}).(string)
+	return id
+}
@@ -0,0 +1,27 @@
+// Package titles holds the title de-duplication rule shared by moves and availability checks:
+// a taken title gets the first free " (2)", " (3)"... suffix.
+package titles
+
+import "fmt"
+
+// MaxLength is the length of the title columns (varchar(255))
+const MaxLength = 255
+
+// Unique returns title when it is not taken, otherwise title with the first free numeric suffix
+// The base is shortened when needed so the result stays within MaxLength characters.
+func Unique(title string, taken map[string]bool) string {
+	if !taken[title] {
+		return title
+	}
+
+	for n := 2; ; n++ {
+		suffix := fmt.Sprintf(" (%d)", n)
+		base := title
+		if runes := []rune(base); len(runes)+len(suffix) > MaxLength {
+			base = string(runes[:MaxLength-len(suffix)])
+		}
+		if candidate := base + suffix; !taken[candidate] {
+			return candidate
+		}
+	}
+}
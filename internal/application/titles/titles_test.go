@@ -0,0 +1,41 @@
+package titles
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestUnique(t *testing.T) {
+	long := strings.Repeat("é", MaxLength)
+
+	tests := []struct {
+		name  string
+		title string
+		taken []string
+		want  string
+	}{
+		{name: "free", title: "Blog", taken: []string{"Blog (2)"}, want: "Blog"},
+		{name: "taken", title: "Blog", taken: []string{"Blog"}, want: "Blog (2)"},
+		{name: "first gap", title: "Blog", taken: []string{"Blog", "Blog (2)", "Blog (4)"}, want: "Blog (3)"},
+		{name: "other titles ignored", title: "Blog", taken: []string{"Blog", "blog (2)", "Blog(2)"}, want: "Blog (2)"},
+		{name: "full length title shortened", title: long, taken: []string{long}, want: strings.Repeat("é", MaxLength-4) + " (2)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taken := make(map[string]bool, len(tt.taken))
+			for _, title := range tt.taken {
+				taken[title] = true
+			}
+
+			got := Unique(tt.title, taken)
+			if got != tt.want {
+				t.Errorf("Unique(%q) = %q, want %q", tt.title, got, tt.want)
+			}
+			if n := utf8.RuneCountInString(got); n > MaxLength {
+				t.Errorf("Unique(%q) is %d characters, want at most %d", tt.title, n, MaxLength)
+			}
+		})
+	}
+}
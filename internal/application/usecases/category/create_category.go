@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
 	contracts2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
 )
@@ -35,13 +36,13 @@ func NewCreateCategoryUseCase(
 func (uc *CreateCategoryUseCase) Execute(ctx context.Context, input dto.CreateCategoryInput) (*dto.CategoryDTO, error) {
 	// Validate input
 	if input.Title == "" {
-		return nil, fmt.Errorf("category title is required")
+		return nil, apperrors.Required("title", "category title is required")
 	}
 	if input.OwnerID == "" {
 		return nil, fmt.Errorf("owner ID is required")
 	}
 	if input.PortfolioID == 0 {
-		return nil, fmt.Errorf("portfolio ID is required")
+		return nil, apperrors.Required("portfolio_id", "portfolio ID is required")
 	}
 
 	// Verify portfolio exists and user owns it
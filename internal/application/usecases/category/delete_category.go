@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
 	contracts2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
 )
 
 // DeleteCategoryUseCase handles the business logic for deleting a category
@@ -30,8 +32,10 @@ func NewDeleteCategoryUseCase(
 	}
 }
 
-// Execute deletes a category with ownership verification
-func (uc *DeleteCategoryUseCase) Execute(ctx context.Context, id uint, ownerID string) error {
+// Execute deletes a category with ownership verification.
+// Its projects are deleted with it unless input.MoveProjectsTo names a category to move them to
+func (uc *DeleteCategoryUseCase) Execute(ctx context.Context, input dto.DeleteCategoryInput) error {
+	id, ownerID := input.ID, input.OwnerID
 	if id == 0 {
 		return fmt.Errorf("invalid category ID")
 	}
@@ -54,18 +58,56 @@ func (uc *DeleteCategoryUseCase) Execute(ctx context.Context, id uint, ownerID s
 		return fmt.Errorf("unauthorized: you don't own this category")
 	}
 
-	// Delete the category
-	if err := uc.categoryRepo.Delete(ctx, id); err != nil {
-		return fmt.Errorf("failed to delete category: %w", err)
+	details := map[string]interface{}{
+		"title":        category.Title,
+		"portfolio_id": category.PortfolioID,
+		"owner_id":     ownerID,
+	}
+
+	if input.MoveProjectsTo == nil {
+		// Delete the category with its projects
+		if err := uc.categoryRepo.Delete(ctx, id); err != nil {
+			return fmt.Errorf("failed to delete category: %w", err)
+		}
+	} else {
+		targetID := *input.MoveProjectsTo
+		if targetID == id {
+			return apperrors.New(apperrors.KindValidation, apperrors.CodeCategoryMoveTargetSelf,
+				"projects cannot be moved to the category being deleted", nil)
+		}
+
+		// Verify the target category exists and belongs to the same owner
+		target, err := uc.categoryRepo.GetByID(ctx, targetID)
+		if err != nil {
+			return fmt.Errorf("target category not found")
+		}
+		if target.PortfolioID != category.PortfolioID {
+			if !input.AllowOtherPortfolio {
+				return apperrors.New(apperrors.KindValidation, apperrors.CodeCategoryMoveTargetOtherPortfolio,
+					"the target category belongs to another portfolio",
+					map[string]interface{}{"move_projects_to": targetID})
+			}
+			targetPortfolio, err := uc.portfolioRepo.GetByID(ctx, target.PortfolioID)
+			if err != nil {
+				return fmt.Errorf("target category not found")
+			}
+			if targetPortfolio.OwnerID != ownerID {
+				return fmt.Errorf("unauthorized: you don't own the target category")
+			}
+		}
+
+		// Move the projects, then delete the emptied category
+		moved, err := uc.categoryRepo.DeleteMovingProjects(ctx, id, targetID)
+		if err != nil {
+			return fmt.Errorf("failed to delete category: %w", err)
+		}
+		details["moved_to"] = targetID
+		details["moved_project_ids"] = moved
 	}
 
 	// Audit logging
 	if uc.auditLogger != nil {
-		uc.auditLogger.LogDelete(ctx, "category", id, map[string]interface{}{
-			"title":        category.Title,
-			"portfolio_id": category.PortfolioID,
-			"owner_id":     ownerID,
-		})
+		uc.auditLogger.LogDelete(ctx, "category", id, details)
 	}
 
 	// Metrics
@@ -0,0 +1,115 @@
+package category
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// movingCategoryRepo serves categories by ID and records the deletes that reach it
+type movingCategoryRepo struct {
+	contracts.CategoryRepository
+	categories []dto.CategoryDTO
+	deleted    []uint
+	movedTo    []uint
+}
+
+func (r *movingCategoryRepo) GetByID(_ context.Context, id uint) (*dto.CategoryDTO, error) {
+	for _, category := range r.categories {
+		if category.ID == id {
+			return &category, nil
+		}
+	}
+	return nil, errors.New("category not found")
+}
+
+func (r *movingCategoryRepo) Delete(_ context.Context, id uint) error {
+	r.deleted = append(r.deleted, id)
+	return nil
+}
+
+func (r *movingCategoryRepo) DeleteMovingProjects(_ context.Context, id, targetID uint) ([]uint, error) {
+	r.deleted = append(r.deleted, id)
+	r.movedTo = append(r.movedTo, targetID)
+	return []uint{7, 8}, nil
+}
+
+// ownedPortfolioRepo gives portfolio 1 and 2 to "alice" and portfolio 3 to "bob"
+type ownedPortfolioRepo struct{ contracts.PortfolioRepository }
+
+func (ownedPortfolioRepo) GetByID(_ context.Context, id uint) (*dto.PortfolioDTO, error) {
+	owner := "alice"
+	if id == 3 {
+		owner = "bob"
+	}
+	return &dto.PortfolioDTO{ID: id, OwnerID: owner}, nil
+}
+
+// deleteAuditLogger keeps the details of the last delete entry
+type deleteAuditLogger struct {
+	contracts.AuditLogger
+	details map[string]interface{}
+}
+
+func (l *deleteAuditLogger) LogDelete(_ context.Context, _ string, _ uint, details map[string]interface{}) {
+	l.details = details
+}
+
+func TestDeleteCategory_MoveProjectsTarget(t *testing.T) {
+	target := func(id uint) *uint { return &id }
+
+	tests := []struct {
+		name        string
+		input       dto.DeleteCategoryInput
+		wantCode    string // application error code; empty when another error or none is expected
+		wantErr     bool
+		wantMovedTo []uint
+	}{
+		{name: "same portfolio", input: dto.DeleteCategoryInput{MoveProjectsTo: target(11)}, wantMovedTo: []uint{11}},
+		{name: "itself", input: dto.DeleteCategoryInput{MoveProjectsTo: target(10)}, wantCode: apperrors.CodeCategoryMoveTargetSelf, wantErr: true},
+		{name: "other portfolio not allowed", input: dto.DeleteCategoryInput{MoveProjectsTo: target(20)}, wantCode: apperrors.CodeCategoryMoveTargetOtherPortfolio, wantErr: true},
+		{name: "other portfolio allowed", input: dto.DeleteCategoryInput{MoveProjectsTo: target(20), AllowOtherPortfolio: true}, wantMovedTo: []uint{20}},
+		{name: "another owner's portfolio", input: dto.DeleteCategoryInput{MoveProjectsTo: target(30), AllowOtherPortfolio: true}, wantErr: true},
+		{name: "unknown target", input: dto.DeleteCategoryInput{MoveProjectsTo: target(99)}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &movingCategoryRepo{categories: []dto.CategoryDTO{
+				{ID: 10, PortfolioID: 1}, {ID: 11, PortfolioID: 1}, {ID: 20, PortfolioID: 2}, {ID: 30, PortfolioID: 3},
+			}}
+			audit := &deleteAuditLogger{}
+			input := tt.input
+			input.ID, input.OwnerID = 10, "alice"
+
+			err := NewDeleteCategoryUseCase(repo, ownedPortfolioRepo{}, audit, nil).Execute(context.Background(), input)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Execute error = %v, want error %v", err, tt.wantErr)
+			}
+			if tt.wantCode != "" {
+				if appErr, ok := apperrors.As(err); !ok || appErr.Code != tt.wantCode || appErr.Kind != apperrors.KindValidation {
+					t.Errorf("error = %v, want validation error %s", err, tt.wantCode)
+				}
+			}
+			if tt.wantErr {
+				if len(repo.deleted) != 0 {
+					t.Errorf("categories %v deleted after a rejected move", repo.deleted)
+				}
+				return
+			}
+
+			if !reflect.DeepEqual(repo.movedTo, tt.wantMovedTo) || !reflect.DeepEqual(repo.deleted, []uint{10}) {
+				t.Errorf("moved to %v and deleted %v, want moved to %v and deleted [10]", repo.movedTo, repo.deleted, tt.wantMovedTo)
+			}
+			if audit.details["moved_to"] != tt.wantMovedTo[0] || !reflect.DeepEqual(audit.details["moved_project_ids"], []uint{7, 8}) {
+				t.Errorf("audit details = %v, want the destination and the moved project IDs", audit.details)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package category
+
+import (
+	"context"
+	"fmt"
+
+	contracts2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// GetCategoryDetailUseCase handles the owner dashboard view of a category and its projects
+type GetCategoryDetailUseCase struct {
+	categoryRepo contracts2.CategoryRepository
+	auditLogger  contracts2.AuditLogger
+}
+
+// NewGetCategoryDetailUseCase creates a new instance of GetCategoryDetailUseCase
+func NewGetCategoryDetailUseCase(
+	categoryRepo contracts2.CategoryRepository,
+	auditLogger contracts2.AuditLogger,
+) *GetCategoryDetailUseCase {
+	return &GetCategoryDetailUseCase{
+		categoryRepo: categoryRepo,
+		auditLogger:  auditLogger,
+	}
+}
+
+// Execute retrieves a category owned by the user with one page of its projects
+func (uc *GetCategoryDetailUseCase) Execute(ctx context.Context, input dto.CategoryDetailInput) (*dto.CategoryDetailDTO, error) {
+	if input.CategoryID == 0 {
+		return nil, fmt.Errorf("invalid category ID")
+	}
+	if input.OwnerID == "" {
+		return nil, fmt.Errorf("owner ID is required")
+	}
+
+	// Ownership is enforced by the repository query itself
+	detail, err := uc.categoryRepo.GetOwnerCategoryDetail(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("category not found: %w", err)
+	}
+
+	// Log authorized access
+	if uc.auditLogger != nil {
+		uc.auditLogger.LogAccess(ctx, "category", input.CategoryID, input.OwnerID, true)
+	}
+
+	return detail, nil
+}
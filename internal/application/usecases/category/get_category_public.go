@@ -6,19 +6,23 @@ import (
 
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/publication"
 )
 
 // GetCategoryPublicUseCase handles the business logic for retrieving a category publicly (no auth)
 type GetCategoryPublicUseCase struct {
-	categoryRepo contracts.CategoryRepository
+	categoryRepo  contracts.CategoryRepository
+	portfolioRepo contracts.PortfolioRepository
 }
 
 // NewGetCategoryPublicUseCase creates a new instance of GetCategoryPublicUseCase
 func NewGetCategoryPublicUseCase(
 	categoryRepo contracts.CategoryRepository,
+	portfolioRepo contracts.PortfolioRepository,
 ) *GetCategoryPublicUseCase {
 	return &GetCategoryPublicUseCase{
-		categoryRepo: categoryRepo,
+		categoryRepo:  categoryRepo,
+		portfolioRepo: portfolioRepo,
 	}
 }
 
@@ -33,6 +37,9 @@ func (uc *GetCategoryPublicUseCase) Execute(ctx context.Context, id uint) (*dto.
 	if err != nil {
 		return nil, fmt.Errorf("category not found")
 	}
+	if err := publication.Require(ctx, uc.portfolioRepo, category.PortfolioID, "category"); err != nil {
+		return nil, err
+	}
 
 	return category, nil
 }
@@ -25,7 +25,7 @@ func NewListCategoriesUseCase(
 // Execute retrieves all categories owned by a user with pagination
 func (uc *ListCategoriesUseCase) Execute(ctx context.Context, input dto2.ListCategoriesInput) (*dto2.ListCategoriesOutput, error) {
 	// Get categories with pagination
-	categories, total, err := uc.categoryRepo.GetByOwnerID(ctx, input.PortfolioID, input.Pagination)
+	categories, total, err := uc.categoryRepo.GetByOwnerID(ctx, input.OwnerID, input.Pagination)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list categories: %w", err)
 	}
@@ -0,0 +1,109 @@
+package category
+
+import (
+	"context"
+	"fmt"
+
+	contracts2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/validation"
+)
+
+// PatchCategoryUseCase handles the business logic for partially updating a category
+// Unlike UpdateCategoryUseCase, omitted fields (including the position) keep their value.
+type PatchCategoryUseCase struct {
+	categoryRepo  contracts2.CategoryRepository
+	portfolioRepo contracts2.PortfolioRepository
+	auditLogger   contracts2.AuditLogger
+	metrics       contracts2.MetricsCollector
+}
+
+// NewPatchCategoryUseCase creates a new instance of PatchCategoryUseCase
+func NewPatchCategoryUseCase(
+	categoryRepo contracts2.CategoryRepository,
+	portfolioRepo contracts2.PortfolioRepository,
+	auditLogger contracts2.AuditLogger,
+	metrics contracts2.MetricsCollector,
+) *PatchCategoryUseCase {
+	return &PatchCategoryUseCase{
+		categoryRepo:  categoryRepo,
+		portfolioRepo: portfolioRepo,
+		auditLogger:   auditLogger,
+		metrics:       metrics,
+	}
+}
+
+// Execute applies the provided fields to a category with ownership verification
+func (uc *PatchCategoryUseCase) Execute(ctx context.Context, input dto.PatchCategoryInput) error {
+	// Validate input
+	if input.ID == 0 {
+		return fmt.Errorf("invalid category ID")
+	}
+	if input.OwnerID == "" {
+		return fmt.Errorf("owner ID is required")
+	}
+	if err := validation.ValidateCategoryPatch(input).Err(); err != nil {
+		return err
+	}
+
+	// Verify category exists and user owns it
+	category, err := uc.categoryRepo.GetByID(ctx, input.ID)
+	if err != nil {
+		return fmt.Errorf("category not found")
+	}
+
+	// Verify ownership through portfolio
+	portfolio, err := uc.portfolioRepo.GetByID(ctx, category.PortfolioID)
+	if err != nil {
+		return fmt.Errorf("portfolio not found")
+	}
+	if portfolio.OwnerID != input.OwnerID {
+		return fmt.Errorf("unauthorized: you don't own this category")
+	}
+
+	// Update the provided fields
+	if err := uc.categoryRepo.Patch(ctx, input); err != nil {
+		return fmt.Errorf("failed to update category: %w", err)
+	}
+
+	// Audit logging
+	if uc.auditLogger != nil {
+		uc.auditLogger.LogUpdate(ctx, "category", input.ID, map[string]interface{}{
+			"operation":    "patch",
+			"fields":       changedFields(category, input),
+			"portfolio_id": category.PortfolioID,
+			"owner_id":     input.OwnerID,
+		})
+	}
+
+	// Metrics
+	if uc.metrics != nil {
+		uc.metrics.IncrementCategoriesUpdated()
+	}
+
+	return nil
+}
+
+// changedFields lists the JSON names of the fields a patch gives a new value
+// A stored NULL description and an empty one count as the same value.
+func changedFields(existing *dto.CategoryDTO, input dto.PatchCategoryInput) []string {
+	description := ""
+	if existing.Description != nil {
+		description = *existing.Description
+	}
+
+	var fields []string
+	for _, field := range []struct {
+		name    string
+		changed bool
+	}{
+		{"title", input.Title != nil && *input.Title != existing.Title},
+		{"description", input.Description != nil && *input.Description != description},
+		{"position", input.Position != nil && *input.Position != existing.Position},
+	} {
+		if field.changed {
+			fields = append(fields, field.name)
+		}
+	}
+	return fields
+}
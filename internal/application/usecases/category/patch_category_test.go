@@ -0,0 +1,80 @@
+package category
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// patchingCategoryRepo records the patches that reach movingCategoryRepo's categories
+type patchingCategoryRepo struct {
+	*movingCategoryRepo
+	patched []dto.PatchCategoryInput
+}
+
+func (r *patchingCategoryRepo) Patch(_ context.Context, input dto.PatchCategoryInput) error {
+	r.patched = append(r.patched, input)
+	return nil
+}
+
+// updateAuditLogger keeps the details of the last update entry
+type updateAuditLogger struct {
+	contracts.AuditLogger
+	details map[string]interface{}
+}
+
+func (l *updateAuditLogger) LogUpdate(_ context.Context, _ string, _ uint, details map[string]interface{}) {
+	l.details = details
+}
+
+func TestPatchCategoryUseCase(t *testing.T) {
+	strPtr := func(s string) *string { return &s }
+	uintPtr := func(u uint) *uint { return &u }
+
+	tests := []struct {
+		name       string
+		input      dto.PatchCategoryInput
+		wantErr    bool
+		wantFields []string // fields recorded as changed in the audit log
+	}{
+		{name: "new title", input: dto.PatchCategoryInput{ID: 1, Title: strPtr("Backend"), OwnerID: "alice"}, wantFields: []string{"title"}},
+		{name: "same title and position", input: dto.PatchCategoryInput{ID: 1, Title: strPtr("Web"), Position: uintPtr(2), OwnerID: "alice"}},
+		{name: "empty description over a NULL one", input: dto.PatchCategoryInput{ID: 1, Description: strPtr(""), OwnerID: "alice"}},
+		{name: "description and position", input: dto.PatchCategoryInput{ID: 1, Description: strPtr("apps"), Position: uintPtr(1), OwnerID: "alice"}, wantFields: []string{"description", "position"}},
+		{name: "empty title", input: dto.PatchCategoryInput{ID: 1, Title: strPtr(""), OwnerID: "alice"}, wantErr: true},
+		{name: "another owner's category", input: dto.PatchCategoryInput{ID: 3, Title: strPtr("Mine"), OwnerID: "alice"}, wantErr: true},
+		{name: "missing category", input: dto.PatchCategoryInput{ID: 9, Title: strPtr("Mine"), OwnerID: "alice"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &patchingCategoryRepo{movingCategoryRepo: &movingCategoryRepo{categories: []dto.CategoryDTO{
+				{ID: 1, Title: "Web", Position: 2, PortfolioID: 1},
+				{ID: 3, Title: "Bob's", Position: 1, PortfolioID: 3},
+			}}}
+			audit := &updateAuditLogger{}
+
+			err := NewPatchCategoryUseCase(repo, ownedPortfolioRepo{}, audit, nil).Execute(context.Background(), tt.input)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Execute error = %v, want error %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if len(repo.patched) != 0 || audit.details != nil {
+					t.Errorf("rejected patch still wrote %v and logged %v", repo.patched, audit.details)
+				}
+				return
+			}
+
+			if !reflect.DeepEqual(repo.patched, []dto.PatchCategoryInput{tt.input}) {
+				t.Errorf("patches = %+v, want the input once", repo.patched)
+			}
+			if fields, _ := audit.details["fields"].([]string); !reflect.DeepEqual(fields, tt.wantFields) {
+				t.Errorf("audited fields = %v, want %v", fields, tt.wantFields)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package category
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	contracts2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// SwapCategoryPositionsUseCase handles the business logic for exchanging the positions of two categories
+type SwapCategoryPositionsUseCase struct {
+	categoryRepo  contracts2.CategoryRepository
+	portfolioRepo contracts2.PortfolioRepository
+	auditLogger   contracts2.AuditLogger
+}
+
+// NewSwapCategoryPositionsUseCase creates a new instance of SwapCategoryPositionsUseCase
+func NewSwapCategoryPositionsUseCase(
+	categoryRepo contracts2.CategoryRepository,
+	portfolioRepo contracts2.PortfolioRepository,
+	auditLogger contracts2.AuditLogger,
+) *SwapCategoryPositionsUseCase {
+	return &SwapCategoryPositionsUseCase{
+		categoryRepo:  categoryRepo,
+		portfolioRepo: portfolioRepo,
+		auditLogger:   auditLogger,
+	}
+}
+
+// Execute swaps the positions of two categories of the same portfolio with ownership verification
+// Returns both categories with their new positions, in request order
+func (uc *SwapCategoryPositionsUseCase) Execute(ctx context.Context, input dto.SwapPositionsInput) ([]dto.CategoryDTO, error) {
+	if input.FirstID == 0 || input.SecondID == 0 {
+		return nil, fmt.Errorf("invalid category ID")
+	}
+	if input.OwnerID == "" {
+		return nil, fmt.Errorf("owner ID is required")
+	}
+	if input.FirstID == input.SecondID {
+		return nil, apperrors.New(apperrors.KindValidation, apperrors.CodeValidationInvalid,
+			"cannot swap a category with itself", map[string]interface{}{"field": "second_id"})
+	}
+
+	// Verify both categories exist and share a portfolio
+	categories, err := uc.categoryRepo.GetByIDs(ctx, []uint{input.FirstID, input.SecondID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve categories: %w", err)
+	}
+	if len(categories) != 2 {
+		return nil, fmt.Errorf("category not found")
+	}
+	portfolioID := categories[0].PortfolioID
+	if categories[1].PortfolioID != portfolioID {
+		return nil, apperrors.New(apperrors.KindValidation, apperrors.CodeValidationInvalid,
+			"categories must belong to the same portfolio", map[string]interface{}{"field": "second_id"})
+	}
+
+	// Verify ownership through portfolio
+	portfolio, err := uc.portfolioRepo.GetByID(ctx, portfolioID)
+	if err != nil {
+		return nil, fmt.Errorf("portfolio not found")
+	}
+	if portfolio.OwnerID != input.OwnerID {
+		return nil, fmt.Errorf("unauthorized: you don't own this category")
+	}
+
+	if err := uc.categoryRepo.SwapPositions(ctx, input.FirstID, input.SecondID); err != nil {
+		return nil, fmt.Errorf("failed to swap category positions: %w", err)
+	}
+
+	first, err := uc.categoryRepo.GetByID(ctx, input.FirstID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve swapped category: %w", err)
+	}
+	second, err := uc.categoryRepo.GetByID(ctx, input.SecondID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve swapped category: %w", err)
+	}
+
+	// Audit logging
+	if uc.auditLogger != nil {
+		uc.auditLogger.LogUpdate(ctx, "category", 0, map[string]interface{}{
+			"operation":    "swap_positions",
+			"first_id":     input.FirstID,
+			"second_id":    input.SecondID,
+			"portfolio_id": portfolioID,
+			"owner_id":     input.OwnerID,
+		})
+	}
+
+	return []dto.CategoryDTO{*first, *second}, nil
+}
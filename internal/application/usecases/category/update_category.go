@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
 	contracts2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
 )
@@ -41,7 +42,7 @@ func (uc *UpdateCategoryUseCase) Execute(ctx context.Context, input dto.UpdateCa
 		return fmt.Errorf("owner ID is required")
 	}
 	if input.Title == "" {
-		return fmt.Errorf("category title is required")
+		return apperrors.Required("title", "category title is required")
 	}
 
 	// Verify category exists and user owns it
@@ -0,0 +1,81 @@
+package portfolio
+
+import (
+	"context"
+	"fmt"
+
+	contracts2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// ClonePortfolioUseCase handles the business logic for deep-copying a portfolio
+type ClonePortfolioUseCase struct {
+	portfolioRepo contracts2.PortfolioRepository
+	auditLogger   contracts2.AuditLogger
+	metrics       contracts2.MetricsCollector
+}
+
+// NewClonePortfolioUseCase creates a new instance of ClonePortfolioUseCase
+func NewClonePortfolioUseCase(
+	portfolioRepo contracts2.PortfolioRepository,
+	auditLogger contracts2.AuditLogger,
+	metrics contracts2.MetricsCollector,
+) *ClonePortfolioUseCase {
+	return &ClonePortfolioUseCase{
+		portfolioRepo: portfolioRepo,
+		auditLogger:   auditLogger,
+		metrics:       metrics,
+	}
+}
+
+// Execute copies a portfolio owned by the user with all its children
+func (uc *ClonePortfolioUseCase) Execute(ctx context.Context, input dto.ClonePortfolioInput) (*dto.PortfolioCloneDTO, error) {
+	// 1. Validate input
+	if input.PortfolioID == 0 {
+		return nil, fmt.Errorf("portfolio ID is required")
+	}
+	if input.OwnerID == "" {
+		return nil, fmt.Errorf("owner ID is required")
+	}
+
+	// 2. Authorization check - verify ownership
+	source, err := uc.portfolioRepo.GetByID(ctx, input.PortfolioID)
+	if err != nil {
+		return nil, fmt.Errorf("portfolio not found: %w", err)
+	}
+	if source.OwnerID != input.OwnerID {
+		if uc.auditLogger != nil {
+			uc.auditLogger.LogAccess(ctx, "portfolio", input.PortfolioID, input.OwnerID, false)
+		}
+		return nil, fmt.Errorf("unauthorized: you don't own this portfolio")
+	}
+
+	// 3. Copy (the repository picks a free title, so the duplicate check can't fail)
+	clone, err := uc.portfolioRepo.Clone(ctx, input.PortfolioID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to clone portfolio: %w", err)
+	}
+
+	// 4. Audit log
+	if uc.auditLogger != nil {
+		uc.auditLogger.LogCreate(ctx, "portfolio", clone.Portfolio.ID, map[string]interface{}{
+			"operation":        "clone",
+			"source_id":        clone.SourceID,
+			"title":            clone.Portfolio.Title,
+			"ownerID":          clone.Portfolio.OwnerID,
+			"links":            clone.Copied.Links,
+			"categories":       clone.Copied.Categories,
+			"projects":         clone.Copied.Projects,
+			"collaborators":    clone.Copied.ProjectCollaborators,
+			"sections":         clone.Copied.Sections,
+			"section_contents": clone.Copied.SectionContents,
+		})
+	}
+
+	// 5. Update metrics
+	if uc.metrics != nil {
+		uc.metrics.IncrementPortfoliosCreated()
+	}
+
+	return clone, nil
+}
@@ -0,0 +1,92 @@
+package portfolio
+
+import (
+	"context"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// cloningPortfolioRepo clones the portfolios of titledPortfolioRepo into portfolio 99
+type cloningPortfolioRepo struct {
+	*titledPortfolioRepo
+	cloned []uint
+}
+
+func (r *cloningPortfolioRepo) Clone(_ context.Context, id uint) (*dto.PortfolioCloneDTO, error) {
+	r.cloned = append(r.cloned, id)
+	source, err := r.GetByID(context.Background(), id)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.PortfolioCloneDTO{
+		Portfolio: dto.PortfolioDTO{ID: 99, Title: source.Title + " (2)", OwnerID: source.OwnerID},
+		SourceID:  id,
+		Copied:    dto.PortfolioChildCountsDTO{Categories: 2, Projects: 3},
+	}, nil
+}
+
+// cloneAuditLogger records created entities and denied accesses
+type cloneAuditLogger struct {
+	contracts.AuditLogger
+	created map[uint]map[string]interface{}
+	denied  int
+}
+
+func (l *cloneAuditLogger) LogCreate(_ context.Context, _ string, id uint, data map[string]interface{}) {
+	l.created[id] = data
+}
+
+func (l *cloneAuditLogger) LogAccess(_ context.Context, _ string, _ uint, _ string, allowed bool) {
+	if !allowed {
+		l.denied++
+	}
+}
+
+func TestClonePortfolioUseCase(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      dto.ClonePortfolioInput
+		wantErr    bool
+		wantDenied int
+	}{
+		{name: "own portfolio", input: dto.ClonePortfolioInput{PortfolioID: 1, OwnerID: "alice"}},
+		{name: "another owner's portfolio", input: dto.ClonePortfolioInput{PortfolioID: 3, OwnerID: "alice"}, wantErr: true, wantDenied: 1},
+		{name: "missing portfolio", input: dto.ClonePortfolioInput{PortfolioID: 42, OwnerID: "alice"}, wantErr: true},
+		{name: "no owner", input: dto.ClonePortfolioInput{PortfolioID: 1}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &cloningPortfolioRepo{titledPortfolioRepo: &titledPortfolioRepo{portfolios: []dto.PortfolioDTO{
+				{ID: 1, Title: "Work", OwnerID: "alice"},
+				{ID: 3, Title: "Bob's", OwnerID: "bob"},
+			}}}
+			audit := &cloneAuditLogger{created: map[uint]map[string]interface{}{}}
+
+			clone, err := NewClonePortfolioUseCase(repo, audit, nil).Execute(context.Background(), tt.input)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Execute error = %v, want error %v", err, tt.wantErr)
+			}
+			if audit.denied != tt.wantDenied {
+				t.Errorf("denied accesses = %d, want %d", audit.denied, tt.wantDenied)
+			}
+			if tt.wantErr {
+				if len(repo.cloned) != 0 || len(audit.created) != 0 {
+					t.Errorf("rejected clone still copied %v and logged %v", repo.cloned, audit.created)
+				}
+				return
+			}
+
+			if clone.Portfolio.ID != 99 || clone.SourceID != tt.input.PortfolioID {
+				t.Errorf("clone = %+v, want portfolio 99 copied from %d", clone, tt.input.PortfolioID)
+			}
+			entry := audit.created[99]
+			if entry["operation"] != "clone" || entry["source_id"] != tt.input.PortfolioID || entry["projects"] != 3 {
+				t.Errorf("audit entry = %v, want a clone of %d with 3 projects", entry, tt.input.PortfolioID)
+			}
+		})
+	}
+}
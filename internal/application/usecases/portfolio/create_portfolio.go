@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
 	contracts2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
 )
@@ -32,7 +33,7 @@ func NewCreatePortfolioUseCase(
 func (uc *CreatePortfolioUseCase) Execute(ctx context.Context, input dto.CreatePortfolioInput) (*dto.PortfolioDTO, error) {
 	// 1. Validate input
 	if input.Title == "" {
-		return nil, fmt.Errorf("title is required")
+		return nil, apperrors.Required("title", "title is required")
 	}
 	if input.OwnerID == "" {
 		return nil, fmt.Errorf("owner ID is required")
@@ -44,7 +45,8 @@ func (uc *CreatePortfolioUseCase) Execute(ctx context.Context, input dto.CreateP
 		return nil, fmt.Errorf("failed to check duplicate title: %w", err)
 	}
 	if isDuplicate {
-		return nil, fmt.Errorf("portfolio with title '%s' already exists for this user", input.Title)
+		return nil, apperrors.DuplicateTitle(apperrors.CodePortfolioDuplicateTitle, "portfolio", input.Title,
+			fmt.Sprintf("portfolio with title '%s' already exists for this user", input.Title))
 	}
 
 	// 3. Create portfolio via repository
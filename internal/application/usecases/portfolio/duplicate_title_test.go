@@ -0,0 +1,134 @@
+package portfolio
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// titledPortfolioRepo holds portfolios and counts the writes that reach it
+type titledPortfolioRepo struct {
+	contracts.PortfolioRepository
+	portfolios []dto.PortfolioDTO
+	writes     int
+}
+
+func (r *titledPortfolioRepo) GetByID(_ context.Context, id uint) (*dto.PortfolioDTO, error) {
+	for _, portfolio := range r.portfolios {
+		if portfolio.ID == id {
+			return &portfolio, nil
+		}
+	}
+	return nil, errors.New("portfolio not found")
+}
+
+func (r *titledPortfolioRepo) CheckTitleDuplicate(_ context.Context, title, ownerID string, excludeID uint) (bool, error) {
+	for _, portfolio := range r.portfolios {
+		if portfolio.Title == title && portfolio.OwnerID == ownerID && portfolio.ID != excludeID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *titledPortfolioRepo) Create(_ context.Context, input dto.CreatePortfolioInput) (*dto.PortfolioDTO, error) {
+	r.writes++
+	return &dto.PortfolioDTO{ID: 99, Title: input.Title, OwnerID: input.OwnerID}, nil
+}
+
+func (r *titledPortfolioRepo) Update(context.Context, dto.UpdatePortfolioInput) error {
+	r.writes++
+	return nil
+}
+
+func (r *titledPortfolioRepo) Patch(context.Context, dto.PatchPortfolioInput) error {
+	r.writes++
+	return nil
+}
+
+func TestPortfolioDuplicateTitle(t *testing.T) {
+	strPtr := func(s string) *string { return &s }
+
+	tests := []struct {
+		name      string
+		run       func(repo *titledPortfolioRepo) error
+		wantTitle string // empty when the title is accepted
+	}{
+		{
+			name: "create with a title of the same owner",
+			run: func(repo *titledPortfolioRepo) error {
+				_, err := NewCreatePortfolioUseCase(repo, nil, nil).Execute(context.Background(), dto.CreatePortfolioInput{Title: "Work", OwnerID: "alice"})
+				return err
+			},
+			wantTitle: "Work",
+		},
+		{
+			name: "create with a title of another owner",
+			run: func(repo *titledPortfolioRepo) error {
+				_, err := NewCreatePortfolioUseCase(repo, nil, nil).Execute(context.Background(), dto.CreatePortfolioInput{Title: "Bob's", OwnerID: "alice"})
+				return err
+			},
+		},
+		{
+			name: "update to another portfolio's title",
+			run: func(repo *titledPortfolioRepo) error {
+				return NewUpdatePortfolioUseCase(repo, nil, nil).Execute(context.Background(), dto.UpdatePortfolioInput{ID: 2, Title: "Work", OwnerID: "alice"})
+			},
+			wantTitle: "Work",
+		},
+		{
+			name: "update keeping its own title",
+			run: func(repo *titledPortfolioRepo) error {
+				return NewUpdatePortfolioUseCase(repo, nil, nil).Execute(context.Background(), dto.UpdatePortfolioInput{ID: 1, Title: "Work", OwnerID: "alice"})
+			},
+		},
+		{
+			name: "patch to another portfolio's title",
+			run: func(repo *titledPortfolioRepo) error {
+				return NewPatchPortfolioUseCase(repo, nil, nil).Execute(context.Background(), dto.PatchPortfolioInput{ID: 1, Title: strPtr("Side"), OwnerID: "alice"})
+			},
+			wantTitle: "Side",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &titledPortfolioRepo{portfolios: []dto.PortfolioDTO{
+				{ID: 1, Title: "Work", OwnerID: "alice"},
+				{ID: 2, Title: "Side", OwnerID: "alice"},
+				{ID: 3, Title: "Bob's", OwnerID: "bob"},
+			}}
+
+			err := tt.run(repo)
+			if tt.wantTitle == "" {
+				if err != nil || repo.writes != 1 {
+					t.Fatalf("err = %v with %d writes, want the title accepted", err, repo.writes)
+				}
+				return
+			}
+
+			var appErr *apperrors.Error
+			if !errors.As(err, &appErr) {
+				t.Fatalf("err = %v, want a duplicate title conflict", err)
+			}
+			if appErr.Kind != apperrors.KindConflict || appErr.Code != apperrors.CodePortfolioDuplicateTitle {
+				t.Errorf("kind, code = %v, %s, want a conflict with %s", appErr.Kind, appErr.Code, apperrors.CodePortfolioDuplicateTitle)
+			}
+			wantDetails := map[string]interface{}{"reason": "duplicate_title", "resource": "portfolio", "field": "title"}
+			if !reflect.DeepEqual(appErr.Details, wantDetails) {
+				t.Errorf("details = %v, want %v", appErr.Details, wantDetails)
+			}
+			if appErr.Params["title"] != tt.wantTitle {
+				t.Errorf("title param = %v, want %q", appErr.Params["title"], tt.wantTitle)
+			}
+			if repo.writes != 0 {
+				t.Errorf("writes = %d, want none for a rejected title", repo.writes)
+			}
+		})
+	}
+}
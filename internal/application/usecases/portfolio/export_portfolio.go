@@ -0,0 +1,62 @@
+package portfolio
+
+import (
+	"context"
+	"fmt"
+
+	contracts2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// ExportPortfolioUseCase handles the business logic for exporting a portfolio as one document
+type ExportPortfolioUseCase struct {
+	portfolioRepo contracts2.PortfolioRepository
+	auditLogger   contracts2.AuditLogger
+}
+
+// NewExportPortfolioUseCase creates a new instance of ExportPortfolioUseCase
+func NewExportPortfolioUseCase(
+	portfolioRepo contracts2.PortfolioRepository,
+	auditLogger contracts2.AuditLogger,
+) *ExportPortfolioUseCase {
+	return &ExportPortfolioUseCase{
+		portfolioRepo: portfolioRepo,
+		auditLogger:   auditLogger,
+	}
+}
+
+// Execute loads a portfolio owned by the user with all its children
+func (uc *ExportPortfolioUseCase) Execute(ctx context.Context, input dto.ExportPortfolioInput) (*dto.PortfolioExportDTO, error) {
+	// 1. Validate input
+	if input.PortfolioID == 0 {
+		return nil, fmt.Errorf("portfolio ID is required")
+	}
+	if input.OwnerID == "" {
+		return nil, fmt.Errorf("owner ID is required")
+	}
+
+	// 2. Authorization check - verify ownership
+	existing, err := uc.portfolioRepo.GetByID(ctx, input.PortfolioID)
+	if err != nil {
+		return nil, fmt.Errorf("portfolio not found: %w", err)
+	}
+	if existing.OwnerID != input.OwnerID {
+		if uc.auditLogger != nil {
+			uc.auditLogger.LogAccess(ctx, "portfolio", input.PortfolioID, input.OwnerID, false)
+		}
+		return nil, fmt.Errorf("unauthorized: you don't own this portfolio")
+	}
+
+	// 3. Load the whole tree
+	export, err := uc.portfolioRepo.Export(ctx, input.PortfolioID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export portfolio: %w", err)
+	}
+
+	// 4. Audit log (the document holds everything the owner wrote)
+	if uc.auditLogger != nil {
+		uc.auditLogger.LogAccess(ctx, "portfolio", input.PortfolioID, input.OwnerID, true)
+	}
+
+	return export, nil
+}
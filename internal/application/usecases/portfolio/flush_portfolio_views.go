@@ -0,0 +1,45 @@
+package portfolio
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+)
+
+// FlushPortfolioViewsUseCase writes the buffered public portfolio views to the database
+type FlushPortfolioViewsUseCase struct {
+	viewRecorder contracts.PortfolioViewRecorder
+	viewRepo     contracts.PortfolioViewRepository
+}
+
+// NewFlushPortfolioViewsUseCase creates a new instance of FlushPortfolioViewsUseCase
+func NewFlushPortfolioViewsUseCase(
+	viewRecorder contracts.PortfolioViewRecorder,
+	viewRepo contracts.PortfolioViewRepository,
+) *FlushPortfolioViewsUseCase {
+	return &FlushPortfolioViewsUseCase{
+		viewRecorder: viewRecorder,
+		viewRepo:     viewRepo,
+	}
+}
+
+// Execute flushes the buffer and returns the number of views written
+// On failure the views go back to the buffer for the next flush.
+func (uc *FlushPortfolioViewsUseCase) Execute(ctx context.Context) (uint, error) {
+	batches := uc.viewRecorder.Drain()
+	if len(batches) == 0 {
+		return 0, nil
+	}
+
+	if err := uc.viewRepo.Flush(ctx, batches); err != nil {
+		uc.viewRecorder.Requeue(batches)
+		return 0, fmt.Errorf("failed to flush portfolio views: %w", err)
+	}
+
+	var views uint
+	for _, batch := range batches {
+		views += batch.Views
+	}
+	return views, nil
+}
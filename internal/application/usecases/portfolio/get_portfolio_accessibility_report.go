@@ -0,0 +1,133 @@
+package portfolio
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	domain "github.com/JorgeSaicoski/portfolio-manager/backend/internal/domain/portfolio"
+)
+
+// GetPortfolioAccessibilityReportUseCase lints a portfolio's theme colors and content for accessibility
+type GetPortfolioAccessibilityReportUseCase struct {
+	portfolioRepo      contracts.PortfolioRepository
+	projectRepo        contracts.ProjectRepository
+	sectionRepo        contracts.SectionRepository
+	sectionContentRepo contracts.SectionContentRepository
+}
+
+// NewGetPortfolioAccessibilityReportUseCase creates a new instance of GetPortfolioAccessibilityReportUseCase
+func NewGetPortfolioAccessibilityReportUseCase(
+	portfolioRepo contracts.PortfolioRepository,
+	projectRepo contracts.ProjectRepository,
+	sectionRepo contracts.SectionRepository,
+	sectionContentRepo contracts.SectionContentRepository,
+) *GetPortfolioAccessibilityReportUseCase {
+	return &GetPortfolioAccessibilityReportUseCase{
+		portfolioRepo:      portfolioRepo,
+		projectRepo:        projectRepo,
+		sectionRepo:        sectionRepo,
+		sectionContentRepo: sectionContentRepo,
+	}
+}
+
+// Execute computes the accessibility report of a portfolio owned by the caller
+func (uc *GetPortfolioAccessibilityReportUseCase) Execute(ctx context.Context, input dto.AccessibilityReportInput) (*dto.AccessibilityReportOutput, error) {
+	if input.PortfolioID == 0 {
+		return nil, fmt.Errorf("portfolio ID is required")
+	}
+	if input.OwnerID == "" {
+		return nil, fmt.Errorf("owner ID is required")
+	}
+
+	// Verify portfolio exists and user owns it
+	portfolio, err := uc.portfolioRepo.GetByID(ctx, input.PortfolioID)
+	if err != nil {
+		return nil, fmt.Errorf("portfolio not found")
+	}
+	if portfolio.OwnerID != input.OwnerID {
+		return nil, fmt.Errorf("unauthorized: you don't own this portfolio")
+	}
+
+	contents, err := uc.collectContents(ctx, portfolio)
+	if err != nil {
+		return nil, err
+	}
+
+	findings, err := domain.EvaluateAccessibility(domain.AccessibilityInput{
+		Colors: domain.ThemeColors{
+			Text:       input.TextColor,
+			Background: input.BackgroundColor,
+			Accent:     input.AccentColor,
+		},
+		Contents: contents,
+	})
+	if err != nil {
+		return nil, apperrors.New(apperrors.KindValidation, apperrors.CodeValidationInvalid, err.Error(),
+			map[string]interface{}{"field": "color"})
+	}
+
+	output := &dto.AccessibilityReportOutput{
+		PortfolioID: portfolio.ID,
+		Findings:    make([]dto.AccessibilityFindingDTO, len(findings)),
+	}
+	for i, finding := range findings {
+		output.Findings[i] = dto.AccessibilityFindingDTO{
+			Name:         finding.Name,
+			Severity:     finding.Severity,
+			Message:      finding.Message,
+			ResourceType: finding.ResourceType,
+			ResourceIDs:  finding.ResourceIDs,
+			Values:       finding.Values,
+		}
+	}
+
+	return output, nil
+}
+
+// collectContents gathers every markdown text of the portfolio: its description,
+// project descriptions and section contents
+func (uc *GetPortfolioAccessibilityReportUseCase) collectContents(ctx context.Context, portfolio *dto.PortfolioDTO) ([]domain.AccessibilityContent, error) {
+	contents := []domain.AccessibilityContent{{
+		ResourceType: "portfolio",
+		ResourceID:   portfolio.ID,
+		Markdown:     portfolio.Description,
+	}}
+
+	projects, err := uc.projectRepo.GetByPortfolioID(ctx, portfolio.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get projects: %w", err)
+	}
+	for _, p := range projects {
+		contents = append(contents, domain.AccessibilityContent{
+			ResourceType: "project",
+			ResourceID:   p.ID,
+			Markdown:     p.Description,
+		})
+	}
+
+	sections, err := uc.sectionRepo.GetByPortfolioID(ctx, portfolio.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sections: %w", err)
+	}
+	for _, s := range sections {
+		sectionContents, err := uc.sectionContentRepo.GetBySectionID(ctx, s.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get section contents: %w", err)
+		}
+		for _, content := range sectionContents {
+			if content.Content == nil {
+				continue
+			}
+			contents = append(contents, domain.AccessibilityContent{
+				ResourceType: "section_content",
+				ResourceID:   content.ID,
+				Markdown:     *content.Content,
+			})
+		}
+	}
+
+	return contents, nil
+}
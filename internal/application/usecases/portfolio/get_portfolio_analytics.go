@@ -0,0 +1,110 @@
+package portfolio
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	contracts2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// GetPortfolioAnalyticsUseCase handles the business logic for reading the daily views of a portfolio
+type GetPortfolioAnalyticsUseCase struct {
+	portfolioRepo contracts2.PortfolioRepository
+	viewRepo      contracts2.PortfolioViewRepository
+	auditLogger   contracts2.AuditLogger
+}
+
+// NewGetPortfolioAnalyticsUseCase creates a new instance of GetPortfolioAnalyticsUseCase
+func NewGetPortfolioAnalyticsUseCase(
+	portfolioRepo contracts2.PortfolioRepository,
+	viewRepo contracts2.PortfolioViewRepository,
+	auditLogger contracts2.AuditLogger,
+) *GetPortfolioAnalyticsUseCase {
+	return &GetPortfolioAnalyticsUseCase{
+		portfolioRepo: portfolioRepo,
+		viewRepo:      viewRepo,
+		auditLogger:   auditLogger,
+	}
+}
+
+// Execute returns one entry per day of the range (zero when nobody viewed the portfolio) and the total
+// Counts are as of the last view flush.
+func (uc *GetPortfolioAnalyticsUseCase) Execute(ctx context.Context, input dto.PortfolioAnalyticsInput) (*dto.PortfolioAnalyticsDTO, error) {
+	// 1. Validate input
+	if input.PortfolioID == 0 {
+		return nil, fmt.Errorf("portfolio ID is required")
+	}
+	if input.OwnerID == "" {
+		return nil, fmt.Errorf("owner ID is required")
+	}
+	from, to, err := analyticsRange(input.From, input.To, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Get portfolio
+	portfolio, err := uc.portfolioRepo.GetByID(ctx, input.PortfolioID)
+	if err != nil {
+		return nil, fmt.Errorf("portfolio not found: %w", err)
+	}
+
+	// 3. Authorization check - verify ownership
+	if portfolio.OwnerID != input.OwnerID {
+		if uc.auditLogger != nil {
+			uc.auditLogger.LogAccess(ctx, "portfolio", input.PortfolioID, input.OwnerID, false)
+		}
+		return nil, fmt.Errorf("unauthorized: you don't own this portfolio")
+	}
+
+	// 4. Load the stored days and fill the gaps
+	stored, err := uc.viewRepo.GetDays(ctx, portfolio.ID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get portfolio analytics: %w", err)
+	}
+	views := make(map[string]uint, len(stored))
+	for _, day := range stored {
+		views[day.Day.Format(time.DateOnly)] = day.Views
+	}
+
+	analytics := &dto.PortfolioAnalyticsDTO{PortfolioID: portfolio.ID, From: from, To: to}
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		count := views[day.Format(time.DateOnly)]
+		analytics.Days = append(analytics.Days, dto.PortfolioViewDayDTO{Day: day, Views: count})
+		analytics.Total += count
+	}
+
+	return analytics, nil
+}
+
+// analyticsRange resolves the requested range to UTC dates: a missing end is today, a missing
+// start is PortfolioAnalyticsDefaultDays days before the end
+func analyticsRange(from, to, now time.Time) (time.Time, time.Time, error) {
+	if to.IsZero() {
+		to = now
+	}
+	to = utcDate(to)
+	if from.IsZero() {
+		from = to.AddDate(0, 0, -(dto.PortfolioAnalyticsDefaultDays - 1))
+	}
+	from = utcDate(from)
+
+	if from.After(to) {
+		return time.Time{}, time.Time{}, apperrors.New(apperrors.KindValidation, apperrors.CodeValidationInvalid,
+			"from must not be after to", map[string]interface{}{"field": "from"})
+	}
+	if days := int(to.Sub(from).Hours()/24) + 1; days > dto.PortfolioAnalyticsMaxDays {
+		return time.Time{}, time.Time{}, apperrors.New(apperrors.KindValidation, apperrors.CodeValidationMax,
+			fmt.Sprintf("the range cannot exceed %d days", dto.PortfolioAnalyticsMaxDays),
+			map[string]interface{}{"field": "days", "param": dto.PortfolioAnalyticsMaxDays})
+	}
+	return from, to, nil
+}
+
+// utcDate truncates t to its UTC date
+func utcDate(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,38 @@
+package portfolio
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// GetPortfolioAvailabilityUseCase resolves whether a portfolio can be shown publicly,
+// cheaply enough for the frontend router to call on every route transition
+type GetPortfolioAvailabilityUseCase struct {
+	portfolioRepo contracts.PortfolioRepository
+}
+
+// NewGetPortfolioAvailabilityUseCase creates a new instance of GetPortfolioAvailabilityUseCase
+func NewGetPortfolioAvailabilityUseCase(portfolioRepo contracts.PortfolioRepository) *GetPortfolioAvailabilityUseCase {
+	return &GetPortfolioAvailabilityUseCase{portfolioRepo: portfolioRepo}
+}
+
+// Execute returns the public availability of a portfolio (one query, no relations loaded)
+// Drafts are reported as not_found, like missing portfolios, so their existence isn't leaked.
+func (uc *GetPortfolioAvailabilityUseCase) Execute(ctx context.Context, id uint) (*dto.PortfolioAvailabilityDTO, error) {
+	if id == 0 {
+		return &dto.PortfolioAvailabilityDTO{Status: dto.PortfolioAvailabilityNotFound}, nil
+	}
+
+	published, err := uc.portfolioRepo.IsPublished(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get portfolio availability: %w", err)
+	}
+	if !published {
+		return &dto.PortfolioAvailabilityDTO{Status: dto.PortfolioAvailabilityNotFound}, nil
+	}
+
+	return &dto.PortfolioAvailabilityDTO{Status: dto.PortfolioAvailabilityPublished}, nil
+}
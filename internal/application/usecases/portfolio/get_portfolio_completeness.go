@@ -0,0 +1,139 @@
+package portfolio
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	domain "github.com/JorgeSaicoski/portfolio-manager/backend/internal/domain/portfolio"
+)
+
+// GetPortfolioCompletenessUseCase scores how complete a portfolio looks
+type GetPortfolioCompletenessUseCase struct {
+	portfolioRepo      contracts.PortfolioRepository
+	categoryRepo       contracts.CategoryRepository
+	projectRepo        contracts.ProjectRepository
+	sectionRepo        contracts.SectionRepository
+	sectionContentRepo contracts.SectionContentRepository
+}
+
+// NewGetPortfolioCompletenessUseCase creates a new instance of GetPortfolioCompletenessUseCase
+func NewGetPortfolioCompletenessUseCase(
+	portfolioRepo contracts.PortfolioRepository,
+	categoryRepo contracts.CategoryRepository,
+	projectRepo contracts.ProjectRepository,
+	sectionRepo contracts.SectionRepository,
+	sectionContentRepo contracts.SectionContentRepository,
+) *GetPortfolioCompletenessUseCase {
+	return &GetPortfolioCompletenessUseCase{
+		portfolioRepo:      portfolioRepo,
+		categoryRepo:       categoryRepo,
+		projectRepo:        projectRepo,
+		sectionRepo:        sectionRepo,
+		sectionContentRepo: sectionContentRepo,
+	}
+}
+
+// Execute computes the completeness report of a portfolio owned by ownerID
+func (uc *GetPortfolioCompletenessUseCase) Execute(ctx context.Context, id uint, ownerID string) (*dto.PortfolioCompletenessOutput, error) {
+	if id == 0 {
+		return nil, fmt.Errorf("portfolio ID is required")
+	}
+	if ownerID == "" {
+		return nil, fmt.Errorf("owner ID is required")
+	}
+
+	// Verify portfolio exists and user owns it
+	portfolio, err := uc.portfolioRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("portfolio not found")
+	}
+	if portfolio.OwnerID != ownerID {
+		return nil, fmt.Errorf("unauthorized: you don't own this portfolio")
+	}
+
+	input, err := uc.buildInput(ctx, portfolio)
+	if err != nil {
+		return nil, err
+	}
+
+	report := domain.EvaluateCompleteness(*input)
+
+	checks := make([]dto.CompletenessCheckDTO, len(report.Checks))
+	for i, check := range report.Checks {
+		checks[i] = dto.CompletenessCheckDTO{
+			Name:         check.Name,
+			Passed:       check.Passed,
+			Weight:       check.Weight,
+			Message:      check.Message,
+			ResourceType: check.ResourceType,
+			ResourceIDs:  check.ResourceIDs,
+		}
+	}
+
+	return &dto.PortfolioCompletenessOutput{
+		PortfolioID: portfolio.ID,
+		Score:       report.Score,
+		Checks:      checks,
+	}, nil
+}
+
+// buildInput gathers the portfolio tree into the domain's completeness snapshot
+func (uc *GetPortfolioCompletenessUseCase) buildInput(ctx context.Context, portfolio *dto.PortfolioDTO) (*domain.CompletenessInput, error) {
+	categories, err := uc.categoryRepo.GetByPortfolioID(ctx, portfolio.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get categories: %w", err)
+	}
+
+	projects, err := uc.projectRepo.GetByPortfolioID(ctx, portfolio.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get projects: %w", err)
+	}
+
+	sections, err := uc.sectionRepo.GetByPortfolioID(ctx, portfolio.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sections: %w", err)
+	}
+
+	input := &domain.CompletenessInput{Description: portfolio.Description}
+
+	projectCounts := make(map[uint]int, len(categories))
+	for _, p := range projects {
+		projectCounts[p.CategoryID]++
+		input.Projects = append(input.Projects, domain.CompletenessProject{
+			ID:           p.ID,
+			Description:  p.Description,
+			HasMainImage: p.MainImage != nil && *p.MainImage != "",
+		})
+	}
+
+	for _, c := range categories {
+		input.Categories = append(input.Categories, domain.CompletenessCategory{
+			ID:           c.ID,
+			ProjectCount: projectCounts[c.ID],
+		})
+	}
+
+	for _, s := range sections {
+		section := domain.CompletenessSection{ID: s.ID, Type: s.Type}
+
+		// Only sections that feed a word-count rule need their contents loaded
+		if strings.EqualFold(s.Type, domain.AboutSectionType) {
+			contents, err := uc.sectionContentRepo.GetBySectionID(ctx, s.ID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get section contents: %w", err)
+			}
+			for _, content := range contents {
+				if content.Content != nil {
+					section.WordCount += domain.WordCount(*content.Content)
+				}
+			}
+		}
+
+		input.Sections = append(input.Sections, section)
+	}
+
+	return input, nil
+}
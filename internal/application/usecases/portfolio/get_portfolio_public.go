@@ -11,14 +11,17 @@ import (
 // GetPortfolioPublicUseCase handles the business logic for retrieving a portfolio publicly (no auth)
 type GetPortfolioPublicUseCase struct {
 	portfolioRepo contracts.PortfolioRepository
+	linkRepo      contracts.PortfolioLinkRepository
 }
 
 // NewGetPortfolioPublicUseCase creates a new instance of GetPortfolioPublicUseCase
 func NewGetPortfolioPublicUseCase(
 	portfolioRepo contracts.PortfolioRepository,
+	linkRepo contracts.PortfolioLinkRepository,
 ) *GetPortfolioPublicUseCase {
 	return &GetPortfolioPublicUseCase{
 		portfolioRepo: portfolioRepo,
+		linkRepo:      linkRepo,
 	}
 }
 
@@ -28,11 +31,29 @@ func (uc *GetPortfolioPublicUseCase) Execute(ctx context.Context, id uint) (*dto
 		return nil, fmt.Errorf("invalid portfolio ID")
 	}
 
-	// Get portfolio (no ownership check for public access)
+	// Get portfolio (no ownership check for public access; drafts are not found)
 	portfolio, err := uc.portfolioRepo.GetByID(ctx, id)
-	if err != nil {
+	if err != nil || !portfolio.IsPublished {
 		return nil, fmt.Errorf("portfolio not found")
 	}
 
+	if err := attachPublicLinks(ctx, uc.linkRepo, portfolio); err != nil {
+		return nil, err
+	}
+
 	return portfolio, nil
 }
+
+// attachPublicLinks attaches the contact/social links rendered in the public footer
+func attachPublicLinks(ctx context.Context, linkRepo contracts.PortfolioLinkRepository, portfolio *dto.PortfolioDTO) error {
+	if linkRepo == nil {
+		return nil
+	}
+
+	links, err := linkRepo.GetByPortfolioID(ctx, portfolio.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get portfolio links: %w", err)
+	}
+	portfolio.Links = links
+	return nil
+}
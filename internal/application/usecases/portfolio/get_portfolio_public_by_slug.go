@@ -0,0 +1,47 @@
+package portfolio
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// GetPortfolioPublicBySlugUseCase handles the business logic for retrieving a portfolio publicly by its slug
+type GetPortfolioPublicBySlugUseCase struct {
+	portfolioRepo contracts.PortfolioRepository
+	linkRepo      contracts.PortfolioLinkRepository
+}
+
+// NewGetPortfolioPublicBySlugUseCase creates a new instance of GetPortfolioPublicBySlugUseCase
+func NewGetPortfolioPublicBySlugUseCase(
+	portfolioRepo contracts.PortfolioRepository,
+	linkRepo contracts.PortfolioLinkRepository,
+) *GetPortfolioPublicBySlugUseCase {
+	return &GetPortfolioPublicBySlugUseCase{
+		portfolioRepo: portfolioRepo,
+		linkRepo:      linkRepo,
+	}
+}
+
+// Execute retrieves a portfolio by slug without ownership verification (public access)
+// Slugs are stored lowercase, so the lookup ignores the case of the URL
+func (uc *GetPortfolioPublicBySlugUseCase) Execute(ctx context.Context, slug string) (*dto.PortfolioDTO, error) {
+	slug = strings.ToLower(strings.TrimSpace(slug))
+	if slug == "" {
+		return nil, fmt.Errorf("invalid portfolio slug")
+	}
+
+	portfolio, err := uc.portfolioRepo.GetBySlug(ctx, slug)
+	if err != nil || !portfolio.IsPublished {
+		return nil, fmt.Errorf("portfolio not found")
+	}
+
+	if err := attachPublicLinks(ctx, uc.linkRepo, portfolio); err != nil {
+		return nil, err
+	}
+
+	return portfolio, nil
+}
@@ -0,0 +1,79 @@
+package portfolio
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// GetPortfolioStructuredDataUseCase gathers the public data used to describe a portfolio
+// as schema.org structured data (no auth)
+type GetPortfolioStructuredDataUseCase struct {
+	portfolioRepo contracts.PortfolioRepository
+	projectRepo   contracts.ProjectRepository
+	userRepo      contracts.UserRepository
+	linkRepo      contracts.PortfolioLinkRepository
+}
+
+// NewGetPortfolioStructuredDataUseCase creates a new instance of GetPortfolioStructuredDataUseCase
+func NewGetPortfolioStructuredDataUseCase(
+	portfolioRepo contracts.PortfolioRepository,
+	projectRepo contracts.ProjectRepository,
+	userRepo contracts.UserRepository,
+	linkRepo contracts.PortfolioLinkRepository,
+) *GetPortfolioStructuredDataUseCase {
+	return &GetPortfolioStructuredDataUseCase{
+		portfolioRepo: portfolioRepo,
+		projectRepo:   projectRepo,
+		userRepo:      userRepo,
+		linkRepo:      linkRepo,
+	}
+}
+
+// Execute loads the portfolio, its owner's display name, its visible projects and its links
+func (uc *GetPortfolioStructuredDataUseCase) Execute(ctx context.Context, id uint) (*dto.PortfolioStructuredDataOutput, error) {
+	if id == 0 {
+		return nil, fmt.Errorf("invalid portfolio ID")
+	}
+
+	portfolio, err := uc.portfolioRepo.GetByID(ctx, id)
+	if err != nil || !portfolio.IsPublished {
+		return nil, fmt.Errorf("portfolio not found")
+	}
+
+	all, err := uc.projectRepo.GetByPortfolioID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get portfolio projects: %w", err)
+	}
+	projects := make([]dto.ProjectDTO, 0, len(all))
+	for _, project := range all {
+		if !project.Hidden {
+			projects = append(projects, project)
+		}
+	}
+
+	// The person's name falls back to the portfolio title when the owner
+	// has no local profile (or no name) yet
+	ownerName := portfolio.Title
+	if uc.userRepo != nil {
+		if owner, err := uc.userRepo.GetByExternalID(ctx, portfolio.OwnerID); err == nil && owner.Name != "" {
+			ownerName = owner.Name
+		}
+	}
+
+	var links []dto.PortfolioLinkDTO
+	if uc.linkRepo != nil {
+		if links, err = uc.linkRepo.GetByPortfolioID(ctx, id); err != nil {
+			return nil, fmt.Errorf("failed to get portfolio links: %w", err)
+		}
+	}
+
+	return &dto.PortfolioStructuredDataOutput{
+		Portfolio: *portfolio,
+		OwnerName: ownerName,
+		Projects:  projects,
+		Links:     links,
+	}, nil
+}
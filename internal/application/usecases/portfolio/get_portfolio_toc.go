@@ -0,0 +1,55 @@
+package portfolio
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// GetPortfolioTOCUseCase builds the table of contents of a public portfolio: its sections in
+// display order and the former slugs still pointing at them, without loading any content
+type GetPortfolioTOCUseCase struct {
+	portfolioRepo contracts.PortfolioRepository
+	sectionRepo   contracts.SectionRepository
+}
+
+// NewGetPortfolioTOCUseCase creates a new instance of GetPortfolioTOCUseCase
+func NewGetPortfolioTOCUseCase(
+	portfolioRepo contracts.PortfolioRepository,
+	sectionRepo contracts.SectionRepository,
+) *GetPortfolioTOCUseCase {
+	return &GetPortfolioTOCUseCase{
+		portfolioRepo: portfolioRepo,
+		sectionRepo:   sectionRepo,
+	}
+}
+
+// Execute returns the table of contents of a portfolio (public access, no ownership check)
+func (uc *GetPortfolioTOCUseCase) Execute(ctx context.Context, id uint) (*dto.PortfolioTOCDTO, error) {
+	if id == 0 {
+		return nil, fmt.Errorf("invalid portfolio ID")
+	}
+
+	published, err := uc.portfolioRepo.IsPublished(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get portfolio: %w", err)
+	}
+	if !published {
+		return nil, fmt.Errorf("portfolio not found")
+	}
+
+	// Same order as the public sections list
+	sections, err := uc.sectionRepo.GetByPortfolioID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sections: %w", err)
+	}
+
+	redirects, err := uc.sectionRepo.GetSlugRedirects(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get section slug redirects: %w", err)
+	}
+
+	return &dto.PortfolioTOCDTO{Sections: sections, Redirects: redirects}, nil
+}
@@ -0,0 +1,267 @@
+package portfolio
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	contracts2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/customcss"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/titles"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/validation"
+	domainportfolio "github.com/JorgeSaicoski/portfolio-manager/backend/internal/domain/portfolio"
+)
+
+// ImportPortfolioUseCase handles the business logic for creating a portfolio from an export document
+type ImportPortfolioUseCase struct {
+	portfolioRepo  contracts2.PortfolioRepository
+	auditLogger    contracts2.AuditLogger
+	metrics        contracts2.MetricsCollector
+	allowedOrigins []string
+}
+
+// NewImportPortfolioUseCase creates a new instance of ImportPortfolioUseCase
+// allowedOrigins are the hosts url()s of the imported custom CSS may point at
+func NewImportPortfolioUseCase(
+	portfolioRepo contracts2.PortfolioRepository,
+	auditLogger contracts2.AuditLogger,
+	metrics contracts2.MetricsCollector,
+	allowedOrigins []string,
+) *ImportPortfolioUseCase {
+	return &ImportPortfolioUseCase{
+		portfolioRepo:  portfolioRepo,
+		auditLogger:    auditLogger,
+		metrics:        metrics,
+		allowedOrigins: allowedOrigins,
+	}
+}
+
+// Execute creates a portfolio owned by the user from an export document
+// Invalid entries are left out and reported; the portfolio itself must be valid.
+func (uc *ImportPortfolioUseCase) Execute(ctx context.Context, input dto.ImportPortfolioInput) (*dto.PortfolioImportDTO, error) {
+	// 1. Validate input
+	if input.OwnerID == "" {
+		return nil, fmt.Errorf("owner ID is required")
+	}
+	if input.ExportVersion != dto.PortfolioExportVersion {
+		return nil, apperrors.New(apperrors.KindValidation, apperrors.CodePortfolioImportVersion,
+			fmt.Sprintf("unsupported export version %d; this server imports version %d", input.ExportVersion, dto.PortfolioExportVersion),
+			map[string]interface{}{"field": "export_version", "version": input.ExportVersion, "supported": dto.PortfolioExportVersion})
+	}
+	if err := validation.ValidatePortfolio(dto.CreatePortfolioInput{
+		Title:       input.Portfolio.Title,
+		Description: input.Portfolio.Description,
+	}).Err(); err != nil {
+		return nil, err
+	}
+	if len(input.Portfolio.CustomCSS) > customcss.MaxSize {
+		return nil, apperrors.New(apperrors.KindValidation, apperrors.CodeValidationMax,
+			fmt.Sprintf("custom CSS cannot exceed %d bytes", customcss.MaxSize),
+			map[string]interface{}{"field": "custom_css", "param": customcss.MaxSize})
+	}
+	if err := validation.ValidateImportSize(input.Portfolio); err != nil {
+		return nil, err
+	}
+	if input.SourceBaseURL != "" {
+		// Relative images are rebased on it, so it must not smuggle another scheme into their URLs
+		if err := validation.Evaluate(
+			validation.URL("base_url", input.SourceBaseURL, "base URL must be an absolute http(s) URL"),
+		).Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	// 2. Leave out invalid entries and point relative images at the exporting instance
+	plan := &importPlan{}
+	if input.SourceBaseURL != "" && input.SourceBaseURL != input.LocalBaseURL {
+		plan.imageBase = strings.TrimRight(input.SourceBaseURL, "/")
+	}
+	export := plan.prepare(input.Portfolio)
+	sanitized := customcss.Sanitize(export.CustomCSS, customcss.Options{AllowedOrigins: uc.allowedOrigins})
+
+	// 3. Create everything (the repository picks a free title, so the duplicate check can't fail)
+	imported, err := uc.portfolioRepo.Import(ctx, input.OwnerID, &export, sanitized.CSS)
+	if err != nil {
+		return nil, fmt.Errorf("failed to import portfolio: %w", err)
+	}
+	imported.ExternalImages = plan.externalImages
+	imported.Skipped = plan.skipped
+
+	// 4. Audit log
+	if uc.auditLogger != nil {
+		uc.auditLogger.LogCreate(ctx, "portfolio", imported.Portfolio.ID, map[string]interface{}{
+			"operation":        "import",
+			"export_version":   input.ExportVersion,
+			"title":            imported.Portfolio.Title,
+			"ownerID":          imported.Portfolio.OwnerID,
+			"links":            imported.Created.Links,
+			"categories":       imported.Created.Categories,
+			"projects":         imported.Created.Projects,
+			"collaborators":    imported.Created.ProjectCollaborators,
+			"sections":         imported.Created.Sections,
+			"section_contents": imported.Created.SectionContents,
+			"skipped":          len(imported.Skipped),
+		})
+	}
+
+	// 5. Update metrics
+	if uc.metrics != nil {
+		uc.metrics.IncrementPortfoliosCreated()
+	}
+
+	return imported, nil
+}
+
+// importPlan collects what an import leaves out and how many image paths it rewrote
+type importPlan struct {
+	imageBase      string // Base of the exporting instance, empty when images stay as they are
+	externalImages int
+	skipped        []dto.ImportSkipDTO
+}
+
+func (p *importPlan) skip(path, reason string) {
+	p.skipped = append(p.skipped, dto.ImportSkipDTO{Path: path, Reason: reason})
+}
+
+// prepare returns the entries of the document that can be created, in position order
+// A skipped category or section takes its projects or contents with it.
+func (p *importPlan) prepare(source dto.PortfolioExportDTO) dto.PortfolioExportDTO {
+	export := source
+	export.Links = nil
+	export.Categories = nil
+	export.Sections = nil
+
+	for _, i := range positionOrder(len(source.Links), func(i int) uint { return source.Links[i].Position }) {
+		link := source.Links[i]
+		path := fmt.Sprintf("links[%d]", i)
+		if len(export.Links) >= domainportfolio.MaxLinksPerPortfolio {
+			p.skip(path, fmt.Sprintf("a portfolio can have at most %d links", domainportfolio.MaxLinksPerPortfolio))
+			continue
+		}
+		if err := domainportfolio.ValidateLink(link.Kind, link.URL); err != nil {
+			p.skip(path, err.Error())
+			continue
+		}
+		if violations := validation.ValidateImportedLink(link); len(violations) > 0 {
+			p.skip(path, violations.Error())
+			continue
+		}
+		export.Links = append(export.Links, link)
+	}
+
+	for _, i := range positionOrder(len(source.Categories), func(i int) uint { return source.Categories[i].Position }) {
+		category := source.Categories[i]
+		path := fmt.Sprintf("categories[%d]", i)
+		if violations := validation.ValidateImportedCategory(category); len(violations) > 0 {
+			p.skip(path, violations.Error())
+			continue
+		}
+		category.Projects = p.prepareProjects(path, category.Projects)
+		export.Categories = append(export.Categories, category)
+	}
+
+	// Section titles are unique within a portfolio: repeated ones get a numbered suffix
+	taken := make(map[string]bool, len(source.Sections))
+	for _, i := range positionOrder(len(source.Sections), func(i int) uint { return source.Sections[i].Position }) {
+		section := source.Sections[i]
+		path := fmt.Sprintf("sections[%d]", i)
+		if violations := validation.ValidateImportedSection(section); len(violations) > 0 {
+			p.skip(path, violations.Error())
+			continue
+		}
+		section.Title = titles.Unique(section.Title, taken)
+		taken[section.Title] = true
+		section.Slug = domainportfolio.Slugify(section.Slug)
+
+		var contents []dto.SectionContentExportDTO
+		for _, j := range positionOrder(len(section.Contents), func(j int) uint { return section.Contents[j].Position }) {
+			content := section.Contents[j]
+			if violations := validation.ValidateImportedSectionContent(content); len(violations) > 0 {
+				p.skip(fmt.Sprintf("%s.contents[%d]", path, j), violations.Error())
+				continue
+			}
+			contents = append(contents, content)
+		}
+		section.Contents = contents
+		export.Sections = append(export.Sections, section)
+	}
+
+	return export
+}
+
+// prepareProjects returns the projects of a category that can be created, with their collaborators
+func (p *importPlan) prepareProjects(categoryPath string, source []dto.ProjectExportDTO) []dto.ProjectExportDTO {
+	var projects []dto.ProjectExportDTO
+	for _, i := range positionOrder(len(source), func(i int) uint { return source[i].Position }) {
+		project := source[i]
+		path := fmt.Sprintf("%s.projects[%d]", categoryPath, i)
+		if violations := validation.ValidateImportedProject(project); len(violations) > 0 {
+			p.skip(path, violations.Error())
+			continue
+		}
+
+		project.MainImage = p.rebaseImagePtr(project.MainImage)
+		if project.MainImage != nil && len(*project.MainImage) > validation.MaxURLLength {
+			p.skip(path+".main_image", "main image cannot exceed 500 characters")
+			project.MainImage = nil
+		}
+		images := make([]string, len(project.Images))
+		for j, image := range project.Images {
+			images[j] = p.rebaseImage(image)
+		}
+		project.Images = images
+
+		var collaborators []dto.ProjectCollaboratorExportDTO
+		for _, j := range positionOrder(len(project.Collaborators), func(j int) uint { return project.Collaborators[j].Position }) {
+			collaborator := project.Collaborators[j]
+			collaboratorPath := fmt.Sprintf("%s.collaborators[%d]", path, j)
+			if len(collaborators) >= domainportfolio.MaxCollaboratorsPerProject {
+				p.skip(collaboratorPath, fmt.Sprintf("a project can have at most %d collaborators", domainportfolio.MaxCollaboratorsPerProject))
+				continue
+			}
+			if err := domainportfolio.ValidateCollaborator(collaborator.Name, collaborator.URL); err != nil {
+				p.skip(collaboratorPath, err.Error())
+				continue
+			}
+			if violations := validation.ValidateImportedCollaborator(collaborator); len(violations) > 0 {
+				p.skip(collaboratorPath, violations.Error())
+				continue
+			}
+			collaborators = append(collaborators, collaborator)
+		}
+		project.Collaborators = collaborators
+		projects = append(projects, project)
+	}
+	return projects
+}
+
+// rebaseImage makes a relative image path absolute against the exporting instance
+// Absolute URLs are kept, and so are relative paths when the document came from this instance.
+func (p *importPlan) rebaseImage(image string) string {
+	if p.imageBase == "" || !strings.HasPrefix(image, "/") || strings.HasPrefix(image, "//") {
+		return image
+	}
+	p.externalImages++
+	return p.imageBase + image
+}
+
+func (p *importPlan) rebaseImagePtr(image *string) *string {
+	if image == nil {
+		return nil
+	}
+	rebased := p.rebaseImage(*image)
+	return &rebased
+}
+
+// positionOrder returns the indexes 0..n-1 sorted by position, keeping document order on ties
+func positionOrder(n int, position func(i int) uint) []int {
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool { return position(order[a]) < position(order[b]) })
+	return order
+}
@@ -0,0 +1,35 @@
+package portfolio
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// ListDeletedPortfoliosUseCase handles the business logic for listing the portfolios in a user's trash
+type ListDeletedPortfoliosUseCase struct {
+	portfolioRepo contracts.PortfolioRepository
+}
+
+// NewListDeletedPortfoliosUseCase creates a new instance of ListDeletedPortfoliosUseCase
+func NewListDeletedPortfoliosUseCase(portfolioRepo contracts.PortfolioRepository) *ListDeletedPortfoliosUseCase {
+	return &ListDeletedPortfoliosUseCase{
+		portfolioRepo: portfolioRepo,
+	}
+}
+
+// Execute retrieves the soft-deleted portfolios of a user, most recently deleted first
+func (uc *ListDeletedPortfoliosUseCase) Execute(ctx context.Context, ownerID string) ([]dto.DeletedPortfolioDTO, error) {
+	if ownerID == "" {
+		return nil, fmt.Errorf("owner ID is required")
+	}
+
+	portfolios, err := uc.portfolioRepo.GetDeletedByOwnerID(ctx, ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deleted portfolios: %w", err)
+	}
+
+	return portfolios, nil
+}
@@ -0,0 +1,111 @@
+package portfolio
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	contracts2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/validation"
+)
+
+// PatchPortfolioUseCase handles the business logic for partially updating a portfolio
+// Unlike UpdatePortfolioUseCase, omitted fields keep their value and the description can be cleared.
+type PatchPortfolioUseCase struct {
+	portfolioRepo contracts2.PortfolioRepository
+	auditLogger   contracts2.AuditLogger
+	metrics       contracts2.MetricsCollector
+}
+
+// NewPatchPortfolioUseCase creates a new instance of PatchPortfolioUseCase
+func NewPatchPortfolioUseCase(
+	portfolioRepo contracts2.PortfolioRepository,
+	auditLogger contracts2.AuditLogger,
+	metrics contracts2.MetricsCollector,
+) *PatchPortfolioUseCase {
+	return &PatchPortfolioUseCase{
+		portfolioRepo: portfolioRepo,
+		auditLogger:   auditLogger,
+		metrics:       metrics,
+	}
+}
+
+// Execute applies the provided fields to a portfolio with ownership verification
+func (uc *PatchPortfolioUseCase) Execute(ctx context.Context, input dto.PatchPortfolioInput) error {
+	// 1. Validate input
+	if input.ID == 0 {
+		return fmt.Errorf("portfolio ID is required")
+	}
+	if input.OwnerID == "" {
+		return fmt.Errorf("owner ID is required")
+	}
+	if err := validation.ValidatePortfolioPatch(input).Err(); err != nil {
+		return err
+	}
+
+	// 2. Get existing portfolio
+	existing, err := uc.portfolioRepo.GetByID(ctx, input.ID)
+	if err != nil {
+		return fmt.Errorf("portfolio not found: %w", err)
+	}
+
+	// 3. Authorization check - verify ownership
+	if existing.OwnerID != input.OwnerID {
+		if uc.auditLogger != nil {
+			uc.auditLogger.LogAccess(ctx, "portfolio", input.ID, input.OwnerID, false)
+		}
+		return fmt.Errorf("unauthorized: you don't own this portfolio")
+	}
+
+	// 4. Check for duplicate title only if the title changes
+	if input.Title != nil && *input.Title != existing.Title {
+		isDuplicate, err := uc.portfolioRepo.CheckTitleDuplicate(ctx, *input.Title, input.OwnerID, input.ID)
+		if err != nil {
+			return fmt.Errorf("failed to check duplicate title: %w", err)
+		}
+		if isDuplicate {
+			return apperrors.DuplicateTitle(apperrors.CodePortfolioDuplicateTitle, "portfolio", *input.Title,
+				fmt.Sprintf("portfolio with title '%s' already exists for this user", *input.Title))
+		}
+	}
+
+	// 5. Update the provided fields
+	if err := uc.portfolioRepo.Patch(ctx, input); err != nil {
+		return fmt.Errorf("failed to update portfolio: %w", err)
+	}
+
+	// 6. Audit log
+	if uc.auditLogger != nil {
+		uc.auditLogger.LogUpdate(ctx, "portfolio", input.ID, map[string]interface{}{
+			"operation":       "patch",
+			"fields":          changedFields(existing, input),
+			"regenerate_slug": input.RegenerateSlug,
+		})
+	}
+
+	// 7. Update metrics
+	if uc.metrics != nil {
+		uc.metrics.IncrementPortfoliosUpdated()
+	}
+
+	return nil
+}
+
+// changedFields lists the JSON names of the fields a patch gives a new value
+func changedFields(existing *dto.PortfolioDTO, input dto.PatchPortfolioInput) []string {
+	var fields []string
+	for _, field := range []struct {
+		name    string
+		changed bool
+	}{
+		{"title", input.Title != nil && *input.Title != existing.Title},
+		{"description", input.Description != nil && *input.Description != existing.Description},
+		{"endorsements_enabled", input.EndorsementsEnabled != nil && *input.EndorsementsEnabled != existing.EndorsementsEnabled},
+	} {
+		if field.changed {
+			fields = append(fields, field.name)
+		}
+	}
+	return fields
+}
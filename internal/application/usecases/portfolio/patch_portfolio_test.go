@@ -0,0 +1,79 @@
+package portfolio
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// checkingPortfolioRepo counts the duplicate-title checks made against titledPortfolioRepo
+type checkingPortfolioRepo struct {
+	*titledPortfolioRepo
+	checks int
+}
+
+func (r *checkingPortfolioRepo) CheckTitleDuplicate(ctx context.Context, title, ownerID string, excludeID uint) (bool, error) {
+	r.checks++
+	return r.titledPortfolioRepo.CheckTitleDuplicate(ctx, title, ownerID, excludeID)
+}
+
+// patchAuditLogger keeps the details of the last update entry
+type patchAuditLogger struct {
+	contracts.AuditLogger
+	details map[string]interface{}
+}
+
+func (l *patchAuditLogger) LogUpdate(_ context.Context, _ string, _ uint, details map[string]interface{}) {
+	l.details = details
+}
+
+func TestPatchPortfolioUseCase(t *testing.T) {
+	strPtr := func(s string) *string { return &s }
+	boolPtr := func(b bool) *bool { return &b }
+
+	tests := []struct {
+		name       string
+		input      dto.PatchPortfolioInput
+		wantChecks int
+		wantFields []string // fields recorded as changed in the audit log
+	}{
+		{name: "unchanged title skips the duplicate check", input: dto.PatchPortfolioInput{ID: 1, Title: strPtr("Work"), OwnerID: "alice"}},
+		{name: "new title is checked", input: dto.PatchPortfolioInput{ID: 1, Title: strPtr("Jobs"), OwnerID: "alice"}, wantChecks: 1, wantFields: []string{"title"}},
+		{name: "cleared description", input: dto.PatchPortfolioInput{ID: 1, Description: strPtr(""), OwnerID: "alice"}, wantFields: []string{"description"}},
+		{name: "same endorsement setting", input: dto.PatchPortfolioInput{ID: 1, EndorsementsEnabled: boolPtr(true), OwnerID: "alice"}},
+		{name: "disabled endorsements", input: dto.PatchPortfolioInput{ID: 1, EndorsementsEnabled: boolPtr(false), OwnerID: "alice"}, wantFields: []string{"endorsements_enabled"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &checkingPortfolioRepo{titledPortfolioRepo: &titledPortfolioRepo{portfolios: []dto.PortfolioDTO{
+				{ID: 1, Title: "Work", Description: "jobs", OwnerID: "alice", EndorsementsEnabled: true},
+			}}}
+			audit := &patchAuditLogger{}
+
+			if err := NewPatchPortfolioUseCase(repo, audit, nil).Execute(context.Background(), tt.input); err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+
+			if repo.checks != tt.wantChecks || repo.writes != 1 {
+				t.Errorf("%d duplicate checks and %d writes, want %d and 1", repo.checks, repo.writes, tt.wantChecks)
+			}
+			if fields, _ := audit.details["fields"].([]string); !reflect.DeepEqual(fields, tt.wantFields) {
+				t.Errorf("audited fields = %v, want %v", fields, tt.wantFields)
+			}
+		})
+	}
+
+	t.Run("another owner's portfolio", func(t *testing.T) {
+		repo := &checkingPortfolioRepo{titledPortfolioRepo: &titledPortfolioRepo{portfolios: []dto.PortfolioDTO{{ID: 3, Title: "Bob's", OwnerID: "bob"}}}}
+		if err := NewPatchPortfolioUseCase(repo, nil, nil).Execute(context.Background(), dto.PatchPortfolioInput{ID: 3, Title: strPtr("Mine"), OwnerID: "alice"}); err == nil {
+			t.Fatal("patching another owner's portfolio succeeded")
+		}
+		if repo.checks != 0 || repo.writes != 0 {
+			t.Errorf("%d duplicate checks and %d writes, want none", repo.checks, repo.writes)
+		}
+	})
+}
@@ -0,0 +1,164 @@
+package portfolio
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// portfolioViewRecorder keeps the recorded visitors and hands out fixed batches once
+type portfolioViewRecorder struct {
+	visitors []string
+	pending  []dto.PortfolioViewBatchDTO
+	requeued []dto.PortfolioViewBatchDTO
+}
+
+func (r *portfolioViewRecorder) Record(_ uint, visitor string, _ time.Time) bool {
+	r.visitors = append(r.visitors, visitor)
+	return true
+}
+
+func (r *portfolioViewRecorder) Drain() []dto.PortfolioViewBatchDTO {
+	batches := r.pending
+	r.pending = nil
+	return batches
+}
+
+func (r *portfolioViewRecorder) Requeue(batches []dto.PortfolioViewBatchDTO) {
+	r.requeued = append(r.requeued, batches...)
+}
+
+// portfolioViewRepo records flushed batches, or fails every flush, and serves stored days
+type portfolioViewRepo struct {
+	contracts.PortfolioViewRepository
+	err     error
+	flushes [][]dto.PortfolioViewBatchDTO
+	days    []dto.PortfolioViewDayDTO
+}
+
+func (r *portfolioViewRepo) Flush(_ context.Context, batches []dto.PortfolioViewBatchDTO) error {
+	r.flushes = append(r.flushes, batches)
+	return r.err
+}
+
+func (r *portfolioViewRepo) GetDays(context.Context, uint, time.Time, time.Time) ([]dto.PortfolioViewDayDTO, error) {
+	return r.days, nil
+}
+
+func TestRecordPortfolioViewUseCase_HashesVisitors(t *testing.T) {
+	recorder := &portfolioViewRecorder{}
+	uc := NewRecordPortfolioViewUseCase(recorder, "salt")
+	visit := dto.RecordPortfolioViewInput{PortfolioID: 1, ClientIP: "203.0.113.7", UserAgent: "curl/8"}
+
+	uc.Execute(visit)
+	uc.Execute(visit)
+	uc.Execute(dto.RecordPortfolioViewInput{PortfolioID: 1, ClientIP: "203.0.113.7", UserAgent: "Firefox"})
+	NewRecordPortfolioViewUseCase(recorder, "other salt").Execute(visit)
+	if uc.Execute(dto.RecordPortfolioViewInput{ClientIP: "203.0.113.7"}) {
+		t.Error("a view without portfolio was counted")
+	}
+
+	if len(recorder.visitors) != 4 {
+		t.Fatalf("recorded %d views, want 4", len(recorder.visitors))
+	}
+	same, otherAgent, otherSalt := recorder.visitors[0], recorder.visitors[2], recorder.visitors[3]
+	if recorder.visitors[1] != same || otherAgent == same || otherSalt == same {
+		t.Errorf("visitors = %v, want one key per IP, user agent and salt", recorder.visitors)
+	}
+	if strings.Contains(same, "203.0.113.7") {
+		t.Errorf("visitor key %q carries the client IP", same)
+	}
+}
+
+func TestFlushPortfolioViewsUseCase(t *testing.T) {
+	day := time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)
+	batches := []dto.PortfolioViewBatchDTO{{PortfolioID: 1, Day: day, Views: 3}, {PortfolioID: 2, Day: day, Views: 4}}
+
+	recorder := &portfolioViewRecorder{pending: batches}
+	repo := &portfolioViewRepo{}
+	if views, err := NewFlushPortfolioViewsUseCase(recorder, repo).Execute(context.Background()); err != nil || views != 7 {
+		t.Fatalf("Execute = %d, %v, want 7 views", views, err)
+	}
+	if views, err := NewFlushPortfolioViewsUseCase(recorder, repo).Execute(context.Background()); err != nil || views != 0 || len(repo.flushes) != 1 {
+		t.Errorf("empty buffer: Execute = %d, %v after %d flushes, want nothing written", views, err, len(repo.flushes))
+	}
+
+	failing := &portfolioViewRecorder{pending: batches}
+	if _, err := NewFlushPortfolioViewsUseCase(failing, &portfolioViewRepo{err: errors.New("connection reset")}).Execute(context.Background()); err == nil {
+		t.Fatal("failed flush reported no error")
+	}
+	if !reflect.DeepEqual(failing.requeued, batches) {
+		t.Errorf("requeued %+v, want %+v", failing.requeued, batches)
+	}
+}
+
+func TestAnalyticsRange(t *testing.T) {
+	now := time.Date(2026, 3, 9, 23, 30, 0, 0, time.UTC)
+	date := func(month time.Month, day int) time.Time { return time.Date(2026, month, day, 0, 0, 0, 0, time.UTC) }
+	saoPaulo := time.FixedZone("BRT", -3*60*60)
+
+	tests := []struct {
+		name             string
+		from, to         time.Time
+		wantFrom, wantTo time.Time
+		wantCode         string // validation code; empty when the range is accepted
+	}{
+		{name: "last 30 days by default", wantFrom: date(2, 8), wantTo: date(3, 9)},
+		{name: "30 days before the given end", to: date(1, 31), wantFrom: date(1, 2), wantTo: date(1, 31)},
+		{name: "single day", from: date(3, 1), to: date(3, 1), wantFrom: date(3, 1), wantTo: date(3, 1)},
+		{name: "dates are taken in UTC", from: time.Date(2026, 3, 1, 22, 0, 0, 0, saoPaulo), to: date(3, 5), wantFrom: date(3, 2), wantTo: date(3, 5)},
+		{name: "366 days", from: date(3, 9).AddDate(0, 0, -365), to: date(3, 9), wantFrom: date(3, 9).AddDate(0, 0, -365), wantTo: date(3, 9)},
+		{name: "367 days", from: date(3, 9).AddDate(0, 0, -366), to: date(3, 9), wantCode: apperrors.CodeValidationMax},
+		{name: "reversed", from: date(3, 2), to: date(3, 1), wantCode: apperrors.CodeValidationInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, to, err := analyticsRange(tt.from, tt.to, now)
+			if tt.wantCode != "" {
+				var appErr *apperrors.Error
+				if !errors.As(err, &appErr) || appErr.Code != tt.wantCode {
+					t.Errorf("error = %v, want %s", err, tt.wantCode)
+				}
+				return
+			}
+			if err != nil || !from.Equal(tt.wantFrom) || !to.Equal(tt.wantTo) {
+				t.Errorf("range = %s..%s, %v, want %s..%s", from.Format(time.DateOnly), to.Format(time.DateOnly), err,
+					tt.wantFrom.Format(time.DateOnly), tt.wantTo.Format(time.DateOnly))
+			}
+		})
+	}
+}
+
+func TestGetPortfolioAnalyticsUseCase(t *testing.T) {
+	date := func(day int) time.Time { return time.Date(2026, 3, day, 0, 0, 0, 0, time.UTC) }
+	portfolios := &titledPortfolioRepo{portfolios: []dto.PortfolioDTO{{ID: 1, OwnerID: "alice"}, {ID: 3, OwnerID: "bob"}}}
+	views := &portfolioViewRepo{days: []dto.PortfolioViewDayDTO{{Day: date(2), Views: 5}, {Day: date(4), Views: 2}}}
+	uc := NewGetPortfolioAnalyticsUseCase(portfolios, views, nil)
+
+	analytics, err := uc.Execute(context.Background(), dto.PortfolioAnalyticsInput{PortfolioID: 1, OwnerID: "alice", From: date(1), To: date(5)})
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	var counts []uint
+	for i, day := range analytics.Days {
+		if !day.Day.Equal(date(i + 1)) {
+			t.Errorf("day %d = %s, want %s", i, day.Day.Format(time.DateOnly), date(i+1).Format(time.DateOnly))
+		}
+		counts = append(counts, day.Views)
+	}
+	if !reflect.DeepEqual(counts, []uint{0, 5, 0, 2, 0}) || analytics.Total != 7 {
+		t.Errorf("views = %v (total %d), want [0 5 0 2 0] (total 7)", counts, analytics.Total)
+	}
+
+	if _, err := uc.Execute(context.Background(), dto.PortfolioAnalyticsInput{PortfolioID: 3, OwnerID: "alice", From: date(1), To: date(5)}); err == nil {
+		t.Error("analytics of another owner's portfolio succeeded")
+	}
+}
@@ -0,0 +1,66 @@
+package portfolio
+
+import (
+	"context"
+	"fmt"
+
+	contracts2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// PurgePortfolioUseCase handles the business logic for permanently deleting a portfolio from the trash
+type PurgePortfolioUseCase struct {
+	portfolioRepo contracts2.PortfolioRepository
+	trashRepo     contracts2.TrashRepository
+	auditLogger   contracts2.AuditLogger
+}
+
+// NewPurgePortfolioUseCase creates a new instance of PurgePortfolioUseCase
+func NewPurgePortfolioUseCase(
+	portfolioRepo contracts2.PortfolioRepository,
+	trashRepo contracts2.TrashRepository,
+	auditLogger contracts2.AuditLogger,
+) *PurgePortfolioUseCase {
+	return &PurgePortfolioUseCase{
+		portfolioRepo: portfolioRepo,
+		trashRepo:     trashRepo,
+		auditLogger:   auditLogger,
+	}
+}
+
+// Execute permanently deletes a soft-deleted portfolio of the user and everything under it
+// Only portfolios in the trash can be purged: live ones have to be deleted first.
+func (uc *PurgePortfolioUseCase) Execute(ctx context.Context, id uint, ownerID string) error {
+	// 1. Validate input
+	if id == 0 {
+		return fmt.Errorf("portfolio ID is required")
+	}
+	if ownerID == "" {
+		return fmt.Errorf("owner ID is required")
+	}
+
+	// 2. Authorization check - the portfolio must be in the user's trash
+	deleted, err := uc.trashRepo.FindDeleted(ctx, dto.TrashResourcePortfolio, id, ownerID)
+	if err != nil {
+		return fmt.Errorf("failed to look up deleted portfolio: %w", err)
+	}
+	if deleted == nil {
+		return fmt.Errorf("portfolio with ID %d not found in trash", id)
+	}
+
+	// 3. Purge via repository
+	if err := uc.portfolioRepo.Purge(ctx, id); err != nil {
+		return fmt.Errorf("failed to purge portfolio: %w", err)
+	}
+
+	// 4. Audit log
+	if uc.auditLogger != nil {
+		uc.auditLogger.LogDelete(ctx, "portfolio", id, map[string]interface{}{
+			"operation":  "purge",
+			"ownerID":    ownerID,
+			"deleted_at": deleted.DeletedAt,
+		})
+	}
+
+	return nil
+}
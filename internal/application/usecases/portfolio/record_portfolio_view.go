@@ -0,0 +1,42 @@
+package portfolio
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// RecordPortfolioViewUseCase counts a public read of a portfolio page
+// The view is only buffered; the portfolio view flusher writes it to the database.
+type RecordPortfolioViewUseCase struct {
+	viewRecorder    contracts.PortfolioViewRecorder
+	visitorHashSalt string
+}
+
+// NewRecordPortfolioViewUseCase creates a new instance of RecordPortfolioViewUseCase
+// visitorHashSalt is mixed into the visitor hash so it can't be reversed by enumerating IPs
+func NewRecordPortfolioViewUseCase(viewRecorder contracts.PortfolioViewRecorder, visitorHashSalt string) *RecordPortfolioViewUseCase {
+	return &RecordPortfolioViewUseCase{
+		viewRecorder:    viewRecorder,
+		visitorHashSalt: visitorHashSalt,
+	}
+}
+
+// Execute counts the view unless the same visitor (IP and user agent) already viewed the
+// portfolio this hour, and reports whether it was counted
+func (uc *RecordPortfolioViewUseCase) Execute(input dto.RecordPortfolioViewInput) bool {
+	if uc.viewRecorder == nil || input.PortfolioID == 0 {
+		return false
+	}
+
+	return uc.viewRecorder.Record(input.PortfolioID, uc.hashVisitor(input.ClientIP, input.UserAgent), time.Now())
+}
+
+// hashVisitor returns the salted SHA-256 of a visitor IP and user agent
+func (uc *RecordPortfolioViewUseCase) hashVisitor(ip, userAgent string) string {
+	sum := sha256.Sum256([]byte(uc.visitorHashSalt + "|" + ip + "|" + userAgent))
+	return hex.EncodeToString(sum[:])
+}
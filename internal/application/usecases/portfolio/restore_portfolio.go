@@ -0,0 +1,74 @@
+package portfolio
+
+import (
+	"context"
+	"fmt"
+
+	contracts2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// RestorePortfolioUseCase handles the business logic for bringing a portfolio back from the trash
+type RestorePortfolioUseCase struct {
+	portfolioRepo contracts2.PortfolioRepository
+	trashRepo     contracts2.TrashRepository
+	auditLogger   contracts2.AuditLogger
+}
+
+// NewRestorePortfolioUseCase creates a new instance of RestorePortfolioUseCase
+func NewRestorePortfolioUseCase(
+	portfolioRepo contracts2.PortfolioRepository,
+	trashRepo contracts2.TrashRepository,
+	auditLogger contracts2.AuditLogger,
+) *RestorePortfolioUseCase {
+	return &RestorePortfolioUseCase{
+		portfolioRepo: portfolioRepo,
+		trashRepo:     trashRepo,
+		auditLogger:   auditLogger,
+	}
+}
+
+// Execute restores a soft-deleted portfolio of the user with the children deleted along with it
+// Portfolios of other users are reported as not found, like missing ones.
+func (uc *RestorePortfolioUseCase) Execute(ctx context.Context, input dto.RestorePortfolioInput) (*dto.PortfolioRestoreDTO, error) {
+	// 1. Validate input
+	if input.PortfolioID == 0 {
+		return nil, fmt.Errorf("portfolio ID is required")
+	}
+	if input.OwnerID == "" {
+		return nil, fmt.Errorf("owner ID is required")
+	}
+
+	// 2. Authorization check - the portfolio must be in the user's trash
+	deleted, err := uc.trashRepo.FindDeleted(ctx, dto.TrashResourcePortfolio, input.PortfolioID, input.OwnerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up deleted portfolio: %w", err)
+	}
+	if deleted == nil {
+		return nil, fmt.Errorf("portfolio with ID %d not found in trash", input.PortfolioID)
+	}
+
+	// 3. Restore via repository (renames on title collisions)
+	restore, err := uc.portfolioRepo.Restore(ctx, input.PortfolioID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore portfolio: %w", err)
+	}
+
+	// 4. Audit log
+	if uc.auditLogger != nil {
+		uc.auditLogger.LogUpdate(ctx, "portfolio", input.PortfolioID, map[string]interface{}{
+			"operation":        "restore",
+			"title":            restore.Portfolio.Title,
+			"renamed_from":     restore.RenamedFrom,
+			"ownerID":          restore.Portfolio.OwnerID,
+			"links":            restore.Restored.Links,
+			"categories":       restore.Restored.Categories,
+			"projects":         restore.Restored.Projects,
+			"collaborators":    restore.Restored.ProjectCollaborators,
+			"sections":         restore.Restored.Sections,
+			"section_contents": restore.Restored.SectionContents,
+		})
+	}
+
+	return restore, nil
+}
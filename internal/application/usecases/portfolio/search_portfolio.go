@@ -0,0 +1,71 @@
+package portfolio
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// Portfolio search limits
+const (
+	PortfolioSearchMinQuery = 2  // Shorter queries match nearly everything
+	PortfolioSearchLimit    = 50 // Results returned per kind (projects, sections)
+)
+
+// SearchPortfolioUseCase searches the projects and sections of one public portfolio
+type SearchPortfolioUseCase struct {
+	portfolioRepo contracts.PortfolioRepository
+	projectRepo   contracts.ProjectRepository
+	sectionRepo   contracts.SectionRepository
+}
+
+// NewSearchPortfolioUseCase creates a new instance of SearchPortfolioUseCase
+func NewSearchPortfolioUseCase(
+	portfolioRepo contracts.PortfolioRepository,
+	projectRepo contracts.ProjectRepository,
+	sectionRepo contracts.SectionRepository,
+) *SearchPortfolioUseCase {
+	return &SearchPortfolioUseCase{
+		portfolioRepo: portfolioRepo,
+		projectRepo:   projectRepo,
+		sectionRepo:   sectionRepo,
+	}
+}
+
+// Execute searches a portfolio (public access, no ownership check)
+func (uc *SearchPortfolioUseCase) Execute(ctx context.Context, input dto.SearchPortfolioInput) (*dto.PortfolioSearchOutput, error) {
+	if input.PortfolioID == 0 {
+		return nil, fmt.Errorf("invalid portfolio ID")
+	}
+	input.Query = strings.TrimSpace(input.Query)
+	if utf8.RuneCountInString(input.Query) < PortfolioSearchMinQuery {
+		return nil, apperrors.New(apperrors.KindValidation, apperrors.CodeValidationMin,
+			fmt.Sprintf("search query must be at least %d characters", PortfolioSearchMinQuery),
+			map[string]interface{}{"field": "q", "param": PortfolioSearchMinQuery})
+	}
+
+	published, err := uc.portfolioRepo.IsPublished(ctx, input.PortfolioID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get portfolio: %w", err)
+	}
+	if !published {
+		return nil, fmt.Errorf("portfolio not found")
+	}
+
+	projects, err := uc.projectRepo.SearchInPortfolio(ctx, input.PortfolioID, input.Query, PortfolioSearchLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search projects: %w", err)
+	}
+
+	sections, err := uc.sectionRepo.SearchInPortfolio(ctx, input.PortfolioID, input.Query, PortfolioSearchLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search sections: %w", err)
+	}
+
+	return &dto.PortfolioSearchOutput{Projects: projects, Sections: sections}, nil
+}
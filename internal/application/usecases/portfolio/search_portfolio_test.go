@@ -0,0 +1,87 @@
+package portfolio
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// publishingPortfolioRepo publishes portfolio 1 only
+type publishingPortfolioRepo struct{ contracts.PortfolioRepository }
+
+func (publishingPortfolioRepo) IsPublished(_ context.Context, id uint) (bool, error) {
+	return id == 1, nil
+}
+
+// searchedProjectRepo and searchedSectionRepo record the queries they served
+type searchedProjectRepo struct {
+	contracts.ProjectRepository
+	queries []string
+}
+
+func (r *searchedProjectRepo) SearchInPortfolio(_ context.Context, _ uint, query string, _ int) ([]dto.ProjectDTO, error) {
+	r.queries = append(r.queries, query)
+	return []dto.ProjectDTO{{ID: 5, Title: "Go tools"}}, nil
+}
+
+type searchedSectionRepo struct {
+	contracts.SectionRepository
+	queries []string
+}
+
+func (r *searchedSectionRepo) SearchInPortfolio(_ context.Context, _ uint, query string, _ int) ([]dto.SectionSearchHitDTO, error) {
+	r.queries = append(r.queries, query)
+	return []dto.SectionSearchHitDTO{{Section: dto.SectionDTO{ID: 8}, ContentIDs: []uint{13}}}, nil
+}
+
+func TestSearchPortfolioUseCase(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     dto.SearchPortfolioInput
+		wantCode  string // application error code; empty when another error or none is expected
+		wantErr   bool
+		wantQuery string // query passed to the repositories; empty when they must not be called
+	}{
+		{name: "trimmed query", input: dto.SearchPortfolioInput{PortfolioID: 1, Query: "  go  "}, wantQuery: "go"},
+		{name: "one character after trimming", input: dto.SearchPortfolioInput{PortfolioID: 1, Query: " g "}, wantCode: apperrors.CodeValidationMin, wantErr: true},
+		{name: "two characters of one rune each", input: dto.SearchPortfolioInput{PortfolioID: 1, Query: "日本"}, wantQuery: "日本"},
+		{name: "draft portfolio", input: dto.SearchPortfolioInput{PortfolioID: 2, Query: "go"}, wantErr: true},
+		{name: "no portfolio", input: dto.SearchPortfolioInput{Query: "go"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projects := &searchedProjectRepo{}
+			sections := &searchedSectionRepo{}
+
+			output, err := NewSearchPortfolioUseCase(publishingPortfolioRepo{}, projects, sections).Execute(context.Background(), tt.input)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Execute error = %v, want error %v", err, tt.wantErr)
+			}
+			if tt.wantCode != "" {
+				var appErr *apperrors.Error
+				if !errors.As(err, &appErr) || appErr.Code != tt.wantCode || appErr.Kind != apperrors.KindValidation {
+					t.Errorf("error = %v, want a validation error %s", err, tt.wantCode)
+				}
+			}
+			if tt.wantErr {
+				if len(projects.queries)+len(sections.queries) != 0 {
+					t.Errorf("rejected search still ran %v and %v", projects.queries, sections.queries)
+				}
+				return
+			}
+
+			if len(projects.queries) != 1 || projects.queries[0] != tt.wantQuery || len(sections.queries) != 1 || sections.queries[0] != tt.wantQuery {
+				t.Errorf("queries = %v and %v, want %q once each", projects.queries, sections.queries, tt.wantQuery)
+			}
+			if len(output.Projects) != 1 || len(output.Sections) != 1 || output.Sections[0].ContentIDs[0] != 13 {
+				t.Errorf("output = %+v, want both groups", output)
+			}
+		})
+	}
+}
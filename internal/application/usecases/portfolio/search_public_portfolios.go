@@ -0,0 +1,49 @@
+package portfolio
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// SearchPublicPortfoliosUseCase handles the business logic for the public portfolio discovery list
+type SearchPublicPortfoliosUseCase struct {
+	portfolioRepo contracts.PortfolioRepository
+}
+
+// NewSearchPublicPortfoliosUseCase creates a new instance of SearchPublicPortfoliosUseCase
+func NewSearchPublicPortfoliosUseCase(portfolioRepo contracts.PortfolioRepository) *SearchPublicPortfoliosUseCase {
+	return &SearchPublicPortfoliosUseCase{
+		portfolioRepo: portfolioRepo,
+	}
+}
+
+// Execute lists the portfolios of every owner, filtered by the optional query
+func (uc *SearchPublicPortfoliosUseCase) Execute(ctx context.Context, input dto.SearchPublicPortfoliosInput) (*dto.ListPortfoliosOutput, error) {
+	input.Query = strings.TrimSpace(input.Query)
+
+	// Set default pagination if not provided
+	if input.Pagination.Limit == 0 {
+		input.Pagination.Limit = 10
+	}
+	if input.Pagination.Page == 0 {
+		input.Pagination.Page = 1
+	}
+
+	portfolios, total, err := uc.portfolioRepo.SearchPublic(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search portfolios: %w", err)
+	}
+
+	return &dto.ListPortfoliosOutput{
+		Portfolios: portfolios,
+		Pagination: dto.PaginatedResultDTO{
+			Total: total,
+			Page:  input.Pagination.Page,
+			Limit: input.Pagination.Limit,
+		},
+	}, nil
+}
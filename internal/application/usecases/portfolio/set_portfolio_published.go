@@ -0,0 +1,80 @@
+package portfolio
+
+import (
+	"context"
+	"fmt"
+
+	contracts2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// SetPortfolioPublishedUseCase handles the business logic for publishing a portfolio or turning it back into a draft
+type SetPortfolioPublishedUseCase struct {
+	portfolioRepo contracts2.PortfolioRepository
+	auditLogger   contracts2.AuditLogger
+}
+
+// NewSetPortfolioPublishedUseCase creates a new instance of SetPortfolioPublishedUseCase
+func NewSetPortfolioPublishedUseCase(
+	portfolioRepo contracts2.PortfolioRepository,
+	auditLogger contracts2.AuditLogger,
+) *SetPortfolioPublishedUseCase {
+	return &SetPortfolioPublishedUseCase{
+		portfolioRepo: portfolioRepo,
+		auditLogger:   auditLogger,
+	}
+}
+
+// Execute sets the visibility of a portfolio owned by the user and returns the updated portfolio
+// Setting the current visibility again is a no-op.
+func (uc *SetPortfolioPublishedUseCase) Execute(ctx context.Context, input dto.SetPortfolioPublishedInput) (*dto.PortfolioDTO, error) {
+	// 1. Validate input
+	if input.PortfolioID == 0 {
+		return nil, fmt.Errorf("portfolio ID is required")
+	}
+	if input.OwnerID == "" {
+		return nil, fmt.Errorf("owner ID is required")
+	}
+
+	// 2. Get existing portfolio
+	existing, err := uc.portfolioRepo.GetByID(ctx, input.PortfolioID)
+	if err != nil {
+		return nil, fmt.Errorf("portfolio not found: %w", err)
+	}
+
+	// 3. Authorization check - verify ownership
+	if existing.OwnerID != input.OwnerID {
+		if uc.auditLogger != nil {
+			uc.auditLogger.LogAccess(ctx, "portfolio", input.PortfolioID, input.OwnerID, false)
+		}
+		return nil, fmt.Errorf("unauthorized: you don't own this portfolio")
+	}
+	if existing.IsPublished == input.Published {
+		return existing, nil
+	}
+
+	// 4. Update the visibility
+	if err := uc.portfolioRepo.SetPublished(ctx, input.PortfolioID, input.Published); err != nil {
+		return nil, fmt.Errorf("failed to update portfolio visibility: %w", err)
+	}
+
+	// 5. Audit log
+	if uc.auditLogger != nil {
+		operation := "unpublish"
+		if input.Published {
+			operation = "publish"
+		}
+		uc.auditLogger.LogUpdate(ctx, "portfolio", input.PortfolioID, map[string]interface{}{
+			"operation": operation,
+			"owner_id":  input.OwnerID,
+		})
+	}
+
+	// 6. Return updated portfolio
+	updated, err := uc.portfolioRepo.GetByID(ctx, input.PortfolioID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get updated portfolio: %w", err)
+	}
+
+	return updated, nil
+}
@@ -0,0 +1,82 @@
+package portfolio
+
+import (
+	"context"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// publishablePortfolioRepo toggles the visibility of titledPortfolioRepo's portfolios
+type publishablePortfolioRepo struct {
+	*titledPortfolioRepo
+}
+
+func (r *publishablePortfolioRepo) SetPublished(_ context.Context, id uint, published bool) error {
+	r.writes++
+	for i := range r.portfolios {
+		if r.portfolios[i].ID == id {
+			r.portfolios[i].IsPublished = published
+		}
+	}
+	return nil
+}
+
+func newPublishableRepo() *publishablePortfolioRepo {
+	return &publishablePortfolioRepo{titledPortfolioRepo: &titledPortfolioRepo{portfolios: []dto.PortfolioDTO{
+		{ID: 1, Title: "Work", OwnerID: "alice"},
+		{ID: 3, Title: "Bob's", OwnerID: "bob", IsPublished: true},
+	}}}
+}
+
+func TestSetPortfolioPublishedUseCase(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         dto.SetPortfolioPublishedInput
+		wantErr       bool
+		wantWrites    int
+		wantPublished bool
+	}{
+		{name: "publish a draft", input: dto.SetPortfolioPublishedInput{PortfolioID: 1, OwnerID: "alice", Published: true}, wantWrites: 1, wantPublished: true},
+		{name: "draft again is a no-op", input: dto.SetPortfolioPublishedInput{PortfolioID: 1, OwnerID: "alice"}},
+		{name: "another owner's portfolio", input: dto.SetPortfolioPublishedInput{PortfolioID: 3, OwnerID: "alice"}, wantErr: true},
+		{name: "missing portfolio", input: dto.SetPortfolioPublishedInput{PortfolioID: 9, OwnerID: "alice", Published: true}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newPublishableRepo()
+
+			updated, err := NewSetPortfolioPublishedUseCase(repo, nil).Execute(context.Background(), tt.input)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Execute error = %v, want error %v", err, tt.wantErr)
+			}
+			if repo.writes != tt.wantWrites {
+				t.Errorf("writes = %d, want %d", repo.writes, tt.wantWrites)
+			}
+			if !tt.wantErr && updated.IsPublished != tt.wantPublished {
+				t.Errorf("published = %v, want %v", updated.IsPublished, tt.wantPublished)
+			}
+		})
+	}
+}
+
+func TestDraftPortfolio_HiddenPubliclyButEditable(t *testing.T) {
+	ctx := context.Background()
+	repo := newPublishableRepo()
+
+	if _, err := NewGetPortfolioPublicUseCase(repo, nil).Execute(ctx, 1); err == nil {
+		t.Fatal("public read of a draft succeeded, want not found")
+	}
+	if err := NewUpdatePortfolioUseCase(repo, nil, nil).Execute(ctx, dto.UpdatePortfolioInput{ID: 1, Title: "Jobs", OwnerID: "alice"}); err != nil {
+		t.Fatalf("owner update of a draft: %v", err)
+	}
+
+	if _, err := NewSetPortfolioPublishedUseCase(repo, nil).Execute(ctx, dto.SetPortfolioPublishedInput{PortfolioID: 1, OwnerID: "alice", Published: true}); err != nil {
+		t.Fatalf("publish: %v", err)
+	}
+	if got, err := NewGetPortfolioPublicUseCase(repo, nil).Execute(ctx, 1); err != nil || got.ID != 1 {
+		t.Errorf("public read after publishing = %+v, %v, want portfolio 1", got, err)
+	}
+}
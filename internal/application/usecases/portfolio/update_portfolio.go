@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
 	contracts2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
 )
@@ -59,7 +60,8 @@ func (uc *UpdatePortfolioUseCase) Execute(ctx context.Context, input dto.UpdateP
 			return fmt.Errorf("failed to check duplicate title: %w", err)
 		}
 		if isDuplicate {
-			return fmt.Errorf("portfolio with title '%s' already exists for this user", input.Title)
+			return apperrors.DuplicateTitle(apperrors.CodePortfolioDuplicateTitle, "portfolio", input.Title,
+				fmt.Sprintf("portfolio with title '%s' already exists for this user", input.Title))
 		}
 	}
 
@@ -71,8 +73,9 @@ func (uc *UpdatePortfolioUseCase) Execute(ctx context.Context, input dto.UpdateP
 	// 6. Audit log
 	if uc.auditLogger != nil {
 		uc.auditLogger.LogUpdate(ctx, "portfolio", input.ID, map[string]interface{}{
-			"title":       input.Title,
-			"description": input.Description,
+			"title":           input.Title,
+			"description":     input.Description,
+			"regenerate_slug": input.RegenerateSlug,
 		})
 	}
 
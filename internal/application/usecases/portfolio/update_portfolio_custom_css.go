@@ -0,0 +1,87 @@
+package portfolio
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	contracts2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/customcss"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// UpdatePortfolioCustomCSSUseCase handles the business logic for setting a portfolio's custom stylesheet
+type UpdatePortfolioCustomCSSUseCase struct {
+	portfolioRepo  contracts2.PortfolioRepository
+	auditLogger    contracts2.AuditLogger
+	allowedOrigins []string
+}
+
+// NewUpdatePortfolioCustomCSSUseCase creates a new instance of UpdatePortfolioCustomCSSUseCase
+// allowedOrigins are the hosts url()s may point at besides the portfolio's own origin
+func NewUpdatePortfolioCustomCSSUseCase(
+	portfolioRepo contracts2.PortfolioRepository,
+	auditLogger contracts2.AuditLogger,
+	allowedOrigins []string,
+) *UpdatePortfolioCustomCSSUseCase {
+	return &UpdatePortfolioCustomCSSUseCase{
+		portfolioRepo:  portfolioRepo,
+		auditLogger:    auditLogger,
+		allowedOrigins: allowedOrigins,
+	}
+}
+
+// Execute sanitizes the stylesheet and, unless it is a dry run, stores both versions
+func (uc *UpdatePortfolioCustomCSSUseCase) Execute(ctx context.Context, input dto.UpdatePortfolioCustomCSSInput) (*dto.PortfolioCustomCSSOutput, error) {
+	// Validate input
+	if input.PortfolioID == 0 {
+		return nil, fmt.Errorf("portfolio ID is required")
+	}
+	if input.OwnerID == "" {
+		return nil, fmt.Errorf("owner ID is required")
+	}
+	if len(input.CSS) > customcss.MaxSize {
+		return nil, apperrors.New(apperrors.KindValidation, apperrors.CodeValidationMax,
+			fmt.Sprintf("custom CSS cannot exceed %d bytes", customcss.MaxSize),
+			map[string]interface{}{"field": "css", "param": customcss.MaxSize})
+	}
+
+	// Verify ownership
+	portfolio, err := uc.portfolioRepo.GetByID(ctx, input.PortfolioID)
+	if err != nil {
+		return nil, fmt.Errorf("portfolio not found")
+	}
+	if portfolio.OwnerID != input.OwnerID {
+		return nil, fmt.Errorf("unauthorized: you don't own this portfolio")
+	}
+
+	result := customcss.Sanitize(input.CSS, customcss.Options{AllowedOrigins: uc.allowedOrigins})
+	output := &dto.PortfolioCustomCSSOutput{
+		CSS:          input.CSS,
+		SanitizedCSS: result.CSS,
+		Removed:      make([]dto.CSSRemovalDTO, len(result.Removed)),
+	}
+	for i, removal := range result.Removed {
+		output.Removed[i] = dto.CSSRemovalDTO{Kind: removal.Kind, Snippet: removal.Snippet}
+	}
+
+	if input.DryRun {
+		return output, nil
+	}
+
+	if err := uc.portfolioRepo.SetCustomCSS(ctx, input.PortfolioID, input.CSS, result.CSS); err != nil {
+		return nil, fmt.Errorf("failed to update portfolio custom CSS: %w", err)
+	}
+	output.Saved = true
+
+	// Audit logging
+	if uc.auditLogger != nil {
+		uc.auditLogger.LogUpdate(ctx, "portfolio", input.PortfolioID, map[string]interface{}{
+			"custom_css_bytes": len(input.CSS),
+			"removed":          len(result.Removed),
+			"owner_id":         input.OwnerID,
+		})
+	}
+
+	return output, nil
+}
@@ -0,0 +1,77 @@
+package portfolio_link
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/quota"
+	domainportfolio "github.com/JorgeSaicoski/portfolio-manager/backend/internal/domain/portfolio"
+)
+
+// CreatePortfolioLinkUseCase handles the business logic for adding a link to a portfolio
+type CreatePortfolioLinkUseCase struct {
+	linkRepo      contracts.PortfolioLinkRepository
+	portfolioRepo contracts.PortfolioRepository
+	auditLogger   contracts.AuditLogger
+}
+
+// NewCreatePortfolioLinkUseCase creates a new instance of CreatePortfolioLinkUseCase
+func NewCreatePortfolioLinkUseCase(
+	linkRepo contracts.PortfolioLinkRepository,
+	portfolioRepo contracts.PortfolioRepository,
+	auditLogger contracts.AuditLogger,
+) *CreatePortfolioLinkUseCase {
+	return &CreatePortfolioLinkUseCase{
+		linkRepo:      linkRepo,
+		portfolioRepo: portfolioRepo,
+		auditLogger:   auditLogger,
+	}
+}
+
+// Execute creates a new link on a portfolio owned by the user
+// The output warns when the portfolio is getting close to MaxLinksPerPortfolio
+func (uc *CreatePortfolioLinkUseCase) Execute(ctx context.Context, input dto.CreatePortfolioLinkInput) (*dto.CreatePortfolioLinkOutput, error) {
+	// Validate input
+	if input.PortfolioID == 0 {
+		return nil, apperrors.Required("portfolio_id", "portfolio ID is required")
+	}
+	if input.OwnerID == "" {
+		return nil, fmt.Errorf("owner ID is required")
+	}
+	if err := validateLink(input.Kind, input.URL); err != nil {
+		return nil, err
+	}
+
+	// Verify portfolio ownership
+	if err := verifyPortfolioOwner(ctx, uc.portfolioRepo, input.PortfolioID, input.OwnerID); err != nil {
+		return nil, err
+	}
+
+	link, linkCount, err := uc.linkRepo.Create(ctx, input, domainportfolio.MaxLinksPerPortfolio)
+	if err != nil {
+		if errors.Is(err, contracts.ErrPortfolioLinkLimitReached) {
+			return nil, apperrors.New(apperrors.KindValidation, apperrors.CodePortfolioLinkLimit,
+				fmt.Sprintf("a portfolio can have at most %d links", domainportfolio.MaxLinksPerPortfolio),
+				map[string]interface{}{"max": domainportfolio.MaxLinksPerPortfolio})
+		}
+		return nil, fmt.Errorf("failed to create portfolio link: %w", err)
+	}
+
+	// Audit logging
+	if uc.auditLogger != nil {
+		uc.auditLogger.LogCreate(ctx, "portfolio_link", link.ID, map[string]interface{}{
+			"portfolio_id": link.PortfolioID,
+			"kind":         link.Kind,
+			"owner_id":     input.OwnerID,
+		})
+	}
+
+	return &dto.CreatePortfolioLinkOutput{
+		Link:     *link,
+		Warnings: quota.Collect(quota.Check(quota.PortfolioLinks, linkCount, domainportfolio.MaxLinksPerPortfolio)),
+	}, nil
+}
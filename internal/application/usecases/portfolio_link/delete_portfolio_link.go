@@ -0,0 +1,64 @@
+package portfolio_link
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+)
+
+// DeletePortfolioLinkUseCase handles the business logic for removing a portfolio link
+type DeletePortfolioLinkUseCase struct {
+	linkRepo      contracts.PortfolioLinkRepository
+	portfolioRepo contracts.PortfolioRepository
+	auditLogger   contracts.AuditLogger
+}
+
+// NewDeletePortfolioLinkUseCase creates a new instance of DeletePortfolioLinkUseCase
+func NewDeletePortfolioLinkUseCase(
+	linkRepo contracts.PortfolioLinkRepository,
+	portfolioRepo contracts.PortfolioRepository,
+	auditLogger contracts.AuditLogger,
+) *DeletePortfolioLinkUseCase {
+	return &DeletePortfolioLinkUseCase{
+		linkRepo:      linkRepo,
+		portfolioRepo: portfolioRepo,
+		auditLogger:   auditLogger,
+	}
+}
+
+// Execute deletes a link of a portfolio owned by the user
+func (uc *DeletePortfolioLinkUseCase) Execute(ctx context.Context, portfolioID, id uint, ownerID string) error {
+	if id == 0 {
+		return fmt.Errorf("invalid portfolio link ID")
+	}
+	if ownerID == "" {
+		return fmt.Errorf("owner ID is required")
+	}
+
+	// The link must belong to the portfolio in the URL
+	link, err := uc.linkRepo.GetByID(ctx, id)
+	if err != nil || link.PortfolioID != portfolioID {
+		return fmt.Errorf("portfolio link not found")
+	}
+
+	// Verify portfolio ownership
+	if err := verifyPortfolioOwner(ctx, uc.portfolioRepo, link.PortfolioID, ownerID); err != nil {
+		return err
+	}
+
+	if err := uc.linkRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete portfolio link: %w", err)
+	}
+
+	// Audit logging
+	if uc.auditLogger != nil {
+		uc.auditLogger.LogDelete(ctx, "portfolio_link", id, map[string]interface{}{
+			"portfolio_id": link.PortfolioID,
+			"kind":         link.Kind,
+			"owner_id":     ownerID,
+		})
+	}
+
+	return nil
+}
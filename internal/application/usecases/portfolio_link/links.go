@@ -0,0 +1,31 @@
+package portfolio_link
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	domainportfolio "github.com/JorgeSaicoski/portfolio-manager/backend/internal/domain/portfolio"
+)
+
+// validateLink applies the kind-specific link rules, returning a validation error
+func validateLink(kind, value string) error {
+	if err := domainportfolio.ValidateLink(kind, value); err != nil {
+		return apperrors.New(apperrors.KindValidation, apperrors.CodePortfolioLinkInvalid, err.Error(),
+			map[string]interface{}{"kind": kind, "reason": err.Error()})
+	}
+	return nil
+}
+
+// verifyPortfolioOwner checks that the portfolio exists and belongs to ownerID
+func verifyPortfolioOwner(ctx context.Context, portfolioRepo contracts.PortfolioRepository, portfolioID uint, ownerID string) error {
+	portfolio, err := portfolioRepo.GetByID(ctx, portfolioID)
+	if err != nil {
+		return fmt.Errorf("portfolio not found")
+	}
+	if portfolio.OwnerID != ownerID {
+		return fmt.Errorf("unauthorized: you don't own this portfolio")
+	}
+	return nil
+}
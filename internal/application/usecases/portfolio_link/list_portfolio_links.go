@@ -0,0 +1,48 @@
+package portfolio_link
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// ListPortfolioLinksUseCase handles listing the links of a portfolio owned by the user
+type ListPortfolioLinksUseCase struct {
+	linkRepo      contracts.PortfolioLinkRepository
+	portfolioRepo contracts.PortfolioRepository
+}
+
+// NewListPortfolioLinksUseCase creates a new instance of ListPortfolioLinksUseCase
+func NewListPortfolioLinksUseCase(
+	linkRepo contracts.PortfolioLinkRepository,
+	portfolioRepo contracts.PortfolioRepository,
+) *ListPortfolioLinksUseCase {
+	return &ListPortfolioLinksUseCase{
+		linkRepo:      linkRepo,
+		portfolioRepo: portfolioRepo,
+	}
+}
+
+// Execute lists the links of a portfolio (ordered by position)
+func (uc *ListPortfolioLinksUseCase) Execute(ctx context.Context, portfolioID uint, ownerID string) ([]dto.PortfolioLinkDTO, error) {
+	if portfolioID == 0 {
+		return nil, fmt.Errorf("invalid portfolio ID")
+	}
+	if ownerID == "" {
+		return nil, fmt.Errorf("owner ID is required")
+	}
+
+	// Verify portfolio ownership
+	if err := verifyPortfolioOwner(ctx, uc.portfolioRepo, portfolioID, ownerID); err != nil {
+		return nil, err
+	}
+
+	links, err := uc.linkRepo.GetByPortfolioID(ctx, portfolioID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list portfolio links: %w", err)
+	}
+
+	return links, nil
+}
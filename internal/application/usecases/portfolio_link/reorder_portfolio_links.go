@@ -0,0 +1,63 @@
+package portfolio_link
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// ReorderPortfolioLinksUseCase handles reordering the links of a portfolio
+type ReorderPortfolioLinksUseCase struct {
+	linkRepo      contracts.PortfolioLinkRepository
+	portfolioRepo contracts.PortfolioRepository
+	auditLogger   contracts.AuditLogger
+}
+
+// NewReorderPortfolioLinksUseCase creates a new instance of ReorderPortfolioLinksUseCase
+func NewReorderPortfolioLinksUseCase(
+	linkRepo contracts.PortfolioLinkRepository,
+	portfolioRepo contracts.PortfolioRepository,
+	auditLogger contracts.AuditLogger,
+) *ReorderPortfolioLinksUseCase {
+	return &ReorderPortfolioLinksUseCase{
+		linkRepo:      linkRepo,
+		portfolioRepo: portfolioRepo,
+		auditLogger:   auditLogger,
+	}
+}
+
+// Execute updates the positions of links of a portfolio owned by the user
+func (uc *ReorderPortfolioLinksUseCase) Execute(ctx context.Context, input dto.ReorderPortfolioLinksInput) error {
+	if input.PortfolioID == 0 {
+		return fmt.Errorf("invalid portfolio ID")
+	}
+	if input.OwnerID == "" {
+		return fmt.Errorf("owner ID is required")
+	}
+	if len(input.Items) == 0 {
+		return fmt.Errorf("no items to reorder")
+	}
+
+	// Verify portfolio ownership
+	if err := verifyPortfolioOwner(ctx, uc.portfolioRepo, input.PortfolioID, input.OwnerID); err != nil {
+		return err
+	}
+
+	// Items are scoped to the portfolio by the repository
+	if err := uc.linkRepo.BulkUpdatePositions(ctx, input.PortfolioID, input.Items); err != nil {
+		return fmt.Errorf("failed to reorder portfolio links: %w", err)
+	}
+
+	// Audit logging
+	if uc.auditLogger != nil {
+		uc.auditLogger.LogUpdate(ctx, "portfolio_link", input.PortfolioID, map[string]interface{}{
+			"action":   "reorder",
+			"count":    len(input.Items),
+			"owner_id": input.OwnerID,
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package portfolio_link
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// UpdatePortfolioLinkUseCase handles the business logic for updating a portfolio link
+type UpdatePortfolioLinkUseCase struct {
+	linkRepo      contracts.PortfolioLinkRepository
+	portfolioRepo contracts.PortfolioRepository
+	auditLogger   contracts.AuditLogger
+}
+
+// NewUpdatePortfolioLinkUseCase creates a new instance of UpdatePortfolioLinkUseCase
+func NewUpdatePortfolioLinkUseCase(
+	linkRepo contracts.PortfolioLinkRepository,
+	portfolioRepo contracts.PortfolioRepository,
+	auditLogger contracts.AuditLogger,
+) *UpdatePortfolioLinkUseCase {
+	return &UpdatePortfolioLinkUseCase{
+		linkRepo:      linkRepo,
+		portfolioRepo: portfolioRepo,
+		auditLogger:   auditLogger,
+	}
+}
+
+// Execute updates a link of a portfolio owned by the user
+func (uc *UpdatePortfolioLinkUseCase) Execute(ctx context.Context, input dto.UpdatePortfolioLinkInput) error {
+	// Validate input
+	if input.ID == 0 {
+		return fmt.Errorf("invalid portfolio link ID")
+	}
+	if input.OwnerID == "" {
+		return fmt.Errorf("owner ID is required")
+	}
+	if err := validateLink(input.Kind, input.URL); err != nil {
+		return err
+	}
+
+	// The link must belong to the portfolio in the URL
+	link, err := uc.linkRepo.GetByID(ctx, input.ID)
+	if err != nil || link.PortfolioID != input.PortfolioID {
+		return fmt.Errorf("portfolio link not found")
+	}
+
+	// Verify portfolio ownership
+	if err := verifyPortfolioOwner(ctx, uc.portfolioRepo, link.PortfolioID, input.OwnerID); err != nil {
+		return err
+	}
+
+	if err := uc.linkRepo.Update(ctx, input); err != nil {
+		return fmt.Errorf("failed to update portfolio link: %w", err)
+	}
+
+	// Audit logging
+	if uc.auditLogger != nil {
+		uc.auditLogger.LogUpdate(ctx, "portfolio_link", link.ID, map[string]interface{}{
+			"portfolio_id": link.PortfolioID,
+			"kind":         input.Kind,
+			"owner_id":     input.OwnerID,
+		})
+	}
+
+	return nil
+}
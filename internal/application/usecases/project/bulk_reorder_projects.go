@@ -0,0 +1,126 @@
+package project
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	contracts2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// BulkReorderProjectsUseCase handles the business logic for bulk reordering projects of a category
+type BulkReorderProjectsUseCase struct {
+	projectRepo   contracts2.ProjectRepository
+	categoryRepo  contracts2.CategoryRepository
+	portfolioRepo contracts2.PortfolioRepository
+	auditLogger   contracts2.AuditLogger
+}
+
+// NewBulkReorderProjectsUseCase creates a new instance of BulkReorderProjectsUseCase
+func NewBulkReorderProjectsUseCase(
+	projectRepo contracts2.ProjectRepository,
+	categoryRepo contracts2.CategoryRepository,
+	portfolioRepo contracts2.PortfolioRepository,
+	auditLogger contracts2.AuditLogger,
+) *BulkReorderProjectsUseCase {
+	return &BulkReorderProjectsUseCase{
+		projectRepo:   projectRepo,
+		categoryRepo:  categoryRepo,
+		portfolioRepo: portfolioRepo,
+		auditLogger:   auditLogger,
+	}
+}
+
+// Execute performs bulk position updates with ownership verification
+func (uc *BulkReorderProjectsUseCase) Execute(ctx context.Context, input dto.BulkUpdateProjectPositionsInput) error {
+	if len(input.Items) == 0 {
+		return fmt.Errorf("no projects to reorder")
+	}
+	if input.OwnerID == "" {
+		return fmt.Errorf("owner ID is required")
+	}
+
+	// Each project and each position may appear only once
+	projectIDs := make([]uint, len(input.Items))
+	seenIDs := make(map[uint]bool, len(input.Items))
+	seenPositions := make(map[uint]bool, len(input.Items))
+	for i, item := range input.Items {
+		if seenIDs[item.ID] {
+			return apperrors.DuplicateReorderItem(item.ID)
+		}
+		if seenPositions[item.Position] {
+			return apperrors.DuplicateReorderPosition(item.Position)
+		}
+		seenIDs[item.ID] = true
+		seenPositions[item.Position] = true
+		projectIDs[i] = item.ID
+	}
+
+	projects, err := uc.projectRepo.GetByIDs(ctx, projectIDs)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve projects: %w", err)
+	}
+	if len(projects) != len(projectIDs) {
+		return fmt.Errorf("some projects not found")
+	}
+
+	// Verify all projects belong to categories of portfolios owned by the user
+	categoryIDSet := make(map[uint]bool)
+	for _, proj := range projects {
+		categoryIDSet[proj.CategoryID] = true
+	}
+	categoryIDs := make([]uint, 0, len(categoryIDSet))
+	for categoryID := range categoryIDSet {
+		categoryIDs = append(categoryIDs, categoryID)
+	}
+
+	categories, err := uc.categoryRepo.GetByIDs(ctx, categoryIDs)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve categories: %w", err)
+	}
+	if len(categories) != len(categoryIDs) {
+		return fmt.Errorf("category not found")
+	}
+
+	portfolioIDSet := make(map[uint]bool)
+	for _, cat := range categories {
+		portfolioIDSet[cat.PortfolioID] = true
+	}
+
+	for portfolioID := range portfolioIDSet {
+		portfolio, err := uc.portfolioRepo.GetByID(ctx, portfolioID)
+		if err != nil {
+			return fmt.Errorf("portfolio not found")
+		}
+		if portfolio.OwnerID != input.OwnerID {
+			return fmt.Errorf("unauthorized: you don't own all projects")
+		}
+	}
+
+	// Positions are per category: a batch spanning categories would leave duplicates in each
+	if len(categoryIDSet) > 1 {
+		groups := make(map[uint][]uint, len(categoryIDSet))
+		for _, proj := range projects {
+			groups[proj.CategoryID] = append(groups[proj.CategoryID], proj.ID)
+		}
+		return apperrors.MixedCategories("projects", groups)
+	}
+
+	// Perform bulk update
+	if err := uc.projectRepo.BulkUpdatePositions(ctx, input); err != nil {
+		return fmt.Errorf("failed to reorder projects: %w", err)
+	}
+
+	// Audit logging
+	if uc.auditLogger != nil {
+		uc.auditLogger.LogUpdate(ctx, "project", 0, map[string]interface{}{
+			"operation":   "bulk_reorder",
+			"count":       len(input.Items),
+			"category_id": projects[0].CategoryID,
+			"owner_id":    input.OwnerID,
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package project
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// reorderProjectRepo applies bulk reorders to the in-memory projects
+type reorderProjectRepo struct {
+	*swapProjectRepo
+	writes int
+}
+
+func (r *reorderProjectRepo) BulkUpdatePositions(_ context.Context, input dto.BulkUpdateProjectPositionsInput) error {
+	r.writes++
+	for _, item := range input.Items {
+		r.projects[item.ID].Position = item.Position
+	}
+	return nil
+}
+
+// reorderCategoryRepo serves several categories at once
+type reorderCategoryRepo struct{ swapCategoryRepo }
+
+func (r *reorderCategoryRepo) GetByIDs(ctx context.Context, ids []uint) ([]dto.CategoryDTO, error) {
+	var found []dto.CategoryDTO
+	for _, id := range ids {
+		if category, err := r.GetByID(ctx, id); err == nil {
+			found = append(found, *category)
+		}
+	}
+	return found, nil
+}
+
+func TestBulkReorderProjectsUseCase(t *testing.T) {
+	items := func(pairs ...uint) []dto.BulkUpdatePositionItem {
+		var list []dto.BulkUpdatePositionItem
+		for i := 0; i < len(pairs); i += 2 {
+			list = append(list, dto.BulkUpdatePositionItem{ID: pairs[i], Position: pairs[i+1]})
+		}
+		return list
+	}
+
+	tests := []struct {
+		name          string
+		input         dto.BulkUpdateProjectPositionsInput
+		wantCode      string // application error code; empty when another error or none is expected
+		wantErr       bool
+		wantPositions map[uint]uint
+	}{
+		{name: "reverses a category", input: dto.BulkUpdateProjectPositionsInput{OwnerID: "alice", Items: items(1, 3, 2, 2, 3, 1)}, wantPositions: map[uint]uint{1: 3, 2: 2, 3: 1}},
+		{name: "duplicate project", input: dto.BulkUpdateProjectPositionsInput{OwnerID: "alice", Items: items(1, 1, 1, 2)}, wantCode: apperrors.CodeReorderDuplicateItem, wantErr: true},
+		{name: "duplicate position", input: dto.BulkUpdateProjectPositionsInput{OwnerID: "alice", Items: items(1, 2, 2, 2)}, wantCode: apperrors.CodeReorderDuplicatePosition, wantErr: true},
+		{name: "projects of two categories", input: dto.BulkUpdateProjectPositionsInput{OwnerID: "alice", Items: items(1, 2, 4, 1)}, wantCode: apperrors.CodeReorderMixedCategories, wantErr: true},
+		{name: "another owner's projects", input: dto.BulkUpdateProjectPositionsInput{OwnerID: "alice", Items: items(5, 1)}, wantErr: true},
+		{name: "unknown project", input: dto.BulkUpdateProjectPositionsInput{OwnerID: "alice", Items: items(1, 1, 99, 2)}, wantErr: true},
+		{name: "empty batch", input: dto.BulkUpdateProjectPositionsInput{OwnerID: "alice"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &reorderProjectRepo{swapProjectRepo: &swapProjectRepo{projects: map[uint]*dto.ProjectDTO{
+				1: {ID: 1, CategoryID: 10, Position: 1},
+				2: {ID: 2, CategoryID: 10, Position: 2},
+				3: {ID: 3, CategoryID: 10, Position: 3},
+				4: {ID: 4, CategoryID: 20, Position: 1},
+				5: {ID: 5, CategoryID: 30, Position: 1},
+			}}}
+			categories := &reorderCategoryRepo{swapCategoryRepo{portfolioIDs: map[uint]uint{10: 1, 20: 1, 30: 2}}}
+			portfolios := &swapPortfolioRepo{owners: map[uint]string{1: "alice", 2: "bob"}}
+
+			err := NewBulkReorderProjectsUseCase(repo, categories, portfolios, nil).Execute(context.Background(), tt.input)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Execute error = %v, want error %v", err, tt.wantErr)
+			}
+			if tt.wantCode != "" {
+				if appErr, ok := apperrors.As(err); !ok || appErr.Code != tt.wantCode {
+					t.Errorf("error = %v, want %s", err, tt.wantCode)
+				}
+			}
+			if tt.wantErr {
+				if repo.writes != 0 {
+					t.Errorf("%d writes after a rejected reorder, want none", repo.writes)
+				}
+				return
+			}
+
+			got := make(map[uint]uint, len(tt.wantPositions))
+			for id := range tt.wantPositions {
+				got[id] = repo.projects[id].Position
+			}
+			if !reflect.DeepEqual(got, tt.wantPositions) || repo.writes != 1 {
+				t.Errorf("positions = %v after %d writes, want %v in one write", got, repo.writes, tt.wantPositions)
+			}
+		})
+	}
+}
+
+func TestBulkReorderProjectsUseCase_MixedCategoriesDetails(t *testing.T) {
+	repo := &reorderProjectRepo{swapProjectRepo: &swapProjectRepo{projects: map[uint]*dto.ProjectDTO{
+		1: {ID: 1, CategoryID: 10}, 2: {ID: 2, CategoryID: 20}, 3: {ID: 3, CategoryID: 10},
+	}}}
+	categories := &reorderCategoryRepo{swapCategoryRepo{portfolioIDs: map[uint]uint{10: 1, 20: 1}}}
+	portfolios := &swapPortfolioRepo{owners: map[uint]string{1: "alice"}}
+
+	err := NewBulkReorderProjectsUseCase(repo, categories, portfolios, nil).Execute(context.Background(), dto.BulkUpdateProjectPositionsInput{
+		OwnerID: "alice",
+		Items:   []dto.BulkUpdatePositionItem{{ID: 1, Position: 1}, {ID: 2, Position: 2}, {ID: 3, Position: 3}},
+	})
+
+	appErr, ok := apperrors.As(err)
+	if !ok || appErr.Kind != apperrors.KindUnprocessable {
+		t.Fatalf("error = %v, want an unprocessable reorder", err)
+	}
+	if want := map[uint][]uint{10: {1, 3}, 20: {2}}; !reflect.DeepEqual(appErr.Details["categories"], want) {
+		t.Errorf("details categories = %v, want %v", appErr.Details["categories"], want)
+	}
+}
@@ -0,0 +1,56 @@
+package project
+
+import (
+	"context"
+	"fmt"
+
+	contracts2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/diff"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// CompareProjectsUseCase handles the business logic for comparing two of the caller's projects
+type CompareProjectsUseCase struct {
+	projectRepo contracts2.ProjectRepository
+}
+
+// NewCompareProjectsUseCase creates a new instance of CompareProjectsUseCase
+func NewCompareProjectsUseCase(projectRepo contracts2.ProjectRepository) *CompareProjectsUseCase {
+	return &CompareProjectsUseCase{
+		projectRepo: projectRepo,
+	}
+}
+
+// Execute returns the differences between two projects, both owned by ownerID
+func (uc *CompareProjectsUseCase) Execute(ctx context.Context, leftID, rightID uint, ownerID string) (*dto.ProjectDiffDTO, error) {
+	if leftID == 0 || rightID == 0 {
+		return nil, fmt.Errorf("invalid project ID")
+	}
+	if ownerID == "" {
+		return nil, fmt.Errorf("owner ID is required")
+	}
+
+	left, err := uc.getOwned(ctx, leftID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	right, err := uc.getOwned(ctx, rightID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := diff.Projects(*left, *right)
+	return &result, nil
+}
+
+// getOwned retrieves a project and verifies ownership through its category
+func (uc *CompareProjectsUseCase) getOwned(ctx context.Context, id uint, ownerID string) (*dto.ProjectDTO, error) {
+	project, err := uc.projectRepo.GetByIDWithContext(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("project not found")
+	}
+	if project.Context.CategoryOwnerID != ownerID {
+		return nil, fmt.Errorf("unauthorized: you don't own this project")
+	}
+	return project, nil
+}
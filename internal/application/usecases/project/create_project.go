@@ -6,12 +6,14 @@ import (
 
 	contracts2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/validation"
 )
 
 // CreateProjectUseCase handles the business logic for creating a project
 type CreateProjectUseCase struct {
 	projectRepo  contracts2.ProjectRepository
 	categoryRepo contracts2.CategoryRepository
+	settingsRepo contracts2.UserSettingsRepository
 	auditLogger  contracts2.AuditLogger
 	metrics      contracts2.MetricsCollector
 }
@@ -20,12 +22,14 @@ type CreateProjectUseCase struct {
 func NewCreateProjectUseCase(
 	projectRepo contracts2.ProjectRepository,
 	categoryRepo contracts2.CategoryRepository,
+	settingsRepo contracts2.UserSettingsRepository,
 	auditLogger contracts2.AuditLogger,
 	metrics contracts2.MetricsCollector,
 ) *CreateProjectUseCase {
 	return &CreateProjectUseCase{
 		projectRepo:  projectRepo,
 		categoryRepo: categoryRepo,
+		settingsRepo: settingsRepo,
 		auditLogger:  auditLogger,
 		metrics:      metrics,
 	}
@@ -34,17 +38,15 @@ func NewCreateProjectUseCase(
 // Execute creates a new project
 func (uc *CreateProjectUseCase) Execute(ctx context.Context, input dto.CreateProjectInput) (*dto.ProjectDTO, error) {
 	// Validate input
-	if input.Title == "" {
-		return nil, fmt.Errorf("project title is required")
-	}
-	if input.Description == "" {
-		return nil, fmt.Errorf("project description is required")
-	}
 	if input.OwnerID == "" {
 		return nil, fmt.Errorf("owner ID is required")
 	}
-	if input.CategoryID == 0 {
-		return nil, fmt.Errorf("category ID is required")
+	defaulted, err := uc.applyDefaults(ctx, &input)
+	if err != nil {
+		return nil, err
+	}
+	if err := validation.ValidateProject(input).Err(); err != nil {
+		return nil, err
 	}
 
 	// Verify category exists and user owns it
@@ -62,6 +64,8 @@ func (uc *CreateProjectUseCase) Execute(ctx context.Context, input dto.CreatePro
 		return nil, fmt.Errorf("failed to create project: %w", err)
 	}
 
+	project.DefaultedFields = defaulted
+
 	// Audit logging
 	if uc.auditLogger != nil {
 		uc.auditLogger.LogCreate(ctx, "project", project.ID, map[string]interface{}{
@@ -79,3 +83,39 @@ func (uc *CreateProjectUseCase) Execute(ctx context.Context, input dto.CreatePro
 
 	return project, nil
 }
+
+// applyDefaults fills the fields the request omitted (nil, not merely empty) from the
+// owner's project defaults and returns the names of the fields it filled
+func (uc *CreateProjectUseCase) applyDefaults(ctx context.Context, input *dto.CreateProjectInput) ([]string, error) {
+	if uc.settingsRepo == nil {
+		return nil, nil
+	}
+	if input.Client != nil && input.Skills != nil && (input.CategoryID != 0 || input.PortfolioID == 0) {
+		return nil, nil
+	}
+
+	settings, err := uc.settingsRepo.Get(ctx, input.OwnerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project defaults: %w", err)
+	}
+	defaults := settings.ProjectDefaults
+
+	var defaulted []string
+	if input.Client == nil && defaults.Client != nil {
+		client := *defaults.Client
+		input.Client = &client
+		defaulted = append(defaulted, "client")
+	}
+	if input.Skills == nil && len(defaults.Skills) > 0 {
+		input.Skills = append([]string(nil), defaults.Skills...)
+		defaulted = append(defaulted, "skills")
+	}
+	if input.CategoryID == 0 && input.PortfolioID != 0 {
+		if categoryID, ok := defaults.Categories[input.PortfolioID]; ok {
+			input.CategoryID = categoryID
+			defaulted = append(defaulted, "category_id")
+		}
+	}
+
+	return defaulted, nil
+}
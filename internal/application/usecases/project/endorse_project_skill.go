@@ -0,0 +1,119 @@
+package project
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// Endorsement abuse controls
+const (
+	MaxEndorsementsPerProjectPerDay = 500 // Votes a project accepts per day across its skills
+	EndorsementSpikeThreshold       = 100 // Daily votes on one project worth an audit entry (and every multiple)
+)
+
+// EndorseProjectSkillUseCase handles the business logic for a visitor endorsing a project skill
+type EndorseProjectSkillUseCase struct {
+	projectRepo     contracts.ProjectRepository
+	portfolioRepo   contracts.PortfolioRepository
+	endorsementRepo contracts.SkillEndorsementRepository
+	auditLogger     contracts.AuditLogger
+	ipHashSalt      string
+}
+
+// NewEndorseProjectSkillUseCase creates a new instance of EndorseProjectSkillUseCase
+// ipHashSalt is mixed into the visitor IP hash so stored hashes can't be reversed by enumeration
+func NewEndorseProjectSkillUseCase(
+	projectRepo contracts.ProjectRepository,
+	portfolioRepo contracts.PortfolioRepository,
+	endorsementRepo contracts.SkillEndorsementRepository,
+	auditLogger contracts.AuditLogger,
+	ipHashSalt string,
+) *EndorseProjectSkillUseCase {
+	return &EndorseProjectSkillUseCase{
+		projectRepo:     projectRepo,
+		portfolioRepo:   portfolioRepo,
+		endorsementRepo: endorsementRepo,
+		auditLogger:     auditLogger,
+		ipHashSalt:      ipHashSalt,
+	}
+}
+
+// Execute endorses the skill once per visitor IP per day
+// Projects of drafts or of portfolios with endorsements disabled, and skills the project doesn't list,
+// are reported as not found.
+func (uc *EndorseProjectSkillUseCase) Execute(ctx context.Context, input dto.EndorseSkillInput) (*dto.EndorseSkillResultDTO, error) {
+	if input.ProjectID == 0 {
+		return nil, fmt.Errorf("invalid project ID")
+	}
+	if input.ClientIP == "" {
+		return nil, fmt.Errorf("client IP is required")
+	}
+
+	// Only live, visible projects of live, published portfolios can be endorsed
+	project, err := uc.projectRepo.GetByIDWithContext(ctx, input.ProjectID)
+	if err != nil || project.Hidden {
+		return nil, fmt.Errorf("project not found")
+	}
+	portfolio, err := uc.portfolioRepo.GetByID(ctx, project.Context.PortfolioID)
+	if err != nil || !portfolio.IsPublished || !portfolio.EndorsementsEnabled {
+		return nil, fmt.Errorf("project not found")
+	}
+
+	skill, ok := matchSkill(project.Skills, input.Skill)
+	if !ok {
+		return nil, fmt.Errorf("skill not found")
+	}
+
+	now := time.Now().UTC()
+	result, err := uc.endorsementRepo.Endorse(ctx, dto.EndorseSkillVoteInput{
+		ProjectID:  project.ID,
+		Skill:      skill,
+		IPHash:     uc.hashIP(input.ClientIP),
+		Day:        time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC),
+		DailyLimit: MaxEndorsementsPerProjectPerDay,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to endorse skill: %w", err)
+	}
+	if result.LimitReached {
+		return nil, apperrors.New(apperrors.KindRateLimited, apperrors.CodeEndorsementLimit,
+			"this project received too many endorsements today, try again tomorrow",
+			map[string]interface{}{"max": MaxEndorsementsPerProjectPerDay})
+	}
+
+	// Record unusual activity for review (bots or a shared link going viral)
+	if result.Counted && result.ProjectVotes%EndorsementSpikeThreshold == 0 && uc.auditLogger != nil {
+		uc.auditLogger.LogUpdate(ctx, "skill_endorsement_spike", project.ID, map[string]interface{}{
+			"votes_today": result.ProjectVotes,
+			"skill":       skill,
+			"owner_id":    project.OwnerID,
+		})
+	}
+
+	return result, nil
+}
+
+// hashIP returns the salted SHA-256 of a visitor IP
+func (uc *EndorseProjectSkillUseCase) hashIP(ip string) string {
+	sum := sha256.Sum256([]byte(uc.ipHashSalt + "|" + ip))
+	return hex.EncodeToString(sum[:])
+}
+
+// matchSkill finds skill among the project skills (case-insensitive) and returns its project spelling
+func matchSkill(skills []string, skill string) (string, bool) {
+	skill = strings.TrimSpace(skill)
+	for _, candidate := range skills {
+		if skill != "" && strings.EqualFold(candidate, skill) {
+			return candidate, true
+		}
+	}
+	return "", false
+}
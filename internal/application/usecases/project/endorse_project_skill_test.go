@@ -0,0 +1,84 @@
+package project
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// endorseProjectRepo holds one project of a published portfolio
+type endorseProjectRepo struct {
+	contracts.ProjectRepository
+	project dto.ProjectDTO
+}
+
+func (r *endorseProjectRepo) GetByIDWithContext(_ context.Context, id uint) (*dto.ProjectDTO, error) {
+	if id != r.project.ID {
+		return nil, errors.New("project not found")
+	}
+	project := r.project
+	return &project, nil
+}
+
+// endorsePortfolioRepo holds one portfolio
+type endorsePortfolioRepo struct {
+	contracts.PortfolioRepository
+	portfolio dto.PortfolioDTO
+}
+
+func (r *endorsePortfolioRepo) GetByID(_ context.Context, id uint) (*dto.PortfolioDTO, error) {
+	if id != r.portfolio.ID {
+		return nil, errors.New("portfolio not found")
+	}
+	portfolio := r.portfolio
+	return &portfolio, nil
+}
+
+// failingEndorsementRepo fails every vote after recording that it was attempted
+type failingEndorsementRepo struct {
+	contracts.SkillEndorsementRepository
+	attempts []dto.EndorseSkillVoteInput
+}
+
+func (r *failingEndorsementRepo) Endorse(_ context.Context, input dto.EndorseSkillVoteInput) (*dto.EndorseSkillResultDTO, error) {
+	r.attempts = append(r.attempts, input)
+	return nil, errors.New("increment failed")
+}
+
+// updateCountingAuditLogger counts update entries
+type updateCountingAuditLogger struct {
+	contracts.AuditLogger
+	updates int
+}
+
+func (l *updateCountingAuditLogger) LogUpdate(context.Context, string, uint, map[string]interface{}) {
+	l.updates++
+}
+
+func TestEndorseProjectSkillUseCase_FailedVoteIsNotReported(t *testing.T) {
+	project := dto.ProjectDTO{ID: 1, Skills: []string{"Go"}, OwnerID: "alice", Context: &dto.ProjectContextDTO{PortfolioID: 2}}
+	endorsements := &failingEndorsementRepo{}
+	audit := &updateCountingAuditLogger{}
+	uc := NewEndorseProjectSkillUseCase(
+		&endorseProjectRepo{project: project},
+		&endorsePortfolioRepo{portfolio: dto.PortfolioDTO{ID: 2, IsPublished: true, EndorsementsEnabled: true}},
+		endorsements, audit, "salt",
+	)
+
+	result, err := uc.Execute(context.Background(), dto.EndorseSkillInput{ProjectID: 1, Skill: "go", ClientIP: "203.0.113.7"})
+	if err == nil {
+		t.Fatalf("Execute = %+v, want the repository failure", result)
+	}
+	if result != nil {
+		t.Errorf("result = %+v, want none", result)
+	}
+	if len(endorsements.attempts) != 1 || endorsements.attempts[0].Skill != "Go" {
+		t.Errorf("votes attempted = %+v, want one for the project spelling %q", endorsements.attempts, "Go")
+	}
+	if audit.updates != 0 {
+		t.Errorf("audit updates = %d, want none for a failed vote", audit.updates)
+	}
+}
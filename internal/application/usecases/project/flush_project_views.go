@@ -0,0 +1,45 @@
+package project
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+)
+
+// FlushProjectViewsUseCase writes the buffered public views to the database
+type FlushProjectViewsUseCase struct {
+	viewRecorder contracts.ProjectViewRecorder
+	viewRepo     contracts.ProjectViewRepository
+}
+
+// NewFlushProjectViewsUseCase creates a new instance of FlushProjectViewsUseCase
+func NewFlushProjectViewsUseCase(
+	viewRecorder contracts.ProjectViewRecorder,
+	viewRepo contracts.ProjectViewRepository,
+) *FlushProjectViewsUseCase {
+	return &FlushProjectViewsUseCase{
+		viewRecorder: viewRecorder,
+		viewRepo:     viewRepo,
+	}
+}
+
+// Execute flushes the buffer and returns the number of views written
+// On failure the views go back to the buffer for the next flush.
+func (uc *FlushProjectViewsUseCase) Execute(ctx context.Context) (uint, error) {
+	batches := uc.viewRecorder.Drain()
+	if len(batches) == 0 {
+		return 0, nil
+	}
+
+	if err := uc.viewRepo.Flush(ctx, batches); err != nil {
+		uc.viewRecorder.Requeue(batches)
+		return 0, fmt.Errorf("failed to flush project views: %w", err)
+	}
+
+	var views uint
+	for _, batch := range batches {
+		views += batch.Views
+	}
+	return views, nil
+}
@@ -0,0 +1,84 @@
+package project
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// drainingViewRecorder hands out fixed batches once and keeps the requeued ones
+type drainingViewRecorder struct {
+	pending  []dto.ProjectViewBatchDTO
+	requeued []dto.ProjectViewBatchDTO
+}
+
+func (r *drainingViewRecorder) Record(uint, time.Time) {}
+
+func (r *drainingViewRecorder) Drain() []dto.ProjectViewBatchDTO {
+	batches := r.pending
+	r.pending = nil
+	return batches
+}
+
+func (r *drainingViewRecorder) Requeue(batches []dto.ProjectViewBatchDTO) {
+	r.requeued = append(r.requeued, batches...)
+}
+
+// flushingViewRepo records the flushed batches, or fails every flush
+type flushingViewRepo struct {
+	contracts.ProjectViewRepository
+	err     error
+	flushes [][]dto.ProjectViewBatchDTO
+}
+
+func (r *flushingViewRepo) Flush(_ context.Context, batches []dto.ProjectViewBatchDTO) error {
+	r.flushes = append(r.flushes, batches)
+	return r.err
+}
+
+func TestFlushProjectViewsUseCase(t *testing.T) {
+	day := time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)
+	batches := []dto.ProjectViewBatchDTO{
+		{ProjectID: 1, Day: day, Views: 3, LastViewedAt: day.Add(time.Hour)},
+		{ProjectID: 2, Day: day, Views: 4, LastViewedAt: day.Add(2 * time.Hour)},
+	}
+
+	t.Run("writes the buffer", func(t *testing.T) {
+		recorder := &drainingViewRecorder{pending: batches}
+		repo := &flushingViewRepo{}
+
+		views, err := NewFlushProjectViewsUseCase(recorder, repo).Execute(context.Background())
+		if err != nil || views != 7 {
+			t.Fatalf("Execute = %d, %v, want 7 views", views, err)
+		}
+		if !reflect.DeepEqual(repo.flushes, [][]dto.ProjectViewBatchDTO{batches}) || len(recorder.requeued) != 0 {
+			t.Errorf("flushed %+v and requeued %+v, want one flush of the batches", repo.flushes, recorder.requeued)
+		}
+	})
+
+	t.Run("empty buffer skips the database", func(t *testing.T) {
+		repo := &flushingViewRepo{}
+		views, err := NewFlushProjectViewsUseCase(&drainingViewRecorder{}, repo).Execute(context.Background())
+		if err != nil || views != 0 || len(repo.flushes) != 0 {
+			t.Errorf("Execute = %d, %v with %d flushes, want nothing written", views, err, len(repo.flushes))
+		}
+	})
+
+	t.Run("failed flush requeues the views", func(t *testing.T) {
+		recorder := &drainingViewRecorder{pending: batches}
+		repo := &flushingViewRepo{err: errors.New("connection reset")}
+
+		views, err := NewFlushProjectViewsUseCase(recorder, repo).Execute(context.Background())
+		if err == nil || views != 0 {
+			t.Fatalf("Execute = %d, %v, want the flush failure", views, err)
+		}
+		if !reflect.DeepEqual(recorder.requeued, batches) {
+			t.Errorf("requeued %+v, want %+v", recorder.requeued, batches)
+		}
+	})
+}
@@ -10,25 +10,28 @@ import (
 
 // GetProjectUseCase handles the business logic for retrieving a project by ID
 type GetProjectUseCase struct {
-	projectRepo  contracts2.ProjectRepository
-	categoryRepo contracts2.CategoryRepository
-	auditLogger  contracts2.AuditLogger
+	projectRepo      contracts2.ProjectRepository
+	viewRepo         contracts2.ProjectViewRepository
+	collaboratorRepo contracts2.ProjectCollaboratorRepository
+	auditLogger      contracts2.AuditLogger
 }
 
 // NewGetProjectUseCase creates a new instance of GetProjectUseCase
 func NewGetProjectUseCase(
 	projectRepo contracts2.ProjectRepository,
-	categoryRepo contracts2.CategoryRepository,
+	viewRepo contracts2.ProjectViewRepository,
+	collaboratorRepo contracts2.ProjectCollaboratorRepository,
 	auditLogger contracts2.AuditLogger,
 ) *GetProjectUseCase {
 	return &GetProjectUseCase{
-		projectRepo:  projectRepo,
-		categoryRepo: categoryRepo,
-		auditLogger:  auditLogger,
+		projectRepo:      projectRepo,
+		viewRepo:         viewRepo,
+		collaboratorRepo: collaboratorRepo,
+		auditLogger:      auditLogger,
 	}
 }
 
-// Execute retrieves a project by ID with ownership verification
+// Execute retrieves a project by ID (with category/portfolio context) with ownership verification
 func (uc *GetProjectUseCase) Execute(ctx context.Context, id uint, ownerID string) (*dto.ProjectDTO, error) {
 	if id == 0 {
 		return nil, fmt.Errorf("invalid project ID")
@@ -37,18 +40,14 @@ func (uc *GetProjectUseCase) Execute(ctx context.Context, id uint, ownerID strin
 		return nil, fmt.Errorf("owner ID is required")
 	}
 
-	// Get project
-	project, err := uc.projectRepo.GetByID(ctx, id)
+	// Get project with its category/portfolio context (single query)
+	project, err := uc.projectRepo.GetByIDWithContext(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("project not found")
 	}
 
 	// Verify ownership through category
-	category, err := uc.categoryRepo.GetByID(ctx, project.CategoryID)
-	if err != nil {
-		return nil, fmt.Errorf("category not found")
-	}
-	if category.OwnerID != ownerID {
+	if project.Context.CategoryOwnerID != ownerID {
 		// Log unauthorized access attempt
 		if uc.auditLogger != nil {
 			uc.auditLogger.LogAccess(ctx, "project", id, ownerID, false)
@@ -61,5 +60,18 @@ func (uc *GetProjectUseCase) Execute(ctx context.Context, id uint, ownerID strin
 		uc.auditLogger.LogAccess(ctx, "project", id, ownerID, true)
 	}
 
+	// Owner-facing view stats
+	stats, err := uc.viewRepo.GetStats(ctx, []uint{project.ID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+	views := stats[project.ID]
+	project.Views = &views
+
+	project.Collaborators, err = uc.collaboratorRepo.GetByProjectID(ctx, project.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
 	return project, nil
 }
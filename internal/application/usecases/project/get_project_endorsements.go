@@ -0,0 +1,61 @@
+package project
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// GetProjectEndorsementsUseCase handles the business logic for the owner view of a project's endorsements
+type GetProjectEndorsementsUseCase struct {
+	projectRepo     contracts.ProjectRepository
+	portfolioRepo   contracts.PortfolioRepository
+	endorsementRepo contracts.SkillEndorsementRepository
+}
+
+// NewGetProjectEndorsementsUseCase creates a new instance of GetProjectEndorsementsUseCase
+func NewGetProjectEndorsementsUseCase(
+	projectRepo contracts.ProjectRepository,
+	portfolioRepo contracts.PortfolioRepository,
+	endorsementRepo contracts.SkillEndorsementRepository,
+) *GetProjectEndorsementsUseCase {
+	return &GetProjectEndorsementsUseCase{
+		projectRepo:     projectRepo,
+		portfolioRepo:   portfolioRepo,
+		endorsementRepo: endorsementRepo,
+	}
+}
+
+// Execute retrieves the endorsement count of every skill of an owned project
+func (uc *GetProjectEndorsementsUseCase) Execute(ctx context.Context, projectID uint, ownerID string) (*dto.ProjectEndorsementsDTO, error) {
+	project, err := uc.projectRepo.GetByIDWithContext(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("project not found")
+	}
+	if project.OwnerID != ownerID {
+		return nil, fmt.Errorf("unauthorized: you don't own this project")
+	}
+
+	portfolio, err := uc.portfolioRepo.GetByID(ctx, project.Context.PortfolioID)
+	if err != nil {
+		return nil, fmt.Errorf("portfolio not found")
+	}
+
+	counts, err := uc.endorsementRepo.GetCounts(ctx, []uint{project.ID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get endorsements: %w", err)
+	}
+
+	output := &dto.ProjectEndorsementsDTO{
+		ProjectID:           project.ID,
+		EndorsementsEnabled: portfolio.EndorsementsEnabled,
+		Skills:              make([]dto.SkillEndorsementDTO, len(project.Skills)),
+	}
+	for i, skill := range project.Skills {
+		output.Skills[i] = dto.SkillEndorsementDTO{Skill: skill, Count: counts[project.ID][skill]}
+	}
+
+	return output, nil
+}
@@ -3,22 +3,36 @@ package project
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/publication"
 )
 
 // GetProjectPublicUseCase handles the business logic for retrieving a project publicly (no auth)
 type GetProjectPublicUseCase struct {
-	projectRepo contracts.ProjectRepository
+	projectRepo      contracts.ProjectRepository
+	portfolioRepo    contracts.PortfolioRepository
+	endorsementRepo  contracts.SkillEndorsementRepository
+	collaboratorRepo contracts.ProjectCollaboratorRepository
+	viewRecorder     contracts.ProjectViewRecorder
 }
 
 // NewGetProjectPublicUseCase creates a new instance of GetProjectPublicUseCase
 func NewGetProjectPublicUseCase(
 	projectRepo contracts.ProjectRepository,
+	portfolioRepo contracts.PortfolioRepository,
+	endorsementRepo contracts.SkillEndorsementRepository,
+	collaboratorRepo contracts.ProjectCollaboratorRepository,
+	viewRecorder contracts.ProjectViewRecorder,
 ) *GetProjectPublicUseCase {
 	return &GetProjectPublicUseCase{
-		projectRepo: projectRepo,
+		projectRepo:      projectRepo,
+		portfolioRepo:    portfolioRepo,
+		endorsementRepo:  endorsementRepo,
+		collaboratorRepo: collaboratorRepo,
+		viewRecorder:     viewRecorder,
 	}
 }
 
@@ -28,11 +42,37 @@ func (uc *GetProjectPublicUseCase) Execute(ctx context.Context, id uint) (*dto.P
 		return nil, fmt.Errorf("invalid project ID")
 	}
 
-	// Get project (no ownership check for public access)
-	project, err := uc.projectRepo.GetByID(ctx, id)
-	if err != nil {
+	// Get project with its category/portfolio context (no ownership check for public access)
+	project, err := uc.projectRepo.GetByIDWithContext(ctx, id)
+	if err != nil || project.Hidden {
 		return nil, fmt.Errorf("project not found")
 	}
+	if err := publication.Require(ctx, uc.portfolioRepo, project.Context.PortfolioID, "project"); err != nil {
+		return nil, err
+	}
+
+	// Count the view; it is buffered and written by the view flusher
+	if uc.viewRecorder != nil {
+		uc.viewRecorder.Record(project.ID, time.Now())
+	}
+
+	// Attach skill endorsement counts when the portfolio shows them
+	portfolio, err := uc.portfolioRepo.GetByID(ctx, project.Context.PortfolioID)
+	if err == nil && portfolio.EndorsementsEnabled {
+		counts, err := uc.endorsementRepo.GetCounts(ctx, []uint{project.ID})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get project: %w", err)
+		}
+		project.Endorsements = counts[project.ID]
+		if project.Endorsements == nil {
+			project.Endorsements = map[string]uint{}
+		}
+	}
+
+	project.Collaborators, err = uc.collaboratorRepo.GetByProjectID(ctx, project.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
 
 	return project, nil
 }
@@ -3,6 +3,7 @@ package project
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
 	dto2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
@@ -11,25 +12,67 @@ import (
 // ListProjectsUseCase handles the business logic for listing projects
 type ListProjectsUseCase struct {
 	projectRepo contracts.ProjectRepository
+	viewRepo    contracts.ProjectViewRepository
 }
 
 // NewListProjectsUseCase creates a new instance of ListProjectsUseCase
 func NewListProjectsUseCase(
 	projectRepo contracts.ProjectRepository,
+	viewRepo contracts.ProjectViewRepository,
 ) *ListProjectsUseCase {
 	return &ListProjectsUseCase{
 		projectRepo: projectRepo,
+		viewRepo:    viewRepo,
 	}
 }
 
 // Execute retrieves all projects owned by a user with pagination
 func (uc *ListProjectsUseCase) Execute(ctx context.Context, input dto2.ListProjectsInput) (*dto2.ListProjectsOutput, error) {
-	// Get projects with pagination
-	projects, total, err := uc.projectRepo.GetByOwnerID(ctx, input.OwnerID, input.Pagination)
+	// Get the filtered projects with pagination
+	input.Filter.Client = strings.TrimSpace(input.Filter.Client)
+	input.Filter.Skill = strings.TrimSpace(input.Filter.Skill)
+	projects, total, err := uc.projectRepo.GetByOwnerIDFiltered(ctx, input.OwnerID, input.Filter, input.Pagination, input.Sort)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list projects: %w", err)
 	}
 
+	// Attach breadcrumb context on request (one extra query for the whole page)
+	if input.IncludeContext && len(projects) > 0 {
+		ids := make([]uint, len(projects))
+		for i, p := range projects {
+			ids[i] = p.ID
+		}
+
+		contexts, err := uc.projectRepo.GetContextByIDs(ctx, ids)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list projects: %w", err)
+		}
+
+		for i := range projects {
+			if projectContext, ok := contexts[projects[i].ID]; ok {
+				projects[i].Context = &projectContext
+			}
+		}
+	}
+
+	// Attach the owner-facing view stats (one extra query for the whole page)
+	if len(projects) > 0 {
+		ids := make([]uint, len(projects))
+		for i, p := range projects {
+			ids[i] = p.ID
+		}
+
+		stats, err := uc.viewRepo.GetStats(ctx, ids)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list projects: %w", err)
+		}
+
+		for i := range projects {
+			views := stats[projects[i].ID]
+			projects[i].Views = &views
+		}
+	}
+
 	return &dto2.ListProjectsOutput{
 		Projects: projects,
 		Pagination: dto2.PaginatedResultDTO{
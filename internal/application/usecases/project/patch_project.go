@@ -0,0 +1,118 @@
+package project
+
+import (
+	"context"
+	"fmt"
+
+	contracts2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/validation"
+)
+
+// PatchProjectUseCase handles the business logic for partially updating a project
+// Unlike UpdateProjectUseCase, omitted fields keep their value and the project can change category.
+type PatchProjectUseCase struct {
+	projectRepo  contracts2.ProjectRepository
+	categoryRepo contracts2.CategoryRepository
+	auditLogger  contracts2.AuditLogger
+}
+
+// NewPatchProjectUseCase creates a new instance of PatchProjectUseCase
+func NewPatchProjectUseCase(
+	projectRepo contracts2.ProjectRepository,
+	categoryRepo contracts2.CategoryRepository,
+	auditLogger contracts2.AuditLogger,
+) *PatchProjectUseCase {
+	return &PatchProjectUseCase{
+		projectRepo:  projectRepo,
+		categoryRepo: categoryRepo,
+		auditLogger:  auditLogger,
+	}
+}
+
+// Execute applies the provided fields to a project with ownership verification
+// of the project and, when it moves, of the target category
+func (uc *PatchProjectUseCase) Execute(ctx context.Context, input dto.PatchProjectInput) error {
+	// Validate input
+	if input.ID == 0 {
+		return fmt.Errorf("invalid project ID")
+	}
+	if input.OwnerID == "" {
+		return fmt.Errorf("owner ID is required")
+	}
+	if err := validation.ValidateProjectPatch(input).Err(); err != nil {
+		return err
+	}
+
+	// Verify project exists and user owns it
+	project, err := uc.projectRepo.GetByID(ctx, input.ID)
+	if err != nil {
+		return fmt.Errorf("project not found")
+	}
+
+	// Verify ownership through category
+	category, err := uc.categoryRepo.GetByID(ctx, project.CategoryID)
+	if err != nil {
+		return fmt.Errorf("category not found")
+	}
+	if category.OwnerID != input.OwnerID {
+		return fmt.Errorf("unauthorized: you don't own this project")
+	}
+
+	// Verify the user owns the category the project moves to
+	moved := input.CategoryID != nil && *input.CategoryID != project.CategoryID
+	if moved {
+		target, err := uc.categoryRepo.GetByID(ctx, *input.CategoryID)
+		if err != nil {
+			return fmt.Errorf("category not found")
+		}
+		if target.OwnerID != input.OwnerID {
+			return fmt.Errorf("unauthorized: you don't own this category")
+		}
+	}
+
+	// Update the provided fields
+	if err := uc.projectRepo.Patch(ctx, input); err != nil {
+		return fmt.Errorf("failed to update project: %w", err)
+	}
+
+	// Audit logging
+	if uc.auditLogger != nil {
+		details := map[string]interface{}{
+			"operation":   "patch",
+			"fields":      patchedFields(input),
+			"category_id": project.CategoryID,
+			"owner_id":    input.OwnerID,
+		}
+		if moved {
+			details["new_category_id"] = *input.CategoryID
+		}
+		uc.auditLogger.LogUpdate(ctx, "project", input.ID, details)
+	}
+
+	return nil
+}
+
+// patchedFields lists the JSON names of the fields a patch provides
+func patchedFields(input dto.PatchProjectInput) []string {
+	var fields []string
+	for _, field := range []struct {
+		name string
+		set  bool
+	}{
+		{"title", input.Title != nil},
+		{"description", input.Description != nil},
+		{"main_image", input.MainImage != nil},
+		{"images", input.Images != nil},
+		{"skills", input.Skills != nil},
+		{"client", input.Client != nil},
+		{"link", input.Link != nil},
+		{"category_id", input.CategoryID != nil},
+		{"hidden", input.Hidden != nil},
+	} {
+		if field.set {
+			fields = append(fields, field.name)
+		}
+	}
+	return fields
+}
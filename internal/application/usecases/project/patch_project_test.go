@@ -0,0 +1,88 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// patchProjectRepo records the patches that reach the repository
+type patchProjectRepo struct {
+	contracts.ProjectRepository
+	project dto.ProjectDTO
+	patched []dto.PatchProjectInput
+}
+
+func (r *patchProjectRepo) GetByID(_ context.Context, id uint) (*dto.ProjectDTO, error) {
+	if id != r.project.ID {
+		return nil, fmt.Errorf("project not found")
+	}
+	project := r.project
+	return &project, nil
+}
+
+func (r *patchProjectRepo) Patch(_ context.Context, input dto.PatchProjectInput) error {
+	r.patched = append(r.patched, input)
+	return nil
+}
+
+// patchCategoryRepo holds categories by ID
+type patchCategoryRepo struct {
+	contracts.CategoryRepository
+	categories map[uint]dto.CategoryDTO
+}
+
+func (r *patchCategoryRepo) GetByID(_ context.Context, id uint) (*dto.CategoryDTO, error) {
+	category, ok := r.categories[id]
+	if !ok {
+		return nil, fmt.Errorf("category not found")
+	}
+	return &category, nil
+}
+
+func TestPatchProjectUseCase_CategoryMove(t *testing.T) {
+	categories := &patchCategoryRepo{categories: map[uint]dto.CategoryDTO{
+		10: {ID: 10, PortfolioID: 100, OwnerID: "alice"},
+		11: {ID: 11, PortfolioID: 100, OwnerID: "alice"},
+		20: {ID: 20, PortfolioID: 200, OwnerID: "mallory"},
+	}}
+	uintPtr := func(v uint) *uint { return &v }
+
+	tests := []struct {
+		name       string
+		categoryID *uint
+		wantErr    string
+	}{
+		{name: "category of the same portfolio", categoryID: uintPtr(11)},
+		{name: "category of another owner's portfolio", categoryID: uintPtr(20), wantErr: "unauthorized"},
+		{name: "missing category", categoryID: uintPtr(99), wantErr: "category not found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projects := &patchProjectRepo{project: dto.ProjectDTO{ID: 1, CategoryID: 10, Position: 1}}
+			uc := NewPatchProjectUseCase(projects, categories, nil)
+
+			err := uc.Execute(context.Background(), dto.PatchProjectInput{ID: 1, CategoryID: tt.categoryID, OwnerID: "alice"})
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Execute() error = %v, want it to mention %q", err, tt.wantErr)
+				}
+				if len(projects.patched) != 0 {
+					t.Errorf("repository patched %d times, want the move refused before it", len(projects.patched))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if len(projects.patched) != 1 || *projects.patched[0].CategoryID != *tt.categoryID {
+				t.Errorf("repository patches = %+v, want one moving to category %d", projects.patched, *tt.categoryID)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+)
+
+// endorsementVoteRetention is how long per-IP dedup votes are kept (one day counts, plus margin)
+const endorsementVoteRetention = 48 * time.Hour
+
+// PurgeEndorsementVotesUseCase deletes the per-IP endorsement votes that no longer dedup anything
+type PurgeEndorsementVotesUseCase struct {
+	endorsementRepo contracts.SkillEndorsementRepository
+}
+
+// NewPurgeEndorsementVotesUseCase creates a new instance of PurgeEndorsementVotesUseCase
+func NewPurgeEndorsementVotesUseCase(endorsementRepo contracts.SkillEndorsementRepository) *PurgeEndorsementVotesUseCase {
+	return &PurgeEndorsementVotesUseCase{endorsementRepo: endorsementRepo}
+}
+
+// Execute purges the expired votes and returns how many were deleted
+func (uc *PurgeEndorsementVotesUseCase) Execute(ctx context.Context) (int64, error) {
+	cutoff := time.Now().UTC().Add(-endorsementVoteRetention)
+	day := time.Date(cutoff.Year(), cutoff.Month(), cutoff.Day(), 0, 0, 0, 0, time.UTC)
+
+	deleted, err := uc.endorsementRepo.PurgeVotesBefore(ctx, day)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge endorsement votes: %w", err)
+	}
+	return deleted, nil
+}
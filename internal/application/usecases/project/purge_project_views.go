@@ -0,0 +1,33 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// PurgeProjectViewsUseCase deletes the daily view counts that fell out of the stats window
+type PurgeProjectViewsUseCase struct {
+	viewRepo contracts.ProjectViewRepository
+}
+
+// NewPurgeProjectViewsUseCase creates a new instance of PurgeProjectViewsUseCase
+func NewPurgeProjectViewsUseCase(viewRepo contracts.ProjectViewRepository) *PurgeProjectViewsUseCase {
+	return &PurgeProjectViewsUseCase{viewRepo: viewRepo}
+}
+
+// Execute purges the expired days and returns how many rows were deleted
+// last_viewed_at is kept: it is the one stat that must outlive the window.
+func (uc *PurgeProjectViewsUseCase) Execute(ctx context.Context) (int64, error) {
+	now := time.Now().UTC()
+	windowStart := time.Date(now.Year(), now.Month(), now.Day()-(dto.ProjectViewWindowDays-1), 0, 0, 0, 0, time.UTC)
+
+	deleted, err := uc.viewRepo.PurgeDaysBefore(ctx, windowStart)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge project views: %w", err)
+	}
+	return deleted, nil
+}
@@ -0,0 +1,113 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// Public search tuning
+const (
+	PublicSearchTotalCap   = 1000             // Totals above this are reported as "1000+"
+	publicSearchCacheTTL   = 30 * time.Second // Discovery results may lag edits by this much
+	publicSearchCacheLimit = 500              // Cached result pages kept at most
+)
+
+// SearchPublicProjectsUseCase handles the business logic for the public project discovery search
+// Identical searches within a short window are served from memory so a popular
+// discovery page doesn't turn every visit into a cross-portfolio scan.
+type SearchPublicProjectsUseCase struct {
+	projectRepo contracts.ProjectRepository
+
+	mu    sync.Mutex
+	cache map[string]publicSearchCacheEntry
+}
+
+type publicSearchCacheEntry struct {
+	output    *dto.SearchPublicProjectsOutput
+	expiresAt time.Time
+}
+
+// NewSearchPublicProjectsUseCase creates a new instance of SearchPublicProjectsUseCase
+func NewSearchPublicProjectsUseCase(
+	projectRepo contracts.ProjectRepository,
+) *SearchPublicProjectsUseCase {
+	return &SearchPublicProjectsUseCase{
+		projectRepo: projectRepo,
+		cache:       make(map[string]publicSearchCacheEntry),
+	}
+}
+
+// Execute searches the projects of every live portfolio
+func (uc *SearchPublicProjectsUseCase) Execute(ctx context.Context, input dto.SearchPublicProjectsInput) (*dto.SearchPublicProjectsOutput, error) {
+	input.Query = strings.TrimSpace(input.Query)
+	input.Skill = strings.TrimSpace(input.Skill)
+	if input.Sort == "" {
+		input.Sort = dto.ProjectSearchSortRecent
+	}
+	input.TotalCap = PublicSearchTotalCap
+
+	key := fmt.Sprintf("%s\x00%s\x00%s\x00%d\x00%d",
+		strings.ToLower(input.Query), strings.ToLower(input.Skill), input.Sort,
+		input.Pagination.Page, input.Pagination.Limit)
+	if output := uc.cached(key); output != nil {
+		return output, nil
+	}
+
+	projects, total, err := uc.projectRepo.SearchPublic(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search projects: %w", err)
+	}
+
+	output := &dto.SearchPublicProjectsOutput{
+		Projects: projects,
+		Pagination: dto.PaginatedResultDTO{
+			Total: total,
+			Page:  input.Pagination.Page,
+			Limit: input.Pagination.Limit,
+		},
+	}
+	if total > PublicSearchTotalCap {
+		output.Pagination.Total = PublicSearchTotalCap
+		output.TotalCapped = true
+	}
+
+	uc.store(key, output)
+	return output, nil
+}
+
+func (uc *SearchPublicProjectsUseCase) cached(key string) *dto.SearchPublicProjectsOutput {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	entry, ok := uc.cache[key]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return nil
+	}
+	return entry.output
+}
+
+func (uc *SearchPublicProjectsUseCase) store(key string, output *dto.SearchPublicProjectsOutput) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	now := time.Now()
+	if len(uc.cache) >= publicSearchCacheLimit {
+		for k, entry := range uc.cache {
+			if now.After(entry.expiresAt) {
+				delete(uc.cache, k)
+			}
+		}
+		// Still full of live entries: start over rather than track recency
+		if len(uc.cache) >= publicSearchCacheLimit {
+			uc.cache = make(map[string]publicSearchCacheEntry)
+		}
+	}
+
+	uc.cache[key] = publicSearchCacheEntry{output: output, expiresAt: now.Add(publicSearchCacheTTL)}
+}
@@ -0,0 +1,101 @@
+package project
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	contracts2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// SwapProjectPositionsUseCase handles the business logic for exchanging the positions of two projects
+type SwapProjectPositionsUseCase struct {
+	projectRepo   contracts2.ProjectRepository
+	categoryRepo  contracts2.CategoryRepository
+	portfolioRepo contracts2.PortfolioRepository
+	auditLogger   contracts2.AuditLogger
+}
+
+// NewSwapProjectPositionsUseCase creates a new instance of SwapProjectPositionsUseCase
+func NewSwapProjectPositionsUseCase(
+	projectRepo contracts2.ProjectRepository,
+	categoryRepo contracts2.CategoryRepository,
+	portfolioRepo contracts2.PortfolioRepository,
+	auditLogger contracts2.AuditLogger,
+) *SwapProjectPositionsUseCase {
+	return &SwapProjectPositionsUseCase{
+		projectRepo:   projectRepo,
+		categoryRepo:  categoryRepo,
+		portfolioRepo: portfolioRepo,
+		auditLogger:   auditLogger,
+	}
+}
+
+// Execute swaps the positions of two projects of the same category with ownership verification
+// Returns both projects with their new positions, in request order
+func (uc *SwapProjectPositionsUseCase) Execute(ctx context.Context, input dto.SwapPositionsInput) ([]dto.ProjectDTO, error) {
+	if input.FirstID == 0 || input.SecondID == 0 {
+		return nil, fmt.Errorf("invalid project ID")
+	}
+	if input.OwnerID == "" {
+		return nil, fmt.Errorf("owner ID is required")
+	}
+	if input.FirstID == input.SecondID {
+		return nil, apperrors.New(apperrors.KindValidation, apperrors.CodeValidationInvalid,
+			"cannot swap a project with itself", map[string]interface{}{"field": "second_id"})
+	}
+
+	// Verify both projects exist and share a category
+	projects, err := uc.projectRepo.GetByIDs(ctx, []uint{input.FirstID, input.SecondID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve projects: %w", err)
+	}
+	if len(projects) != 2 {
+		return nil, fmt.Errorf("project not found")
+	}
+	categoryID := projects[0].CategoryID
+	if projects[1].CategoryID != categoryID {
+		return nil, apperrors.New(apperrors.KindValidation, apperrors.CodeValidationInvalid,
+			"projects must belong to the same category", map[string]interface{}{"field": "second_id"})
+	}
+
+	// Verify ownership through category and portfolio
+	category, err := uc.categoryRepo.GetByID(ctx, categoryID)
+	if err != nil {
+		return nil, fmt.Errorf("category not found")
+	}
+	portfolio, err := uc.portfolioRepo.GetByID(ctx, category.PortfolioID)
+	if err != nil {
+		return nil, fmt.Errorf("portfolio not found")
+	}
+	if portfolio.OwnerID != input.OwnerID {
+		return nil, fmt.Errorf("unauthorized: you don't own this project")
+	}
+
+	if err := uc.projectRepo.SwapPositions(ctx, input.FirstID, input.SecondID); err != nil {
+		return nil, fmt.Errorf("failed to swap project positions: %w", err)
+	}
+
+	first, err := uc.projectRepo.GetByID(ctx, input.FirstID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve swapped project: %w", err)
+	}
+	second, err := uc.projectRepo.GetByID(ctx, input.SecondID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve swapped project: %w", err)
+	}
+
+	// Audit logging
+	if uc.auditLogger != nil {
+		uc.auditLogger.LogUpdate(ctx, "project", 0, map[string]interface{}{
+			"operation":   "swap_positions",
+			"first_id":    input.FirstID,
+			"second_id":   input.SecondID,
+			"category_id": categoryID,
+			"owner_id":    input.OwnerID,
+		})
+	}
+
+	return []dto.ProjectDTO{*first, *second}, nil
+}
@@ -0,0 +1,129 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// swapProjectRepo keeps projects in memory; unused methods panic through the nil interface
+type swapProjectRepo struct {
+	contracts.ProjectRepository
+	projects map[uint]*dto.ProjectDTO
+	swaps    int
+}
+
+func (r *swapProjectRepo) GetByIDs(_ context.Context, ids []uint) ([]dto.ProjectDTO, error) {
+	var found []dto.ProjectDTO
+	for _, id := range ids {
+		if proj, ok := r.projects[id]; ok {
+			found = append(found, *proj)
+		}
+	}
+	return found, nil
+}
+
+func (r *swapProjectRepo) GetByID(_ context.Context, id uint) (*dto.ProjectDTO, error) {
+	proj, ok := r.projects[id]
+	if !ok {
+		return nil, fmt.Errorf("project not found")
+	}
+	copied := *proj
+	return &copied, nil
+}
+
+func (r *swapProjectRepo) SwapPositions(_ context.Context, firstID, secondID uint) error {
+	r.swaps++
+	first, second := r.projects[firstID], r.projects[secondID]
+	first.Position, second.Position = second.Position, first.Position
+	return nil
+}
+
+type swapCategoryRepo struct {
+	contracts.CategoryRepository
+	portfolioIDs map[uint]uint
+}
+
+func (r *swapCategoryRepo) GetByID(_ context.Context, id uint) (*dto.CategoryDTO, error) {
+	portfolioID, ok := r.portfolioIDs[id]
+	if !ok {
+		return nil, fmt.Errorf("category not found")
+	}
+	return &dto.CategoryDTO{ID: id, PortfolioID: portfolioID}, nil
+}
+
+type swapPortfolioRepo struct {
+	contracts.PortfolioRepository
+	owners map[uint]string
+}
+
+func (r *swapPortfolioRepo) GetByID(_ context.Context, id uint) (*dto.PortfolioDTO, error) {
+	owner, ok := r.owners[id]
+	if !ok {
+		return nil, fmt.Errorf("portfolio not found")
+	}
+	return &dto.PortfolioDTO{ID: id, OwnerID: owner}, nil
+}
+
+func TestSwapProjectPositionsUseCase(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     dto.SwapPositionsInput
+		wantErr   string
+		wantKind  apperrors.Kind
+		wantSwaps int
+	}{
+		{name: "same category", input: dto.SwapPositionsInput{FirstID: 1, SecondID: 2, OwnerID: "alice"}, wantSwaps: 1},
+		{name: "with itself", input: dto.SwapPositionsInput{FirstID: 1, SecondID: 1, OwnerID: "alice"}, wantErr: "itself", wantKind: apperrors.KindValidation},
+		{name: "other category", input: dto.SwapPositionsInput{FirstID: 1, SecondID: 3, OwnerID: "alice"}, wantErr: "same category", wantKind: apperrors.KindValidation},
+		{name: "other user", input: dto.SwapPositionsInput{FirstID: 1, SecondID: 2, OwnerID: "mallory"}, wantErr: "unauthorized"},
+		{name: "missing project", input: dto.SwapPositionsInput{FirstID: 1, SecondID: 9, OwnerID: "alice"}, wantErr: "not found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projects := &swapProjectRepo{projects: map[uint]*dto.ProjectDTO{
+				1: {ID: 1, CategoryID: 10, Position: 1},
+				2: {ID: 2, CategoryID: 10, Position: 2},
+				3: {ID: 3, CategoryID: 11, Position: 1},
+			}}
+			uc := NewSwapProjectPositionsUseCase(
+				projects,
+				&swapCategoryRepo{portfolioIDs: map[uint]uint{10: 100, 11: 100}},
+				&swapPortfolioRepo{owners: map[uint]string{100: "alice"}},
+				nil,
+			)
+
+			swapped, err := uc.Execute(context.Background(), tt.input)
+			if projects.swaps != tt.wantSwaps {
+				t.Errorf("repository swaps = %d, want %d", projects.swaps, tt.wantSwaps)
+			}
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Execute() error = %v, want it to mention %q", err, tt.wantErr)
+				}
+				if tt.wantKind != "" {
+					if appErr, ok := apperrors.As(err); !ok || appErr.Kind != tt.wantKind {
+						t.Errorf("Execute() error kind = %v, want %v", err, tt.wantKind)
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+
+			if len(swapped) != 2 || swapped[0].ID != 1 || swapped[1].ID != 2 {
+				t.Fatalf("Execute() returned %+v, want projects 1 and 2 in request order", swapped)
+			}
+			if swapped[0].Position != 2 || swapped[1].Position != 1 {
+				t.Errorf("positions = %d, %d, want 2, 1", swapped[0].Position, swapped[1].Position)
+			}
+		})
+	}
+}
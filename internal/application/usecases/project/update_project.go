@@ -6,6 +6,7 @@ import (
 
 	contracts2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/validation"
 )
 
 // UpdateProjectUseCase handles the business logic for updating a project
@@ -37,11 +38,8 @@ func (uc *UpdateProjectUseCase) Execute(ctx context.Context, input dto.UpdatePro
 	if input.OwnerID == "" {
 		return fmt.Errorf("owner ID is required")
 	}
-	if input.Title == "" {
-		return fmt.Errorf("project title is required")
-	}
-	if input.Description == "" {
-		return fmt.Errorf("project description is required")
+	if err := validation.ValidateProjectUpdate(input).Err(); err != nil {
+		return err
 	}
 
 	// Verify project exists and user owns it
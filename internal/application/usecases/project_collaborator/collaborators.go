@@ -0,0 +1,31 @@
+package project_collaborator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	domainportfolio "github.com/JorgeSaicoski/portfolio-manager/backend/internal/domain/portfolio"
+)
+
+// validateCollaborator applies the collaborator rules, returning a validation error
+func validateCollaborator(name string, url *string) error {
+	if err := domainportfolio.ValidateCollaborator(name, url); err != nil {
+		return apperrors.New(apperrors.KindValidation, apperrors.CodeProjectCollaboratorInvalid, err.Error(),
+			map[string]interface{}{"reason": err.Error()})
+	}
+	return nil
+}
+
+// verifyProjectOwner checks that the project exists and belongs to ownerID
+func verifyProjectOwner(ctx context.Context, projectRepo contracts.ProjectRepository, projectID uint, ownerID string) error {
+	project, err := projectRepo.GetByID(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("project not found")
+	}
+	if project.OwnerID != ownerID {
+		return fmt.Errorf("unauthorized: you don't own this project")
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package project_collaborator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/quota"
+	domainportfolio "github.com/JorgeSaicoski/portfolio-manager/backend/internal/domain/portfolio"
+)
+
+// CreateProjectCollaboratorUseCase handles the business logic for crediting a collaborator on a project
+type CreateProjectCollaboratorUseCase struct {
+	collaboratorRepo contracts.ProjectCollaboratorRepository
+	projectRepo      contracts.ProjectRepository
+	auditLogger      contracts.AuditLogger
+}
+
+// NewCreateProjectCollaboratorUseCase creates a new instance of CreateProjectCollaboratorUseCase
+func NewCreateProjectCollaboratorUseCase(
+	collaboratorRepo contracts.ProjectCollaboratorRepository,
+	projectRepo contracts.ProjectRepository,
+	auditLogger contracts.AuditLogger,
+) *CreateProjectCollaboratorUseCase {
+	return &CreateProjectCollaboratorUseCase{
+		collaboratorRepo: collaboratorRepo,
+		projectRepo:      projectRepo,
+		auditLogger:      auditLogger,
+	}
+}
+
+// Execute adds a collaborator to a project owned by the user
+// The output warns when the project is getting close to MaxCollaboratorsPerProject
+func (uc *CreateProjectCollaboratorUseCase) Execute(ctx context.Context, input dto.CreateProjectCollaboratorInput) (*dto.CreateProjectCollaboratorOutput, error) {
+	// Validate input
+	if input.ProjectID == 0 {
+		return nil, apperrors.Required("project_id", "project ID is required")
+	}
+	if input.OwnerID == "" {
+		return nil, fmt.Errorf("owner ID is required")
+	}
+	if err := validateCollaborator(input.Name, input.URL); err != nil {
+		return nil, err
+	}
+
+	// Verify project ownership
+	if err := verifyProjectOwner(ctx, uc.projectRepo, input.ProjectID, input.OwnerID); err != nil {
+		return nil, err
+	}
+
+	collaborator, collaboratorCount, err := uc.collaboratorRepo.Create(ctx, input, domainportfolio.MaxCollaboratorsPerProject)
+	if err != nil {
+		if errors.Is(err, contracts.ErrProjectCollaboratorLimitReached) {
+			return nil, apperrors.New(apperrors.KindValidation, apperrors.CodeProjectCollaboratorLimit,
+				fmt.Sprintf("a project can have at most %d collaborators", domainportfolio.MaxCollaboratorsPerProject),
+				map[string]interface{}{"max": domainportfolio.MaxCollaboratorsPerProject})
+		}
+		return nil, fmt.Errorf("failed to create project collaborator: %w", err)
+	}
+
+	// Audit logging
+	if uc.auditLogger != nil {
+		uc.auditLogger.LogCreate(ctx, "project_collaborator", collaborator.ID, map[string]interface{}{
+			"project_id": collaborator.ProjectID,
+			"owner_id":   input.OwnerID,
+		})
+	}
+
+	return &dto.CreateProjectCollaboratorOutput{
+		Collaborator: *collaborator,
+		Warnings: quota.Collect(quota.Check(quota.ProjectCollaborators, collaboratorCount,
+			domainportfolio.MaxCollaboratorsPerProject)),
+	}, nil
+}
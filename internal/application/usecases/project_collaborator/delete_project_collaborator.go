@@ -0,0 +1,63 @@
+package project_collaborator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+)
+
+// DeleteProjectCollaboratorUseCase handles the business logic for removing a project collaborator
+type DeleteProjectCollaboratorUseCase struct {
+	collaboratorRepo contracts.ProjectCollaboratorRepository
+	projectRepo      contracts.ProjectRepository
+	auditLogger      contracts.AuditLogger
+}
+
+// NewDeleteProjectCollaboratorUseCase creates a new instance of DeleteProjectCollaboratorUseCase
+func NewDeleteProjectCollaboratorUseCase(
+	collaboratorRepo contracts.ProjectCollaboratorRepository,
+	projectRepo contracts.ProjectRepository,
+	auditLogger contracts.AuditLogger,
+) *DeleteProjectCollaboratorUseCase {
+	return &DeleteProjectCollaboratorUseCase{
+		collaboratorRepo: collaboratorRepo,
+		projectRepo:      projectRepo,
+		auditLogger:      auditLogger,
+	}
+}
+
+// Execute deletes a collaborator of a project owned by the user
+func (uc *DeleteProjectCollaboratorUseCase) Execute(ctx context.Context, projectID, id uint, ownerID string) error {
+	if id == 0 {
+		return fmt.Errorf("invalid project collaborator ID")
+	}
+	if ownerID == "" {
+		return fmt.Errorf("owner ID is required")
+	}
+
+	// The collaborator must belong to the project in the URL
+	collaborator, err := uc.collaboratorRepo.GetByID(ctx, id)
+	if err != nil || collaborator.ProjectID != projectID {
+		return fmt.Errorf("project collaborator not found")
+	}
+
+	// Verify project ownership
+	if err := verifyProjectOwner(ctx, uc.projectRepo, collaborator.ProjectID, ownerID); err != nil {
+		return err
+	}
+
+	if err := uc.collaboratorRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete project collaborator: %w", err)
+	}
+
+	// Audit logging
+	if uc.auditLogger != nil {
+		uc.auditLogger.LogDelete(ctx, "project_collaborator", id, map[string]interface{}{
+			"project_id": collaborator.ProjectID,
+			"owner_id":   ownerID,
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package project_collaborator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// ListProjectCollaboratorsUseCase handles listing the collaborators of a project owned by the user
+type ListProjectCollaboratorsUseCase struct {
+	collaboratorRepo contracts.ProjectCollaboratorRepository
+	projectRepo      contracts.ProjectRepository
+}
+
+// NewListProjectCollaboratorsUseCase creates a new instance of ListProjectCollaboratorsUseCase
+func NewListProjectCollaboratorsUseCase(
+	collaboratorRepo contracts.ProjectCollaboratorRepository,
+	projectRepo contracts.ProjectRepository,
+) *ListProjectCollaboratorsUseCase {
+	return &ListProjectCollaboratorsUseCase{
+		collaboratorRepo: collaboratorRepo,
+		projectRepo:      projectRepo,
+	}
+}
+
+// Execute lists the collaborators of a project (ordered by position)
+func (uc *ListProjectCollaboratorsUseCase) Execute(ctx context.Context, projectID uint, ownerID string) ([]dto.ProjectCollaboratorDTO, error) {
+	if projectID == 0 {
+		return nil, fmt.Errorf("invalid project ID")
+	}
+	if ownerID == "" {
+		return nil, fmt.Errorf("owner ID is required")
+	}
+
+	// Verify project ownership
+	if err := verifyProjectOwner(ctx, uc.projectRepo, projectID, ownerID); err != nil {
+		return nil, err
+	}
+
+	collaborators, err := uc.collaboratorRepo.GetByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list project collaborators: %w", err)
+	}
+
+	return collaborators, nil
+}
@@ -0,0 +1,63 @@
+package project_collaborator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// ReorderProjectCollaboratorsUseCase handles reordering the collaborators of a project
+type ReorderProjectCollaboratorsUseCase struct {
+	collaboratorRepo contracts.ProjectCollaboratorRepository
+	projectRepo      contracts.ProjectRepository
+	auditLogger      contracts.AuditLogger
+}
+
+// NewReorderProjectCollaboratorsUseCase creates a new instance of ReorderProjectCollaboratorsUseCase
+func NewReorderProjectCollaboratorsUseCase(
+	collaboratorRepo contracts.ProjectCollaboratorRepository,
+	projectRepo contracts.ProjectRepository,
+	auditLogger contracts.AuditLogger,
+) *ReorderProjectCollaboratorsUseCase {
+	return &ReorderProjectCollaboratorsUseCase{
+		collaboratorRepo: collaboratorRepo,
+		projectRepo:      projectRepo,
+		auditLogger:      auditLogger,
+	}
+}
+
+// Execute updates the positions of collaborators of a project owned by the user
+func (uc *ReorderProjectCollaboratorsUseCase) Execute(ctx context.Context, input dto.ReorderProjectCollaboratorsInput) error {
+	if input.ProjectID == 0 {
+		return fmt.Errorf("invalid project ID")
+	}
+	if input.OwnerID == "" {
+		return fmt.Errorf("owner ID is required")
+	}
+	if len(input.Items) == 0 {
+		return fmt.Errorf("no items to reorder")
+	}
+
+	// Verify project ownership
+	if err := verifyProjectOwner(ctx, uc.projectRepo, input.ProjectID, input.OwnerID); err != nil {
+		return err
+	}
+
+	// Items are scoped to the project by the repository
+	if err := uc.collaboratorRepo.BulkUpdatePositions(ctx, input.ProjectID, input.Items); err != nil {
+		return fmt.Errorf("failed to reorder project collaborators: %w", err)
+	}
+
+	// Audit logging
+	if uc.auditLogger != nil {
+		uc.auditLogger.LogUpdate(ctx, "project_collaborator", input.ProjectID, map[string]interface{}{
+			"action":   "reorder",
+			"count":    len(input.Items),
+			"owner_id": input.OwnerID,
+		})
+	}
+
+	return nil
+}
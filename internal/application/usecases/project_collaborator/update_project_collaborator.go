@@ -0,0 +1,68 @@
+package project_collaborator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// UpdateProjectCollaboratorUseCase handles the business logic for updating a project collaborator
+type UpdateProjectCollaboratorUseCase struct {
+	collaboratorRepo contracts.ProjectCollaboratorRepository
+	projectRepo      contracts.ProjectRepository
+	auditLogger      contracts.AuditLogger
+}
+
+// NewUpdateProjectCollaboratorUseCase creates a new instance of UpdateProjectCollaboratorUseCase
+func NewUpdateProjectCollaboratorUseCase(
+	collaboratorRepo contracts.ProjectCollaboratorRepository,
+	projectRepo contracts.ProjectRepository,
+	auditLogger contracts.AuditLogger,
+) *UpdateProjectCollaboratorUseCase {
+	return &UpdateProjectCollaboratorUseCase{
+		collaboratorRepo: collaboratorRepo,
+		projectRepo:      projectRepo,
+		auditLogger:      auditLogger,
+	}
+}
+
+// Execute updates a collaborator of a project owned by the user
+func (uc *UpdateProjectCollaboratorUseCase) Execute(ctx context.Context, input dto.UpdateProjectCollaboratorInput) error {
+	// Validate input
+	if input.ID == 0 {
+		return fmt.Errorf("invalid project collaborator ID")
+	}
+	if input.OwnerID == "" {
+		return fmt.Errorf("owner ID is required")
+	}
+	if err := validateCollaborator(input.Name, input.URL); err != nil {
+		return err
+	}
+
+	// The collaborator must belong to the project in the URL
+	collaborator, err := uc.collaboratorRepo.GetByID(ctx, input.ID)
+	if err != nil || collaborator.ProjectID != input.ProjectID {
+		return fmt.Errorf("project collaborator not found")
+	}
+
+	// Verify project ownership
+	if err := verifyProjectOwner(ctx, uc.projectRepo, collaborator.ProjectID, input.OwnerID); err != nil {
+		return err
+	}
+
+	if err := uc.collaboratorRepo.Update(ctx, input); err != nil {
+		return fmt.Errorf("failed to update project collaborator: %w", err)
+	}
+
+	// Audit logging
+	if uc.auditLogger != nil {
+		uc.auditLogger.LogUpdate(ctx, "project_collaborator", collaborator.ID, map[string]interface{}{
+			"project_id": collaborator.ProjectID,
+			"owner_id":   input.OwnerID,
+		})
+	}
+
+	return nil
+}
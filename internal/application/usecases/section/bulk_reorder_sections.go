@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
 	contracts2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
 )
@@ -12,6 +13,7 @@ import (
 type BulkReorderSectionsUseCase struct {
 	sectionRepo   contracts2.SectionRepository
 	portfolioRepo contracts2.PortfolioRepository
+	txManager     contracts2.TransactionManager
 	auditLogger   contracts2.AuditLogger
 }
 
@@ -19,11 +21,13 @@ type BulkReorderSectionsUseCase struct {
 func NewBulkReorderSectionsUseCase(
 	sectionRepo contracts2.SectionRepository,
 	portfolioRepo contracts2.PortfolioRepository,
+	txManager contracts2.TransactionManager,
 	auditLogger contracts2.AuditLogger,
 ) *BulkReorderSectionsUseCase {
 	return &BulkReorderSectionsUseCase{
 		sectionRepo:   sectionRepo,
 		portfolioRepo: portfolioRepo,
+		txManager:     txManager,
 		auditLogger:   auditLogger,
 	}
 }
@@ -37,6 +41,27 @@ func (uc *BulkReorderSectionsUseCase) Execute(ctx context.Context, input dto.Bul
 		return fmt.Errorf("owner ID is required")
 	}
 
+	// The checks and the update run as one unit of work
+	if err := uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		return uc.reorder(ctx, input)
+	}); err != nil {
+		return err
+	}
+
+	// Audit logging
+	if uc.auditLogger != nil {
+		uc.auditLogger.LogUpdate(ctx, "section", 0, map[string]interface{}{
+			"operation": "bulk_reorder",
+			"count":     len(input.Items),
+			"owner_id":  input.OwnerID,
+		})
+	}
+
+	return nil
+}
+
+// reorder verifies that the owner owns all the sections, all in one portfolio, and updates their positions
+func (uc *BulkReorderSectionsUseCase) reorder(ctx context.Context, input dto.BulkUpdateSectionPositionsInput) error {
 	// Verify ownership of all sections
 	sectionIDs := make([]uint, len(input.Items))
 	for i, item := range input.Items {
@@ -67,19 +92,19 @@ func (uc *BulkReorderSectionsUseCase) Execute(ctx context.Context, input dto.Bul
 		}
 	}
 
+	// Positions are per portfolio: a batch spanning portfolios would leave duplicates in each
+	if len(portfolioIDSet) > 1 {
+		groups := make(map[uint][]uint, len(portfolioIDSet))
+		for _, sec := range sections {
+			groups[sec.PortfolioID] = append(groups[sec.PortfolioID], sec.ID)
+		}
+		return apperrors.MixedParents("sections", groups)
+	}
+
 	// Perform bulk update
 	if err := uc.sectionRepo.BulkUpdatePositions(ctx, input); err != nil {
 		return fmt.Errorf("failed to reorder sections: %w", err)
 	}
 
-	// Audit logging
-	if uc.auditLogger != nil {
-		uc.auditLogger.LogUpdate(ctx, "section", 0, map[string]interface{}{
-			"operation": "bulk_reorder",
-			"count":     len(input.Items),
-			"owner_id":  input.OwnerID,
-		})
-	}
-
 	return nil
 }
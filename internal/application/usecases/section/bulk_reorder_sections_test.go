@@ -0,0 +1,68 @@
+package section
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// positionedSectionRepo serves sections by ID and counts the position writes that reach it
+type positionedSectionRepo struct{ titledSectionRepo }
+
+func (r *positionedSectionRepo) GetByIDs(_ context.Context, ids []uint) ([]dto.SectionDTO, error) {
+	var found []dto.SectionDTO
+	for _, id := range ids {
+		if section, err := r.GetByID(context.Background(), id); err == nil {
+			found = append(found, *section)
+		}
+	}
+	return found, nil
+}
+
+func (r *positionedSectionRepo) BulkUpdatePositions(context.Context, dto.BulkUpdateSectionPositionsInput) error {
+	r.writes++
+	return nil
+}
+
+// inlineTxManager runs the unit of work without a transaction
+type inlineTxManager struct{}
+
+func (inlineTxManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func TestBulkReorderSections_MixedPortfolios(t *testing.T) {
+	repo := &positionedSectionRepo{titledSectionRepo{sections: []dto.SectionDTO{
+		{ID: 1, PortfolioID: 10}, {ID: 2, PortfolioID: 10}, {ID: 3, PortfolioID: 20},
+	}}}
+	uc := NewBulkReorderSectionsUseCase(repo, alicePortfolioRepo{}, inlineTxManager{}, nil)
+
+	err := uc.Execute(context.Background(), dto.BulkUpdateSectionPositionsInput{OwnerID: "alice", Items: []dto.BulkUpdatePositionItem{
+		{ID: 1, Position: 3}, {ID: 2, Position: 2}, {ID: 3, Position: 1},
+	}})
+
+	appErr, ok := apperrors.As(err)
+	if !ok || appErr.Kind != apperrors.KindUnprocessable || appErr.Code != apperrors.CodeReorderMixedParents {
+		t.Fatalf("error = %v, want %s", err, apperrors.CodeReorderMixedParents)
+	}
+	want := map[uint][]uint{10: {1, 2}, 20: {3}}
+	if got := appErr.Details["portfolios"]; !reflect.DeepEqual(got, want) {
+		t.Errorf("details portfolios = %v, want %v", got, want)
+	}
+	if repo.writes != 0 {
+		t.Errorf("%d position writes reached the repository, want none", repo.writes)
+	}
+
+	// One portfolio at a time is accepted
+	if err := uc.Execute(context.Background(), dto.BulkUpdateSectionPositionsInput{OwnerID: "alice", Items: []dto.BulkUpdatePositionItem{
+		{ID: 1, Position: 2}, {ID: 2, Position: 1},
+	}}); err != nil {
+		t.Fatalf("single-portfolio reorder: %v", err)
+	}
+	if repo.writes != 1 {
+		t.Errorf("%d position writes, want 1", repo.writes)
+	}
+}
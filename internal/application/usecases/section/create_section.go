@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
 	contracts2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/validation"
 )
 
 // CreateSectionUseCase handles the business logic for creating a section
@@ -34,17 +36,11 @@ func NewCreateSectionUseCase(
 // Execute creates a new section
 func (uc *CreateSectionUseCase) Execute(ctx context.Context, input dto.CreateSectionInput) (*dto.SectionDTO, error) {
 	// Validate input
-	if input.Title == "" {
-		return nil, fmt.Errorf("section title is required")
-	}
 	if input.OwnerID == "" {
 		return nil, fmt.Errorf("owner ID is required")
 	}
-	if input.PortfolioID == 0 {
-		return nil, fmt.Errorf("portfolio ID is required")
-	}
-	if input.Type == "" {
-		return nil, fmt.Errorf("section type is required")
+	if err := validation.ValidateSection(input).Err(); err != nil {
+		return nil, err
 	}
 
 	// Verify portfolio exists and user owns it
@@ -62,7 +58,8 @@ func (uc *CreateSectionUseCase) Execute(ctx context.Context, input dto.CreateSec
 		return nil, fmt.Errorf("failed to check for duplicate title: %w", err)
 	}
 	if isDuplicate {
-		return nil, fmt.Errorf("section with title '%s' already exists in this portfolio", input.Title)
+		return nil, apperrors.DuplicateTitle(apperrors.CodeSectionDuplicateTitle, "section", input.Title,
+			fmt.Sprintf("section with title '%s' already exists in this portfolio", input.Title))
 	}
 
 	// Create the section
@@ -0,0 +1,131 @@
+package section
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// titledSectionRepo holds sections and counts the writes that reach it
+type titledSectionRepo struct {
+	contracts.SectionRepository
+	sections []dto.SectionDTO
+	writes   int
+}
+
+func (r *titledSectionRepo) GetByID(_ context.Context, id uint) (*dto.SectionDTO, error) {
+	for _, section := range r.sections {
+		if section.ID == id {
+			return &section, nil
+		}
+	}
+	return nil, errors.New("section not found")
+}
+
+func (r *titledSectionRepo) CheckTitleDuplicate(_ context.Context, title string, portfolioID uint, excludeID uint) (bool, error) {
+	for _, section := range r.sections {
+		if section.Title == title && section.PortfolioID == portfolioID && section.ID != excludeID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *titledSectionRepo) Create(_ context.Context, input dto.CreateSectionInput) (*dto.SectionDTO, error) {
+	r.writes++
+	return &dto.SectionDTO{ID: 99, Title: input.Title, PortfolioID: input.PortfolioID, OwnerID: input.OwnerID}, nil
+}
+
+func (r *titledSectionRepo) Update(context.Context, dto.UpdateSectionInput) error {
+	r.writes++
+	return nil
+}
+
+// alicePortfolioRepo makes every portfolio belong to "alice"
+type alicePortfolioRepo struct{ contracts.PortfolioRepository }
+
+func (alicePortfolioRepo) GetByID(_ context.Context, id uint) (*dto.PortfolioDTO, error) {
+	return &dto.PortfolioDTO{ID: id, OwnerID: "alice"}, nil
+}
+
+func TestSectionDuplicateTitle(t *testing.T) {
+	tests := []struct {
+		name      string
+		run       func(repo *titledSectionRepo) error
+		wantTitle string // empty when the title is accepted
+	}{
+		{
+			name: "create with a title of the same portfolio",
+			run: func(repo *titledSectionRepo) error {
+				_, err := NewCreateSectionUseCase(repo, alicePortfolioRepo{}, nil, nil).Execute(context.Background(),
+					dto.CreateSectionInput{Title: "About", Type: "text", PortfolioID: 1, OwnerID: "alice"})
+				return err
+			},
+			wantTitle: "About",
+		},
+		{
+			name: "create with a title of another portfolio",
+			run: func(repo *titledSectionRepo) error {
+				_, err := NewCreateSectionUseCase(repo, alicePortfolioRepo{}, nil, nil).Execute(context.Background(),
+					dto.CreateSectionInput{Title: "Elsewhere", Type: "text", PortfolioID: 1, OwnerID: "alice"})
+				return err
+			},
+		},
+		{
+			name: "update to another section's title",
+			run: func(repo *titledSectionRepo) error {
+				return NewUpdateSectionUseCase(repo, alicePortfolioRepo{}, nil, nil).Execute(context.Background(),
+					dto.UpdateSectionInput{ID: 2, Title: "About", Type: "text", OwnerID: "alice"})
+			},
+			wantTitle: "About",
+		},
+		{
+			name: "update keeping its own title",
+			run: func(repo *titledSectionRepo) error {
+				return NewUpdateSectionUseCase(repo, alicePortfolioRepo{}, nil, nil).Execute(context.Background(),
+					dto.UpdateSectionInput{ID: 1, Title: "About", Type: "text", OwnerID: "alice"})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &titledSectionRepo{sections: []dto.SectionDTO{
+				{ID: 1, Title: "About", PortfolioID: 1, OwnerID: "alice"},
+				{ID: 2, Title: "Contact", PortfolioID: 1, OwnerID: "alice"},
+				{ID: 3, Title: "Elsewhere", PortfolioID: 2, OwnerID: "alice"},
+			}}
+
+			err := tt.run(repo)
+			if tt.wantTitle == "" {
+				if err != nil || repo.writes != 1 {
+					t.Fatalf("err = %v with %d writes, want the title accepted", err, repo.writes)
+				}
+				return
+			}
+
+			var appErr *apperrors.Error
+			if !errors.As(err, &appErr) {
+				t.Fatalf("err = %v, want a duplicate title conflict", err)
+			}
+			if appErr.Kind != apperrors.KindConflict || appErr.Code != apperrors.CodeSectionDuplicateTitle {
+				t.Errorf("kind, code = %v, %s, want a conflict with %s", appErr.Kind, appErr.Code, apperrors.CodeSectionDuplicateTitle)
+			}
+			wantDetails := map[string]interface{}{"reason": "duplicate_title", "resource": "section", "field": "title"}
+			if !reflect.DeepEqual(appErr.Details, wantDetails) {
+				t.Errorf("details = %v, want %v", appErr.Details, wantDetails)
+			}
+			if appErr.Params["title"] != tt.wantTitle {
+				t.Errorf("title param = %v, want %q", appErr.Params["title"], tt.wantTitle)
+			}
+			if repo.writes != 0 {
+				t.Errorf("writes = %d, want none for a rejected title", repo.writes)
+			}
+		})
+	}
+}
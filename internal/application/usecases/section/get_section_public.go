@@ -6,19 +6,23 @@ import (
 
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/publication"
 )
 
 // GetSectionPublicUseCase handles the business logic for retrieving a section publicly (no auth)
 type GetSectionPublicUseCase struct {
-	sectionRepo contracts.SectionRepository
+	sectionRepo   contracts.SectionRepository
+	portfolioRepo contracts.PortfolioRepository
 }
 
 // NewGetSectionPublicUseCase creates a new instance of GetSectionPublicUseCase
 func NewGetSectionPublicUseCase(
 	sectionRepo contracts.SectionRepository,
+	portfolioRepo contracts.PortfolioRepository,
 ) *GetSectionPublicUseCase {
 	return &GetSectionPublicUseCase{
-		sectionRepo: sectionRepo,
+		sectionRepo:   sectionRepo,
+		portfolioRepo: portfolioRepo,
 	}
 }
 
@@ -33,6 +37,9 @@ func (uc *GetSectionPublicUseCase) Execute(ctx context.Context, id uint) (*dto.S
 	if err != nil {
 		return nil, fmt.Errorf("section not found")
 	}
+	if err := publication.Require(ctx, uc.portfolioRepo, section.PortfolioID, "section"); err != nil {
+		return nil, err
+	}
 
 	return section, nil
 }
@@ -25,7 +25,7 @@ func NewListSectionsUseCase(
 // Execute retrieves all sections owned by a user with pagination
 func (uc *ListSectionsUseCase) Execute(ctx context.Context, input dto2.ListSectionsInput) (*dto2.ListSectionsOutput, error) {
 	// Get sections with pagination
-	sections, total, err := uc.sectionRepo.GetByOwnerID(ctx, input.PortfolioID, input.Pagination)
+	sections, total, err := uc.sectionRepo.GetByOwnerID(ctx, input.OwnerID, input.Pagination)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list sections: %w", err)
 	}
@@ -0,0 +1,72 @@
+package section
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	dto2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// ListSectionsByTypeUseCase handles the business logic for listing the caller's sections of one type
+type ListSectionsByTypeUseCase struct {
+	sectionRepo   contracts.SectionRepository
+	portfolioRepo contracts.PortfolioRepository
+}
+
+// NewListSectionsByTypeUseCase creates a new instance of ListSectionsByTypeUseCase
+func NewListSectionsByTypeUseCase(
+	sectionRepo contracts.SectionRepository,
+	portfolioRepo contracts.PortfolioRepository,
+) *ListSectionsByTypeUseCase {
+	return &ListSectionsByTypeUseCase{
+		sectionRepo:   sectionRepo,
+		portfolioRepo: portfolioRepo,
+	}
+}
+
+// Execute retrieves the owner's sections of a type with pagination, optionally within one of their portfolios
+func (uc *ListSectionsByTypeUseCase) Execute(ctx context.Context, input dto2.ListSectionsByTypeInput) (*dto2.ListSectionsOutput, error) {
+	// Validate input
+	if input.OwnerID == "" {
+		return nil, fmt.Errorf("owner ID is required")
+	}
+	input.Type = strings.TrimSpace(input.Type)
+	if input.Type == "" {
+		return nil, fmt.Errorf("section type is required")
+	}
+
+	// Set default pagination if not provided
+	if input.Pagination.Limit == 0 {
+		input.Pagination.Limit = 10
+	}
+	if input.Pagination.Page == 0 {
+		input.Pagination.Page = 1
+	}
+
+	// The portfolio filter must name one of the caller's portfolios
+	if input.PortfolioID != nil {
+		portfolio, err := uc.portfolioRepo.GetByID(ctx, *input.PortfolioID)
+		if err != nil {
+			return nil, fmt.Errorf("portfolio not found")
+		}
+		if portfolio.OwnerID != input.OwnerID {
+			return nil, fmt.Errorf("unauthorized: you don't own this portfolio")
+		}
+	}
+
+	sections, total, err := uc.sectionRepo.GetByType(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sections: %w", err)
+	}
+
+	return &dto2.ListSectionsOutput{
+		Sections: sections,
+		Pagination: dto2.PaginatedResultDTO{
+			Total: total,
+			Page:  input.Pagination.Page,
+			Limit: input.Pagination.Limit,
+		},
+	}, nil
+}
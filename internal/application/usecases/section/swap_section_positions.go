@@ -0,0 +1,94 @@
+package section
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	contracts2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// SwapSectionPositionsUseCase handles the business logic for exchanging the positions of two sections
+type SwapSectionPositionsUseCase struct {
+	sectionRepo   contracts2.SectionRepository
+	portfolioRepo contracts2.PortfolioRepository
+	auditLogger   contracts2.AuditLogger
+}
+
+// NewSwapSectionPositionsUseCase creates a new instance of SwapSectionPositionsUseCase
+func NewSwapSectionPositionsUseCase(
+	sectionRepo contracts2.SectionRepository,
+	portfolioRepo contracts2.PortfolioRepository,
+	auditLogger contracts2.AuditLogger,
+) *SwapSectionPositionsUseCase {
+	return &SwapSectionPositionsUseCase{
+		sectionRepo:   sectionRepo,
+		portfolioRepo: portfolioRepo,
+		auditLogger:   auditLogger,
+	}
+}
+
+// Execute swaps the positions of two sections of the same portfolio with ownership verification
+// Returns both sections with their new positions, in request order
+func (uc *SwapSectionPositionsUseCase) Execute(ctx context.Context, input dto.SwapPositionsInput) ([]dto.SectionDTO, error) {
+	if input.FirstID == 0 || input.SecondID == 0 {
+		return nil, fmt.Errorf("invalid section ID")
+	}
+	if input.OwnerID == "" {
+		return nil, fmt.Errorf("owner ID is required")
+	}
+	if input.FirstID == input.SecondID {
+		return nil, apperrors.New(apperrors.KindValidation, apperrors.CodeValidationInvalid,
+			"cannot swap a section with itself", map[string]interface{}{"field": "second_id"})
+	}
+
+	// Verify both sections exist and share a portfolio
+	sections, err := uc.sectionRepo.GetByIDs(ctx, []uint{input.FirstID, input.SecondID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve sections: %w", err)
+	}
+	if len(sections) != 2 {
+		return nil, fmt.Errorf("section not found")
+	}
+	portfolioID := sections[0].PortfolioID
+	if sections[1].PortfolioID != portfolioID {
+		return nil, apperrors.New(apperrors.KindValidation, apperrors.CodeValidationInvalid,
+			"sections must belong to the same portfolio", map[string]interface{}{"field": "second_id"})
+	}
+
+	// Verify ownership through portfolio
+	portfolio, err := uc.portfolioRepo.GetByID(ctx, portfolioID)
+	if err != nil {
+		return nil, fmt.Errorf("portfolio not found")
+	}
+	if portfolio.OwnerID != input.OwnerID {
+		return nil, fmt.Errorf("unauthorized: you don't own this section")
+	}
+
+	if err := uc.sectionRepo.SwapPositions(ctx, input.FirstID, input.SecondID); err != nil {
+		return nil, fmt.Errorf("failed to swap section positions: %w", err)
+	}
+
+	first, err := uc.sectionRepo.GetByID(ctx, input.FirstID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve swapped section: %w", err)
+	}
+	second, err := uc.sectionRepo.GetByID(ctx, input.SecondID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve swapped section: %w", err)
+	}
+
+	// Audit logging
+	if uc.auditLogger != nil {
+		uc.auditLogger.LogUpdate(ctx, "section", 0, map[string]interface{}{
+			"operation":    "swap_positions",
+			"first_id":     input.FirstID,
+			"second_id":    input.SecondID,
+			"portfolio_id": portfolioID,
+			"owner_id":     input.OwnerID,
+		})
+	}
+
+	return []dto.SectionDTO{*first, *second}, nil
+}
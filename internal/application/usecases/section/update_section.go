@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
 	contracts2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/validation"
 )
 
 // UpdateSectionUseCase handles the business logic for updating a section
@@ -40,11 +42,8 @@ func (uc *UpdateSectionUseCase) Execute(ctx context.Context, input dto.UpdateSec
 	if input.OwnerID == "" {
 		return fmt.Errorf("owner ID is required")
 	}
-	if input.Title == "" {
-		return fmt.Errorf("section title is required")
-	}
-	if input.Type == "" {
-		return fmt.Errorf("section type is required")
+	if err := validation.ValidateSectionUpdate(input).Err(); err != nil {
+		return err
 	}
 
 	// Verify section exists and user owns it
@@ -69,7 +68,8 @@ func (uc *UpdateSectionUseCase) Execute(ctx context.Context, input dto.UpdateSec
 			return fmt.Errorf("failed to check for duplicate title: %w", err)
 		}
 		if isDuplicate {
-			return fmt.Errorf("section with title '%s' already exists in this portfolio", input.Title)
+			return apperrors.DuplicateTitle(apperrors.CodeSectionDuplicateTitle, "section", input.Title,
+				fmt.Sprintf("section with title '%s' already exists in this portfolio", input.Title))
 		}
 	}
 
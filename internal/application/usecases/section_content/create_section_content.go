@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
 	contracts2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
 )
@@ -35,10 +36,10 @@ func NewCreateSectionContentUseCase(
 func (uc *CreateSectionContentUseCase) Execute(ctx context.Context, input dto.CreateSectionContentInput) (*dto.SectionContentDTO, error) {
 	// Validate input
 	if input.SectionID == 0 {
-		return nil, fmt.Errorf("section ID is required")
+		return nil, apperrors.Required("section_id", "section ID is required")
 	}
 	if input.Type == "" {
-		return nil, fmt.Errorf("content type is required")
+		return nil, apperrors.Required("type", "content type is required")
 	}
 	if input.OwnerID == "" {
 		return nil, fmt.Errorf("owner ID is required")
@@ -6,19 +6,26 @@ import (
 
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/publication"
 )
 
 // GetSectionContentPublicUseCase handles the business logic for getting a section content publicly
 type GetSectionContentPublicUseCase struct {
-	contentRepo contracts.SectionContentRepository
+	contentRepo   contracts.SectionContentRepository
+	sectionRepo   contracts.SectionRepository
+	portfolioRepo contracts.PortfolioRepository
 }
 
 // NewGetSectionContentPublicUseCase creates a new instance of GetSectionContentPublicUseCase
 func NewGetSectionContentPublicUseCase(
 	contentRepo contracts.SectionContentRepository,
+	sectionRepo contracts.SectionRepository,
+	portfolioRepo contracts.PortfolioRepository,
 ) *GetSectionContentPublicUseCase {
 	return &GetSectionContentPublicUseCase{
-		contentRepo: contentRepo,
+		contentRepo:   contentRepo,
+		sectionRepo:   sectionRepo,
+		portfolioRepo: portfolioRepo,
 	}
 }
 
@@ -32,6 +39,13 @@ func (uc *GetSectionContentPublicUseCase) Execute(ctx context.Context, id uint)
 	if err != nil {
 		return nil, fmt.Errorf("section content not found")
 	}
+	section, err := uc.sectionRepo.GetByID(ctx, content.SectionID)
+	if err != nil {
+		return nil, fmt.Errorf("section content not found")
+	}
+	if err := publication.Require(ctx, uc.portfolioRepo, section.PortfolioID, "section content"); err != nil {
+		return nil, err
+	}
 
 	return content, nil
 }
@@ -0,0 +1,55 @@
+package section_content
+
+import (
+	"context"
+	"fmt"
+
+	contracts2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// GetSectionContentRevisionUseCase handles retrieving a single revision with its full content
+type GetSectionContentRevisionUseCase struct {
+	contentRepo   contracts2.SectionContentRepository
+	sectionRepo   contracts2.SectionRepository
+	portfolioRepo contracts2.PortfolioRepository
+	revisionRepo  contracts2.SectionContentRevisionRepository
+}
+
+// NewGetSectionContentRevisionUseCase creates a new instance of GetSectionContentRevisionUseCase
+func NewGetSectionContentRevisionUseCase(
+	contentRepo contracts2.SectionContentRepository,
+	sectionRepo contracts2.SectionRepository,
+	portfolioRepo contracts2.PortfolioRepository,
+	revisionRepo contracts2.SectionContentRevisionRepository,
+) *GetSectionContentRevisionUseCase {
+	return &GetSectionContentRevisionUseCase{
+		contentRepo:   contentRepo,
+		sectionRepo:   sectionRepo,
+		portfolioRepo: portfolioRepo,
+		revisionRepo:  revisionRepo,
+	}
+}
+
+// Execute retrieves a revision of a section content owned by the user
+func (uc *GetSectionContentRevisionUseCase) Execute(ctx context.Context, id uint, revisionID uint, ownerID string) (*dto.SectionContentRevisionDTO, error) {
+	// Validate input
+	if id == 0 || revisionID == 0 {
+		return nil, fmt.Errorf("section content ID and revision ID are required")
+	}
+	if ownerID == "" {
+		return nil, fmt.Errorf("owner ID is required")
+	}
+
+	// Verify ownership through section and portfolio
+	if _, err := getOwnedSectionContent(ctx, uc.contentRepo, uc.sectionRepo, uc.portfolioRepo, id, ownerID); err != nil {
+		return nil, err
+	}
+
+	revision, err := uc.revisionRepo.GetByID(ctx, id, revisionID)
+	if err != nil {
+		return nil, fmt.Errorf("revision not found")
+	}
+
+	return revision, nil
+}
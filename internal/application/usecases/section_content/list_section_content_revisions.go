@@ -0,0 +1,55 @@
+package section_content
+
+import (
+	"context"
+	"fmt"
+
+	contracts2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// ListSectionContentRevisionsUseCase handles listing the revisions of a section content
+type ListSectionContentRevisionsUseCase struct {
+	contentRepo   contracts2.SectionContentRepository
+	sectionRepo   contracts2.SectionRepository
+	portfolioRepo contracts2.PortfolioRepository
+	revisionRepo  contracts2.SectionContentRevisionRepository
+}
+
+// NewListSectionContentRevisionsUseCase creates a new instance of ListSectionContentRevisionsUseCase
+func NewListSectionContentRevisionsUseCase(
+	contentRepo contracts2.SectionContentRepository,
+	sectionRepo contracts2.SectionRepository,
+	portfolioRepo contracts2.PortfolioRepository,
+	revisionRepo contracts2.SectionContentRevisionRepository,
+) *ListSectionContentRevisionsUseCase {
+	return &ListSectionContentRevisionsUseCase{
+		contentRepo:   contentRepo,
+		sectionRepo:   sectionRepo,
+		portfolioRepo: portfolioRepo,
+		revisionRepo:  revisionRepo,
+	}
+}
+
+// Execute lists the revisions of a section content owned by the user (newest first)
+func (uc *ListSectionContentRevisionsUseCase) Execute(ctx context.Context, id uint, ownerID string) ([]dto.SectionContentRevisionDTO, error) {
+	// Validate input
+	if id == 0 {
+		return nil, fmt.Errorf("section content ID is required")
+	}
+	if ownerID == "" {
+		return nil, fmt.Errorf("owner ID is required")
+	}
+
+	// Verify ownership through section and portfolio
+	if _, err := getOwnedSectionContent(ctx, uc.contentRepo, uc.sectionRepo, uc.portfolioRepo, id, ownerID); err != nil {
+		return nil, err
+	}
+
+	revisions, err := uc.revisionRepo.ListBySectionContentID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list revisions: %w", err)
+	}
+
+	return revisions, nil
+}
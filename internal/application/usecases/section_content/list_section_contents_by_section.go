@@ -6,28 +6,43 @@ import (
 
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/publication"
 )
 
 // ListSectionContentsBySectionUseCase handles the business logic for listing section contents by section ID
 type ListSectionContentsBySectionUseCase struct {
-	contentRepo contracts.SectionContentRepository
+	contentRepo   contracts.SectionContentRepository
+	sectionRepo   contracts.SectionRepository
+	portfolioRepo contracts.PortfolioRepository
 }
 
 // NewListSectionContentsBySectionUseCase creates a new instance of ListSectionContentsBySectionUseCase
 func NewListSectionContentsBySectionUseCase(
 	contentRepo contracts.SectionContentRepository,
+	sectionRepo contracts.SectionRepository,
+	portfolioRepo contracts.PortfolioRepository,
 ) *ListSectionContentsBySectionUseCase {
 	return &ListSectionContentsBySectionUseCase{
-		contentRepo: contentRepo,
+		contentRepo:   contentRepo,
+		sectionRepo:   sectionRepo,
+		portfolioRepo: portfolioRepo,
 	}
 }
 
-// Execute retrieves all section contents for a specific section
+// Execute retrieves all section contents for a specific section (public access, drafts are not found)
 func (uc *ListSectionContentsBySectionUseCase) Execute(ctx context.Context, sectionID uint) ([]dto.SectionContentDTO, error) {
 	if sectionID == 0 {
 		return nil, fmt.Errorf("section ID is required")
 	}
 
+	section, err := uc.sectionRepo.GetByID(ctx, sectionID)
+	if err != nil {
+		return nil, fmt.Errorf("section not found")
+	}
+	if err := publication.Require(ctx, uc.portfolioRepo, section.PortfolioID, "section"); err != nil {
+		return nil, err
+	}
+
 	contents, err := uc.contentRepo.GetBySectionID(ctx, sectionID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get section contents: %w", err)
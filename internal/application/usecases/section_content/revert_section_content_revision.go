@@ -0,0 +1,97 @@
+package section_content
+
+import (
+	"context"
+	"fmt"
+
+	contracts2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// RevertSectionContentRevisionUseCase handles restoring a section content to a previous revision
+type RevertSectionContentRevisionUseCase struct {
+	contentRepo   contracts2.SectionContentRepository
+	sectionRepo   contracts2.SectionRepository
+	portfolioRepo contracts2.PortfolioRepository
+	revisionRepo  contracts2.SectionContentRevisionRepository
+	txManager     contracts2.TransactionManager
+	auditLogger   contracts2.AuditLogger
+	policy        RevisionPolicy
+}
+
+// NewRevertSectionContentRevisionUseCase creates a new instance of RevertSectionContentRevisionUseCase
+func NewRevertSectionContentRevisionUseCase(
+	contentRepo contracts2.SectionContentRepository,
+	sectionRepo contracts2.SectionRepository,
+	portfolioRepo contracts2.PortfolioRepository,
+	revisionRepo contracts2.SectionContentRevisionRepository,
+	txManager contracts2.TransactionManager,
+	auditLogger contracts2.AuditLogger,
+	policy RevisionPolicy,
+) *RevertSectionContentRevisionUseCase {
+	return &RevertSectionContentRevisionUseCase{
+		contentRepo:   contentRepo,
+		sectionRepo:   sectionRepo,
+		portfolioRepo: portfolioRepo,
+		revisionRepo:  revisionRepo,
+		txManager:     txManager,
+		auditLogger:   auditLogger,
+		policy:        policy,
+	}
+}
+
+// Execute restores the type, content and image of a revision
+// The current state is recorded as a new revision first, so a revert can itself be undone.
+func (uc *RevertSectionContentRevisionUseCase) Execute(ctx context.Context, id uint, revisionID uint, ownerID string) (*dto.SectionContentDTO, error) {
+	// Validate input
+	if id == 0 || revisionID == 0 {
+		return nil, fmt.Errorf("section content ID and revision ID are required")
+	}
+	if ownerID == "" {
+		return nil, fmt.Errorf("owner ID is required")
+	}
+
+	// Verify ownership through section and portfolio
+	content, err := getOwnedSectionContent(ctx, uc.contentRepo, uc.sectionRepo, uc.portfolioRepo, id, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	revision, err := uc.revisionRepo.GetByID(ctx, id, revisionID)
+	if err != nil {
+		return nil, fmt.Errorf("revision not found")
+	}
+
+	// Save the current state regardless of the coalesce window; a failed revert keeps none
+	if err := uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := recordRevision(ctx, uc.revisionRepo, uc.policy, content, true); err != nil {
+			return fmt.Errorf("failed to record revision: %w", err)
+		}
+
+		if err := uc.contentRepo.Update(ctx, dto.UpdateSectionContentInput{
+			ID:      content.ID,
+			Type:    revision.Type,
+			Content: revision.Content,
+			Order:   content.Order,
+			ImageID: revision.ImageID,
+			OwnerID: ownerID,
+		}); err != nil {
+			return fmt.Errorf("failed to revert section content: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// Audit logging
+	if uc.auditLogger != nil {
+		uc.auditLogger.LogUpdate(ctx, "section_content", content.ID, map[string]interface{}{
+			"section_id":  content.SectionID,
+			"revision_id": revision.ID,
+			"reverted":    true,
+			"owner_id":    ownerID,
+		})
+	}
+
+	return uc.contentRepo.GetByID(ctx, content.ID)
+}
@@ -0,0 +1,113 @@
+package section_content
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	contracts2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// Default revision policy values
+const (
+	DefaultMaxRevisions   = 20
+	DefaultCoalesceWindow = 5 * time.Minute
+)
+
+// RevisionPolicy configures how section content revisions are kept
+type RevisionPolicy struct {
+	// MaxRevisions is the number of revisions kept per content block (oldest evicted first)
+	MaxRevisions int
+	// CoalesceWindow skips recording a revision when the latest one is younger than this,
+	// so autosaving editors don't produce one revision per keystroke batch
+	CoalesceWindow time.Duration
+}
+
+// DefaultRevisionPolicy returns the revision policy used when none is configured
+func DefaultRevisionPolicy() RevisionPolicy {
+	return RevisionPolicy{
+		MaxRevisions:   DefaultMaxRevisions,
+		CoalesceWindow: DefaultCoalesceWindow,
+	}
+}
+
+// recordRevision stores the given (previous) state of a section content as a revision
+// Unless force is set, nothing is recorded while the latest revision is inside the coalesce window.
+func recordRevision(
+	ctx context.Context,
+	revisionRepo contracts2.SectionContentRevisionRepository,
+	policy RevisionPolicy,
+	content *dto.SectionContentDTO,
+	force bool,
+) error {
+	if !force && policy.CoalesceWindow > 0 {
+		latest, err := revisionRepo.GetLatest(ctx, content.ID)
+		if err != nil {
+			return err
+		}
+		if latest != nil && time.Since(latest.CreatedAt) < policy.CoalesceWindow {
+			return nil
+		}
+	}
+
+	_, err := revisionRepo.Create(ctx, dto.CreateSectionContentRevisionInput{
+		SectionContentID: content.ID,
+		Type:             content.Type,
+		Content:          content.Content,
+		ImageID:          content.ImageID,
+		OwnerID:          content.OwnerID,
+	}, policy.MaxRevisions)
+	return err
+}
+
+// contentChanged reports whether an update would change the revisioned fields of content
+func contentChanged(content *dto.SectionContentDTO, input dto.UpdateSectionContentInput) bool {
+	return content.Type != input.Type ||
+		!equalStringPtr(content.Content, input.Content) ||
+		!equalUintPtr(content.ImageID, input.ImageID)
+}
+
+// getOwnedSectionContent loads a section content and verifies ownership through section and portfolio
+func getOwnedSectionContent(
+	ctx context.Context,
+	contentRepo contracts2.SectionContentRepository,
+	sectionRepo contracts2.SectionRepository,
+	portfolioRepo contracts2.PortfolioRepository,
+	id uint,
+	ownerID string,
+) (*dto.SectionContentDTO, error) {
+	content, err := contentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("section content not found")
+	}
+
+	section, err := sectionRepo.GetByID(ctx, content.SectionID)
+	if err != nil {
+		return nil, fmt.Errorf("section not found")
+	}
+
+	portfolio, err := portfolioRepo.GetByID(ctx, section.PortfolioID)
+	if err != nil {
+		return nil, fmt.Errorf("portfolio not found")
+	}
+	if portfolio.OwnerID != ownerID {
+		return nil, fmt.Errorf("unauthorized: you don't own this section content")
+	}
+
+	return content, nil
+}
+
+func equalStringPtr(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func equalUintPtr(a, b *uint) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,186 @@
+package section_content
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// revisionStore is an in-memory content block with its revisions
+// Its transaction manager restores both on a failed unit of work, as the database would.
+type revisionStore struct {
+	content    dto.SectionContentDTO
+	revisions  []dto.SectionContentRevisionDTO
+	failUpdate bool
+}
+
+type storeContentRepo struct {
+	contracts.SectionContentRepository
+	store *revisionStore
+}
+
+func (r storeContentRepo) GetByID(_ context.Context, id uint) (*dto.SectionContentDTO, error) {
+	if id != r.store.content.ID {
+		return nil, errors.New("section content not found")
+	}
+	content := r.store.content
+	return &content, nil
+}
+
+func (r storeContentRepo) Update(_ context.Context, input dto.UpdateSectionContentInput) error {
+	if r.store.failUpdate {
+		return errors.New("write failed")
+	}
+	r.store.content.Type, r.store.content.Content, r.store.content.ImageID = input.Type, input.Content, input.ImageID
+	return nil
+}
+
+type storeRevisionRepo struct {
+	contracts.SectionContentRevisionRepository
+	store *revisionStore
+}
+
+func (r storeRevisionRepo) Create(_ context.Context, input dto.CreateSectionContentRevisionInput, _ int) (*dto.SectionContentRevisionDTO, error) {
+	revision := dto.SectionContentRevisionDTO{
+		ID: uint(len(r.store.revisions) + 1), SectionContentID: input.SectionContentID,
+		Type: input.Type, Content: input.Content, ImageID: input.ImageID, CreatedAt: time.Now(),
+	}
+	r.store.revisions = append(r.store.revisions, revision)
+	return &revision, nil
+}
+
+func (r storeRevisionRepo) GetByID(_ context.Context, _ uint, id uint) (*dto.SectionContentRevisionDTO, error) {
+	for _, revision := range r.store.revisions {
+		if revision.ID == id {
+			return &revision, nil
+		}
+	}
+	return nil, errors.New("revision not found")
+}
+
+func (r storeRevisionRepo) GetLatest(context.Context, uint) (*dto.SectionContentRevisionDTO, error) {
+	if len(r.store.revisions) == 0 {
+		return nil, nil
+	}
+	latest := r.store.revisions[len(r.store.revisions)-1]
+	return &latest, nil
+}
+
+type storeTxManager struct{ store *revisionStore }
+
+func (m storeTxManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	content, revisions := m.store.content, append([]dto.SectionContentRevisionDTO(nil), m.store.revisions...)
+	if err := fn(ctx); err != nil {
+		m.store.content, m.store.revisions = content, revisions
+		return err
+	}
+	return nil
+}
+
+// ownerSectionRepo and ownerPortfolioRepo make every section belong to a portfolio of "alice"
+type ownerSectionRepo struct{ contracts.SectionRepository }
+
+func (ownerSectionRepo) GetByID(_ context.Context, id uint) (*dto.SectionDTO, error) {
+	return &dto.SectionDTO{ID: id, PortfolioID: 1}, nil
+}
+
+type ownerPortfolioRepo struct{ contracts.PortfolioRepository }
+
+func (ownerPortfolioRepo) GetByID(_ context.Context, id uint) (*dto.PortfolioDTO, error) {
+	return &dto.PortfolioDTO{ID: id, OwnerID: "alice"}, nil
+}
+
+func newRevisionStore() *revisionStore {
+	text := "v1"
+	return &revisionStore{content: dto.SectionContentDTO{ID: 1, SectionID: 1, Type: "text", Content: &text, OwnerID: "alice"}}
+}
+
+func editText(text string) dto.UpdateSectionContentInput {
+	return dto.UpdateSectionContentInput{ID: 1, Type: "text", Content: &text, OwnerID: "alice"}
+}
+
+func TestUpdateSectionContent_CoalescesRevisions(t *testing.T) {
+	tests := []struct {
+		name   string
+		window time.Duration
+		edits  []string
+		want   []string // contents of the recorded revisions, oldest first
+	}{
+		{name: "autosaves inside the window keep the first previous state", window: time.Hour, edits: []string{"v2", "v3", "v4"}, want: []string{"v1"}},
+		{name: "no window records every change", window: 0, edits: []string{"v2", "v3"}, want: []string{"v1", "v2"}},
+		{name: "saving the same content records nothing", window: 0, edits: []string{"v1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newRevisionStore()
+			uc := NewUpdateSectionContentUseCase(storeContentRepo{store: store}, ownerSectionRepo{}, ownerPortfolioRepo{},
+				storeRevisionRepo{store: store}, storeTxManager{store}, nil, RevisionPolicy{MaxRevisions: 20, CoalesceWindow: tt.window})
+
+			for _, edit := range tt.edits {
+				if err := uc.Execute(context.Background(), editText(edit)); err != nil {
+					t.Fatalf("update to %s: %v", edit, err)
+				}
+			}
+
+			var got []string
+			for _, revision := range store.revisions {
+				got = append(got, *revision.Content)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("revisions = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("revisions = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestRevertSectionContentRevision(t *testing.T) {
+	store := newRevisionStore()
+	policy := RevisionPolicy{MaxRevisions: 20, CoalesceWindow: time.Hour}
+	update := NewUpdateSectionContentUseCase(storeContentRepo{store: store}, ownerSectionRepo{}, ownerPortfolioRepo{},
+		storeRevisionRepo{store: store}, storeTxManager{store}, nil, policy)
+	revert := NewRevertSectionContentRevisionUseCase(storeContentRepo{store: store}, ownerSectionRepo{}, ownerPortfolioRepo{},
+		storeRevisionRepo{store: store}, storeTxManager{store}, nil, policy)
+	ctx := context.Background()
+
+	if err := update.Execute(ctx, editText("v2")); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+
+	// A failed write keeps neither the content change nor the revision of the current state
+	store.failUpdate = true
+	if _, err := revert.Execute(ctx, 1, 1, "alice"); err == nil {
+		t.Fatal("revert with a failing write succeeded, want an error")
+	}
+	if *store.content.Content != "v2" || len(store.revisions) != 1 {
+		t.Fatalf("after the failed revert: content %q with %d revisions, want %q with 1", *store.content.Content, len(store.revisions), "v2")
+	}
+
+	// The revert records the current state even inside the coalesce window, so it can be undone
+	store.failUpdate = false
+	reverted, err := revert.Execute(ctx, 1, 1, "alice")
+	if err != nil {
+		t.Fatalf("revert: %v", err)
+	}
+	if *reverted.Content != "v1" || len(store.revisions) != 2 || *store.revisions[1].Content != "v2" {
+		t.Errorf("after the revert: content %q with %d revisions, want %q and the v2 state recorded", *reverted.Content, len(store.revisions), "v1")
+	}
+
+	// Nor does a failed update keep its revision
+	store.revisions, store.failUpdate = nil, true
+	if err := update.Execute(ctx, editText("v3")); err == nil {
+		t.Fatal("update with a failing write succeeded, want an error")
+	}
+	if len(store.revisions) != 0 {
+		t.Errorf("revisions after the failed update = %d, want 0", len(store.revisions))
+	}
+}
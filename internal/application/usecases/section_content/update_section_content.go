@@ -13,7 +13,10 @@ type UpdateSectionContentUseCase struct {
 	contentRepo   contracts2.SectionContentRepository
 	sectionRepo   contracts2.SectionRepository
 	portfolioRepo contracts2.PortfolioRepository
+	revisionRepo  contracts2.SectionContentRevisionRepository
+	txManager     contracts2.TransactionManager
 	auditLogger   contracts2.AuditLogger
+	policy        RevisionPolicy
 }
 
 // NewUpdateSectionContentUseCase creates a new instance of UpdateSectionContentUseCase
@@ -21,13 +24,19 @@ func NewUpdateSectionContentUseCase(
 	contentRepo contracts2.SectionContentRepository,
 	sectionRepo contracts2.SectionRepository,
 	portfolioRepo contracts2.PortfolioRepository,
+	revisionRepo contracts2.SectionContentRevisionRepository,
+	txManager contracts2.TransactionManager,
 	auditLogger contracts2.AuditLogger,
+	policy RevisionPolicy,
 ) *UpdateSectionContentUseCase {
 	return &UpdateSectionContentUseCase{
 		contentRepo:   contentRepo,
 		sectionRepo:   sectionRepo,
 		portfolioRepo: portfolioRepo,
+		revisionRepo:  revisionRepo,
+		txManager:     txManager,
 		auditLogger:   auditLogger,
+		policy:        policy,
 	}
 }
 
@@ -61,9 +70,21 @@ func (uc *UpdateSectionContentUseCase) Execute(ctx context.Context, input dto.Up
 		return fmt.Errorf("unauthorized: you don't own this section content")
 	}
 
-	// Update the section content
-	if err := uc.contentRepo.Update(ctx, input); err != nil {
-		return fmt.Errorf("failed to update section content: %w", err)
+	// Keep the previous state as a revision (coalesced for autosaves); a failed update keeps none
+	if err := uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if uc.revisionRepo != nil && contentChanged(content, input) {
+			if err := recordRevision(ctx, uc.revisionRepo, uc.policy, content, false); err != nil {
+				return fmt.Errorf("failed to record revision: %w", err)
+			}
+		}
+
+		// Update the section content
+		if err := uc.contentRepo.Update(ctx, input); err != nil {
+			return fmt.Errorf("failed to update section content: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return err
 	}
 
 	// Audit logging
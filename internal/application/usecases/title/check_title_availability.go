@@ -0,0 +1,108 @@
+package title
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/titles"
+)
+
+// CheckTitleAvailabilityUseCase tells create/edit forms whether a title is free within its parent.
+// It is read-only: nothing is reserved, the create itself still decides.
+type CheckTitleAvailabilityUseCase struct {
+	titleRepo     contracts.TitleRepository
+	portfolioRepo contracts.PortfolioRepository
+	categoryRepo  contracts.CategoryRepository
+}
+
+// NewCheckTitleAvailabilityUseCase creates a new instance of CheckTitleAvailabilityUseCase
+func NewCheckTitleAvailabilityUseCase(
+	titleRepo contracts.TitleRepository,
+	portfolioRepo contracts.PortfolioRepository,
+	categoryRepo contracts.CategoryRepository,
+) *CheckTitleAvailabilityUseCase {
+	return &CheckTitleAvailabilityUseCase{
+		titleRepo:     titleRepo,
+		portfolioRepo: portfolioRepo,
+		categoryRepo:  categoryRepo,
+	}
+}
+
+// Execute checks the title within the parent of the resource and suggests a free variant when it is taken
+// Titles are trimmed, then compared exactly, like the duplicate checks on create.
+func (uc *CheckTitleAvailabilityUseCase) Execute(ctx context.Context, input dto.CheckTitleInput) (*dto.TitleAvailabilityDTO, error) {
+	// Validate input
+	if input.OwnerID == "" {
+		return nil, fmt.Errorf("owner ID is required")
+	}
+	input.Title = strings.TrimSpace(input.Title)
+	if input.Title == "" {
+		return nil, apperrors.Required("title", "title is required")
+	}
+
+	// Verify the caller owns the parent that scopes the title
+	if err := uc.verifyParent(ctx, input); err != nil {
+		return nil, err
+	}
+
+	variants, err := uc.titleRepo.FindTitleVariants(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check title: %w", err)
+	}
+
+	conflictingID, taken := variants[input.Title]
+	if !taken {
+		return &dto.TitleAvailabilityDTO{Available: true}, nil
+	}
+
+	used := make(map[string]bool, len(variants))
+	for title := range variants {
+		used[title] = true
+	}
+	suggested := titles.Unique(input.Title, used)
+
+	return &dto.TitleAvailabilityDTO{
+		Available:     false,
+		ConflictingID: &conflictingID,
+		Suggested:     &suggested,
+	}, nil
+}
+
+// verifyParent checks the parent ID is set (except for portfolios) and owned by the caller
+func (uc *CheckTitleAvailabilityUseCase) verifyParent(ctx context.Context, input dto.CheckTitleInput) error {
+	portfolioID := input.ParentID
+
+	switch input.Resource {
+	case dto.TitleResourcePortfolios:
+		return nil
+	case dto.TitleResourceCategories, dto.TitleResourceSections:
+		if input.ParentID == 0 {
+			return apperrors.Required("parent_id", "parent_id (portfolio ID) is required")
+		}
+	case dto.TitleResourceProjects:
+		if input.ParentID == 0 {
+			return apperrors.Required("parent_id", "parent_id (category ID) is required")
+		}
+		category, err := uc.categoryRepo.GetByID(ctx, input.ParentID)
+		if err != nil {
+			return fmt.Errorf("category not found")
+		}
+		portfolioID = category.PortfolioID
+	default:
+		return fmt.Errorf("unknown title resource %q", input.Resource)
+	}
+
+	portfolio, err := uc.portfolioRepo.GetByID(ctx, portfolioID)
+	if err != nil {
+		return fmt.Errorf("portfolio not found")
+	}
+	if portfolio.OwnerID != input.OwnerID {
+		return fmt.Errorf("unauthorized: you don't own this portfolio")
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package title
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// variantTitleRepo returns the titles of the scope that equal the title or are suffixed variants of it
+type variantTitleRepo struct {
+	titles  map[string]uint
+	queried []dto.CheckTitleInput
+}
+
+func (r *variantTitleRepo) FindTitleVariants(_ context.Context, input dto.CheckTitleInput) (map[string]uint, error) {
+	r.queried = append(r.queried, input)
+	variants := make(map[string]uint)
+	for title, id := range r.titles {
+		if title == input.Title || strings.HasPrefix(title, input.Title+" (") {
+			variants[title] = id
+		}
+	}
+	return variants, nil
+}
+
+// ownersPortfolioRepo gives portfolio 1 to "alice" and portfolio 2 to "bob"
+type ownersPortfolioRepo struct{ contracts.PortfolioRepository }
+
+func (ownersPortfolioRepo) GetByID(_ context.Context, id uint) (*dto.PortfolioDTO, error) {
+	switch id {
+	case 1:
+		return &dto.PortfolioDTO{ID: 1, OwnerID: "alice"}, nil
+	case 2:
+		return &dto.PortfolioDTO{ID: 2, OwnerID: "bob"}, nil
+	}
+	return nil, errors.New("portfolio not found")
+}
+
+// portfolioCategoryRepo puts category 10 in portfolio 1 and category 20 in portfolio 2
+type portfolioCategoryRepo struct{ contracts.CategoryRepository }
+
+func (portfolioCategoryRepo) GetByID(_ context.Context, id uint) (*dto.CategoryDTO, error) {
+	switch id {
+	case 10:
+		return &dto.CategoryDTO{ID: 10, PortfolioID: 1}, nil
+	case 20:
+		return &dto.CategoryDTO{ID: 20, PortfolioID: 2}, nil
+	}
+	return nil, errors.New("category not found")
+}
+
+func TestCheckTitleAvailability(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         dto.CheckTitleInput
+		wantErr       bool
+		wantAvailable bool
+		wantSuggested string
+		wantConflict  uint
+	}{
+		{name: "free portfolio title", input: dto.CheckTitleInput{Resource: dto.TitleResourcePortfolios, Title: "Work"}, wantAvailable: true},
+		{name: "taken portfolio title", input: dto.CheckTitleInput{Resource: dto.TitleResourcePortfolios, Title: "Blog"}, wantSuggested: "Blog (3)", wantConflict: 5},
+		{name: "title is trimmed", input: dto.CheckTitleInput{Resource: dto.TitleResourcePortfolios, Title: "  Blog \t"}, wantSuggested: "Blog (3)", wantConflict: 5},
+		{name: "suffixed title taken", input: dto.CheckTitleInput{Resource: dto.TitleResourcePortfolios, Title: "Blog (2)"}, wantSuggested: "Blog (2) (2)", wantConflict: 6},
+		{name: "category in own portfolio", input: dto.CheckTitleInput{Resource: dto.TitleResourceCategories, Title: "Work", ParentID: 1}, wantAvailable: true},
+		{name: "section without a portfolio", input: dto.CheckTitleInput{Resource: dto.TitleResourceSections, Title: "Work"}, wantErr: true},
+		{name: "section in another owner's portfolio", input: dto.CheckTitleInput{Resource: dto.TitleResourceSections, Title: "Work", ParentID: 2}, wantErr: true},
+		{name: "project in own category", input: dto.CheckTitleInput{Resource: dto.TitleResourceProjects, Title: "Blog", ParentID: 10}, wantSuggested: "Blog (3)", wantConflict: 5},
+		{name: "project in another owner's category", input: dto.CheckTitleInput{Resource: dto.TitleResourceProjects, Title: "Work", ParentID: 20}, wantErr: true},
+		{name: "unknown resource", input: dto.CheckTitleInput{Resource: "links", Title: "Work"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &variantTitleRepo{titles: map[string]uint{"Blog": 5, "Blog (2)": 6, "Work log": 7}}
+			uc := NewCheckTitleAvailabilityUseCase(repo, ownersPortfolioRepo{}, portfolioCategoryRepo{})
+			input := tt.input
+			input.OwnerID = "alice"
+
+			got, err := uc.Execute(context.Background(), input)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Execute = %+v, want an error", got)
+				}
+				// Nothing about another owner's titles is looked up
+				if len(repo.queried) != 0 {
+					t.Errorf("titles looked up for a rejected check: %+v", repo.queried)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+
+			if got.Available != tt.wantAvailable {
+				t.Errorf("Available = %v, want %v", got.Available, tt.wantAvailable)
+			}
+			if tt.wantAvailable {
+				if got.ConflictingID != nil || got.Suggested != nil {
+					t.Errorf("free title has conflict %v and suggestion %v", got.ConflictingID, got.Suggested)
+				}
+				return
+			}
+			if got.ConflictingID == nil || *got.ConflictingID != tt.wantConflict {
+				t.Errorf("ConflictingID = %v, want %d", got.ConflictingID, tt.wantConflict)
+			}
+			if got.Suggested == nil || *got.Suggested != tt.wantSuggested {
+				t.Errorf("Suggested = %v, want %q", got.Suggested, tt.wantSuggested)
+			}
+		})
+	}
+}
+
+func TestCheckTitleAvailability_RequiresTitle(t *testing.T) {
+	uc := NewCheckTitleAvailabilityUseCase(&variantTitleRepo{}, ownersPortfolioRepo{}, portfolioCategoryRepo{})
+	_, err := uc.Execute(context.Background(), dto.CheckTitleInput{Resource: dto.TitleResourcePortfolios, Title: " ", OwnerID: "alice"})
+	if appErr, ok := apperrors.As(err); !ok || appErr.Code != apperrors.CodeValidationRequired {
+		t.Errorf("error = %v, want %s", err, apperrors.CodeValidationRequired)
+	}
+}
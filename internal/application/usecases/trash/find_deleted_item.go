@@ -0,0 +1,34 @@
+package trash
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// FindDeletedItemUseCase tells whether an item an owner can't find is in their trash,
+// so "deleted" can be told apart from "never existed"
+type FindDeletedItemUseCase struct {
+	trashRepo contracts.TrashRepository
+}
+
+// NewFindDeletedItemUseCase creates a new instance of FindDeletedItemUseCase
+func NewFindDeletedItemUseCase(trashRepo contracts.TrashRepository) *FindDeletedItemUseCase {
+	return &FindDeletedItemUseCase{trashRepo: trashRepo}
+}
+
+// Execute returns the owner's soft-deleted item, or nil when it is not in their trash
+// Other users' items are never reported, whatever their state.
+func (uc *FindDeletedItemUseCase) Execute(ctx context.Context, resource string, id uint, ownerID string) (*dto.DeletedItemDTO, error) {
+	if ownerID == "" || id == 0 {
+		return nil, nil
+	}
+
+	item, err := uc.trashRepo.FindDeleted(ctx, resource, id, ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up deleted item: %w", err)
+	}
+	return item, nil
+}
@@ -0,0 +1,39 @@
+package trash
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+)
+
+// DefaultRetentionDays is how long deleted items stay restorable by default
+const DefaultRetentionDays = 30
+
+// PurgeExpiredTrashUseCase permanently deletes the items that stayed in the trash past the retention period
+type PurgeExpiredTrashUseCase struct {
+	trashRepo contracts.TrashRepository
+	retention time.Duration
+}
+
+// NewPurgeExpiredTrashUseCase creates a new instance of PurgeExpiredTrashUseCase
+// retentionDays <= 0 falls back to DefaultRetentionDays.
+func NewPurgeExpiredTrashUseCase(trashRepo contracts.TrashRepository, retentionDays int) *PurgeExpiredTrashUseCase {
+	if retentionDays <= 0 {
+		retentionDays = DefaultRetentionDays
+	}
+	return &PurgeExpiredTrashUseCase{
+		trashRepo: trashRepo,
+		retention: time.Duration(retentionDays) * 24 * time.Hour,
+	}
+}
+
+// Execute purges the expired items and returns how many rows were deleted
+func (uc *PurgeExpiredTrashUseCase) Execute(ctx context.Context) (int64, error) {
+	purged, err := uc.trashRepo.PurgeDeletedBefore(ctx, time.Now().Add(-uc.retention))
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge expired trash: %w", err)
+	}
+	return purged, nil
+}
@@ -0,0 +1,43 @@
+package trash
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+)
+
+type cutoffTrashRepo struct {
+	contracts.TrashRepository
+	cutoff time.Time
+}
+
+func (r *cutoffTrashRepo) PurgeDeletedBefore(_ context.Context, cutoff time.Time) (int64, error) {
+	r.cutoff = cutoff
+	return 3, nil
+}
+
+func TestPurgeExpiredTrashUseCase_Cutoff(t *testing.T) {
+	tests := []struct {
+		name          string
+		retentionDays int
+		wantAge       time.Duration
+	}{
+		{name: "configured retention", retentionDays: 7, wantAge: 7 * 24 * time.Hour},
+		{name: "unset retention", retentionDays: 0, wantAge: DefaultRetentionDays * 24 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &cutoffTrashRepo{}
+			purged, err := NewPurgeExpiredTrashUseCase(repo, tt.retentionDays).Execute(context.Background())
+			if err != nil || purged != 3 {
+				t.Fatalf("Execute() = %d, %v, want 3 purged rows", purged, err)
+			}
+			if age := time.Since(repo.cutoff); age < tt.wantAge || age > tt.wantAge+time.Minute {
+				t.Errorf("cutoff is %v old, want %v", age, tt.wantAge)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// Bootstrap limits
+const (
+	BootstrapTimeout       = 5 * time.Second // Sections still running after this are reported as failed
+	BootstrapActivityLimit = 20              // Recent activity items returned
+)
+
+// GetBootstrapUseCase handles the business logic for reading everything the app shell needs after login
+type GetBootstrapUseCase struct {
+	userRepo      contracts.UserRepository
+	settingsRepo  contracts.UserSettingsRepository
+	portfolioRepo contracts.PortfolioRepository
+	changeBus     contracts.ChangeEventBus
+}
+
+// NewGetBootstrapUseCase creates a new instance of GetBootstrapUseCase
+func NewGetBootstrapUseCase(
+	userRepo contracts.UserRepository,
+	settingsRepo contracts.UserSettingsRepository,
+	portfolioRepo contracts.PortfolioRepository,
+	changeBus contracts.ChangeEventBus,
+) *GetBootstrapUseCase {
+	return &GetBootstrapUseCase{
+		userRepo:      userRepo,
+		settingsRepo:  settingsRepo,
+		portfolioRepo: portfolioRepo,
+		changeBus:     changeBus,
+	}
+}
+
+// Execute reads every bootstrap section in parallel
+// A failing section is marked in Sections and left empty; it never fails the whole bootstrap.
+func (uc *GetBootstrapUseCase) Execute(ctx context.Context, userID string) (*dto.BootstrapDTO, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("user ID is required")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, BootstrapTimeout)
+	defer cancel()
+
+	bootstrap := &dto.BootstrapDTO{
+		Portfolios: []dto.PortfolioSummaryDTO{},
+		Activity:   []dto.ChangeEventDTO{},
+		Sections:   make(map[string]dto.BootstrapSectionStatusDTO),
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	run := func(section string, read func(ctx context.Context) error) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			err := read(ctx)
+			if err != nil {
+				log.Printf("⚠️ Bootstrap section %s failed for user %s: %v", section, userID, err)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			bootstrap.Sections[section] = dto.BootstrapSectionStatusDTO{FetchedAt: time.Now(), Err: err}
+		}()
+	}
+
+	// Each section writes only its own field, so only the status map needs the lock
+	run(dto.BootstrapSectionUser, func(ctx context.Context) error {
+		user, err := uc.userRepo.GetByID(ctx, userID)
+		if err != nil {
+			return err
+		}
+		bootstrap.User = user
+		return nil
+	})
+	run(dto.BootstrapSectionSettings, func(ctx context.Context) error {
+		settings, err := uc.settingsRepo.Get(ctx, userID)
+		if err != nil {
+			return err
+		}
+		bootstrap.Settings = settings
+		return nil
+	})
+	run(dto.BootstrapSectionPortfolios, func(ctx context.Context) error {
+		summaries, err := uc.portfolioRepo.GetSummariesByOwnerID(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if summaries != nil {
+			bootstrap.Portfolios = summaries
+		}
+		return nil
+	})
+	run(dto.BootstrapSectionActivity, func(ctx context.Context) error {
+		// In-memory: empty after a restart until the user changes something again
+		bootstrap.Activity = uc.changeBus.Recent(userID, BootstrapActivityLimit)
+		return nil
+	})
+
+	wg.Wait()
+
+	return bootstrap, nil
+}
@@ -0,0 +1,102 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+var errBootstrapRead = errors.New("read failed")
+
+// bootstrapUserRepo returns a user, along with errBootstrapRead when fail is set
+type bootstrapUserRepo struct {
+	contracts.UserRepository
+	fail bool
+}
+
+func (r *bootstrapUserRepo) GetByID(_ context.Context, userID string) (*dto.UserDTO, error) {
+	if r.fail {
+		return &dto.UserDTO{ID: userID}, errBootstrapRead
+	}
+	return &dto.UserDTO{ID: userID}, nil
+}
+
+// bootstrapSettingsRepo returns settings, along with errBootstrapRead when fail is set
+type bootstrapSettingsRepo struct {
+	contracts.UserSettingsRepository
+	fail bool
+}
+
+func (r *bootstrapSettingsRepo) Get(_ context.Context, userID string) (*dto.UserSettingsDTO, error) {
+	if r.fail {
+		return &dto.UserSettingsDTO{UserID: userID}, errBootstrapRead
+	}
+	return &dto.UserSettingsDTO{UserID: userID}, nil
+}
+
+// bootstrapPortfolioRepo returns one summary, along with errBootstrapRead when fail is set
+type bootstrapPortfolioRepo struct {
+	contracts.PortfolioRepository
+	fail bool
+}
+
+func (r *bootstrapPortfolioRepo) GetSummariesByOwnerID(context.Context, string) ([]dto.PortfolioSummaryDTO, error) {
+	summaries := []dto.PortfolioSummaryDTO{{ID: 1, Title: "partial"}}
+	if r.fail {
+		return summaries, errBootstrapRead
+	}
+	return summaries, nil
+}
+
+// bootstrapChangeBus has one recent event per user
+type bootstrapChangeBus struct {
+	contracts.ChangeEventBus
+}
+
+func (bootstrapChangeBus) Recent(string, int) []dto.ChangeEventDTO {
+	return []dto.ChangeEventDTO{{ID: 1, Resource: "portfolio", ResourceID: 1}}
+}
+
+func TestGetBootstrapUseCase_FailedSectionServesNothing(t *testing.T) {
+	for _, failing := range []string{dto.BootstrapSectionUser, dto.BootstrapSectionSettings, dto.BootstrapSectionPortfolios} {
+		t.Run(failing, func(t *testing.T) {
+			uc := NewGetBootstrapUseCase(
+				&bootstrapUserRepo{fail: failing == dto.BootstrapSectionUser},
+				&bootstrapSettingsRepo{fail: failing == dto.BootstrapSectionSettings},
+				&bootstrapPortfolioRepo{fail: failing == dto.BootstrapSectionPortfolios},
+				bootstrapChangeBus{},
+			)
+
+			bootstrap, err := uc.Execute(context.Background(), "user-1")
+			if err != nil {
+				t.Fatalf("Execute: %v (a failing section never fails the bootstrap)", err)
+			}
+
+			// Data returned along with the error is not served
+			loaded := map[string]bool{
+				dto.BootstrapSectionUser:       bootstrap.User != nil,
+				dto.BootstrapSectionSettings:   bootstrap.Settings != nil,
+				dto.BootstrapSectionPortfolios: len(bootstrap.Portfolios) > 0,
+				dto.BootstrapSectionActivity:   len(bootstrap.Activity) > 0,
+			}
+			for section, ok := range loaded {
+				status, read := bootstrap.Sections[section]
+				if !read || status.FetchedAt.IsZero() {
+					t.Errorf("%s: no status, want one for every section", section)
+				}
+				if section == failing {
+					if ok || !errors.Is(status.Err, errBootstrapRead) {
+						t.Errorf("%s: loaded = %v, err = %v, want no data and the read error", section, ok, status.Err)
+					}
+					continue
+				}
+				if !ok || status.Err != nil {
+					t.Errorf("%s: loaded = %v, err = %v, want the data of a section that succeeded", section, ok, status.Err)
+				}
+			}
+		})
+	}
+}
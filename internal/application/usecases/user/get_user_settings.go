@@ -0,0 +1,35 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// GetUserSettingsUseCase handles the business logic for getting the current user's settings
+type GetUserSettingsUseCase struct {
+	settingsRepo contracts.UserSettingsRepository
+}
+
+// NewGetUserSettingsUseCase creates a new instance of GetUserSettingsUseCase
+func NewGetUserSettingsUseCase(settingsRepo contracts.UserSettingsRepository) *GetUserSettingsUseCase {
+	return &GetUserSettingsUseCase{
+		settingsRepo: settingsRepo,
+	}
+}
+
+// Execute retrieves the current user's settings
+func (uc *GetUserSettingsUseCase) Execute(ctx context.Context, userID string) (*dto.UserSettingsDTO, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("user ID is required")
+	}
+
+	settings, err := uc.settingsRepo.Get(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user settings: %w", err)
+	}
+
+	return settings, nil
+}
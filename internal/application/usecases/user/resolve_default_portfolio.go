@@ -0,0 +1,58 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// ResolveDefaultPortfolioUseCase handles the business logic for resolving the portfolio a user's profile points to
+type ResolveDefaultPortfolioUseCase struct {
+	settingsRepo  contracts.UserSettingsRepository
+	portfolioRepo contracts.PortfolioRepository
+}
+
+// NewResolveDefaultPortfolioUseCase creates a new instance of ResolveDefaultPortfolioUseCase
+func NewResolveDefaultPortfolioUseCase(
+	settingsRepo contracts.UserSettingsRepository,
+	portfolioRepo contracts.PortfolioRepository,
+) *ResolveDefaultPortfolioUseCase {
+	return &ResolveDefaultPortfolioUseCase{
+		settingsRepo:  settingsRepo,
+		portfolioRepo: portfolioRepo,
+	}
+}
+
+// Execute resolves the user's default portfolio
+// The explicit default wins while it still exists and belongs to the user; otherwise a user
+// with exactly one portfolio defaults to it. Anyone else has no default (not found).
+func (uc *ResolveDefaultPortfolioUseCase) Execute(ctx context.Context, userID string) (*dto.DefaultPortfolioDTO, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("user ID is required")
+	}
+
+	settings, err := uc.settingsRepo.Get(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user settings: %w", err)
+	}
+
+	if settings.DefaultPortfolioID != nil {
+		portfolio, err := uc.portfolioRepo.GetByID(ctx, *settings.DefaultPortfolioID)
+		if err == nil && portfolio.OwnerID == userID {
+			return &dto.DefaultPortfolioDTO{Portfolio: *portfolio, Explicit: true}, nil
+		}
+	}
+
+	// Two rows are enough to tell "exactly one" apart
+	portfolios, total, err := uc.portfolioRepo.GetByOwnerID(ctx, userID, dto.PaginationDTO{Page: 1, Limit: 2})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list portfolios: %w", err)
+	}
+	if total != 1 || len(portfolios) != 1 {
+		return nil, fmt.Errorf("default portfolio not found")
+	}
+
+	return &dto.DefaultPortfolioDTO{Portfolio: portfolios[0]}, nil
+}
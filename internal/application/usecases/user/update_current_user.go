@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
 	contracts2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
 )
@@ -38,7 +39,7 @@ func (uc *UpdateCurrentUserUseCase) Execute(ctx context.Context, input UpdateCur
 		return nil, fmt.Errorf("user ID is required")
 	}
 	if input.Name == "" {
-		return nil, fmt.Errorf("name is required")
+		return nil, apperrors.Required("name", "name is required")
 	}
 
 	// Verify user exists before update
@@ -0,0 +1,113 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/validation"
+)
+
+// UpdateProjectDefaultsUseCase handles the business logic for updating the current user's new-project defaults
+type UpdateProjectDefaultsUseCase struct {
+	settingsRepo contracts.UserSettingsRepository
+	categoryRepo contracts.CategoryRepository
+	auditLogger  contracts.AuditLogger
+}
+
+// NewUpdateProjectDefaultsUseCase creates a new instance of UpdateProjectDefaultsUseCase
+func NewUpdateProjectDefaultsUseCase(
+	settingsRepo contracts.UserSettingsRepository,
+	categoryRepo contracts.CategoryRepository,
+	auditLogger contracts.AuditLogger,
+) *UpdateProjectDefaultsUseCase {
+	return &UpdateProjectDefaultsUseCase{
+		settingsRepo: settingsRepo,
+		categoryRepo: categoryRepo,
+		auditLogger:  auditLogger,
+	}
+}
+
+// Execute merges the given fields into the stored project defaults
+func (uc *UpdateProjectDefaultsUseCase) Execute(ctx context.Context, input dto.UpdateProjectDefaultsInput) (*dto.UserSettingsDTO, error) {
+	// Validate input
+	if input.UserID == "" {
+		return nil, fmt.Errorf("user ID is required")
+	}
+
+	settings, err := uc.settingsRepo.Get(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user settings: %w", err)
+	}
+	defaults := settings.ProjectDefaults
+
+	if input.Client != nil {
+		defaults.Client = input.Client
+		if *input.Client == "" {
+			defaults.Client = nil
+		}
+	}
+	if input.Skills != nil {
+		defaults.Skills = input.Skills
+	}
+	if input.Categories != nil {
+		if len(input.Categories) == 0 {
+			defaults.Categories = nil
+		}
+		for portfolioID, categoryID := range input.Categories {
+			if categoryID == 0 {
+				delete(defaults.Categories, portfolioID)
+				continue
+			}
+			if defaults.Categories == nil {
+				defaults.Categories = make(map[uint]uint)
+			}
+			defaults.Categories[portfolioID] = categoryID
+		}
+	}
+
+	if err := validation.ValidateProjectDefaults(defaults).Err(); err != nil {
+		return nil, err
+	}
+
+	// Pinned categories must be the user's own and belong to the portfolio they're pinned for
+	for portfolioID, categoryID := range input.Categories {
+		if categoryID == 0 {
+			continue
+		}
+		category, err := uc.categoryRepo.GetByID(ctx, categoryID)
+		if err != nil {
+			return nil, fmt.Errorf("category not found")
+		}
+		if category.OwnerID != input.UserID {
+			return nil, fmt.Errorf("unauthorized: you don't own this category")
+		}
+		if category.PortfolioID != portfolioID {
+			return nil, apperrors.New(apperrors.KindValidation, apperrors.CodeValidationInvalid,
+				fmt.Sprintf("category %d does not belong to portfolio %d", categoryID, portfolioID),
+				map[string]interface{}{"field": "categories"})
+		}
+	}
+
+	if err := uc.settingsRepo.SetProjectDefaults(ctx, input.UserID, defaults); err != nil {
+		return nil, fmt.Errorf("failed to update project defaults: %w", err)
+	}
+
+	settings, err = uc.settingsRepo.Get(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve updated user settings: %w", err)
+	}
+
+	// Audit logging
+	if uc.auditLogger != nil {
+		// Settings are keyed by user, not by a numeric ID
+		uc.auditLogger.LogUpdate(ctx, "user_settings", 0, map[string]interface{}{
+			"user_id":          input.UserID,
+			"project_defaults": true,
+		})
+	}
+
+	return settings, nil
+}
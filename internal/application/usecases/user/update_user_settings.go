@@ -0,0 +1,68 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// UpdateUserSettingsUseCase handles the business logic for updating the current user's settings
+type UpdateUserSettingsUseCase struct {
+	settingsRepo  contracts.UserSettingsRepository
+	portfolioRepo contracts.PortfolioRepository
+	auditLogger   contracts.AuditLogger
+}
+
+// NewUpdateUserSettingsUseCase creates a new instance of UpdateUserSettingsUseCase
+func NewUpdateUserSettingsUseCase(
+	settingsRepo contracts.UserSettingsRepository,
+	portfolioRepo contracts.PortfolioRepository,
+	auditLogger contracts.AuditLogger,
+) *UpdateUserSettingsUseCase {
+	return &UpdateUserSettingsUseCase{
+		settingsRepo:  settingsRepo,
+		portfolioRepo: portfolioRepo,
+		auditLogger:   auditLogger,
+	}
+}
+
+// Execute updates the current user's settings
+func (uc *UpdateUserSettingsUseCase) Execute(ctx context.Context, input dto.UpdateUserSettingsInput) (*dto.UserSettingsDTO, error) {
+	// Validate input
+	if input.UserID == "" {
+		return nil, fmt.Errorf("user ID is required")
+	}
+
+	// The default portfolio must be one of the user's own
+	if input.DefaultPortfolioID != nil {
+		portfolio, err := uc.portfolioRepo.GetByID(ctx, *input.DefaultPortfolioID)
+		if err != nil {
+			return nil, fmt.Errorf("portfolio not found")
+		}
+		if portfolio.OwnerID != input.UserID {
+			return nil, fmt.Errorf("unauthorized: you don't own this portfolio")
+		}
+	}
+
+	if err := uc.settingsRepo.SetDefaultPortfolio(ctx, input.UserID, input.DefaultPortfolioID); err != nil {
+		return nil, fmt.Errorf("failed to update user settings: %w", err)
+	}
+
+	settings, err := uc.settingsRepo.Get(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve updated user settings: %w", err)
+	}
+
+	// Audit logging
+	if uc.auditLogger != nil {
+		// Settings are keyed by user, not by a numeric ID
+		uc.auditLogger.LogUpdate(ctx, "user_settings", 0, map[string]interface{}{
+			"user_id":              input.UserID,
+			"default_portfolio_id": input.DefaultPortfolioID,
+		})
+	}
+
+	return settings, nil
+}
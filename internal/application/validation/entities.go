@@ -0,0 +1,172 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// Field limits shared with the request binding tags
+const (
+	MaxTitleLength       = 255
+	MaxDescriptionLength = 1000
+	MaxTypeLength        = 50
+	MaxClientLength      = 255
+)
+
+// ValidatePortfolio validates the client fields of a new portfolio
+func ValidatePortfolio(input dto.CreatePortfolioInput) Violations {
+	return Evaluate(
+		Required("title", input.Title, "title is required"),
+		MaxLength("title", input.Title, MaxTitleLength, "title cannot exceed 255 characters"),
+		MaxLength("description", input.Description, MaxDescriptionLength, "description cannot exceed 1000 characters"),
+	)
+}
+
+// ValidatePortfolioUpdate validates a portfolio update (an empty title keeps the current one)
+func ValidatePortfolioUpdate(input dto.UpdatePortfolioInput) Violations {
+	return Evaluate(
+		MaxLength("title", input.Title, MaxTitleLength, "title cannot exceed 255 characters"),
+		MaxLength("description", input.Description, MaxDescriptionLength, "description cannot exceed 1000 characters"),
+	)
+}
+
+// ValidatePortfolioPatch validates a partial portfolio update: only the provided fields are checked
+func ValidatePortfolioPatch(input dto.PatchPortfolioInput) Violations {
+	rules := []Rule{OptionalMaxLength("description", input.Description, MaxDescriptionLength, "description cannot exceed 1000 characters")}
+	if input.Title != nil {
+		rules = append(rules,
+			Required("title", *input.Title, "title is required"),
+			MaxLength("title", *input.Title, MaxTitleLength, "title cannot exceed 255 characters"),
+		)
+	}
+	return Evaluate(rules...)
+}
+
+// ValidateCategory validates the client fields of a new category
+func ValidateCategory(input dto.CreateCategoryInput) Violations {
+	return Evaluate(
+		Required("title", input.Title, "category title is required"),
+		MaxLength("title", input.Title, MaxTitleLength, "category title cannot exceed 255 characters"),
+		OptionalMaxLength("description", input.Description, MaxDescriptionLength, "category description cannot exceed 1000 characters"),
+		RequiredID("portfolio_id", input.PortfolioID, "portfolio ID is required"),
+	)
+}
+
+// ValidateCategoryUpdate validates a category update
+func ValidateCategoryUpdate(input dto.UpdateCategoryInput) Violations {
+	return Evaluate(
+		Required("title", input.Title, "category title is required"),
+		MaxLength("title", input.Title, MaxTitleLength, "category title cannot exceed 255 characters"),
+		OptionalMaxLength("description", input.Description, MaxDescriptionLength, "category description cannot exceed 1000 characters"),
+	)
+}
+
+// ValidateCategoryPatch validates a partial category update: only the provided fields are checked
+func ValidateCategoryPatch(input dto.PatchCategoryInput) Violations {
+	rules := []Rule{OptionalMaxLength("description", input.Description, MaxDescriptionLength, "category description cannot exceed 1000 characters")}
+	if input.Title != nil {
+		rules = append(rules,
+			Required("title", *input.Title, "category title is required"),
+			MaxLength("title", *input.Title, MaxTitleLength, "category title cannot exceed 255 characters"),
+		)
+	}
+	return Evaluate(rules...)
+}
+
+// ValidateSection validates the client fields of a new section
+func ValidateSection(input dto.CreateSectionInput) Violations {
+	return Evaluate(
+		Required("title", input.Title, "section title is required"),
+		MaxLength("title", input.Title, MaxTitleLength, "section title cannot exceed 255 characters"),
+		OptionalMaxLength("description", input.Description, MaxDescriptionLength, "section description cannot exceed 1000 characters"),
+		Required("type", input.Type, "section type is required"),
+		MaxLength("type", input.Type, MaxTypeLength, "section type cannot exceed 50 characters"),
+		RequiredID("portfolio_id", input.PortfolioID, "portfolio ID is required"),
+	)
+}
+
+// ValidateSectionUpdate validates a section update
+func ValidateSectionUpdate(input dto.UpdateSectionInput) Violations {
+	return Evaluate(
+		Required("title", input.Title, "section title is required"),
+		MaxLength("title", input.Title, MaxTitleLength, "section title cannot exceed 255 characters"),
+		OptionalMaxLength("description", input.Description, MaxDescriptionLength, "section description cannot exceed 1000 characters"),
+		Required("type", input.Type, "section type is required"),
+		MaxLength("type", input.Type, MaxTypeLength, "section type cannot exceed 50 characters"),
+	)
+}
+
+// ValidateProject validates the client fields of a new project
+func ValidateProject(input dto.CreateProjectInput) Violations {
+	return Evaluate(
+		Required("title", input.Title, "project title is required"),
+		MaxLength("title", input.Title, MaxTitleLength, "project title cannot exceed 255 characters"),
+		Required("description", input.Description, "project description is required"),
+		projectClientRule(input.Client),
+		RequiredID("category_id", input.CategoryID, "category ID is required"),
+	)
+}
+
+// ValidateProjectUpdate validates a project update
+func ValidateProjectUpdate(input dto.UpdateProjectInput) Violations {
+	return Evaluate(
+		Required("title", input.Title, "project title is required"),
+		MaxLength("title", input.Title, MaxTitleLength, "project title cannot exceed 255 characters"),
+		Required("description", input.Description, "project description is required"),
+		projectClientRule(input.Client),
+	)
+}
+
+// ValidateProjectPatch validates a partial project update: only the provided fields are checked
+func ValidateProjectPatch(input dto.PatchProjectInput) Violations {
+	rules := []Rule{projectClientRule(input.Client)}
+	if input.Title != nil {
+		rules = append(rules,
+			Required("title", *input.Title, "project title is required"),
+			MaxLength("title", *input.Title, MaxTitleLength, "project title cannot exceed 255 characters"),
+		)
+	}
+	if input.Description != nil {
+		rules = append(rules, Required("description", *input.Description, "project description is required"))
+	}
+	if input.CategoryID != nil {
+		rules = append(rules, RequiredID("category_id", *input.CategoryID, "category ID is required"))
+	}
+	return Evaluate(rules...)
+}
+
+// ValidateProjectDefaults validates stored new-project defaults with the same
+// field rules as a project, so applying them can't produce an invalid project
+func ValidateProjectDefaults(defaults dto.ProjectDefaultsDTO) Violations {
+	rules := []Rule{projectClientRule(defaults.Client)}
+	for i, skill := range defaults.Skills {
+		field := fmt.Sprintf("skills[%d]", i)
+		rules = append(rules, Required(field, strings.TrimSpace(skill), "skill cannot be empty"))
+	}
+	for portfolioID := range defaults.Categories {
+		rules = append(rules, RequiredID("categories", portfolioID, "portfolio ID is required"))
+	}
+	return Evaluate(rules...)
+}
+
+func projectClientRule(client *string) Rule {
+	return OptionalMaxLength("client", client, MaxClientLength, "client cannot exceed 255 characters")
+}
+
+// ValidateSectionContent validates the client fields of a new section content
+func ValidateSectionContent(input dto.CreateSectionContentInput) Violations {
+	return Evaluate(
+		RequiredID("section_id", input.SectionID, "section ID is required"),
+		Required("type", input.Type, "content type is required"),
+		MaxLength("type", input.Type, MaxTypeLength, "content type cannot exceed 50 characters"),
+	)
+}
+
+// ValidateSectionContentUpdate validates a section content update (an empty type keeps the current one)
+func ValidateSectionContentUpdate(input dto.UpdateSectionContentInput) Violations {
+	return Evaluate(
+		MaxLength("type", input.Type, MaxTypeLength, "content type cannot exceed 50 characters"),
+	)
+}
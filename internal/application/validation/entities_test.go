@@ -0,0 +1,82 @@
+package validation
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// failing returns "field:rule" of every violation, in order
+func failing(violations Violations) []string {
+	var out []string
+	for _, v := range violations {
+		out = append(out, v.Field+":"+v.Rule)
+	}
+	return out
+}
+
+func TestEntityValidators(t *testing.T) {
+	long := strings.Repeat("a", MaxTitleLength+1)
+	longDescription := strings.Repeat("a", MaxDescriptionLength+1)
+	longType := strings.Repeat("a", MaxTypeLength+1)
+	var zero uint
+
+	tests := []struct {
+		name       string
+		violations Violations
+		want       []string
+	}{
+		{"portfolio valid", ValidatePortfolio(dto.CreatePortfolioInput{Title: "Work"}), nil},
+		{"portfolio missing title", ValidatePortfolio(dto.CreatePortfolioInput{}), []string{"title:required"}},
+		{"portfolio long fields", ValidatePortfolio(dto.CreatePortfolioInput{Title: long, Description: longDescription}),
+			[]string{"title:max_length", "description:max_length"}},
+		{"portfolio update keeps empty title", ValidatePortfolioUpdate(dto.UpdatePortfolioInput{}), nil},
+		{"portfolio patch without fields", ValidatePortfolioPatch(dto.PatchPortfolioInput{}), nil},
+		{"portfolio patch empty title", ValidatePortfolioPatch(dto.PatchPortfolioInput{Title: strPtr("")}), []string{"title:required"}},
+
+		{"category valid", ValidateCategory(dto.CreateCategoryInput{Title: "Web", PortfolioID: 1}), nil},
+		{"category missing everything", ValidateCategory(dto.CreateCategoryInput{}), []string{"title:required", "portfolio_id:required"}},
+		{"category long description", ValidateCategoryUpdate(dto.UpdateCategoryInput{Title: "Web", Description: strPtr(longDescription)}),
+			[]string{"description:max_length"}},
+		{"category patch long title", ValidateCategoryPatch(dto.PatchCategoryInput{Title: strPtr(long)}), []string{"title:max_length"}},
+
+		{"section valid", ValidateSection(dto.CreateSectionInput{Title: "About", Type: "about", PortfolioID: 1}), nil},
+		{"section missing everything", ValidateSection(dto.CreateSectionInput{}),
+			[]string{"title:required", "type:required", "portfolio_id:required"}},
+		{"section update long type", ValidateSectionUpdate(dto.UpdateSectionInput{Title: "About", Type: longType}), []string{"type:max_length"}},
+
+		{"project valid", ValidateProject(dto.CreateProjectInput{Title: "API", Description: "d", CategoryID: 1}), nil},
+		{"project missing everything", ValidateProject(dto.CreateProjectInput{}),
+			[]string{"title:required", "description:required", "category_id:required"}},
+		{"project long client", ValidateProjectUpdate(dto.UpdateProjectInput{Title: "API", Description: "d", Client: strPtr(long)}),
+			[]string{"client:max_length"}},
+		{"project patch without fields", ValidateProjectPatch(dto.PatchProjectInput{}), nil},
+		{"project patch cleared fields", ValidateProjectPatch(dto.PatchProjectInput{Title: strPtr(""), Description: strPtr(""), CategoryID: &zero}),
+			[]string{"title:required", "description:required", "category_id:required"}},
+		{"project defaults blank skill", ValidateProjectDefaults(dto.ProjectDefaultsDTO{Skills: []string{"go", "  "}}), []string{"skills[1]:required"}},
+
+		{"section content valid", ValidateSectionContent(dto.CreateSectionContentInput{SectionID: 1, Type: "text"}), nil},
+		{"section content missing everything", ValidateSectionContent(dto.CreateSectionContentInput{}),
+			[]string{"section_id:required", "type:required"}},
+		{"section content update long type", ValidateSectionContentUpdate(dto.UpdateSectionContentInput{Type: longType}), []string{"type:max_length"}},
+
+		{"imported link long URL", ValidateImportedLink(dto.PortfolioLinkExportDTO{URL: strings.Repeat("a", MaxURLLength+1)}), []string{"url:max_length"}},
+		{"imported category missing title", ValidateImportedCategory(dto.CategoryExportDTO{}), []string{"title:required"}},
+		{"imported project long link", ValidateImportedProject(dto.ProjectExportDTO{Title: "API", Description: "d", Link: strPtr(strings.Repeat("a", MaxURLLength+1))}),
+			[]string{"link:max_length"}},
+		{"imported collaborator long role", ValidateImportedCollaborator(dto.ProjectCollaboratorExportDTO{Name: "Ada", Role: strings.Repeat("a", MaxLabelLength+1)}),
+			[]string{"role:max_length"}},
+		{"imported section missing type", ValidateImportedSection(dto.SectionExportDTO{Title: "About"}), []string{"type:required"}},
+		{"imported section content missing type", ValidateImportedSectionContent(dto.SectionContentExportDTO{}), []string{"type:required"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := failing(tt.violations); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("violations = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
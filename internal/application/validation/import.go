@@ -0,0 +1,131 @@
+package validation
+
+import (
+	"strings"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// Field limits of the imported entries that have no create rule of their own above
+const (
+	MaxURLLength   = 500
+	MaxLabelLength = 100
+)
+
+// Entries one import document may hold, per kind (projects and contents across the whole document)
+// They bound the work of one import transaction; links and collaborators have domain limits.
+const (
+	MaxImportedCategories      = 100
+	MaxImportedProjects        = 1000
+	MaxImportedSections        = 100
+	MaxImportedSectionContents = 2000
+)
+
+// ValidateImportSize rejects a document holding more entries of a kind than an import accepts
+// Unlike the per-entry rules it fails the whole import: leaving out entries past the cap would
+// silently truncate the portfolio.
+func ValidateImportSize(export dto.PortfolioExportDTO) error {
+	projects := 0
+	for _, category := range export.Categories {
+		projects += len(category.Projects)
+	}
+	contents := 0
+	for _, section := range export.Sections {
+		contents += len(section.Contents)
+	}
+
+	for _, limit := range []struct {
+		kind  string
+		count int
+		max   int
+	}{
+		{"categories", len(export.Categories), MaxImportedCategories},
+		{"projects", projects, MaxImportedProjects},
+		{"sections", len(export.Sections), MaxImportedSections},
+		{"section contents", contents, MaxImportedSectionContents},
+	} {
+		if limit.count > limit.max {
+			return apperrors.ImportLimit(limit.kind, limit.count, limit.max)
+		}
+	}
+	return nil
+}
+
+// ValidateImportedLink validates the stored lengths of an imported link
+// The kind-specific value rules live in the domain and are checked separately.
+func ValidateImportedLink(link dto.PortfolioLinkExportDTO) Violations {
+	return Evaluate(
+		MaxLength("label", link.Label, MaxLabelLength, "link label cannot exceed 100 characters"),
+		MaxLength("url", link.URL, MaxURLLength, "link URL cannot exceed 500 characters"),
+	)
+}
+
+// ValidateImportedCategory validates an imported category with the rules of a new one
+func ValidateImportedCategory(category dto.CategoryExportDTO) Violations {
+	return Evaluate(
+		Required("title", category.Title, "category title is required"),
+		MaxLength("title", category.Title, MaxTitleLength, "category title cannot exceed 255 characters"),
+		OptionalMaxLength("description", category.Description, MaxDescriptionLength, "category description cannot exceed 1000 characters"),
+	)
+}
+
+// ValidateImportedProject validates an imported project with the rules of a new one
+// Images may also be paths on the exporting server ("/uploads/..."), the form they are stored in.
+func ValidateImportedProject(project dto.ProjectExportDTO) Violations {
+	rules := []Rule{
+		Required("title", project.Title, "project title is required"),
+		MaxLength("title", project.Title, MaxTitleLength, "project title cannot exceed 255 characters"),
+		Required("description", project.Description, "project description is required"),
+		projectClientRule(project.Client),
+		OptionalMaxLength("main_image", project.MainImage, MaxURLLength, "main image cannot exceed 500 characters"),
+		OptionalMaxLength("link", project.Link, MaxURLLength, "project link cannot exceed 500 characters"),
+		OptionalURL("link", project.Link, "project link must be an http(s) URL"),
+	}
+	if project.MainImage != nil {
+		rules = append(rules, importedImageRule("main_image", *project.MainImage))
+	}
+	for _, image := range project.Images {
+		rules = append(rules,
+			MaxLength("images", image, MaxURLLength, "image URLs cannot exceed 500 characters"),
+			importedImageRule("images", image),
+		)
+	}
+	return Evaluate(rules...)
+}
+
+// importedImageRule fails when an image is neither an http(s) URL nor a path on the server
+// (a single leading slash: "//host/..." would load from another host)
+func importedImageRule(field, image string) Rule {
+	serverPath := strings.HasPrefix(image, "/") && !strings.HasPrefix(image, "//")
+	return Check(field, RuleURL, serverPath || IsHTTPURL(image), "images must be http(s) URLs or paths on the server")
+}
+
+// ValidateImportedCollaborator validates the stored lengths of an imported collaborator
+// The name and URL rules live in the domain and are checked separately.
+func ValidateImportedCollaborator(collaborator dto.ProjectCollaboratorExportDTO) Violations {
+	return Evaluate(
+		MaxLength("name", collaborator.Name, MaxLabelLength, "collaborator name cannot exceed 100 characters"),
+		MaxLength("role", collaborator.Role, MaxLabelLength, "collaborator role cannot exceed 100 characters"),
+		OptionalMaxLength("url", collaborator.URL, MaxURLLength, "collaborator URL cannot exceed 500 characters"),
+	)
+}
+
+// ValidateImportedSection validates an imported section with the rules of a new one
+func ValidateImportedSection(section dto.SectionExportDTO) Violations {
+	return Evaluate(
+		Required("title", section.Title, "section title is required"),
+		MaxLength("title", section.Title, MaxTitleLength, "section title cannot exceed 255 characters"),
+		OptionalMaxLength("description", section.Description, MaxDescriptionLength, "section description cannot exceed 1000 characters"),
+		Required("type", section.Type, "section type is required"),
+		MaxLength("type", section.Type, MaxTypeLength, "section type cannot exceed 50 characters"),
+	)
+}
+
+// ValidateImportedSectionContent validates an imported section content with the rules of a new one
+func ValidateImportedSectionContent(content dto.SectionContentExportDTO) Violations {
+	return Evaluate(
+		Required("type", content.Type, "content type is required"),
+		MaxLength("type", content.Type, MaxTypeLength, "content type cannot exceed 50 characters"),
+	)
+}
@@ -0,0 +1,101 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// importDocument builds a document with the given number of entries of each kind
+func importDocument(categories, projectsPerCategory, sections, contentsPerSection int) dto.PortfolioExportDTO {
+	export := dto.PortfolioExportDTO{Title: "Imported"}
+	for i := 0; i < categories; i++ {
+		export.Categories = append(export.Categories, dto.CategoryExportDTO{
+			Title:    "Category",
+			Projects: make([]dto.ProjectExportDTO, projectsPerCategory),
+		})
+	}
+	for i := 0; i < sections; i++ {
+		export.Sections = append(export.Sections, dto.SectionExportDTO{
+			Title:    "Section",
+			Contents: make([]dto.SectionContentExportDTO, contentsPerSection),
+		})
+	}
+	return export
+}
+
+func TestValidateImportSize(t *testing.T) {
+	tests := []struct {
+		name     string
+		export   dto.PortfolioExportDTO
+		wantKind string
+	}{
+		{name: "empty", export: importDocument(0, 0, 0, 0)},
+		{name: "at every cap", export: importDocument(MaxImportedCategories, MaxImportedProjects/MaxImportedCategories, MaxImportedSections, MaxImportedSectionContents/MaxImportedSections)},
+		{name: "too many categories", export: importDocument(MaxImportedCategories+1, 0, 0, 0), wantKind: "categories"},
+		{name: "too many projects across categories", export: importDocument(2, MaxImportedProjects/2+1, 0, 0), wantKind: "projects"},
+		{name: "too many sections", export: importDocument(0, 0, MaxImportedSections+1, 0), wantKind: "sections"},
+		{name: "too many contents across sections", export: importDocument(0, 0, 2, MaxImportedSectionContents/2+1), wantKind: "section contents"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateImportSize(tt.export)
+			if tt.wantKind == "" {
+				if err != nil {
+					t.Fatalf("ValidateImportSize() = %v, want nil", err)
+				}
+				return
+			}
+
+			appErr, ok := apperrors.As(err)
+			if !ok || appErr.Kind != apperrors.KindUnprocessable || appErr.Code != apperrors.CodePortfolioImportLimit {
+				t.Fatalf("ValidateImportSize() = %v, want an unprocessable %s error", err, apperrors.CodePortfolioImportLimit)
+			}
+			if appErr.Details["kind"] != tt.wantKind {
+				t.Errorf("details kind = %v, want %q", appErr.Details["kind"], tt.wantKind)
+			}
+		})
+	}
+}
+
+func TestValidateImportedProjectURLs(t *testing.T) {
+	tests := []struct {
+		name      string
+		link      *string
+		mainImage *string
+		images    []string
+		wantField string // empty when the project is valid
+	}{
+		{name: "absolute URLs", link: strPtr("https://example.com"), mainImage: strPtr("https://cdn.example.com/a.png"), images: []string{"http://cdn.example.com/b.png"}},
+		{name: "server paths", mainImage: strPtr("/uploads/a.png"), images: []string{"/uploads/b.png"}},
+		{name: "link without scheme", link: strPtr("example.com"), wantField: "link"},
+		{name: "link to a server path", link: strPtr("/uploads/a.png"), wantField: "link"},
+		{name: "script main image", mainImage: strPtr("javascript:alert(1)"), wantField: "main_image"},
+		{name: "protocol-relative main image", mainImage: strPtr("//evil.example.com/a.png"), wantField: "main_image"},
+		{name: "data image", images: []string{"/uploads/a.png", "data:image/png;base64,AAAA"}, wantField: "images"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations := ValidateImportedProject(dto.ProjectExportDTO{
+				Title:       "Project",
+				Description: "Description",
+				Link:        tt.link,
+				MainImage:   tt.mainImage,
+				Images:      tt.images,
+			})
+
+			if tt.wantField == "" {
+				if len(violations) != 0 {
+					t.Fatalf("violations = %v, want none", violations)
+				}
+				return
+			}
+			if len(violations) != 1 || violations[0].Field != tt.wantField || violations[0].Rule != RuleURL {
+				t.Fatalf("violations = %+v, want one url violation on %s", violations, tt.wantField)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+// Package validation holds the input rules of the application layer.
+// Each entity validator is a list of named rules; evaluating them yields
+// structured violations (field, rule, params) instead of ad-hoc strings, so
+// the transport layer can report and localize every failing field at once.
+package validation
+
+import (
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+)
+
+// Rule names
+const (
+	RuleRequired  = "required"
+	RuleMaxLength = "max_length"
+	RuleOneOf     = "one_of"
+	RuleURL       = "url"
+)
+
+// ruleCodes maps rule names to the error codes clients already know
+var ruleCodes = map[string]string{
+	RuleRequired:  apperrors.CodeValidationRequired,
+	RuleMaxLength: apperrors.CodeValidationMax,
+	RuleOneOf:     apperrors.CodeValidationOneOf,
+	RuleURL:       apperrors.CodeValidationURL,
+}
+
+// RuleViolation is a single failed rule on a field
+// Params always carry "field" and, for parameterized rules, "param".
+type RuleViolation struct {
+	Field   string
+	Rule    string
+	Params  map[string]interface{}
+	Message string // English rendering, kept for logs and single-error clients
+}
+
+// Code returns the error code of the violated rule
+func (v RuleViolation) Code() string {
+	if code, ok := ruleCodes[v.Rule]; ok {
+		return code
+	}
+	return apperrors.CodeValidationInvalid
+}
+
+// Rule is a named check on one field
+type Rule struct {
+	Field   string
+	Name    string
+	Params  map[string]interface{}
+	Message string
+	Valid   bool
+}
+
+// Required fails when value is empty
+func Required(field, value, message string) Rule {
+	return newRule(field, RuleRequired, nil, message, value != "")
+}
+
+// RequiredID fails when id is zero
+func RequiredID(field string, id uint, message string) Rule {
+	return newRule(field, RuleRequired, nil, message, id != 0)
+}
+
+// MaxLength fails when value has more than max characters
+func MaxLength(field, value string, max int, message string) Rule {
+	return newRule(field, RuleMaxLength, max, message, utf8.RuneCountInString(value) <= max)
+}
+
+// OptionalMaxLength is MaxLength for optional (nil-able) values
+func OptionalMaxLength(field string, value *string, max int, message string) Rule {
+	if value == nil {
+		return newRule(field, RuleMaxLength, max, message, true)
+	}
+	return MaxLength(field, *value, max, message)
+}
+
+// OneOf fails when value is not one of allowed
+func OneOf(field, value string, allowed []string, message string) Rule {
+	valid := false
+	for _, candidate := range allowed {
+		if value == candidate {
+			valid = true
+			break
+		}
+	}
+	return newRule(field, RuleOneOf, strings.Join(allowed, " "), message, valid)
+}
+
+// URL fails when value isn't an absolute http(s) URL
+func URL(field, value, message string) Rule {
+	return newRule(field, RuleURL, nil, message, IsHTTPURL(value))
+}
+
+// OptionalURL is URL for optional (nil-able) values
+func OptionalURL(field string, value *string, message string) Rule {
+	if value == nil {
+		return newRule(field, RuleURL, nil, message, true)
+	}
+	return URL(field, *value, message)
+}
+
+// IsHTTPURL reports whether value is an absolute http or https URL with a host
+func IsHTTPURL(value string) bool {
+	parsed, err := url.Parse(value)
+	return err == nil && (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
+
+// Check is a custom (e.g. cross-field) rule whose outcome is computed by the caller
+func Check(field, name string, valid bool, message string) Rule {
+	return newRule(field, name, nil, message, valid)
+}
+
+func newRule(field, name string, param interface{}, message string, valid bool) Rule {
+	params := map[string]interface{}{"field": field}
+	if param != nil {
+		params["param"] = param
+	}
+	return Rule{Field: field, Name: name, Params: params, Message: message, Valid: valid}
+}
+
+// Violations is the outcome of evaluating a set of rules, in rule order
+type Violations []RuleViolation
+
+// Evaluate runs the rules and collects the failing ones
+// Only the first violation of each field is kept, so an empty title is
+// reported as required rather than also as too short.
+func Evaluate(rules ...Rule) Violations {
+	var violations Violations
+	failed := make(map[string]bool)
+
+	for _, rule := range rules {
+		if rule.Valid || failed[rule.Field] {
+			continue
+		}
+		failed[rule.Field] = true
+		violations = append(violations, RuleViolation{
+			Field:   rule.Field,
+			Rule:    rule.Name,
+			Params:  rule.Params,
+			Message: rule.Message,
+		})
+	}
+
+	return violations
+}
+
+// Error renders the violations as the single error string handlers used to return
+func (v Violations) Error() string {
+	if len(v) == 0 {
+		return ""
+	}
+	return v[0].Message
+}
+
+// Err returns nil when there are no violations, otherwise a validation
+// application error coded after the first violation and wrapping all of them
+func (v Violations) Err() error {
+	if len(v) == 0 {
+		return nil
+	}
+
+	first := v[0]
+	return &apperrors.Error{
+		Kind:    apperrors.KindValidation,
+		Code:    first.Code(),
+		Message: first.Message,
+		Params:  first.Params,
+		Err:     v,
+	}
+}
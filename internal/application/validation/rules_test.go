@@ -0,0 +1,125 @@
+package validation
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+)
+
+func strPtr(s string) *string { return &s }
+
+func TestRules(t *testing.T) {
+	tests := []struct {
+		name      string
+		rule      Rule
+		wantValid bool
+		wantName  string
+		wantParam interface{} // nil: no "param"
+	}{
+		{"required with value", Required("title", "x", ""), true, RuleRequired, nil},
+		{"required empty", Required("title", "", ""), false, RuleRequired, nil},
+		{"required ID set", RequiredID("portfolio_id", 3, ""), true, RuleRequired, nil},
+		{"required ID zero", RequiredID("portfolio_id", 0, ""), false, RuleRequired, nil},
+		{"max length at limit", MaxLength("title", "abc", 3, ""), true, RuleMaxLength, 3},
+		{"max length over limit", MaxLength("title", "abcd", 3, ""), false, RuleMaxLength, 3},
+		{"max length counts characters, not bytes", MaxLength("title", "ééé", 3, ""), true, RuleMaxLength, 3},
+		{"optional max length nil", OptionalMaxLength("description", nil, 3, ""), true, RuleMaxLength, 3},
+		{"optional max length over limit", OptionalMaxLength("description", strPtr("abcd"), 3, ""), false, RuleMaxLength, 3},
+		{"one of allowed", OneOf("kind", "b", []string{"a", "b"}, ""), true, RuleOneOf, "a b"},
+		{"one of not allowed", OneOf("kind", "c", []string{"a", "b"}, ""), false, RuleOneOf, "a b"},
+		{"url https", URL("link", "https://example.com/a?b=c", ""), true, RuleURL, nil},
+		{"url without scheme", URL("link", "example.com/a", ""), false, RuleURL, nil},
+		{"url with another scheme", URL("link", "javascript:alert(1)", ""), false, RuleURL, nil},
+		{"url without host", URL("link", "http:///path", ""), false, RuleURL, nil},
+		{"optional url nil", OptionalURL("link", nil, ""), true, RuleURL, nil},
+		{"optional url set", OptionalURL("link", strPtr("ftp://example.com"), ""), false, RuleURL, nil},
+		{"check valid", Check("range", "date_order", true, ""), true, "date_order", nil},
+		{"check invalid", Check("range", "date_order", false, ""), false, "date_order", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.rule.Valid != tt.wantValid {
+				t.Errorf("Valid = %v, want %v", tt.rule.Valid, tt.wantValid)
+			}
+			if tt.rule.Name != tt.wantName {
+				t.Errorf("Name = %q, want %q", tt.rule.Name, tt.wantName)
+			}
+			if tt.rule.Params["field"] != tt.rule.Field {
+				t.Errorf("Params[field] = %v, want %q", tt.rule.Params["field"], tt.rule.Field)
+			}
+			param, ok := tt.rule.Params["param"]
+			if tt.wantParam == nil && ok {
+				t.Errorf("unexpected param %v", param)
+			}
+			if tt.wantParam != nil && param != tt.wantParam {
+				t.Errorf("Params[param] = %v, want %v", param, tt.wantParam)
+			}
+		})
+	}
+}
+
+func TestEvaluateKeepsFirstViolationPerField(t *testing.T) {
+	violations := Evaluate(
+		Required("title", "", "title is required"),
+		MaxLength("title", "", 0, "never reported"),
+		MaxLength("description", "abcd", 3, "description too long"),
+		Required("type", "x", "type is required"),
+	)
+
+	if len(violations) != 2 {
+		t.Fatalf("got %d violations, want 2: %v", len(violations), violations)
+	}
+	if violations[0].Field != "title" || violations[0].Rule != RuleRequired {
+		t.Errorf("first violation = %+v, want title/required", violations[0])
+	}
+	if violations[1].Field != "description" || violations[1].Rule != RuleMaxLength {
+		t.Errorf("second violation = %+v, want description/max_length", violations[1])
+	}
+	if violations.Error() != "title is required" {
+		t.Errorf("Error() = %q, want the first message", violations.Error())
+	}
+}
+
+func TestViolationCodes(t *testing.T) {
+	tests := []struct {
+		rule string
+		want string
+	}{
+		{RuleRequired, apperrors.CodeValidationRequired},
+		{RuleMaxLength, apperrors.CodeValidationMax},
+		{RuleOneOf, apperrors.CodeValidationOneOf},
+		{RuleURL, apperrors.CodeValidationURL},
+		{"date_order", apperrors.CodeValidationInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			if got := (RuleViolation{Rule: tt.rule}).Code(); got != tt.want {
+				t.Errorf("Code() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestViolationsErr(t *testing.T) {
+	if err := Evaluate(Required("title", "x", "")).Err(); err != nil {
+		t.Fatalf("Err() = %v, want nil without violations", err)
+	}
+
+	violations := Evaluate(MaxLength("title", strings.Repeat("a", 4), 3, "title too long"))
+	err := violations.Err()
+	appErr, ok := apperrors.As(err)
+	if !ok {
+		t.Fatalf("Err() = %T, want an application error", err)
+	}
+	if appErr.Kind != apperrors.KindValidation || appErr.Code != apperrors.CodeValidationMax {
+		t.Errorf("kind/code = %v/%q, want validation/%q", appErr.Kind, appErr.Code, apperrors.CodeValidationMax)
+	}
+	var wrapped Violations
+	if !errors.As(err, &wrapped) || len(wrapped) != 1 {
+		t.Errorf("Err() does not wrap the violations: %v", err)
+	}
+}
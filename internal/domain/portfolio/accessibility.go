@@ -0,0 +1,292 @@
+package portfolio
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// WCAG 2.1 contrast thresholds
+const (
+	MinTextContrastRatio = 4.5 // normal text (AA)
+	MinUIContrastRatio   = 3.0 // large text and UI components such as accent-colored links (AA)
+)
+
+// Accessibility finding severities
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+)
+
+// Accessibility finding names (stable identifiers the dashboard keys its report on)
+const (
+	FindingLowTextContrast   = "low_text_contrast"
+	FindingLowAccentContrast = "low_accent_contrast"
+	FindingImageMissingAlt   = "image_missing_alt"
+	FindingHeadingLevelSkip  = "heading_level_skip"
+	FindingVagueLinkText     = "vague_link_text"
+)
+
+// vagueLinkTexts are link texts that say nothing about the destination out of context
+var vagueLinkTexts = map[string]bool{
+	"click here":  true,
+	"click":       true,
+	"here":        true,
+	"link":        true,
+	"this link":   true,
+	"more":        true,
+	"read more":   true,
+	"learn more":  true,
+	"clique aqui": true,
+	"aqui":        true,
+	"saiba mais":  true,
+	"leia mais":   true,
+}
+
+var (
+	markdownLinkPattern    = regexp.MustCompile(`(!?)\[([^\]]*)\]\(([^)\s]*)[^)]*\)`)
+	markdownHeadingPattern = regexp.MustCompile(`^ {0,3}(#{1,6})(?:[ \t]|$)`)
+	hexColorPattern        = regexp.MustCompile(`^#?([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+)
+
+// ThemeColors are the colors a portfolio is rendered with (hex, e.g. "#1a1a1a")
+// Empty values are not checked.
+type ThemeColors struct {
+	Text       string
+	Background string
+	Accent     string
+}
+
+// AccessibilityContent is a piece of markdown text to lint, with the resource it belongs to
+type AccessibilityContent struct {
+	ResourceType string
+	ResourceID   uint
+	Markdown     string
+}
+
+// AccessibilityInput is a snapshot of the portfolio data the report is computed from
+type AccessibilityInput struct {
+	Colors   ThemeColors
+	Contents []AccessibilityContent
+}
+
+// AccessibilityFinding is a single accessibility problem
+// Values carry the data involved (colors and ratio, heading levels, link text...)
+type AccessibilityFinding struct {
+	Name         string
+	Severity     string
+	Message      string
+	ResourceType string
+	ResourceIDs  []uint
+	Values       map[string]string
+}
+
+// HeadingSkip is a heading that jumps more than one level deeper than the previous one
+type HeadingSkip struct {
+	From int
+	To   int
+}
+
+// MarkdownLink is an inline markdown link or image
+type MarkdownLink struct {
+	Text  string // link text, or alt text for images
+	URL   string
+	Image bool
+}
+
+// EvaluateAccessibility lints the theme colors and markdown contents of a portfolio
+// Findings are ordered: colors first, then contents in input order.
+func EvaluateAccessibility(input AccessibilityInput) ([]AccessibilityFinding, error) {
+	findings := []AccessibilityFinding{}
+
+	if input.Colors.Text != "" && input.Colors.Background != "" {
+		finding, err := checkContrast(FindingLowTextContrast, SeverityError, MinTextContrastRatio,
+			"text", input.Colors.Text, input.Colors.Background)
+		if err != nil {
+			return nil, err
+		}
+		if finding != nil {
+			findings = append(findings, *finding)
+		}
+	}
+	if input.Colors.Accent != "" && input.Colors.Background != "" {
+		finding, err := checkContrast(FindingLowAccentContrast, SeverityWarning, MinUIContrastRatio,
+			"accent", input.Colors.Accent, input.Colors.Background)
+		if err != nil {
+			return nil, err
+		}
+		if finding != nil {
+			findings = append(findings, *finding)
+		}
+	}
+
+	for _, content := range input.Contents {
+		findings = append(findings, lintMarkdown(content)...)
+	}
+
+	return findings, nil
+}
+
+// ContrastRatio returns the WCAG contrast ratio (1 to 21) between two hex colors
+func ContrastRatio(foreground, background string) (float64, error) {
+	fg, err := relativeLuminance(foreground)
+	if err != nil {
+		return 0, err
+	}
+	bg, err := relativeLuminance(background)
+	if err != nil {
+		return 0, err
+	}
+
+	lighter, darker := math.Max(fg, bg), math.Min(fg, bg)
+	return (lighter + 0.05) / (darker + 0.05), nil
+}
+
+// HeadingSkips returns the headings of a markdown text that skip levels (e.g. h1 followed by h4)
+// Headings inside fenced code blocks are ignored. The first heading may be any level.
+func HeadingSkips(markdown string) []HeadingSkip {
+	var skips []HeadingSkip
+	previous := 0
+	inFence := false
+
+	for _, line := range strings.Split(markdown, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+
+		match := markdownHeadingPattern.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+
+		level := len(match[1])
+		if previous > 0 && level > previous+1 {
+			skips = append(skips, HeadingSkip{From: previous, To: level})
+		}
+		previous = level
+	}
+
+	return skips
+}
+
+// MarkdownLinks returns the inline links and images of a markdown text
+func MarkdownLinks(markdown string) []MarkdownLink {
+	matches := markdownLinkPattern.FindAllStringSubmatch(markdown, -1)
+	links := make([]MarkdownLink, 0, len(matches))
+	for _, match := range matches {
+		links = append(links, MarkdownLink{
+			Text:  strings.TrimSpace(match[2]),
+			URL:   match[3],
+			Image: match[1] == "!",
+		})
+	}
+	return links
+}
+
+// IsVagueLinkText reports whether a link text says nothing about its destination
+func IsVagueLinkText(text string) bool {
+	normalized := strings.ToLower(strings.Trim(strings.TrimSpace(text), ".!:…"))
+	return vagueLinkTexts[normalized]
+}
+
+func checkContrast(name, severity string, minRatio float64, role, foreground, background string) (*AccessibilityFinding, error) {
+	ratio, err := ContrastRatio(foreground, background)
+	if err != nil {
+		return nil, err
+	}
+	if ratio >= minRatio {
+		return nil, nil
+	}
+
+	// Truncated rather than rounded, so 4.499 is never reported as 4.50 next to a 4.5 minimum
+	shown := strconv.FormatFloat(math.Floor(ratio*100)/100, 'f', 2, 64)
+
+	return &AccessibilityFinding{
+		Name:     name,
+		Severity: severity,
+		Message:  fmt.Sprintf("%s color contrast against the background is %s:1, below %.1f:1", role, shown, minRatio),
+		Values: map[string]string{
+			"foreground": foreground,
+			"background": background,
+			"ratio":      shown,
+			"minimum":    strconv.FormatFloat(minRatio, 'f', 1, 64),
+		},
+	}, nil
+}
+
+func lintMarkdown(content AccessibilityContent) []AccessibilityFinding {
+	var findings []AccessibilityFinding
+	resourceIDs := []uint{content.ResourceID}
+
+	for _, skip := range HeadingSkips(content.Markdown) {
+		findings = append(findings, AccessibilityFinding{
+			Name:         FindingHeadingLevelSkip,
+			Severity:     SeverityWarning,
+			Message:      fmt.Sprintf("heading jumps from h%d to h%d", skip.From, skip.To),
+			ResourceType: content.ResourceType,
+			ResourceIDs:  resourceIDs,
+			Values: map[string]string{
+				"from": fmt.Sprintf("h%d", skip.From),
+				"to":   fmt.Sprintf("h%d", skip.To),
+			},
+		})
+	}
+
+	for _, link := range MarkdownLinks(content.Markdown) {
+		switch {
+		case link.Image && link.Text == "":
+			findings = append(findings, AccessibilityFinding{
+				Name:         FindingImageMissingAlt,
+				Severity:     SeverityError,
+				Message:      "image has no alt text",
+				ResourceType: content.ResourceType,
+				ResourceIDs:  resourceIDs,
+				Values:       map[string]string{"url": link.URL},
+			})
+		case !link.Image && IsVagueLinkText(link.Text):
+			findings = append(findings, AccessibilityFinding{
+				Name:         FindingVagueLinkText,
+				Severity:     SeverityWarning,
+				Message:      fmt.Sprintf("link text %q doesn't describe its destination", link.Text),
+				ResourceType: content.ResourceType,
+				ResourceIDs:  resourceIDs,
+				Values:       map[string]string{"text": link.Text, "url": link.URL},
+			})
+		}
+	}
+
+	return findings
+}
+
+// relativeLuminance computes the WCAG relative luminance of a hex color
+func relativeLuminance(hex string) (float64, error) {
+	match := hexColorPattern.FindStringSubmatch(strings.TrimSpace(hex))
+	if match == nil {
+		return 0, fmt.Errorf("invalid color %q: expected #rgb or #rrggbb", hex)
+	}
+
+	digits := match[1]
+	if len(digits) == 3 {
+		digits = string([]byte{digits[0], digits[0], digits[1], digits[1], digits[2], digits[2]})
+	}
+
+	var channels [3]float64
+	for i := range channels {
+		value, _ := strconv.ParseUint(digits[i*2:i*2+2], 16, 8)
+		c := float64(value) / 255
+		if c <= 0.03928 {
+			channels[i] = c / 12.92
+		} else {
+			channels[i] = math.Pow((c+0.055)/1.055, 2.4)
+		}
+	}
+
+	return 0.2126*channels[0] + 0.7152*channels[1] + 0.0722*channels[2], nil
+}
@@ -0,0 +1,109 @@
+package portfolio
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestContrastRatio(t *testing.T) {
+	tests := []struct {
+		name       string
+		foreground string
+		background string
+		want       float64
+		wantErr    bool
+	}{
+		{name: "black on white", foreground: "#000000", background: "#ffffff", want: 21},
+		{name: "order doesn't matter", foreground: "#ffffff", background: "#000000", want: 21},
+		{name: "same color", foreground: "#336699", background: "#336699", want: 1},
+		{name: "shorthand and missing hash", foreground: "000", background: "#FFF", want: 21},
+		{name: "lightest gray passing for text", foreground: "#767676", background: "#ffffff", want: 4.54},
+		{name: "invalid foreground", foreground: "#12345", background: "#ffffff", wantErr: true},
+		{name: "invalid background", foreground: "#000000", background: "white", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ContrastRatio(tt.foreground, tt.background)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ContrastRatio = %v, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ContrastRatio: %v", err)
+			}
+			if math.Abs(got-tt.want) > 0.005 {
+				t.Errorf("ContrastRatio = %.3f, want %.2f", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluateAccessibility_ContrastThresholds(t *testing.T) {
+	tests := []struct {
+		name      string
+		colors    ThemeColors
+		wantNames []string
+		wantRatio string // ratio value of the first finding
+	}{
+		{name: "text at 4.54:1 passes", colors: ThemeColors{Text: "#767676", Background: "#ffffff"}},
+		{name: "text at 4.48:1 fails", colors: ThemeColors{Text: "#777777", Background: "#ffffff"}, wantNames: []string{FindingLowTextContrast}, wantRatio: "4.47"},
+		{name: "accent at 3.03:1 passes", colors: ThemeColors{Accent: "#949494", Background: "#ffffff"}},
+		{name: "accent at 2.99:1 fails", colors: ThemeColors{Accent: "#959595", Background: "#ffffff"}, wantNames: []string{FindingLowAccentContrast}, wantRatio: "2.99"},
+		{name: "accent passes the UI minimum below the text one", colors: ThemeColors{Text: "#000000", Accent: "#777777", Background: "#ffffff"}},
+		{name: "text is reported before accent", colors: ThemeColors{Text: "#959595", Accent: "#eeeeee", Background: "#ffffff"}, wantNames: []string{FindingLowTextContrast, FindingLowAccentContrast}, wantRatio: "2.99"},
+		{name: "no background, nothing checked", colors: ThemeColors{Text: "#ffffff", Accent: "#ffffff"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			findings, err := EvaluateAccessibility(AccessibilityInput{Colors: tt.colors})
+			if err != nil {
+				t.Fatalf("EvaluateAccessibility: %v", err)
+			}
+			var names []string
+			for _, finding := range findings {
+				names = append(names, finding.Name)
+			}
+			if !reflect.DeepEqual(names, tt.wantNames) {
+				t.Fatalf("findings = %v, want %v", names, tt.wantNames)
+			}
+			if tt.wantRatio != "" && findings[0].Values["ratio"] != tt.wantRatio {
+				t.Errorf("ratio = %s, want %s (truncated, never rounded up to the minimum)", findings[0].Values["ratio"], tt.wantRatio)
+			}
+		})
+	}
+}
+
+func TestHeadingSkips(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		want     []HeadingSkip
+	}{
+		{name: "no headings", markdown: "plain text"},
+		{name: "first heading may be any level", markdown: "### Title\n#### Sub"},
+		{name: "one level at a time", markdown: "# A\n## B\n### C\n# D\n## E"},
+		{name: "going back up any number of levels", markdown: "# A\n## B\n### C\n#### D\n# E"},
+		{name: "h1 to h4", markdown: "# A\ntext\n#### B", want: []HeadingSkip{{From: 1, To: 4}}},
+		{name: "compares with the previous heading", markdown: "# A\n### B\n##### C", want: []HeadingSkip{{From: 1, To: 3}, {From: 3, To: 5}}},
+		{name: "up to three spaces of indentation", markdown: "# A\n   ### B", want: []HeadingSkip{{From: 1, To: 3}}},
+		{name: "four spaces are code", markdown: "# A\n    ### B"},
+		{name: "hash without a space is not a heading", markdown: "# A\n###B"},
+		{name: "seven hashes are not a heading", markdown: "# A\n####### B"},
+		{name: "empty heading", markdown: "# A\n###", want: []HeadingSkip{{From: 1, To: 3}}},
+		{name: "fenced code is ignored", markdown: "# A\n```\n#### comment\n```\n## B"},
+		{name: "tilde fences too", markdown: "# A\n~~~sh\n#### comment\n~~~\n#### B", want: []HeadingSkip{{From: 1, To: 4}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HeadingSkips(tt.markdown); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("HeadingSkips = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
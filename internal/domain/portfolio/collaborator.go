@@ -0,0 +1,30 @@
+package portfolio
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// MaxCollaboratorsPerProject caps the credited collaborators of a project
+const MaxCollaboratorsPerProject = 20
+
+// ValidateCollaborator applies the collaborator rules: a non-blank name, and when a URL
+// is given, an absolute http(s) URL (other schemes such as javascript: are rejected)
+func ValidateCollaborator(name string, link *string) error {
+	if strings.TrimSpace(name) == "" {
+		return fmt.Errorf("collaborator name cannot be empty")
+	}
+	if link == nil || *link == "" {
+		return nil
+	}
+
+	parsed, err := url.Parse(*link)
+	if err != nil || parsed.Host == "" {
+		return fmt.Errorf("%q is not a valid URL", *link)
+	}
+	if parsed.Scheme != "https" && parsed.Scheme != "http" {
+		return fmt.Errorf("collaborator links must use http or https")
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package portfolio
+
+import "testing"
+
+func TestValidateCollaborator(t *testing.T) {
+	link := func(s string) *string { return &s }
+
+	tests := []struct {
+		name    string
+		person  string
+		link    *string
+		wantErr bool
+	}{
+		{name: "name only", person: "Ada"},
+		{name: "empty link", person: "Ada", link: link("")},
+		{name: "https link", person: "Ada", link: link("https://ada.example.com/about")},
+		{name: "http link", person: "Ada", link: link("http://ada.example.com")},
+		{name: "blank name", person: "  \t", wantErr: true},
+		{name: "javascript link", person: "Ada", link: link("javascript:alert(1)"), wantErr: true},
+		{name: "relative link", person: "Ada", link: link("/about"), wantErr: true},
+		{name: "ftp link", person: "Ada", link: link("ftp://files.example.com"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateCollaborator(tt.person, tt.link); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateCollaborator(%q) error = %v, want error %v", tt.person, err, tt.wantErr)
+			}
+		})
+	}
+}
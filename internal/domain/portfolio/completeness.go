@@ -0,0 +1,197 @@
+package portfolio
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Completeness thresholds
+const (
+	MinProjectsPerCategory      = 3
+	MinProjectDescriptionLength = 80
+	MinAboutSectionWords        = 100
+	AboutSectionType            = "about"
+)
+
+// Completeness check names (stable identifiers the dashboard keys its checklist on)
+const (
+	CheckPortfolioDescription = "portfolio_description"
+	CheckCategoryWithProjects = "category_with_projects"
+	CheckProjectDescriptions  = "project_descriptions"
+	CheckProjectMainImages    = "project_main_images"
+	CheckAboutSection         = "about_section"
+)
+
+// CompletenessInput is a snapshot of the portfolio data the score is computed from
+type CompletenessInput struct {
+	Description string
+	Categories  []CompletenessCategory
+	Projects    []CompletenessProject
+	Sections    []CompletenessSection
+}
+
+// CompletenessCategory is the category data relevant to completeness
+type CompletenessCategory struct {
+	ID           uint
+	ProjectCount int
+}
+
+// CompletenessProject is the project data relevant to completeness
+type CompletenessProject struct {
+	ID           uint
+	Description  string
+	HasMainImage bool
+}
+
+// CompletenessSection is the section data relevant to completeness
+// WordCount is the number of words across the section's text contents
+type CompletenessSection struct {
+	ID        uint
+	Type      string
+	WordCount int
+}
+
+// CompletenessCheck is the outcome of a single rule
+// ResourceIDs point at the resources that made the check fail (empty when it passed)
+type CompletenessCheck struct {
+	Name         string
+	Passed       bool
+	Weight       int
+	Message      string
+	ResourceType string
+	ResourceIDs  []uint
+}
+
+// CompletenessReport is the overall score (0-100) with the per-check breakdown
+type CompletenessReport struct {
+	Score  int
+	Checks []CompletenessCheck
+}
+
+// EvaluateCompleteness scores a portfolio against the completeness rules
+func EvaluateCompleteness(input CompletenessInput) CompletenessReport {
+	checks := []CompletenessCheck{
+		checkDescription(input),
+		checkCategoryWithProjects(input),
+		checkProjectDescriptions(input),
+		checkProjectMainImages(input),
+		checkAboutSection(input),
+	}
+
+	total, earned := 0, 0
+	for _, check := range checks {
+		total += check.Weight
+		if check.Passed {
+			earned += check.Weight
+		}
+	}
+
+	score := 0
+	if total > 0 {
+		score = earned * 100 / total
+	}
+
+	return CompletenessReport{Score: score, Checks: checks}
+}
+
+// WordCount counts whitespace-separated words in text
+func WordCount(text string) int {
+	return len(strings.Fields(text))
+}
+
+func checkDescription(input CompletenessInput) CompletenessCheck {
+	return CompletenessCheck{
+		Name:    CheckPortfolioDescription,
+		Passed:  strings.TrimSpace(input.Description) != "",
+		Weight:  20,
+		Message: "portfolio has a description",
+	}
+}
+
+func checkCategoryWithProjects(input CompletenessInput) CompletenessCheck {
+	check := CompletenessCheck{
+		Name:         CheckCategoryWithProjects,
+		Weight:       20,
+		Message:      "at least one category has 3 or more projects",
+		ResourceType: "category",
+	}
+
+	for _, category := range input.Categories {
+		if category.ProjectCount >= MinProjectsPerCategory {
+			check.Passed = true
+			return check
+		}
+	}
+
+	// Point at the categories that are closest to qualifying: those with the most projects
+	most := -1
+	for _, category := range input.Categories {
+		switch {
+		case category.ProjectCount > most:
+			most = category.ProjectCount
+			check.ResourceIDs = []uint{category.ID}
+		case category.ProjectCount == most:
+			check.ResourceIDs = append(check.ResourceIDs, category.ID)
+		}
+	}
+	return check
+}
+
+func checkProjectDescriptions(input CompletenessInput) CompletenessCheck {
+	check := CompletenessCheck{
+		Name:         CheckProjectDescriptions,
+		Weight:       20,
+		Message:      "every project has a description of at least 80 characters",
+		ResourceType: "project",
+	}
+
+	for _, project := range input.Projects {
+		if utf8.RuneCountInString(strings.TrimSpace(project.Description)) < MinProjectDescriptionLength {
+			check.ResourceIDs = append(check.ResourceIDs, project.ID)
+		}
+	}
+
+	check.Passed = len(input.Projects) > 0 && len(check.ResourceIDs) == 0
+	return check
+}
+
+func checkProjectMainImages(input CompletenessInput) CompletenessCheck {
+	check := CompletenessCheck{
+		Name:         CheckProjectMainImages,
+		Weight:       20,
+		Message:      "every project has a main image",
+		ResourceType: "project",
+	}
+
+	for _, project := range input.Projects {
+		if !project.HasMainImage {
+			check.ResourceIDs = append(check.ResourceIDs, project.ID)
+		}
+	}
+
+	check.Passed = len(input.Projects) > 0 && len(check.ResourceIDs) == 0
+	return check
+}
+
+func checkAboutSection(input CompletenessInput) CompletenessCheck {
+	check := CompletenessCheck{
+		Name:         CheckAboutSection,
+		Weight:       20,
+		Message:      "an \"about\" section has at least 100 words",
+		ResourceType: "section",
+	}
+
+	for _, section := range input.Sections {
+		if !strings.EqualFold(section.Type, AboutSectionType) {
+			continue
+		}
+		if section.WordCount >= MinAboutSectionWords {
+			check.Passed = true
+			check.ResourceIDs = nil
+			return check
+		}
+		check.ResourceIDs = append(check.ResourceIDs, section.ID)
+	}
+
+	return check
+}
@@ -0,0 +1,52 @@
+package portfolio
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCheckCategoryWithProjects(t *testing.T) {
+	tests := []struct {
+		name        string
+		categories  []CompletenessCategory
+		wantPassed  bool
+		wantPointed []uint
+	}{
+		{
+			name:       "no categories",
+			categories: nil,
+		},
+		{
+			name:       "one category qualifies",
+			categories: []CompletenessCategory{{ID: 1, ProjectCount: 1}, {ID: 2, ProjectCount: 3}},
+			wantPassed: true,
+		},
+		{
+			name:        "points at the category with the most projects",
+			categories:  []CompletenessCategory{{ID: 1, ProjectCount: 0}, {ID: 2, ProjectCount: 2}, {ID: 3, ProjectCount: 1}},
+			wantPointed: []uint{2},
+		},
+		{
+			name:        "points at every category tied for the most projects",
+			categories:  []CompletenessCategory{{ID: 1, ProjectCount: 2}, {ID: 2, ProjectCount: 0}, {ID: 3, ProjectCount: 2}},
+			wantPointed: []uint{1, 3},
+		},
+		{
+			name:        "empty categories are all closest when none has projects",
+			categories:  []CompletenessCategory{{ID: 4}, {ID: 5}},
+			wantPointed: []uint{4, 5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := checkCategoryWithProjects(CompletenessInput{Categories: tt.categories})
+			if check.Passed != tt.wantPassed {
+				t.Errorf("Passed = %v, want %v", check.Passed, tt.wantPassed)
+			}
+			if !reflect.DeepEqual(check.ResourceIDs, tt.wantPointed) {
+				t.Errorf("ResourceIDs = %v, want %v", check.ResourceIDs, tt.wantPointed)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package portfolio
+
+import (
+	"fmt"
+	"net/mail"
+	"net/url"
+	"strings"
+)
+
+// MaxLinksPerPortfolio caps the contact/social links of a portfolio
+const MaxLinksPerPortfolio = 10
+
+// Link kinds
+const (
+	LinkKindGitHub   = "github"
+	LinkKindLinkedIn = "linkedin"
+	LinkKindEmail    = "email"
+	LinkKindWebsite  = "website"
+	LinkKindTwitter  = "twitter"
+	LinkKindCustom   = "custom"
+)
+
+// linkKindHosts lists the hosts accepted for the known social kinds
+// (the host itself or any subdomain of it)
+var linkKindHosts = map[string][]string{
+	LinkKindGitHub:   {"github.com"},
+	LinkKindLinkedIn: {"linkedin.com"},
+	LinkKindTwitter:  {"twitter.com", "x.com"},
+}
+
+// IsValidLinkKind reports whether kind is a supported link kind
+func IsValidLinkKind(kind string) bool {
+	switch kind {
+	case LinkKindGitHub, LinkKindLinkedIn, LinkKindEmail, LinkKindWebsite, LinkKindTwitter, LinkKindCustom:
+		return true
+	}
+	return false
+}
+
+// ValidateLink applies the kind-specific rules to a link value:
+// a bare address for email, an https URL for everything else, on the
+// provider's host for the known social kinds
+func ValidateLink(kind, value string) error {
+	if !IsValidLinkKind(kind) {
+		return fmt.Errorf("unsupported link kind %q", kind)
+	}
+	if strings.TrimSpace(value) == "" {
+		return fmt.Errorf("link value cannot be empty")
+	}
+
+	if kind == LinkKindEmail {
+		address, err := mail.ParseAddress(value)
+		if err != nil || address.Address != value {
+			return fmt.Errorf("%q is not a valid email address", value)
+		}
+		return nil
+	}
+
+	parsed, err := url.Parse(value)
+	if err != nil || parsed.Host == "" {
+		return fmt.Errorf("%q is not a valid URL", value)
+	}
+	if parsed.Scheme != "https" {
+		return fmt.Errorf("%s links must use https", kind)
+	}
+
+	hosts, ok := linkKindHosts[kind]
+	if !ok {
+		return nil
+	}
+
+	host := strings.ToLower(parsed.Hostname())
+	for _, allowed := range hosts {
+		if host == allowed || strings.HasSuffix(host, "."+allowed) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s links must point to %s", kind, strings.Join(hosts, " or "))
+}
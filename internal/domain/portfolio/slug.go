@@ -0,0 +1,62 @@
+package portfolio
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// MaxSlugLength caps slugs generated from titles, leaving room for a numeric suffix in the column
+const MaxSlugLength = 80
+
+// DefaultSectionSlug is the slug of sections whose title has no ASCII letter or digit
+const DefaultSectionSlug = "section"
+
+// DefaultPortfolioSlug is the slug of portfolios whose title has no ASCII letter or digit
+const DefaultPortfolioSlug = "portfolio"
+
+// Slugify turns a title into an anchor-friendly slug: lowercase ASCII letters and digits
+// separated by single hyphens, accents stripped ("À propos de moi" -> "a-propos-de-moi").
+// Returns an empty string when nothing is left.
+func Slugify(title string) string {
+	var b strings.Builder
+	separate := false
+
+	for _, r := range norm.NFD.String(title) {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			// Combining accent of the previous letter
+		case r <= unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			if separate && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			separate = false
+			b.WriteRune(unicode.ToLower(r))
+		default:
+			separate = true
+		}
+	}
+
+	slug := b.String()
+	if len(slug) > MaxSlugLength {
+		slug = strings.TrimRight(slug[:MaxSlugLength], "-")
+	}
+	return slug
+}
+
+// SectionSlug returns the base slug of a section title (DefaultSectionSlug when Slugify has nothing)
+func SectionSlug(title string) string {
+	if slug := Slugify(title); slug != "" {
+		return slug
+	}
+	return DefaultSectionSlug
+}
+
+// PortfolioSlug returns the base slug of a portfolio title (DefaultPortfolioSlug when Slugify has nothing)
+func PortfolioSlug(title string) string {
+	if slug := Slugify(title); slug != "" {
+		return slug
+	}
+	return DefaultPortfolioSlug
+}
@@ -0,0 +1,43 @@
+package portfolio
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSlugify(t *testing.T) {
+	tests := []struct {
+		title string
+		want  string
+	}{
+		{title: "About me", want: "about-me"},
+		{title: "À propos de moi", want: "a-propos-de-moi"},
+		{title: "  Work -- 2024!  ", want: "work-2024"},
+		{title: "C++ & Go", want: "c-go"},
+		{title: "日本語", want: ""},
+		{title: "Ünïcödé 日本 mix", want: "unicode-mix"},
+		{title: strings.Repeat("ab ", 40), want: strings.TrimSuffix(strings.Repeat("ab-", 27), "-")},
+	}
+
+	for _, tt := range tests {
+		got := Slugify(tt.title)
+		if got != tt.want {
+			t.Errorf("Slugify(%q) = %q, want %q", tt.title, got, tt.want)
+		}
+		if len(got) > MaxSlugLength {
+			t.Errorf("Slugify(%q) is %d bytes, want at most %d", tt.title, len(got), MaxSlugLength)
+		}
+	}
+}
+
+func TestDefaultSlugs(t *testing.T) {
+	if got := SectionSlug("!!!"); got != DefaultSectionSlug {
+		t.Errorf("SectionSlug(!!!) = %q, want %q", got, DefaultSectionSlug)
+	}
+	if got := PortfolioSlug("日本語"); got != DefaultPortfolioSlug {
+		t.Errorf("PortfolioSlug(日本語) = %q, want %q", got, DefaultPortfolioSlug)
+	}
+	if got := SectionSlug("Contact"); got != "contact" {
+		t.Errorf("SectionSlug(Contact) = %q, want contact", got)
+	}
+}
@@ -0,0 +1,58 @@
+package events
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/actor"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// publishingAuditLogger is an AuditLogger decorator turning audited mutations into change events
+// Every use case already audits its writes, so this is the one place that sees them all.
+// Events go to the acting user, who is the owner of everything reachable through /own routes.
+type publishingAuditLogger struct {
+	next contracts.AuditLogger
+	bus  contracts.ChangeEventBus
+}
+
+// NewPublishingAuditLogger wraps next so creates, updates and deletes are also published on bus
+func NewPublishingAuditLogger(next contracts.AuditLogger, bus contracts.ChangeEventBus) contracts.AuditLogger {
+	return &publishingAuditLogger{next: next, bus: bus}
+}
+
+func (l *publishingAuditLogger) LogCreate(ctx context.Context, entity string, id uint, data map[string]interface{}) {
+	l.next.LogCreate(ctx, entity, id, data)
+	l.publish(ctx, entity, id, dto.ChangeActionCreated)
+}
+
+func (l *publishingAuditLogger) LogUpdate(ctx context.Context, entity string, id uint, data map[string]interface{}) {
+	l.next.LogUpdate(ctx, entity, id, data)
+	l.publish(ctx, entity, id, dto.ChangeActionUpdated)
+}
+
+func (l *publishingAuditLogger) LogDelete(ctx context.Context, entity string, id uint, data map[string]interface{}) {
+	l.next.LogDelete(ctx, entity, id, data)
+	l.publish(ctx, entity, id, dto.ChangeActionDeleted)
+}
+
+func (l *publishingAuditLogger) LogAccess(ctx context.Context, entity string, id uint, userID string, allowed bool) {
+	l.next.LogAccess(ctx, entity, id, userID, allowed)
+}
+
+func (l *publishingAuditLogger) publish(ctx context.Context, entity string, id uint, action string) {
+	// The actor is "<userID>/<credential>" (see actor.Format)
+	userID, _, _ := strings.Cut(actor.FromContext(ctx), "/")
+	if userID == "" {
+		return
+	}
+
+	l.bus.Publish(userID, dto.ChangeEventDTO{
+		Resource:   entity,
+		ResourceID: id,
+		Action:     action,
+		UpdatedAt:  time.Now().UTC(),
+	})
+}
@@ -0,0 +1,263 @@
+// Package events is the in-process change event bus behind the own-content SSE stream.
+package events
+
+import (
+	"sync"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// Bus defaults
+const (
+	DefaultMaxStreamsPerUser = 5   // Open streams (tabs) per user
+	DefaultReplaySize        = 100 // Events kept per user for Last-Event-ID replay
+	subscriberBuffer         = 32  // Events queued per stream before it is dropped as too slow
+
+	// DefaultReplayRetention is how long the replay buffer of a user without open streams is
+	// kept after their last event, for tabs reconnecting; then the user is forgotten
+	DefaultReplayRetention = 10 * time.Minute
+)
+
+// bus is the in-memory implementation of ChangeEventBus
+// Events only live in this process: a restart (or another replica) means a resync.
+type bus struct {
+	mu         sync.Mutex
+	lastID     uint64
+	users      map[string]*userEvents
+	maxStreams int
+	replaySize int
+	retention  time.Duration
+	lastSweep  time.Time
+	closed     bool
+	metrics    contracts.MetricsCollector
+	now        func() time.Time
+}
+
+// userEvents is the replay buffer and open streams of one user
+type userEvents struct {
+	ring          []dto.ChangeEventDTO // Oldest first, at most replaySize
+	subscriptions map[*subscription]struct{}
+	lastEvent     time.Time // When the newest event of the ring was published
+}
+
+// NewBus creates a change event bus allowing maxStreamsPerUser open streams per user (default when <= 0)
+func NewBus(maxStreamsPerUser int, metrics contracts.MetricsCollector) contracts.ChangeEventBus {
+	if maxStreamsPerUser <= 0 {
+		maxStreamsPerUser = DefaultMaxStreamsPerUser
+	}
+	return &bus{
+		users:      make(map[string]*userEvents),
+		maxStreams: maxStreamsPerUser,
+		replaySize: DefaultReplaySize,
+		retention:  DefaultReplayRetention,
+		lastSweep:  time.Now(),
+		metrics:    metrics,
+		now:        time.Now,
+	}
+}
+
+// Publish assigns the event its ID, buffers it and delivers it to the user's streams
+// A stream whose queue is full is closed rather than blocking the publisher; the
+// client reconnects with its Last-Event-ID and catches up from the replay buffer.
+func (b *bus) Publish(userID string, event dto.ChangeEventDTO) {
+	if userID == "" {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return
+	}
+
+	now := b.now()
+	b.sweepLocked(now)
+
+	b.lastID++
+	event.ID = b.lastID
+
+	user := b.userLocked(userID)
+	user.lastEvent = now
+	user.ring = append(user.ring, event)
+	if len(user.ring) > b.replaySize {
+		user.ring = append([]dto.ChangeEventDTO(nil), user.ring[len(user.ring)-b.replaySize:]...)
+	}
+
+	for sub := range user.subscriptions {
+		select {
+		case sub.events <- event:
+		default:
+			b.removeLocked(sub)
+		}
+	}
+}
+
+// Subscribe opens a stream for the user, replaying the buffered events after lastEventID
+func (b *bus) Subscribe(userID string, lastEventID uint64) (contracts.ChangeSubscription, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return nil, contracts.ErrChangeEventBusClosed
+	}
+
+	now := b.now()
+	b.sweepLocked(now)
+
+	user, known := b.users[userID]
+	if !known {
+		user = b.userLocked(userID)
+	}
+	if len(user.subscriptions) >= b.maxStreams {
+		return nil, contracts.ErrTooManyChangeStreams
+	}
+
+	sub := &subscription{
+		bus:    b,
+		userID: userID,
+		events: make(chan dto.ChangeEventDTO, subscriberBuffer),
+	}
+
+	if lastEventID > 0 {
+		switch {
+		case lastEventID > b.lastID:
+			// The ID comes from before a restart
+			sub.resync = true
+		case !known && lastEventID < b.lastID:
+			// The user's replay buffer aged out (or never existed): events may have been missed
+			sub.resync = true
+		case len(user.ring) > 0 && lastEventID < user.ring[0].ID-1:
+			// Some of the user's events may have been evicted from the ring; since IDs are
+			// global we can't tell for sure, so err on the side of a resync
+			sub.resync = true
+			sub.replay = append([]dto.ChangeEventDTO(nil), user.ring...)
+		default:
+			for _, event := range user.ring {
+				if event.ID > lastEventID {
+					sub.replay = append(sub.replay, event)
+				}
+			}
+		}
+	}
+
+	user.subscriptions[sub] = struct{}{}
+	if b.metrics != nil {
+		b.metrics.AddEventStreamConnections(1)
+	}
+
+	return sub, nil
+}
+
+// Recent returns up to limit of the user's buffered events, most recent first
+func (b *bus) Recent(userID string, limit int) []dto.ChangeEventDTO {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	user, ok := b.users[userID]
+	if !ok {
+		return []dto.ChangeEventDTO{}
+	}
+
+	if limit <= 0 || limit > len(user.ring) {
+		limit = len(user.ring)
+	}
+	recent := make([]dto.ChangeEventDTO, 0, limit)
+	for i := len(user.ring) - 1; i >= 0 && len(recent) < limit; i-- {
+		recent = append(recent, user.ring[i])
+	}
+	return recent
+}
+
+// Close ends every open stream and rejects new ones (called when the server shuts down)
+func (b *bus) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+	for _, user := range b.users {
+		for sub := range user.subscriptions {
+			b.removeLocked(sub)
+		}
+	}
+}
+
+func (b *bus) userLocked(userID string) *userEvents {
+	user, ok := b.users[userID]
+	if !ok {
+		user = &userEvents{subscriptions: make(map[*subscription]struct{})}
+		b.users[userID] = user
+	}
+	return user
+}
+
+// removeLocked detaches a stream and closes its channel (no-op when already removed)
+func (b *bus) removeLocked(sub *subscription) {
+	user, ok := b.users[sub.userID]
+	if !ok {
+		return
+	}
+	if _, ok := user.subscriptions[sub]; !ok {
+		return
+	}
+
+	delete(user.subscriptions, sub)
+	close(sub.events)
+	if b.metrics != nil {
+		b.metrics.AddEventStreamConnections(-1)
+	}
+
+	if len(user.subscriptions) == 0 && b.agedOut(user, b.now()) {
+		delete(b.users, sub.userID)
+	}
+}
+
+// sweepLocked forgets the users without open streams whose replay buffer aged out, at most once
+// per retention period
+func (b *bus) sweepLocked(now time.Time) {
+	if now.Sub(b.lastSweep) < b.retention {
+		return
+	}
+	b.lastSweep = now
+
+	for userID, user := range b.users {
+		if len(user.subscriptions) == 0 && b.agedOut(user, now) {
+			delete(b.users, userID)
+		}
+	}
+}
+
+// agedOut reports whether the user's replay buffer is empty or too old to be worth keeping
+func (b *bus) agedOut(user *userEvents, now time.Time) bool {
+	return len(user.ring) == 0 || now.Sub(user.lastEvent) >= b.retention
+}
+
+// subscription is one open stream of a user
+type subscription struct {
+	bus    *bus
+	userID string
+	events chan dto.ChangeEventDTO
+	replay []dto.ChangeEventDTO
+	resync bool
+}
+
+func (s *subscription) Replay() []dto.ChangeEventDTO {
+	return s.replay
+}
+
+func (s *subscription) Resync() bool {
+	return s.resync
+}
+
+func (s *subscription) Events() <-chan dto.ChangeEventDTO {
+	return s.events
+}
+
+func (s *subscription) Close() {
+	s.bus.mu.Lock()
+	defer s.bus.mu.Unlock()
+
+	s.bus.removeLocked(s)
+}
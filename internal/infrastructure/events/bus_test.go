@@ -0,0 +1,92 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// newTestBus returns a bus whose clock the test moves with the returned function
+func newTestBus(t *testing.T) (*bus, func(time.Duration)) {
+	t.Helper()
+
+	b := NewBus(DefaultMaxStreamsPerUser, nil).(*bus)
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	b.now = func() time.Time { return now }
+	b.lastSweep = now
+	t.Cleanup(b.Close)
+
+	return b, func(d time.Duration) { now = now.Add(d) }
+}
+
+func TestBus_ForgetsUsers(t *testing.T) {
+	tests := []struct {
+		name       string
+		publish    bool
+		wait       time.Duration
+		wantKnown  bool
+		wantResync bool
+	}{
+		{name: "no events", publish: false, wait: 0, wantKnown: false, wantResync: true},
+		{name: "recent events", publish: true, wait: time.Minute, wantKnown: true, wantResync: false},
+		{name: "aged out events", publish: true, wait: DefaultReplayRetention, wantKnown: false, wantResync: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, advance := newTestBus(t)
+
+			sub, err := b.Subscribe("user-1", 0)
+			if err != nil {
+				t.Fatalf("Subscribe: %v", err)
+			}
+			if tt.publish {
+				b.Publish("user-1", dto.ChangeEventDTO{Resource: "project", ResourceID: 1, Action: "update"})
+			}
+			b.Publish("user-2", dto.ChangeEventDTO{Resource: "project", ResourceID: 2, Action: "update"})
+			b.Publish("user-2", dto.ChangeEventDTO{Resource: "project", ResourceID: 3, Action: "update"})
+			advance(tt.wait)
+			sub.Close()
+
+			if _, known := b.users["user-1"]; known != tt.wantKnown {
+				t.Fatalf("user kept = %v, want %v", known, tt.wantKnown)
+			}
+
+			// A reconnect after the buffer was dropped can't be replayed and must resync
+			again, err := b.Subscribe("user-1", 1)
+			if err != nil {
+				t.Fatalf("Subscribe: %v", err)
+			}
+			defer again.Close()
+			if again.Resync() != tt.wantResync {
+				t.Errorf("Resync() = %v, want %v", again.Resync(), tt.wantResync)
+			}
+		})
+	}
+}
+
+func TestBus_SweepsIdleUsers(t *testing.T) {
+	b, advance := newTestBus(t)
+
+	b.Publish("idle", dto.ChangeEventDTO{Resource: "portfolio", ResourceID: 1, Action: "update"})
+
+	connected, err := b.Subscribe("connected", 0)
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+	defer connected.Close()
+
+	advance(DefaultReplayRetention)
+	b.Publish("active", dto.ChangeEventDTO{Resource: "portfolio", ResourceID: 2, Action: "update"})
+
+	if _, ok := b.users["idle"]; ok {
+		t.Error("idle user with an aged out buffer was not swept")
+	}
+	if _, ok := b.users["connected"]; !ok {
+		t.Error("user with an open stream was swept")
+	}
+	if got := b.Recent("active", 10); len(got) != 1 {
+		t.Errorf("Recent(active) returned %d events, want 1", len(got))
+	}
+}
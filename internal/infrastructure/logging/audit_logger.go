@@ -3,10 +3,10 @@ package logging
 import (
 	"context"
 	"io"
-	"os"
-	"path/filepath"
 
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/actor"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/requestid"
 	"github.com/sirupsen/logrus"
 )
 
@@ -18,37 +18,21 @@ type auditLogger struct {
 	accessLogger *logrus.Logger
 }
 
-// NewAuditLogger creates a new audit logger instance
+// NewAuditLogger creates a new audit logger instance writing to the shared log writers
 // Returns the interface type (contracts.AuditLogger), not the concrete type
-func NewAuditLogger() contracts.AuditLogger {
-	// Ensure logs directory exists
-	logsDir := "logs"
-	if err := os.MkdirAll(logsDir, 0755); err != nil {
-		// Fallback to current directory if can't create logs directory
-		logsDir = "."
-	}
-
+func NewAuditLogger(writers *Writers) contracts.AuditLogger {
 	return &auditLogger{
-		createLogger: setupLogger(filepath.Join(logsDir, "create.log")),
-		updateLogger: setupLogger(filepath.Join(logsDir, "update.log")),
-		deleteLogger: setupLogger(filepath.Join(logsDir, "delete.log")),
-		accessLogger: setupLogger(filepath.Join(logsDir, "access.log")),
+		createLogger: setupLogger(writers.Writer(LogCreate)),
+		updateLogger: setupLogger(writers.Writer(LogUpdate)),
+		deleteLogger: setupLogger(writers.Writer(LogDelete)),
+		accessLogger: setupLogger(writers.Writer(LogAccess)),
 	}
 }
 
-// setupLogger creates and configures a logrus logger for a specific log file
-func setupLogger(filename string) *logrus.Logger {
+// setupLogger creates and configures a logrus logger writing to output
+func setupLogger(output io.Writer) *logrus.Logger {
 	logger := logrus.New()
-
-	// Open log file
-	file, err := os.OpenFile(filename, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
-	if err != nil {
-		// If file can't be opened, log to stdout only
-		logger.SetOutput(os.Stdout)
-	} else {
-		// Write to both file and stdout
-		logger.SetOutput(io.MultiWriter(os.Stdout, file))
-	}
+	logger.SetOutput(output)
 
 	// Use JSON formatting for structured logging
 	logger.SetFormatter(&logrus.JSONFormatter{
@@ -58,6 +42,9 @@ func setupLogger(filename string) *logrus.Logger {
 	// Set log level
 	logger.SetLevel(logrus.InfoLevel)
 
+	// Bound the size of every entry (oversized or binary request values)
+	logger.AddHook(newTruncationHook(DefaultMaxFieldLength, DefaultMaxEntryBytes))
+
 	return logger
 }
 
@@ -67,7 +54,7 @@ func (l *auditLogger) LogCreate(ctx context.Context, entity string, id uint, dat
 		"entity": entity,
 		"id":     id,
 		"data":   data,
-	}).Info("Entity created")
+	}).WithFields(requestFields(ctx)).Info("Entity created")
 }
 
 // LogUpdate logs entity update events
@@ -76,7 +63,7 @@ func (l *auditLogger) LogUpdate(ctx context.Context, entity string, id uint, dat
 		"entity": entity,
 		"id":     id,
 		"data":   data,
-	}).Info("Entity updated")
+	}).WithFields(requestFields(ctx)).Info("Entity updated")
 }
 
 // LogDelete logs entity deletion events
@@ -85,7 +72,7 @@ func (l *auditLogger) LogDelete(ctx context.Context, entity string, id uint, dat
 		"entity": entity,
 		"id":     id,
 		"data":   data,
-	}).Info("Entity deleted")
+	}).WithFields(requestFields(ctx)).Info("Entity deleted")
 }
 
 // LogAccess logs entity access attempts (authorized or unauthorized)
@@ -103,5 +90,14 @@ func (l *auditLogger) LogAccess(ctx context.Context, entity string, id uint, use
 		"id":      id,
 		"userID":  userID,
 		"allowed": allowed,
-	}).Log(level, message)
+	}).WithFields(requestFields(ctx)).Log(level, message)
+}
+
+// requestFields returns who made the request being served and its ID, for correlation
+// Entries written outside an HTTP request (background jobs) get an empty request_id.
+func requestFields(ctx context.Context) logrus.Fields {
+	return logrus.Fields{
+		"actor":      actor.FromContext(ctx),
+		"request_id": requestid.FromContext(ctx),
+	}
 }
@@ -0,0 +1,56 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/actor"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/requestid"
+)
+
+func TestAuditLogger_RequestFields(t *testing.T) {
+	tests := []struct {
+		name          string
+		ctx           context.Context
+		wantRequestID string
+		wantActor     string
+	}{
+		{
+			name:          "http request",
+			ctx:           requestid.WithRequestID(actor.WithActor(context.Background(), "user-1/jwt"), "req-42"),
+			wantRequestID: "req-42",
+			wantActor:     "user-1/jwt",
+		},
+		{name: "background job", ctx: context.Background()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			logger := setupLogger(&buf)
+			l := &auditLogger{createLogger: logger, updateLogger: logger, deleteLogger: logger, accessLogger: logger}
+
+			l.LogCreate(tt.ctx, "portfolio", 7, map[string]interface{}{"title": "Mine"})
+			l.LogAccess(tt.ctx, "portfolio", 7, "user-2", false)
+
+			lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+			if len(lines) != 2 {
+				t.Fatalf("got %d entries, want 2:\n%s", len(lines), buf.String())
+			}
+			for _, line := range lines {
+				var entry map[string]interface{}
+				if err := json.Unmarshal(line, &entry); err != nil {
+					t.Fatalf("entry is not JSON: %v\n%s", err, line)
+				}
+				if entry["request_id"] != tt.wantRequestID {
+					t.Errorf("request_id = %v, want %q", entry["request_id"], tt.wantRequestID)
+				}
+				if entry["actor"] != tt.wantActor {
+					t.Errorf("actor = %v, want %q", entry["actor"], tt.wantActor)
+				}
+			}
+		})
+	}
+}
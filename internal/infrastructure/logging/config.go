@@ -0,0 +1,180 @@
+package logging
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// Log sinks
+const (
+	SinkFile   = "file"   // rotated files under Dir, mirrored to stdout
+	SinkStdout = "stdout" // stdout only (containers shipping logs elsewhere)
+)
+
+// Log file names (without extension) opened by OpenWriters
+const (
+	LogApp    = "app"
+	LogCreate = "create"
+	LogUpdate = "update"
+	LogDelete = "delete"
+	LogAccess = "access"
+)
+
+// Config describes where logs go and how the files rotate
+type Config struct {
+	Sink       string
+	Dir        string
+	MaxSizeMB  int  // Size a file reaches before it is rotated
+	MaxBackups int  // Rotated files kept per log (0 keeps all)
+	MaxAgeDays int  // Days rotated files are kept (0 keeps them forever)
+	Compress   bool // Gzip rotated files
+}
+
+// DefaultConfig returns the configuration used when nothing is set
+func DefaultConfig() Config {
+	return Config{
+		Sink:       SinkFile,
+		Dir:        "logs",
+		MaxSizeMB:  100,
+		MaxBackups: 5,
+		MaxAgeDays: 30,
+		Compress:   true,
+	}
+}
+
+// ConfigFromEnv reads the logging configuration from LOG_SINK, LOG_DIR, LOG_MAX_SIZE_MB,
+// LOG_MAX_BACKUPS, LOG_MAX_AGE_DAYS and LOG_COMPRESS, starting from DefaultConfig
+// Malformed values are errors rather than silent fallbacks.
+func ConfigFromEnv(getenv func(string) string) (Config, error) {
+	cfg := DefaultConfig()
+
+	if value := getenv("LOG_SINK"); value != "" {
+		cfg.Sink = strings.ToLower(value)
+	}
+	if value := getenv("LOG_DIR"); value != "" {
+		cfg.Dir = value
+	}
+
+	ints := []struct {
+		key    string
+		target *int
+	}{
+		{"LOG_MAX_SIZE_MB", &cfg.MaxSizeMB},
+		{"LOG_MAX_BACKUPS", &cfg.MaxBackups},
+		{"LOG_MAX_AGE_DAYS", &cfg.MaxAgeDays},
+	}
+	for _, entry := range ints {
+		value := getenv(entry.key)
+		if value == "" {
+			continue
+		}
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid %s %q: must be an integer", entry.key, value)
+		}
+		*entry.target = parsed
+	}
+
+	if value := getenv("LOG_COMPRESS"); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid LOG_COMPRESS %q: must be a boolean", value)
+		}
+		cfg.Compress = parsed
+	}
+
+	return cfg, cfg.Validate()
+}
+
+// Validate checks the configuration before any file is touched
+func (c Config) Validate() error {
+	switch c.Sink {
+	case SinkStdout:
+		return nil
+	case SinkFile:
+	default:
+		return fmt.Errorf("invalid log sink %q: must be %q or %q", c.Sink, SinkFile, SinkStdout)
+	}
+
+	if strings.TrimSpace(c.Dir) == "" {
+		return fmt.Errorf("log directory is required for the %q sink", SinkFile)
+	}
+	if c.MaxSizeMB <= 0 {
+		return fmt.Errorf("log max size must be positive, got %d MB", c.MaxSizeMB)
+	}
+	if c.MaxBackups < 0 {
+		return fmt.Errorf("log max backups cannot be negative, got %d", c.MaxBackups)
+	}
+	if c.MaxAgeDays < 0 {
+		return fmt.Errorf("log max age cannot be negative, got %d days", c.MaxAgeDays)
+	}
+	return nil
+}
+
+// Writers holds the log outputs of the process, opened once and shared by every logger
+type Writers struct {
+	files map[string]*lumberjack.Logger
+}
+
+// OpenWriters creates the log directory and opens one rotated file per log
+// Every file is opened eagerly so an unwritable directory fails at startup instead
+// of on the first log line. The stdout sink opens nothing.
+func OpenWriters(cfg Config) (*Writers, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	writers := &Writers{files: make(map[string]*lumberjack.Logger)}
+	if cfg.Sink == SinkStdout {
+		return writers, nil
+	}
+
+	if err := os.MkdirAll(cfg.Dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create log directory %s: %w", cfg.Dir, err)
+	}
+
+	for _, name := range []string{LogApp, LogCreate, LogUpdate, LogDelete, LogAccess} {
+		filename := filepath.Join(cfg.Dir, name+".log")
+		file, err := os.OpenFile(filename, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+		if err != nil {
+			_ = writers.Close()
+			return nil, fmt.Errorf("failed to open log file %s: %w", filename, err)
+		}
+		_ = file.Close()
+
+		writers.files[name] = &lumberjack.Logger{
+			Filename:   filename,
+			MaxSize:    cfg.MaxSizeMB,
+			MaxBackups: cfg.MaxBackups,
+			MaxAge:     cfg.MaxAgeDays,
+			Compress:   cfg.Compress,
+		}
+	}
+
+	return writers, nil
+}
+
+// Writer returns the output of a log: its rotated file mirrored to stdout, or stdout alone
+func (w *Writers) Writer(name string) io.Writer {
+	if file, ok := w.files[name]; ok {
+		return io.MultiWriter(os.Stdout, file)
+	}
+	return os.Stdout
+}
+
+// Close closes every open log file
+func (w *Writers) Close() error {
+	var firstErr error
+	for _, file := range w.files {
+		if err := file.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
@@ -0,0 +1,128 @@
+package logging
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestOpenWritersReadOnlyDirectory(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.Chmod(dir, 0500); err != nil {
+		t.Fatalf("chmod: %v", err)
+	}
+	t.Cleanup(func() { _ = os.Chmod(dir, 0755) })
+
+	// Root ignores directory permissions, so the failure can't be observed
+	if probe, err := os.Create(filepath.Join(dir, "probe")); err == nil {
+		_ = probe.Close()
+		t.Skip("directory permissions are not enforced for this user")
+	}
+
+	cfg := DefaultConfig()
+	cfg.Dir = dir
+	writers, err := OpenWriters(cfg)
+	if err == nil {
+		_ = writers.Close()
+		t.Fatal("OpenWriters succeeded in a read-only directory, want an error")
+	}
+	if !strings.Contains(err.Error(), filepath.Join(dir, LogApp+".log")) {
+		t.Errorf("error = %v, want it to name the log file", err)
+	}
+
+	// The stdout sink never touches the directory
+	cfg.Sink = SinkStdout
+	if _, err := OpenWriters(cfg); err != nil {
+		t.Errorf("OpenWriters with the stdout sink: %v", err)
+	}
+}
+
+func TestOpenWritersFailures(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(t *testing.T, root string) string // returns the log directory
+		wantErr string
+	}{
+		{
+			name: "directory path is a file",
+			setup: func(t *testing.T, root string) string {
+				file := filepath.Join(root, "file")
+				if err := os.WriteFile(file, nil, 0644); err != nil {
+					t.Fatal(err)
+				}
+				return filepath.Join(file, "logs")
+			},
+			wantErr: "failed to create log directory",
+		},
+		{
+			name: "log file path is a directory",
+			setup: func(t *testing.T, root string) string {
+				if err := os.MkdirAll(filepath.Join(root, LogDelete+".log"), 0755); err != nil {
+					t.Fatal(err)
+				}
+				return root
+			},
+			wantErr: "failed to open log file",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.Dir = tt.setup(t, t.TempDir())
+
+			writers, err := OpenWriters(cfg)
+			if err == nil {
+				_ = writers.Close()
+				t.Fatal("OpenWriters succeeded, want an error")
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestOpenWritersCreatesEveryLog(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Dir = filepath.Join(t.TempDir(), "nested", "logs")
+
+	writers, err := OpenWriters(cfg)
+	if err != nil {
+		t.Fatalf("OpenWriters: %v", err)
+	}
+	defer writers.Close()
+
+	for _, name := range []string{LogApp, LogCreate, LogUpdate, LogDelete, LogAccess} {
+		if _, err := os.Stat(filepath.Join(cfg.Dir, name+".log")); err != nil {
+			t.Errorf("%s.log: %v", name, err)
+		}
+	}
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     map[string]string
+		wantErr bool
+	}{
+		{name: "defaults"},
+		{name: "stdout sink needs no directory", env: map[string]string{"LOG_SINK": "STDOUT", "LOG_MAX_SIZE_MB": "0"}},
+		{name: "unknown sink", env: map[string]string{"LOG_SINK": "syslog"}, wantErr: true},
+		{name: "malformed size", env: map[string]string{"LOG_MAX_SIZE_MB": "10MB"}, wantErr: true},
+		{name: "zero size", env: map[string]string{"LOG_MAX_SIZE_MB": "0"}, wantErr: true},
+		{name: "negative backups", env: map[string]string{"LOG_MAX_BACKUPS": "-1"}, wantErr: true},
+		{name: "malformed compress", env: map[string]string{"LOG_COMPRESS": "sometimes"}, wantErr: true},
+		{name: "blank directory", env: map[string]string{"LOG_DIR": "  "}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ConfigFromEnv(func(key string) string { return tt.env[key] })
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ConfigFromEnv error = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package logging
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Audit entry size limits
+const (
+	DefaultMaxFieldLength = 500       // characters kept per string field
+	DefaultMaxEntryBytes  = 16 * 1024 // serialized size of all fields of one entry
+)
+
+// TruncateString caps s at maxLength characters, appending a notice with the original size
+// Strings that are not valid UTF-8 are treated as binary content and replaced entirely.
+func TruncateString(s string, maxLength int) string {
+	if !utf8.ValidString(s) {
+		return fmt.Sprintf("…(binary data, %d bytes)", len(s))
+	}
+	if maxLength <= 0 || utf8.RuneCountInString(s) <= maxLength {
+		return s
+	}
+
+	runes := []rune(s)
+	return fmt.Sprintf("%s…(truncated, %d bytes)", string(runes[:maxLength]), len(s))
+}
+
+// truncationHook is a logrus hook bounding the size of every audit entry
+// Applied at the logger level so individual handlers don't need to sanitize their payloads.
+type truncationHook struct {
+	maxFieldLength int
+	maxEntryBytes  int
+}
+
+// newTruncationHook creates a hook with the given limits (defaults when <= 0)
+func newTruncationHook(maxFieldLength, maxEntryBytes int) *truncationHook {
+	if maxFieldLength <= 0 {
+		maxFieldLength = DefaultMaxFieldLength
+	}
+	if maxEntryBytes <= 0 {
+		maxEntryBytes = DefaultMaxEntryBytes
+	}
+	return &truncationHook{maxFieldLength: maxFieldLength, maxEntryBytes: maxEntryBytes}
+}
+
+// Levels applies the hook to every level
+func (h *truncationHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+// Fire truncates the entry fields in place before the entry is formatted
+func (h *truncationHook) Fire(entry *logrus.Entry) error {
+	entry.Message = TruncateString(entry.Message, h.maxFieldLength)
+
+	for key, value := range entry.Data {
+		entry.Data[key] = h.truncateValue(value)
+	}
+
+	h.capEntry(entry.Data)
+	return nil
+}
+
+// truncateValue walks strings nested in maps and slices
+func (h *truncationHook) truncateValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case string:
+		return TruncateString(v, h.maxFieldLength)
+	case *string:
+		if v == nil {
+			return v
+		}
+		truncated := TruncateString(*v, h.maxFieldLength)
+		return &truncated
+	case []string:
+		out := make([]string, len(v))
+		for i, s := range v {
+			out[i] = TruncateString(s, h.maxFieldLength)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, item := range v {
+			out[i] = h.truncateValue(item)
+		}
+		return out
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			out[k] = h.truncateValue(item)
+		}
+		return out
+	case logrus.Fields:
+		out := make(logrus.Fields, len(v))
+		for k, item := range v {
+			out[k] = h.truncateValue(item)
+		}
+		return out
+	case error:
+		return TruncateString(v.Error(), h.maxFieldLength)
+	default:
+		return value
+	}
+}
+
+// capEntry summarizes the largest fields until the entry fits within maxEntryBytes
+// Many individually-truncated fields can still add up, so the biggest ones are
+// replaced by a size notice (largest first) rather than dropping the entry.
+func (h *truncationHook) capEntry(data logrus.Fields) {
+	sizes := make(map[string]int, len(data))
+	total := 0
+	for key, value := range data {
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			continue
+		}
+		sizes[key] = len(encoded)
+		total += len(encoded) + len(key)
+	}
+
+	if total <= h.maxEntryBytes {
+		return
+	}
+
+	keys := make([]string, 0, len(sizes))
+	for key := range sizes {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if sizes[keys[i]] != sizes[keys[j]] {
+			return sizes[keys[i]] > sizes[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+
+	for _, key := range keys {
+		if total <= h.maxEntryBytes {
+			break
+		}
+		notice := fmt.Sprintf("…(omitted, %d bytes)", sizes[key])
+		data[key] = notice
+		total -= sizes[key] - len(notice)
+	}
+	data["truncated"] = true
+}
@@ -0,0 +1,101 @@
+package logging
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/sirupsen/logrus"
+)
+
+func TestTruncateString(t *testing.T) {
+	tests := []struct {
+		name      string
+		s         string
+		maxLength int
+		want      string
+	}{
+		{name: "under the limit", s: "abc", maxLength: 5, want: "abc"},
+		{name: "at the limit", s: "abcde", maxLength: 5, want: "abcde"},
+		{name: "one over the limit", s: "abcdef", maxLength: 5, want: "abcde…(truncated, 6 bytes)"},
+		{name: "no limit", s: "abcdef", maxLength: 0, want: "abcdef"},
+		{name: "empty", s: "", maxLength: 5, want: ""},
+		// "é" is 2 bytes, "😀" is 4: the limit counts characters and the notice bytes
+		{name: "multi-byte runes at the limit", s: "ééé", maxLength: 3, want: "ééé"},
+		{name: "multi-byte rune on the boundary", s: "ab😀c", maxLength: 3, want: "ab😀…(truncated, 7 bytes)"},
+		{name: "cut before a multi-byte rune", s: "ab😀c", maxLength: 2, want: "ab…(truncated, 7 bytes)"},
+		{name: "combining mark split from its letter", s: "éé", maxLength: 3, want: "ée…(truncated, 6 bytes)"},
+		{name: "invalid UTF-8", s: "ab\xffcd", maxLength: 10, want: "…(binary data, 5 bytes)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TruncateString(tt.s, tt.maxLength)
+			if got != tt.want {
+				t.Errorf("TruncateString(%q, %d) = %q, want %q", tt.s, tt.maxLength, got, tt.want)
+			}
+			if !utf8.ValidString(got) {
+				t.Errorf("TruncateString(%q, %d) = %q, not valid UTF-8", tt.s, tt.maxLength, got)
+			}
+		})
+	}
+}
+
+func TestTruncationHook(t *testing.T) {
+	long := strings.Repeat("x", 20)
+	entry := &logrus.Entry{
+		Message: long,
+		Data: logrus.Fields{
+			"title":  long,
+			"skills": []string{"go", long},
+			"nested": map[string]interface{}{"body": long, "count": 3},
+			"err":    errors.New(long),
+			"id":     uint(7),
+		},
+	}
+
+	if err := newTruncationHook(10, 1024).Fire(entry); err != nil {
+		t.Fatalf("Fire: %v", err)
+	}
+
+	want := "xxxxxxxxxx…(truncated, 20 bytes)"
+	if entry.Message != want || entry.Data["title"] != want || entry.Data["err"] != want {
+		t.Errorf("message, title, err = %q, %q, %q, want %q", entry.Message, entry.Data["title"], entry.Data["err"], want)
+	}
+	if skills := entry.Data["skills"].([]string); skills[0] != "go" || skills[1] != want {
+		t.Errorf("skills = %q, want [go %s]", skills, want)
+	}
+	if nested := entry.Data["nested"].(map[string]interface{}); nested["body"] != want || nested["count"] != 3 {
+		t.Errorf("nested = %v, want the body truncated and the count kept", nested)
+	}
+	if entry.Data["id"] != uint(7) {
+		t.Errorf("id = %v, want 7", entry.Data["id"])
+	}
+	if _, ok := entry.Data["truncated"]; ok {
+		t.Error("entry flagged as truncated, want it under the entry limit")
+	}
+}
+
+func TestTruncationHookCapsEntry(t *testing.T) {
+	entry := &logrus.Entry{Data: logrus.Fields{
+		"big":    strings.Repeat("b", 80),
+		"medium": strings.Repeat("m", 60),
+		"small":  "s",
+	}}
+
+	// 110 bytes fit "small" and one of the others: the largest goes first
+	if err := newTruncationHook(1000, 110).Fire(entry); err != nil {
+		t.Fatalf("Fire: %v", err)
+	}
+
+	if entry.Data["big"] != "…(omitted, 82 bytes)" {
+		t.Errorf("big = %v, want the omission notice", entry.Data["big"])
+	}
+	if entry.Data["medium"] != strings.Repeat("m", 60) || entry.Data["small"] != "s" {
+		t.Errorf("medium, small = %v, %v, want them kept", entry.Data["medium"], entry.Data["small"])
+	}
+	if entry.Data["truncated"] != true {
+		t.Errorf("truncated = %v, want true", entry.Data["truncated"])
+	}
+}
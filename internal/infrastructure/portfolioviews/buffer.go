@@ -0,0 +1,98 @@
+package portfolioviews
+
+import (
+	"sync"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// bufferKey is one portfolio on one UTC day
+type bufferKey struct {
+	portfolioID uint
+	day         time.Time
+}
+
+// visitorKey is one visitor of one portfolio within the current hour
+type visitorKey struct {
+	portfolioID uint
+	visitor     string
+}
+
+// buffer is the in-memory PortfolioViewRecorder of one API instance
+// Views are only held until the next flush, so a crash loses at most one interval of them.
+// Visitors are remembered for the current UTC hour only, so the dedup set never outgrows
+// one hour of traffic; each instance dedups the requests it serves.
+type buffer struct {
+	mu       sync.Mutex
+	pending  map[bufferKey]*dto.PortfolioViewBatchDTO
+	hour     time.Time
+	visitors map[visitorKey]struct{}
+}
+
+// NewBuffer creates an empty view buffer
+func NewBuffer() contracts.PortfolioViewRecorder {
+	return &buffer{
+		pending:  make(map[bufferKey]*dto.PortfolioViewBatchDTO),
+		visitors: make(map[visitorKey]struct{}),
+	}
+}
+
+// Record counts one view in the batch of the portfolio and day, unless the visitor was
+// already counted for the portfolio this hour
+func (b *buffer) Record(portfolioID uint, visitor string, at time.Time) bool {
+	at = at.UTC()
+	hour := at.Truncate(time.Hour)
+	key := bufferKey{portfolioID: portfolioID, day: time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, time.UTC)}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if hour.After(b.hour) {
+		b.hour = hour
+		b.visitors = make(map[visitorKey]struct{})
+	}
+	seen := visitorKey{portfolioID: portfolioID, visitor: visitor}
+	if _, ok := b.visitors[seen]; ok {
+		return false
+	}
+	b.visitors[seen] = struct{}{}
+
+	b.addLocked(key, dto.PortfolioViewBatchDTO{PortfolioID: portfolioID, Day: key.day, Views: 1})
+	return true
+}
+
+// Drain swaps the pending batches for an empty set and returns them (the visitors of the hour are kept)
+func (b *buffer) Drain() []dto.PortfolioViewBatchDTO {
+	b.mu.Lock()
+	pending := b.pending
+	b.pending = make(map[bufferKey]*dto.PortfolioViewBatchDTO)
+	b.mu.Unlock()
+
+	batches := make([]dto.PortfolioViewBatchDTO, 0, len(pending))
+	for _, batch := range pending {
+		batches = append(batches, *batch)
+	}
+	return batches
+}
+
+// Requeue merges the batches back into views recorded since the drain
+func (b *buffer) Requeue(batches []dto.PortfolioViewBatchDTO) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, batch := range batches {
+		b.addLocked(bufferKey{portfolioID: batch.PortfolioID, day: batch.Day}, batch)
+	}
+}
+
+func (b *buffer) addLocked(key bufferKey, batch dto.PortfolioViewBatchDTO) {
+	existing, ok := b.pending[key]
+	if !ok {
+		b.pending[key] = &batch
+		return
+	}
+
+	existing.Views += batch.Views
+}
@@ -0,0 +1,116 @@
+package portfolioviews
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// sorted orders batches by portfolio, then day
+func sorted(batches []dto.PortfolioViewBatchDTO) []dto.PortfolioViewBatchDTO {
+	sort.Slice(batches, func(i, j int) bool {
+		if batches[i].PortfolioID != batches[j].PortfolioID {
+			return batches[i].PortfolioID < batches[j].PortfolioID
+		}
+		return batches[i].Day.Before(batches[j].Day)
+	})
+	return batches
+}
+
+func TestBuffer_RecordDedupsVisitorsPerHour(t *testing.T) {
+	b := NewBuffer()
+	day := time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)
+	saoPaulo := time.FixedZone("BRT", -3*60*60)
+
+	records := []struct {
+		portfolioID uint
+		visitor     string
+		at          time.Time
+		want        bool
+	}{
+		{1, "a", day.Add(10 * time.Hour), true},
+		{1, "a", day.Add(10*time.Hour + 59*time.Minute), false}, // same visitor, same hour
+		{2, "a", day.Add(10*time.Hour + 5*time.Minute), true},   // same visitor, other portfolio
+		{1, "b", day.Add(10*time.Hour + 6*time.Minute), true},   // other visitor
+		{1, "a", day.Add(11 * time.Hour), true},                 // next hour
+		// 22:30 in São Paulo is already the next UTC day
+		{1, "a", time.Date(2026, 3, 9, 22, 30, 0, 0, saoPaulo), true},
+	}
+	for i, r := range records {
+		if got := b.Record(r.portfolioID, r.visitor, r.at); got != r.want {
+			t.Errorf("record %d: Record = %v, want %v", i, got, r.want)
+		}
+	}
+
+	got := sorted(b.Drain())
+	want := []dto.PortfolioViewBatchDTO{
+		{PortfolioID: 1, Day: day, Views: 3},
+		{PortfolioID: 1, Day: day.AddDate(0, 0, 1), Views: 1},
+		{PortfolioID: 2, Day: day, Views: 1},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Drain = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i].PortfolioID != want[i].PortfolioID || !got[i].Day.Equal(want[i].Day) || got[i].Views != want[i].Views {
+			t.Errorf("batch %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+
+	if again := b.Drain(); len(again) != 0 {
+		t.Errorf("second Drain = %+v, want an empty buffer", again)
+	}
+}
+
+func TestBuffer_DrainKeepsTheHoursVisitors(t *testing.T) {
+	b := NewBuffer()
+	at := time.Date(2026, 3, 9, 10, 0, 0, 0, time.UTC)
+
+	b.Record(1, "a", at)
+	b.Drain()
+	if b.Record(1, "a", at.Add(time.Minute)) {
+		t.Error("a visitor counted before the drain was counted again in the same hour")
+	}
+}
+
+func TestBuffer_RequeueMergesWithNewViews(t *testing.T) {
+	b := NewBuffer()
+	day := time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)
+
+	b.Record(1, "a", day.Add(time.Hour))
+	failed := b.Drain()
+
+	// Views recorded while the failed flush ran are kept alongside the requeued ones
+	b.Record(1, "b", day.Add(time.Hour))
+	b.Requeue(failed)
+
+	if got := b.Drain(); len(got) != 1 || got[0].Views != 2 {
+		t.Errorf("Drain after Requeue = %+v, want one batch of 2 views", got)
+	}
+}
+
+func TestBuffer_ConcurrentRecords(t *testing.T) {
+	b := NewBuffer()
+	now := time.Date(2026, 3, 9, 12, 0, 0, 0, time.UTC)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				b.Record(7, fmt.Sprintf("visitor-%d", i), now) // only the first of each visitor counts
+				b.Record(7, fmt.Sprintf("visitor-%d-%d", i, j), now)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if got := b.Drain(); len(got) != 1 || got[0].Views != 50+1000 {
+		t.Errorf("Drain = %+v, want one batch of 1050 views", got)
+	}
+}
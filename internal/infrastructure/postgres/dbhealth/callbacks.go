@@ -0,0 +1,99 @@
+package dbhealth
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"gorm.io/gorm"
+	"gorm.io/gorm/callbacks"
+)
+
+// readRetryDelay and readRetryJitter bound the pause before a failed read is retried
+// (delay plus up to jitter), spreading the retries of concurrent requests
+const (
+	readRetryDelay  = 50 * time.Millisecond
+	readRetryJitter = 150 * time.Millisecond
+)
+
+// RegisterCallbacks installs the connection failure handling on db:
+//   - a read (Find, First, Take, Count...) failing on a connection error is retried once, after
+//     the monitor discarded the idle connections, unless it runs inside a transaction
+//   - any operation still failing on a connection error returns an error wrapping
+//     contracts.ErrDatabaseUnavailable, so handlers answer 503 instead of 500
+//   - any operation failing on a foreign key violation returns an error wrapping
+//     contracts.ErrReferenceNotFound, so handlers answer 400 instead of 500
+//
+// Writes are never retried: the statement may have been applied before the connection dropped.
+func RegisterCallbacks(db *gorm.DB, monitor *Monitor) error {
+	if err := db.Callback().Query().After("gorm:query").Before("gorm:preload").
+		Register("dbhealth:retry_read", monitor.retryRead); err != nil {
+		return fmt.Errorf("failed to register read retry: %w", err)
+	}
+
+	processors := map[string]interface {
+		Register(name string, fn func(*gorm.DB)) error
+	}{
+		"create": db.Callback().Create(),
+		"query":  db.Callback().Query(),
+		"update": db.Callback().Update(),
+		"delete": db.Callback().Delete(),
+		"row":    db.Callback().Row(),
+		"raw":    db.Callback().Raw(),
+	}
+	for name, processor := range processors {
+		if err := processor.Register("dbhealth:classify_error", monitor.classifyError); err != nil {
+			return fmt.Errorf("failed to register %s error classification: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// retryRead runs a query failed on a connection error one more time
+func (m *Monitor) retryRead(db *gorm.DB) {
+	if db.Error == nil || ClassifyError(db.Error) != ErrorClassRetryable || inTransaction(db) {
+		return
+	}
+
+	// Drops the stale idle connections, so the retry dials a fresh one
+	m.ReportConnectionError(db.Error)
+
+	timer := time.NewTimer(readRetryDelay + rand.N(readRetryJitter))
+	defer timer.Stop()
+	select {
+	case <-db.Statement.Context.Done():
+		return
+	case <-timer.C:
+	}
+
+	db.Error = nil
+	callbacks.Query(db)
+}
+
+// classifyError wraps connection errors with contracts.ErrDatabaseUnavailable and
+// foreign key violations with contracts.ErrReferenceNotFound
+func (m *Monitor) classifyError(db *gorm.DB) {
+	if db.Error == nil || errors.Is(db.Error, contracts.ErrDatabaseUnavailable) || errors.Is(db.Error, contracts.ErrReferenceNotFound) {
+		return
+	}
+	if IsForeignKeyViolation(db.Error) {
+		db.Error = fmt.Errorf("%w: %w", contracts.ErrReferenceNotFound, db.Error)
+		return
+	}
+	if ClassifyError(db.Error) != ErrorClassRetryable {
+		return
+	}
+
+	m.ReportConnectionError(db.Error)
+	db.Error = fmt.Errorf("%w: %w", contracts.ErrDatabaseUnavailable, db.Error)
+}
+
+// inTransaction reports whether the statement runs on a transaction; its connection is
+// pinned, so a retry would fail the same way
+func inTransaction(db *gorm.DB) bool {
+	_, ok := db.Statement.ConnPool.(gorm.TxCommitter)
+	return ok
+}
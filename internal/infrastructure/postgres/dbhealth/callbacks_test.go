@@ -0,0 +1,198 @@
+package dbhealth
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// flakyDriver is a database/sql driver whose statements fail with a reset connection
+// while failures remain, then succeed; it stands in for a database during a failover
+type flakyDriver struct {
+	failures atomic.Int64
+	queries  atomic.Int64
+	execs    atomic.Int64
+}
+
+var (
+	flakyDrivers   sync.Map // DSN -> *flakyDriver
+	registerDriver sync.Once
+)
+
+type flakyConnector struct{}
+
+func (flakyConnector) Open(dsn string) (driver.Conn, error) {
+	d, ok := flakyDrivers.Load(dsn)
+	if !ok {
+		return nil, fmt.Errorf("unknown flaky DSN %q", dsn)
+	}
+	return &flakyConn{driver: d.(*flakyDriver)}, nil
+}
+
+type flakyConn struct {
+	driver *flakyDriver
+}
+
+func (c *flakyConn) fail() error {
+	if c.driver.failures.Add(-1) >= 0 {
+		return &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}
+	}
+	return nil
+}
+
+func (c *flakyConn) QueryContext(_ context.Context, _ string, _ []driver.NamedValue) (driver.Rows, error) {
+	c.driver.queries.Add(1)
+	if err := c.fail(); err != nil {
+		return nil, err
+	}
+	return &flakyRows{}, nil
+}
+
+func (c *flakyConn) ExecContext(_ context.Context, _ string, _ []driver.NamedValue) (driver.Result, error) {
+	c.driver.execs.Add(1)
+	if err := c.fail(); err != nil {
+		return nil, err
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (c *flakyConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepared statements are not supported")
+}
+
+func (c *flakyConn) Begin() (driver.Tx, error) { return flakyTx{}, nil }
+func (c *flakyConn) Close() error              { return nil }
+
+type flakyTx struct{}
+
+func (flakyTx) Commit() error   { return nil }
+func (flakyTx) Rollback() error { return nil }
+
+// flakyRows is a single row with an id column
+type flakyRows struct {
+	done bool
+}
+
+func (r *flakyRows) Columns() []string { return []string{"id"} }
+func (r *flakyRows) Close() error      { return nil }
+
+func (r *flakyRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	dest[0] = int64(1)
+	return nil
+}
+
+// openFlaky returns a GORM connection with the dbhealth callbacks over a flaky driver
+func openFlaky(t *testing.T) (*gorm.DB, *flakyDriver, *Monitor) {
+	t.Helper()
+
+	registerDriver.Do(func() { sql.Register("dbhealth-flaky", flakyConnector{}) })
+	d := &flakyDriver{}
+	flakyDrivers.Store(t.Name(), d)
+	t.Cleanup(func() { flakyDrivers.Delete(t.Name()) })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{DriverName: "dbhealth-flaky", DSN: t.Name()}), &gorm.Config{
+		Logger:                 logger.Default.LogMode(logger.Silent),
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	monitor := NewMonitor(db, 2, 0)
+	if err := RegisterCallbacks(db, monitor); err != nil {
+		t.Fatalf("RegisterCallbacks: %v", err)
+	}
+
+	return db, d, monitor
+}
+
+type item struct {
+	ID int64
+}
+
+func TestRegisterCallbacks_Reads(t *testing.T) {
+	tests := []struct {
+		name        string
+		failures    int64
+		wantErr     bool
+		wantQueries int64
+	}{
+		{name: "healthy", failures: 0, wantQueries: 1},
+		{name: "recovers after one failure", failures: 1, wantQueries: 2},
+		{name: "retries only once", failures: 2, wantErr: true, wantQueries: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, d, monitor := openFlaky(t)
+			d.failures.Store(tt.failures)
+
+			var items []item
+			err := db.Table("items").Find(&items).Error
+
+			if tt.wantErr {
+				if !errors.Is(err, contracts.ErrDatabaseUnavailable) {
+					t.Fatalf("Find() error = %v, want ErrDatabaseUnavailable", err)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("Find() error = %v", err)
+				}
+				if len(items) != 1 || items[0].ID != 1 {
+					t.Errorf("Find() = %+v, want one item", items)
+				}
+			}
+			if got := d.queries.Load(); got != tt.wantQueries {
+				t.Errorf("queries = %d, want %d", got, tt.wantQueries)
+			}
+			if degraded := tt.failures > 0; monitor.Degraded() != degraded {
+				t.Errorf("Degraded() = %v, want %v", monitor.Degraded(), degraded)
+			}
+		})
+	}
+}
+
+func TestRegisterCallbacks_WritesFailFast(t *testing.T) {
+	db, d, _ := openFlaky(t)
+	d.failures.Store(1)
+
+	err := db.Table("items").Where("id = ?", 1).Update("title", "new").Error
+	if !errors.Is(err, contracts.ErrDatabaseUnavailable) {
+		t.Fatalf("Update() error = %v, want ErrDatabaseUnavailable", err)
+	}
+	if got := d.execs.Load(); got != 1 {
+		t.Errorf("execs = %d, want 1: writes must not be retried", got)
+	}
+}
+
+func TestRegisterCallbacks_NoRetryInTransaction(t *testing.T) {
+	db, d, _ := openFlaky(t)
+	d.failures.Store(1)
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var items []item
+		return tx.Table("items").Find(&items).Error
+	})
+	if !errors.Is(err, contracts.ErrDatabaseUnavailable) {
+		t.Fatalf("Transaction() error = %v, want ErrDatabaseUnavailable", err)
+	}
+	if got := d.queries.Load(); got != 1 {
+		t.Errorf("queries = %d, want 1: reads inside a transaction must not be retried", got)
+	}
+}
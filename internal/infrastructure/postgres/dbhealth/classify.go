@@ -0,0 +1,85 @@
+package dbhealth
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"syscall"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// ErrorClass tells callers whether a failed query is worth retrying
+type ErrorClass int
+
+const (
+	// ErrorClassPermanent errors (constraint violations, bad SQL, not found...) fail the same way on retry
+	ErrorClassPermanent ErrorClass = iota
+	// ErrorClassRetryable errors come from a lost or refused connection and may succeed on a fresh one
+	ErrorClassRetryable
+)
+
+// pgConnectionExceptionClass is the SQLSTATE class of connection exceptions (08xxx)
+const pgConnectionExceptionClass = "08"
+
+// pgForeignKeyViolation is the SQLSTATE of a row referencing a missing one
+const pgForeignKeyViolation = "23503"
+
+// pgShutdownCodes are the SQLSTATEs sent when the server terminates sessions:
+// admin_shutdown (failover, pg_terminate_backend), crash_shutdown and cannot_connect_now (starting up)
+var pgShutdownCodes = map[string]bool{
+	"57P01": true,
+	"57P02": true,
+	"57P03": true,
+}
+
+// ClassifyError reports whether err was caused by the database connection rather than the query.
+// Cancelled requests and query deadlines are permanent: the caller gave up, retrying would not
+// help. Connection attempts running out of connect_timeout are retryable.
+func ClassifyError(err error) ErrorClass {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return ErrorClassPermanent
+	}
+
+	var connectErr *pgconn.ConnectError
+	if errors.As(err, &connectErr) {
+		return ErrorClassRetryable
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return ErrorClassPermanent
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		if strings.HasPrefix(pgErr.Code, pgConnectionExceptionClass) || pgShutdownCodes[pgErr.Code] {
+			return ErrorClassRetryable
+		}
+		return ErrorClassPermanent
+	}
+
+	var netErr *net.OpError
+	switch {
+	case errors.As(err, &netErr):
+		return ErrorClassRetryable
+	case errors.Is(err, driver.ErrBadConn),
+		errors.Is(err, io.EOF),
+		errors.Is(err, io.ErrUnexpectedEOF),
+		errors.Is(err, syscall.ECONNREFUSED),
+		errors.Is(err, syscall.ECONNRESET),
+		errors.Is(err, syscall.EPIPE):
+		return ErrorClassRetryable
+	case pgconn.SafeToRetry(err):
+		return ErrorClassRetryable
+	}
+
+	return ErrorClassPermanent
+}
+
+// IsForeignKeyViolation reports whether err was caused by a foreign key constraint
+func IsForeignKeyViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == pgForeignKeyViolation
+}
@@ -0,0 +1,66 @@
+package dbhealth
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"syscall"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want ErrorClass
+	}{
+		{name: "nil", err: nil, want: ErrorClassPermanent},
+		{name: "plain error", err: errors.New("record not found"), want: ErrorClassPermanent},
+		{name: "cancelled request", err: fmt.Errorf("query: %w", context.Canceled), want: ErrorClassPermanent},
+		{name: "query deadline", err: context.DeadlineExceeded, want: ErrorClassPermanent},
+		{name: "unique violation", err: &pgconn.PgError{Code: "23505"}, want: ErrorClassPermanent},
+		{name: "syntax error", err: &pgconn.PgError{Code: "42601"}, want: ErrorClassPermanent},
+		{name: "connection failure", err: &pgconn.PgError{Code: "08006"}, want: ErrorClassRetryable},
+		{name: "admin shutdown", err: &pgconn.PgError{Code: "57P01"}, want: ErrorClassRetryable},
+		{name: "crash shutdown", err: &pgconn.PgError{Code: "57P02"}, want: ErrorClassRetryable},
+		{name: "starting up", err: &pgconn.PgError{Code: "57P03"}, want: ErrorClassRetryable},
+		{name: "connection refused", err: &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}, want: ErrorClassRetryable},
+		{name: "wrapped connection reset", err: fmt.Errorf("read: %w", syscall.ECONNRESET), want: ErrorClassRetryable},
+		{name: "broken pipe", err: syscall.EPIPE, want: ErrorClassRetryable},
+		{name: "unexpected EOF", err: io.ErrUnexpectedEOF, want: ErrorClassRetryable},
+		{name: "bad connection", err: driver.ErrBadConn, want: ErrorClassRetryable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ClassifyError(tt.err); got != tt.want {
+				t.Errorf("ClassifyError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsForeignKeyViolation(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "foreign key violation", err: fmt.Errorf("insert: %w", &pgconn.PgError{Code: "23503"}), want: true},
+		{name: "unique violation", err: &pgconn.PgError{Code: "23505"}, want: false},
+		{name: "other error", err: errors.New("boom"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsForeignKeyViolation(tt.err); got != tt.want {
+				t.Errorf("IsForeignKeyViolation(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package dbhealth
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// DefaultRecoveryWindow is how long the database is reported degraded after the last connection failure
+const DefaultRecoveryWindow = time.Minute
+
+// pingTimeout bounds a single health check ping
+const pingTimeout = 3 * time.Second
+
+// Monitor tracks whether the database is reachable
+// It is fed by the periodic Check and by connection errors seen on regular queries (see
+// RegisterCallbacks). Every failure discards the pool's idle connections, which after a
+// failover all point at the old primary, so the next queries dial fresh ones.
+type Monitor struct {
+	db             *gorm.DB
+	maxIdleConns   int
+	recoveryWindow time.Duration
+
+	mu          sync.Mutex
+	down        bool
+	lastFailure time.Time
+
+	checking atomic.Bool
+}
+
+// NewMonitor creates a monitor for db; maxIdleConns restores the pool's idle limit after
+// idle connections are discarded, recoveryWindow is DefaultRecoveryWindow when <= 0
+func NewMonitor(db *gorm.DB, maxIdleConns int, recoveryWindow time.Duration) *Monitor {
+	if recoveryWindow <= 0 {
+		recoveryWindow = DefaultRecoveryWindow
+	}
+	return &Monitor{
+		db:             db,
+		maxIdleConns:   maxIdleConns,
+		recoveryWindow: recoveryWindow,
+	}
+}
+
+// Check pings the database and updates the state. Meant to run periodically.
+func (m *Monitor) Check(ctx context.Context) error {
+	sqlDB, err := m.db.DB()
+	if err != nil {
+		return fmt.Errorf("failed to get database instance: %w", err)
+	}
+
+	pingCtx, cancel := context.WithTimeout(ctx, pingTimeout)
+	defer cancel()
+
+	if err := sqlDB.PingContext(pingCtx); err != nil {
+		if ctx.Err() != nil {
+			return err
+		}
+		m.markDown()
+		return fmt.Errorf("database ping failed: %w", err)
+	}
+
+	m.markUp()
+	return nil
+}
+
+// ReportConnectionError records a connection failure seen on a query and checks the
+// database right away (in the background) instead of waiting for the next periodic check
+func (m *Monitor) ReportConnectionError(err error) {
+	if ClassifyError(err) != ErrorClassRetryable {
+		return
+	}
+
+	m.markDown()
+
+	if !m.checking.CompareAndSwap(false, true) {
+		return
+	}
+	go func() {
+		defer m.checking.Store(false)
+		_ = m.Check(context.Background())
+	}()
+}
+
+// Available reports whether the last check (or query) reached the database
+func (m *Monitor) Available() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return !m.down
+}
+
+// Degraded reports whether the database is unreachable or failed within the recovery window
+func (m *Monitor) Degraded() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.down || (!m.lastFailure.IsZero() && time.Since(m.lastFailure) < m.recoveryWindow)
+}
+
+// markDown records a failure and drops the idle connections
+func (m *Monitor) markDown() {
+	m.mu.Lock()
+	wasDown := m.down
+	m.down = true
+	m.lastFailure = time.Now()
+	m.mu.Unlock()
+
+	if !wasDown {
+		log.Println("⚠️  Database connection lost, discarding idle connections")
+	}
+	m.discardIdleConns()
+}
+
+// markUp records a successful check
+func (m *Monitor) markUp() {
+	m.mu.Lock()
+	wasDown := m.down
+	m.down = false
+	m.mu.Unlock()
+
+	if wasDown {
+		log.Println("✅ Database connection recovered")
+	}
+}
+
+// discardIdleConns closes every idle connection of the pool
+// Connections in use are dropped by database/sql once they fail.
+func (m *Monitor) discardIdleConns() {
+	sqlDB, err := m.db.DB()
+	if err != nil {
+		return
+	}
+	sqlDB.SetMaxIdleConns(0)
+	sqlDB.SetMaxIdleConns(m.maxIdleConns)
+}
@@ -12,6 +12,13 @@ type CategoryRecord struct {
 	OwnerID     string  `gorm:"type:varchar(255);not null;index"`
 	PortfolioID uint    `gorm:"not null;index"`
 
+	// Shared by every row soft-deleted by the same delete operation (single or cascade)
+	DeleteBatchID *string `gorm:"type:uuid;index"`
+
+	// Actor (user ID and credential, see application/actor) that created / last updated the row
+	CreatedBy string `gorm:"type:varchar(255);not null;default:''"`
+	UpdatedBy string `gorm:"type:varchar(255);not null;default:''"`
+
 	// Foreign key relationship
 	Portfolio PortfolioRecord `gorm:"foreignKey:PortfolioID;constraint:OnDelete:CASCADE"`
 }
@@ -0,0 +1,29 @@
+package entities
+
+import "gorm.io/gorm"
+
+// PortfolioLinkRecord is the GORM entity for portfolio contact/social links (infrastructure layer)
+type PortfolioLinkRecord struct {
+	gorm.Model
+	PortfolioID uint   `gorm:"not null;index"`
+	Kind        string `gorm:"type:varchar(20);not null"` // github, linkedin, email, website, twitter, custom
+	Label       string `gorm:"type:varchar(100)"`
+	URL         string `gorm:"type:varchar(500);not null"` // URL, or address for the email kind
+	Position    uint   `gorm:"default:0;not null"`
+	OwnerID     string `gorm:"type:varchar(255);not null;index"`
+
+	// Shared by every row soft-deleted by the same delete operation (single or cascade)
+	DeleteBatchID *string `gorm:"type:uuid;index"`
+
+	// Actor (user ID and credential, see application/actor) that created / last updated the row
+	CreatedBy string `gorm:"type:varchar(255);not null;default:''"`
+	UpdatedBy string `gorm:"type:varchar(255);not null;default:''"`
+
+	// Foreign key relationship
+	Portfolio PortfolioRecord `gorm:"foreignKey:PortfolioID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName specifies the table name for the portfolio link record
+func (PortfolioLinkRecord) TableName() string {
+	return "portfolio_links"
+}
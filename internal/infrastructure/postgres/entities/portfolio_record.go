@@ -10,6 +10,27 @@ type PortfolioRecord struct {
 	Description string `gorm:"type:text"`
 	OwnerID     string `gorm:"type:varchar(255);not null;index"`
 
+	// Public URL name, unique among live portfolios (kept when the title changes unless regenerated)
+	Slug string `gorm:"type:varchar(100);not null;default:'';uniqueIndex:idx_portfolios_slug,where:deleted_at IS NULL AND slug <> ''"`
+
+	// Drafts are only visible through the owner routes; rows created before the flag were published
+	IsPublished bool `gorm:"not null;default:false"`
+
+	// Visitors may "+1" the skills of the portfolio's projects
+	EndorsementsEnabled bool `gorm:"not null;default:true"`
+
+	// Custom stylesheet of the public portfolio: as written by the owner, and the sanitized
+	// version that is actually served (see application/customcss)
+	CustomCSS          string `gorm:"type:text;not null;default:''"`
+	CustomCSSSanitized string `gorm:"type:text;not null;default:''"`
+
+	// Shared by every row soft-deleted by the same delete operation (single or cascade)
+	DeleteBatchID *string `gorm:"type:uuid;index"`
+
+	// Actor (user ID and credential, see application/actor) that created / last updated the row
+	CreatedBy string `gorm:"type:varchar(255);not null;default:''"`
+	UpdatedBy string `gorm:"type:varchar(255);not null;default:''"`
+
 	// Add index for checking duplicate titles per owner
 	// Composite index on (owner_id, title) for efficient duplicate checking
 }
@@ -0,0 +1,19 @@
+package entities
+
+import "time"
+
+// PortfolioViewDayRecord is the number of counted public views of a portfolio on a given day
+// Written by the view flusher; kept for the owner-facing analytics.
+type PortfolioViewDayRecord struct {
+	PortfolioID uint      `gorm:"primaryKey"`
+	Day         time.Time `gorm:"type:date;primaryKey;index"`
+	Views       uint      `gorm:"not null;default:0"`
+
+	// Foreign key relationship
+	Portfolio PortfolioRecord `gorm:"foreignKey:PortfolioID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName specifies the table name for the portfolio view day record
+func (PortfolioViewDayRecord) TableName() string {
+	return "portfolio_view_days"
+}
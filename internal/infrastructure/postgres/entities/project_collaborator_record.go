@@ -0,0 +1,29 @@
+package entities
+
+import "gorm.io/gorm"
+
+// ProjectCollaboratorRecord is the GORM entity for the people credited on a project (infrastructure layer)
+type ProjectCollaboratorRecord struct {
+	gorm.Model
+	ProjectID uint    `gorm:"not null;index"`
+	Name      string  `gorm:"type:varchar(100);not null"`
+	Role      string  `gorm:"type:varchar(100)"`
+	URL       *string `gorm:"type:varchar(500)"`
+	Position  uint    `gorm:"default:0;not null"`
+	OwnerID   string  `gorm:"type:varchar(255);not null;index"`
+
+	// Shared by every row soft-deleted by the same delete operation (single or cascade)
+	DeleteBatchID *string `gorm:"type:uuid;index"`
+
+	// Actor (user ID and credential, see application/actor) that created / last updated the row
+	CreatedBy string `gorm:"type:varchar(255);not null;default:''"`
+	UpdatedBy string `gorm:"type:varchar(255);not null;default:''"`
+
+	// Foreign key relationship
+	Project ProjectRecord `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName specifies the table name for the project collaborator record
+func (ProjectCollaboratorRecord) TableName() string {
+	return "project_collaborators"
+}
@@ -1,6 +1,8 @@
 package entities
 
 import (
+	"time"
+
 	"github.com/lib/pq"
 	"gorm.io/gorm"
 )
@@ -15,9 +17,23 @@ type ProjectRecord struct {
 	Skills      pq.StringArray `gorm:"type:text[]"`
 	Client      *string        `gorm:"type:varchar(255)"`
 	Link        *string        `gorm:"type:varchar(500)"`
+	Position    uint           `gorm:"default:0;not null"`
 	CategoryID  uint           `gorm:"not null;index"`
 	OwnerID     string         `gorm:"type:varchar(255);not null;index"`
 
+	// Hidden projects are left out of every public read; owners still see them
+	Hidden bool `gorm:"not null;default:false"`
+
+	// Shared by every row soft-deleted by the same delete operation (single or cascade)
+	DeleteBatchID *string `gorm:"type:uuid;index"`
+
+	// Actor (user ID and credential, see application/actor) that created / last updated the row
+	CreatedBy string `gorm:"type:varchar(255);not null;default:''"`
+	UpdatedBy string `gorm:"type:varchar(255);not null;default:''"`
+
+	// Last public view, written by the view flusher (not on every request)
+	LastViewedAt *time.Time
+
 	// Relations
 	Category CategoryRecord `gorm:"foreignKey:CategoryID;constraint:OnDelete:CASCADE"`
 }
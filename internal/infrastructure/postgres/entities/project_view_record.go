@@ -0,0 +1,19 @@
+package entities
+
+import "time"
+
+// ProjectViewDayRecord is the number of public views of a project on a given day
+// Written by the view flusher; days older than the stats window are purged.
+type ProjectViewDayRecord struct {
+	ProjectID uint      `gorm:"primaryKey"`
+	Day       time.Time `gorm:"type:date;primaryKey;index"`
+	Views     uint      `gorm:"not null;default:0"`
+
+	// Foreign key relationship
+	Project ProjectRecord `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName specifies the table name for the project view day record
+func (ProjectViewDayRecord) TableName() string {
+	return "project_view_days"
+}
@@ -9,8 +9,18 @@ type SectionContentRecord struct {
 	Type      string  `gorm:"type:varchar(50);not null"`
 	Content   *string `gorm:"type:text"`
 	Order     uint    `gorm:"not null;default:0"`
-	ImageID   *uint   `gorm:"index"`
-	OwnerID   string  `gorm:"type:varchar(255);not null;index"`
+	// Position replaces the "order" column (a reserved word that needs quoting in every query).
+	// While the rename is rolled out both columns are written, see BeforeSave
+	Position uint   `gorm:"not null;default:0"`
+	ImageID  *uint  `gorm:"index"`
+	OwnerID  string `gorm:"type:varchar(255);not null;index"`
+
+	// Shared by every row soft-deleted by the same delete operation (single or cascade)
+	DeleteBatchID *string `gorm:"type:uuid;index"`
+
+	// Actor (user ID and credential, see application/actor) that created / last updated the row
+	CreatedBy string `gorm:"type:varchar(255);not null;default:''"`
+	UpdatedBy string `gorm:"type:varchar(255);not null;default:''"`
 
 	// Relations
 	Section SectionRecord `gorm:"foreignKey:SectionID;constraint:OnDelete:CASCADE"`
@@ -20,3 +30,17 @@ type SectionContentRecord struct {
 func (SectionContentRecord) TableName() string {
 	return "section_contents"
 }
+
+// BeforeSave mirrors Order into Position so both columns stay in sync until "order" is dropped.
+// Map updates (Model(...).Updates(map)) carry the new value in the statement, not in the record
+func (r *SectionContentRecord) BeforeSave(tx *gorm.DB) error {
+	if updates, ok := tx.Statement.Dest.(map[string]interface{}); ok {
+		if order, ok := updates["order"]; ok {
+			tx.Statement.SetColumn("position", order)
+		}
+		return nil
+	}
+
+	r.Position = r.Order
+	return nil
+}
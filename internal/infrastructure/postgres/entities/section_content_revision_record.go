@@ -0,0 +1,24 @@
+package entities
+
+import "time"
+
+// SectionContentRevisionRecord is a previous state of a section content (GORM entity)
+// Revisions are capped per content block, the oldest ones are evicted first.
+type SectionContentRevisionRecord struct {
+	ID               uint    `gorm:"primarykey"`
+	SectionContentID uint    `gorm:"not null;index"`
+	Type             string  `gorm:"type:varchar(50);not null"`
+	Content          *string `gorm:"type:text"`
+	ImageID          *uint
+	Size             int    `gorm:"not null;default:0"` // Content length in bytes
+	OwnerID          string `gorm:"type:varchar(255);not null;index"`
+	CreatedAt        time.Time
+
+	// Relations
+	SectionContent SectionContentRecord `gorm:"foreignKey:SectionContentID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName specifies the table name for SectionContentRevisionRecord
+func (SectionContentRevisionRecord) TableName() string {
+	return "section_content_revisions"
+}
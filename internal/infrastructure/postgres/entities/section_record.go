@@ -7,11 +7,19 @@ import "gorm.io/gorm"
 type SectionRecord struct {
 	gorm.Model
 	Title       string  `gorm:"type:varchar(255);not null"`
+	Slug        string  `gorm:"type:varchar(100);not null;default:'';uniqueIndex:idx_sections_portfolio_slug,priority:2,where:deleted_at IS NULL AND slug <> ''"` // Anchor, unique among the portfolio's live sections
 	Description *string `gorm:"type:text"`
 	Type        string  `gorm:"type:varchar(100)"` // Optional: NavBar, HomePageSection, etc.
 	Position    uint    `gorm:"default:0;not null"`
 	OwnerID     string  `gorm:"type:varchar(255);not null;index"`
-	PortfolioID uint    `gorm:"not null;index"`
+	PortfolioID uint    `gorm:"not null;index;uniqueIndex:idx_sections_portfolio_slug,priority:1"`
+
+	// Shared by every row soft-deleted by the same delete operation (single or cascade)
+	DeleteBatchID *string `gorm:"type:uuid;index"`
+
+	// Actor (user ID and credential, see application/actor) that created / last updated the row
+	CreatedBy string `gorm:"type:varchar(255);not null;default:''"`
+	UpdatedBy string `gorm:"type:varchar(255);not null;default:''"`
 
 	// Foreign key relationship
 	Portfolio PortfolioRecord `gorm:"foreignKey:PortfolioID;constraint:OnDelete:CASCADE"`
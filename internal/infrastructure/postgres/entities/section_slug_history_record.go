@@ -0,0 +1,21 @@
+package entities
+
+import "time"
+
+// SectionSlugHistoryRecord maps a former slug of a section to the section, so anchors
+// shared before a rename keep resolving (infrastructure layer)
+type SectionSlugHistoryRecord struct {
+	ID          uint      `gorm:"primaryKey"`
+	PortfolioID uint      `gorm:"not null;uniqueIndex:idx_section_slug_history_portfolio_slug,priority:1"`
+	Slug        string    `gorm:"type:varchar(100);not null;uniqueIndex:idx_section_slug_history_portfolio_slug,priority:2"`
+	SectionID   uint      `gorm:"not null;index"`
+	CreatedAt   time.Time `gorm:"not null"`
+
+	// Foreign key relationship
+	Section SectionRecord `gorm:"foreignKey:SectionID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName specifies the table name for the section slug history record
+func (SectionSlugHistoryRecord) TableName() string {
+	return "section_slug_history"
+}
@@ -0,0 +1,37 @@
+package entities
+
+import "time"
+
+// SkillEndorsementRecord is the GORM entity for the endorsement count of a project skill (infrastructure layer)
+type SkillEndorsementRecord struct {
+	ProjectID uint   `gorm:"primaryKey"`
+	Skill     string `gorm:"type:varchar(100);primaryKey"` // Spelled as on the project
+	Count     uint   `gorm:"not null;default:0"`
+	UpdatedAt time.Time
+
+	// Foreign key relationship
+	Project ProjectRecord `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName specifies the table name for the skill endorsement record
+func (SkillEndorsementRecord) TableName() string {
+	return "skill_endorsements"
+}
+
+// SkillEndorsementVoteRecord remembers that a visitor endorsed a project skill on a given day
+// Only a hash of the visitor IP is stored; rows are purged after a couple of days.
+type SkillEndorsementVoteRecord struct {
+	ProjectID uint      `gorm:"primaryKey"`
+	Skill     string    `gorm:"type:varchar(100);primaryKey"`
+	IPHash    string    `gorm:"type:char(64);primaryKey"`
+	Day       time.Time `gorm:"type:date;primaryKey;index"`
+	CreatedAt time.Time
+
+	// Foreign key relationship
+	Project ProjectRecord `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName specifies the table name for the skill endorsement vote record
+func (SkillEndorsementVoteRecord) TableName() string {
+	return "skill_endorsement_votes"
+}
@@ -0,0 +1,21 @@
+package entities
+
+import "time"
+
+// UserSettingsRecord is the GORM entity for account-level user settings (infrastructure layer)
+// One row per user, created on the first settings write; a missing row means defaults.
+type UserSettingsRecord struct {
+	UserID             string `gorm:"type:varchar(255);primaryKey"`     // Same identifier as owner_id
+	DefaultPortfolioID *uint  `gorm:"index"`                            // nil: resolve to the user's only portfolio
+	ProjectDefaults    string `gorm:"type:jsonb;not null;default:'{}'"` // JSON-encoded new-project defaults
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+
+	// Foreign key relationship
+	DefaultPortfolio *PortfolioRecord `gorm:"foreignKey:DefaultPortfolioID;constraint:OnDelete:SET NULL"`
+}
+
+// TableName specifies the table name for the user settings record
+func (UserSettingsRecord) TableName() string {
+	return "user_settings"
+}
@@ -0,0 +1,243 @@
+package migrations
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/entities"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/repositories"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/searchindex"
+	"gorm.io/gorm"
+)
+
+// Entities are the records whose tables AutoMigrate keeps in sync with the structs
+var Entities = []interface{}{
+	&entities.UserRecord{},
+	&entities.PortfolioRecord{},
+	&entities.CategoryRecord{},
+	&entities.SectionRecord{},
+	&entities.SectionSlugHistoryRecord{},
+	&entities.ProjectRecord{},
+	&entities.SectionContentRecord{},
+	&entities.SectionContentRevisionRecord{},
+	&entities.PortfolioLinkRecord{},
+	&entities.UserSettingsRecord{},
+	&entities.SkillEndorsementRecord{},
+	&entities.SkillEndorsementVoteRecord{},
+	&entities.ProjectViewDayRecord{},
+	&entities.PortfolioViewDayRecord{},
+	&entities.ProjectCollaboratorRecord{},
+}
+
+// All is the versioned migrations, in application order
+// Append new ones with the next number; never renumber or edit an applied one.
+// The first ones were run-every-start steps before versioning: they were written to be
+// no-ops once applied, so databases that predate schema_migrations simply record them.
+var All = []Migration{
+	{ID: "0001_owner_id_checks", Up: ensureOwnerIDChecks, Down: dropOwnerIDChecks},
+	{ID: "0002_backfill_actor_columns", Up: backfillActorColumns},
+	{ID: "0003_backfill_section_content_positions", Up: backfillSectionContentPositions},
+	{ID: "0004_backfill_section_positions", Up: backfillSectionPositions},
+	{ID: "0005_backfill_project_positions", Up: backfillProjectPositions},
+	{ID: "0006_backfill_portfolio_slugs", Up: backfillPortfolioSlugs},
+	// After the positions, so the first of two same-titled sections keeps the plain slug
+	{ID: "0007_backfill_section_slugs", Up: backfillSectionSlugs},
+}
+
+// Migrate brings the database up to date on startup:
+//  1. portfolios.is_published, which must exist before AutoMigrate would add it with the draft default
+//  2. AutoMigrate of the entities (tables, columns, indexes)
+//  3. the pending versioned migrations
+//  4. the search index triggers, recreated every time since they follow searchConfig
+func Migrate(db *gorm.DB, searchConfig string) error {
+	log.Println("Running database migrations...")
+
+	if err := addPortfolioPublishedColumn(db); err != nil {
+		return fmt.Errorf("migration failed: %w", err)
+	}
+
+	if err := db.AutoMigrate(Entities...); err != nil {
+		return fmt.Errorf("migration failed: %w", err)
+	}
+
+	applied, err := NewRunner(db, All).Up()
+	for _, id := range applied {
+		log.Printf("✅ Applied migration %s", id)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := searchindex.EnsureTriggers(db, searchConfig); err != nil {
+		return fmt.Errorf("migration failed: %w", err)
+	}
+
+	log.Println("✅ Migrations completed successfully")
+	return nil
+}
+
+// ownedTables lists every table with an owner_id column
+var ownedTables = []string{"portfolios", "categories", "sections", "projects", "section_contents", "portfolio_links", "project_collaborators"}
+
+// ensureOwnerIDChecks reports rows with an empty owner_id and adds a non-empty CHECK
+// constraint on every owner_id column. When legacy rows violate it the constraint is
+// added NOT VALID, so new writes are still rejected while the backfill is pending.
+func ensureOwnerIDChecks(db *gorm.DB) error {
+	for _, table := range ownedTables {
+		constraint := fmt.Sprintf("chk_%s_owner_id_not_empty", table)
+
+		var exists bool
+		if err := db.Raw(
+			"SELECT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = ?)", constraint,
+		).Scan(&exists).Error; err != nil {
+			return fmt.Errorf("failed to look up constraint %s: %w", constraint, err)
+		}
+		if exists {
+			continue
+		}
+
+		// Backfill report: ownerless rows must be fixed by hand before validating
+		var ownerless int64
+		if err := db.Table(table).Where("owner_id = '' OR owner_id IS NULL").Count(&ownerless).Error; err != nil {
+			return fmt.Errorf("failed to count ownerless rows in %s: %w", table, err)
+		}
+
+		ddl := fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT %s CHECK (owner_id <> '')", table, constraint)
+		if ownerless > 0 {
+			log.Printf("⚠️  %s has %d rows with an empty owner_id; adding %s as NOT VALID", table, ownerless, constraint)
+			ddl += " NOT VALID"
+		}
+
+		if err := db.Exec(ddl).Error; err != nil {
+			return fmt.Errorf("failed to add constraint %s: %w", constraint, err)
+		}
+	}
+
+	return nil
+}
+
+// dropOwnerIDChecks removes the owner_id CHECK constraints
+func dropOwnerIDChecks(db *gorm.DB) error {
+	for _, table := range ownedTables {
+		ddl := fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT IF EXISTS chk_%s_owner_id_not_empty", table, table)
+		if err := db.Exec(ddl).Error; err != nil {
+			return fmt.Errorf("failed to drop the owner_id check of %s: %w", table, err)
+		}
+	}
+
+	return nil
+}
+
+// backfillActorColumns fills created_by / updated_by of rows written before they existed
+// The credential used is unknown for those rows, so the owner ID is used as-is
+func backfillActorColumns(db *gorm.DB) error {
+	for _, table := range ownedTables {
+		for _, column := range []string{"created_by", "updated_by"} {
+			query := fmt.Sprintf("UPDATE %s SET %s = owner_id WHERE %s = ''", table, column, column)
+			if err := db.Exec(query).Error; err != nil {
+				return fmt.Errorf("failed to backfill %s.%s: %w", table, column, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// addPortfolioPublishedColumn adds portfolios.is_published with the portfolios that already exist
+// published (they were all public before the flag) while new ones start as drafts.
+// No-op on a fresh database, where AutoMigrate creates the table, and once the column exists
+func addPortfolioPublishedColumn(db *gorm.DB) error {
+	migrator := db.Migrator()
+	if !migrator.HasTable(&entities.PortfolioRecord{}) || migrator.HasColumn(&entities.PortfolioRecord{}, "IsPublished") {
+		return nil
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("ALTER TABLE portfolios ADD COLUMN is_published boolean NOT NULL DEFAULT true").Error; err != nil {
+			return fmt.Errorf("failed to add portfolios.is_published: %w", err)
+		}
+		if err := tx.Exec("ALTER TABLE portfolios ALTER COLUMN is_published SET DEFAULT false").Error; err != nil {
+			return fmt.Errorf("failed to set portfolios.is_published default: %w", err)
+		}
+		return nil
+	})
+}
+
+// backfillSectionPositions numbers the live sections created at position 0 (section creates didn't
+// assign positions before), appending them by ID after the other sections of their portfolio.
+// Positions start at 1, so only never-assigned rows are touched and later starts are no-ops
+func backfillSectionPositions(db *gorm.DB) error {
+	query := `
+		UPDATE sections SET position = numbered.position
+		FROM (
+			SELECT s.id,
+				(SELECT COALESCE(MAX(o.position), 0) FROM sections o
+				 WHERE o.portfolio_id = s.portfolio_id AND o.deleted_at IS NULL)
+				+ ROW_NUMBER() OVER (PARTITION BY s.portfolio_id ORDER BY s.id) AS position
+			FROM sections s
+			WHERE s.position = 0 AND s.deleted_at IS NULL
+		) AS numbered
+		WHERE sections.id = numbered.id`
+	if err := db.Exec(query).Error; err != nil {
+		return fmt.Errorf("failed to backfill sections.position: %w", err)
+	}
+
+	return nil
+}
+
+// backfillProjectPositions numbers the live projects created before projects had a position,
+// appending them by ID after the other projects of their category.
+// Positions start at 1, so only never-assigned rows are touched and later starts are no-ops
+func backfillProjectPositions(db *gorm.DB) error {
+	query := `
+		UPDATE projects SET position = numbered.position
+		FROM (
+			SELECT p.id,
+				(SELECT COALESCE(MAX(o.position), 0) FROM projects o
+				 WHERE o.category_id = p.category_id AND o.deleted_at IS NULL)
+				+ ROW_NUMBER() OVER (PARTITION BY p.category_id ORDER BY p.id) AS position
+			FROM projects p
+			WHERE p.position = 0 AND p.deleted_at IS NULL
+		) AS numbered
+		WHERE projects.id = numbered.id`
+	if err := db.Exec(query).Error; err != nil {
+		return fmt.Errorf("failed to backfill projects.position: %w", err)
+	}
+
+	return nil
+}
+
+// backfillSectionContentPositions copies section_contents."order" into the new position column.
+// Rows written since the column was added are already in sync (see SectionContentRecord.BeforeSave),
+// so this only touches rows older than the rename and is a no-op on later starts
+func backfillSectionContentPositions(db *gorm.DB) error {
+	if err := db.Exec(`UPDATE section_contents SET position = "order" WHERE position <> "order"`).Error; err != nil {
+		return fmt.Errorf("failed to backfill section_contents.position: %w", err)
+	}
+
+	return nil
+}
+
+// backfillPortfolioSlugs generates the slugs of portfolios created before slugs existed
+func backfillPortfolioSlugs(db *gorm.DB) error {
+	slugged, err := repositories.BackfillPortfolioSlugs(db)
+	if err != nil {
+		return err
+	}
+	if slugged > 0 {
+		log.Printf("✅ Generated slugs for %d portfolios", slugged)
+	}
+	return nil
+}
+
+// backfillSectionSlugs generates the slugs of sections created before slugs existed
+func backfillSectionSlugs(db *gorm.DB) error {
+	slugged, err := repositories.BackfillSectionSlugs(db)
+	if err != nil {
+		return err
+	}
+	if slugged > 0 {
+		log.Printf("✅ Generated slugs for %d sections", slugged)
+	}
+	return nil
+}
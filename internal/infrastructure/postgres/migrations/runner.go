@@ -0,0 +1,194 @@
+// Package migrations applies the versioned data and constraint migrations of the database
+// exactly once, recording them in schema_migrations.
+// Table and column definitions are not versioned: they follow the entities through
+// AutoMigrate on every start (see Migrate).
+package migrations
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Table records the applied migrations
+const Table = "schema_migrations"
+
+// advisoryLockKey serializes migration runs of replicas starting together
+const advisoryLockKey = 727274001
+
+// Migration is one versioned change of the database
+// IDs sort in application order ("0001_...", "0002_..."); Down is nil when the change
+// can't be undone (data backfills).
+type Migration struct {
+	ID   string
+	Up   func(tx *gorm.DB) error
+	Down func(tx *gorm.DB) error
+}
+
+// Status is the state of one migration
+type Status struct {
+	ID        string
+	AppliedAt *time.Time // nil while pending
+	Known     bool       // false for applied migrations this build doesn't define
+}
+
+// schemaMigration is a row of schema_migrations
+type schemaMigration struct {
+	ID        string    `gorm:"primaryKey"`
+	AppliedAt time.Time `gorm:"not null"`
+}
+
+func (schemaMigration) TableName() string {
+	return Table
+}
+
+// Runner applies and rolls back an ordered list of migrations
+type Runner struct {
+	db         *gorm.DB
+	migrations []Migration
+}
+
+// NewRunner creates a runner for migrations, in application order
+func NewRunner(db *gorm.DB, migrations []Migration) *Runner {
+	return &Runner{
+		db:         db,
+		migrations: migrations,
+	}
+}
+
+// Up applies the pending migrations in order and returns the IDs applied
+// Each migration runs in its own transaction together with its schema_migrations row,
+// so a failure leaves it pending and stops the run.
+func (r *Runner) Up() ([]string, error) {
+	if err := r.ensureTable(); err != nil {
+		return nil, err
+	}
+
+	var applied []string
+	for _, migration := range r.migrations {
+		ran := false
+		err := r.db.Transaction(func(tx *gorm.DB) error {
+			if err := lock(tx); err != nil {
+				return err
+			}
+
+			// Re-checked under the lock: another replica may have applied it meanwhile
+			var count int64
+			if err := tx.Model(&schemaMigration{}).Where("id = ?", migration.ID).Count(&count).Error; err != nil {
+				return fmt.Errorf("failed to look up migration %s: %w", migration.ID, err)
+			}
+			if count > 0 {
+				return nil
+			}
+
+			if err := migration.Up(tx); err != nil {
+				return err
+			}
+			ran = true
+			return tx.Create(&schemaMigration{ID: migration.ID, AppliedAt: time.Now()}).Error
+		})
+		if err != nil {
+			return applied, fmt.Errorf("migration %s failed: %w", migration.ID, err)
+		}
+		if ran {
+			applied = append(applied, migration.ID)
+		}
+	}
+
+	return applied, nil
+}
+
+// Down rolls back the last n applied migrations, newest first, and returns the IDs rolled back
+// It stops at the first migration without a Down function.
+func (r *Runner) Down(n int) ([]string, error) {
+	if err := r.ensureTable(); err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]Migration, len(r.migrations))
+	for _, migration := range r.migrations {
+		byID[migration.ID] = migration
+	}
+
+	var rows []schemaMigration
+	if err := r.db.Order("id DESC").Limit(n).Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to list applied migrations: %w", err)
+	}
+
+	var rolledBack []string
+	for _, row := range rows {
+		migration, ok := byID[row.ID]
+		if !ok {
+			return rolledBack, fmt.Errorf("migration %s is not defined in this build", row.ID)
+		}
+		if migration.Down == nil {
+			return rolledBack, fmt.Errorf("migration %s can't be rolled back", row.ID)
+		}
+
+		err := r.db.Transaction(func(tx *gorm.DB) error {
+			if err := lock(tx); err != nil {
+				return err
+			}
+			if err := migration.Down(tx); err != nil {
+				return err
+			}
+			return tx.Delete(&schemaMigration{ID: row.ID}).Error
+		})
+		if err != nil {
+			return rolledBack, fmt.Errorf("rollback of %s failed: %w", row.ID, err)
+		}
+		rolledBack = append(rolledBack, row.ID)
+	}
+
+	return rolledBack, nil
+}
+
+// Status returns every migration with its application time, in order, followed by the
+// applied migrations this build doesn't define
+func (r *Runner) Status() ([]Status, error) {
+	if err := r.ensureTable(); err != nil {
+		return nil, err
+	}
+
+	var rows []schemaMigration
+	if err := r.db.Order("id").Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to list applied migrations: %w", err)
+	}
+	appliedAt := make(map[string]time.Time, len(rows))
+	for _, row := range rows {
+		appliedAt[row.ID] = row.AppliedAt
+	}
+
+	statuses := make([]Status, 0, len(r.migrations))
+	for _, migration := range r.migrations {
+		status := Status{ID: migration.ID, Known: true}
+		if at, ok := appliedAt[migration.ID]; ok {
+			status.AppliedAt = &at
+			delete(appliedAt, migration.ID)
+		}
+		statuses = append(statuses, status)
+	}
+	for _, row := range rows {
+		if at, ok := appliedAt[row.ID]; ok {
+			statuses = append(statuses, Status{ID: row.ID, AppliedAt: &at})
+		}
+	}
+
+	return statuses, nil
+}
+
+func (r *Runner) ensureTable() error {
+	if err := r.db.AutoMigrate(&schemaMigration{}); err != nil {
+		return fmt.Errorf("failed to create %s: %w", Table, err)
+	}
+	return nil
+}
+
+// lock takes the migration advisory lock until the end of the transaction
+func lock(tx *gorm.DB) error {
+	if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", advisoryLockKey).Error; err != nil {
+		return fmt.Errorf("failed to take the migration lock: %w", err)
+	}
+	return nil
+}
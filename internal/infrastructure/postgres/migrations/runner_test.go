@@ -0,0 +1,267 @@
+package migrations_test
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/migrations"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/pgtest"
+	"gorm.io/gorm"
+)
+
+// createTable returns a reversible migration creating a one-column table
+func createTable(id, table string) migrations.Migration {
+	return migrations.Migration{
+		ID:   id,
+		Up:   func(tx *gorm.DB) error { return tx.Exec("CREATE TABLE " + table + " (id integer)").Error },
+		Down: func(tx *gorm.DB) error { return tx.Exec("DROP TABLE " + table).Error },
+	}
+}
+
+func hasTable(t *testing.T, db *gorm.DB, table string) bool {
+	t.Helper()
+	return db.Migrator().HasTable(table)
+}
+
+func appliedIDs(t *testing.T, runner *migrations.Runner) []string {
+	t.Helper()
+
+	statuses, err := runner.Status()
+	if err != nil {
+		t.Fatalf("Status: %v", err)
+	}
+	var ids []string
+	for _, status := range statuses {
+		if status.AppliedAt != nil {
+			ids = append(ids, status.ID)
+		}
+	}
+	return ids
+}
+
+func TestRunnerUpAppliesPendingOnce(t *testing.T) {
+	db := pgtest.OpenEmpty(t)
+
+	var calls atomic.Int32
+	counted := createTable("0002_widgets", "widgets")
+	up := counted.Up
+	counted.Up = func(tx *gorm.DB) error {
+		calls.Add(1)
+		return up(tx)
+	}
+	all := []migrations.Migration{createTable("0001_gadgets", "gadgets"), counted}
+
+	applied, err := migrations.NewRunner(db, all[:1]).Up()
+	if err != nil {
+		t.Fatalf("first Up: %v", err)
+	}
+	if want := []string{"0001_gadgets"}; !reflect.DeepEqual(applied, want) {
+		t.Errorf("first Up applied %v, want %v", applied, want)
+	}
+
+	// A later build adds a migration: only that one runs
+	applied, err = migrations.NewRunner(db, all).Up()
+	if err != nil {
+		t.Fatalf("second Up: %v", err)
+	}
+	if want := []string{"0002_widgets"}; !reflect.DeepEqual(applied, want) {
+		t.Errorf("second Up applied %v, want %v", applied, want)
+	}
+
+	applied, err = migrations.NewRunner(db, all).Up()
+	if err != nil {
+		t.Fatalf("third Up: %v", err)
+	}
+	if len(applied) != 0 {
+		t.Errorf("third Up applied %v, want nothing", applied)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("0002 ran %d times, want 1", calls.Load())
+	}
+	if !hasTable(t, db, "gadgets") || !hasTable(t, db, "widgets") {
+		t.Error("migrated tables missing")
+	}
+}
+
+func TestRunnerUpStopsAtFailure(t *testing.T) {
+	db := pgtest.OpenEmpty(t)
+
+	failing := migrations.Migration{
+		ID: "0002_half_done",
+		Up: func(tx *gorm.DB) error {
+			if err := tx.Exec("CREATE TABLE half_done (id integer)").Error; err != nil {
+				return err
+			}
+			return errors.New("boom")
+		},
+	}
+	runner := migrations.NewRunner(db, []migrations.Migration{
+		createTable("0001_gadgets", "gadgets"),
+		failing,
+		createTable("0003_widgets", "widgets"),
+	})
+
+	applied, err := runner.Up()
+	if err == nil {
+		t.Fatal("Up succeeded, want the failure of 0002")
+	}
+	if want := []string{"0001_gadgets"}; !reflect.DeepEqual(applied, want) {
+		t.Errorf("applied %v, want %v", applied, want)
+	}
+	if hasTable(t, db, "half_done") {
+		t.Error("the failed migration's changes were kept, want them rolled back")
+	}
+	if hasTable(t, db, "widgets") {
+		t.Error("a migration after the failure ran")
+	}
+	if got, want := appliedIDs(t, runner), []string{"0001_gadgets"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("recorded %v, want %v", got, want)
+	}
+}
+
+func TestRunnerDown(t *testing.T) {
+	db := pgtest.OpenEmpty(t)
+
+	backfill := migrations.Migration{ID: "0001_backfill", Up: func(tx *gorm.DB) error { return nil }}
+	runner := migrations.NewRunner(db, []migrations.Migration{
+		backfill,
+		createTable("0002_gadgets", "gadgets"),
+		createTable("0003_widgets", "widgets"),
+	})
+	if _, err := runner.Up(); err != nil {
+		t.Fatalf("Up: %v", err)
+	}
+
+	rolledBack, err := runner.Down(1)
+	if err != nil {
+		t.Fatalf("Down(1): %v", err)
+	}
+	if want := []string{"0003_widgets"}; !reflect.DeepEqual(rolledBack, want) {
+		t.Errorf("Down(1) rolled back %v, want %v", rolledBack, want)
+	}
+	if hasTable(t, db, "widgets") {
+		t.Error("widgets still exists after its rollback")
+	}
+
+	// Stops at the backfill, which can't be undone
+	rolledBack, err = runner.Down(5)
+	if err == nil {
+		t.Fatal("Down(5) succeeded, want an error on the irreversible migration")
+	}
+	if want := []string{"0002_gadgets"}; !reflect.DeepEqual(rolledBack, want) {
+		t.Errorf("Down(5) rolled back %v, want %v", rolledBack, want)
+	}
+	if got, want := appliedIDs(t, runner), []string{"0001_backfill"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("still applied %v, want %v", got, want)
+	}
+
+	// Rolled back migrations are pending again
+	applied, err := runner.Up()
+	if err != nil {
+		t.Fatalf("Up after Down: %v", err)
+	}
+	if want := []string{"0002_gadgets", "0003_widgets"}; !reflect.DeepEqual(applied, want) {
+		t.Errorf("Up after Down applied %v, want %v", applied, want)
+	}
+}
+
+func TestRunnerStatus(t *testing.T) {
+	db := pgtest.OpenEmpty(t)
+
+	if _, err := migrations.NewRunner(db, []migrations.Migration{
+		createTable("0001_gadgets", "gadgets"),
+		createTable("0003_removed", "removed"),
+	}).Up(); err != nil {
+		t.Fatalf("Up: %v", err)
+	}
+
+	// This build dropped 0003 and adds 0002, not applied yet
+	statuses, err := migrations.NewRunner(db, []migrations.Migration{
+		createTable("0001_gadgets", "gadgets"),
+		createTable("0002_widgets", "widgets"),
+	}).Status()
+	if err != nil {
+		t.Fatalf("Status: %v", err)
+	}
+
+	type state struct {
+		id      string
+		applied bool
+		known   bool
+	}
+	var got []state
+	for _, status := range statuses {
+		got = append(got, state{id: status.ID, applied: status.AppliedAt != nil, known: status.Known})
+	}
+	want := []state{
+		{id: "0001_gadgets", applied: true, known: true},
+		{id: "0002_widgets", applied: false, known: true},
+		{id: "0003_removed", applied: true, known: false},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Status = %+v, want %+v", got, want)
+	}
+}
+
+func TestRunnerUpConcurrentReplicas(t *testing.T) {
+	db := pgtest.OpenEmpty(t)
+
+	var calls atomic.Int32
+	all := []migrations.Migration{{
+		ID: "0001_counted",
+		Up: func(tx *gorm.DB) error {
+			calls.Add(1)
+			return tx.Exec("CREATE TABLE counted (id integer)").Error
+		},
+	}}
+	// The table exists before the replicas race, like on any start after the first
+	if _, err := migrations.NewRunner(db, nil).Status(); err != nil {
+		t.Fatalf("Status: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 4)
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := migrations.NewRunner(db, all).Up()
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("Up: %v", err)
+		}
+	}
+	if calls.Load() != 1 {
+		t.Errorf("migration ran %d times across replicas, want 1", calls.Load())
+	}
+}
+
+func TestMigrateIsRepeatable(t *testing.T) {
+	db := pgtest.Open(t)
+
+	if err := migrations.Migrate(db, pgtest.SearchConfig); err != nil {
+		t.Fatalf("second Migrate: %v", err)
+	}
+
+	statuses, err := migrations.NewRunner(db, migrations.All).Status()
+	if err != nil {
+		t.Fatalf("Status: %v", err)
+	}
+	if len(statuses) != len(migrations.All) {
+		t.Fatalf("%d statuses, want %d", len(statuses), len(migrations.All))
+	}
+	for _, status := range statuses {
+		if status.AppliedAt == nil || !status.Known {
+			t.Errorf("%s: applied = %v, known = %v, want an applied known migration", status.ID, status.AppliedAt != nil, status.Known)
+		}
+	}
+}
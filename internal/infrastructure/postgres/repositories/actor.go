@@ -0,0 +1,23 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/actor"
+)
+
+// actorOr returns the actor of the request, or fallback (the owner) when there is none
+func actorOr(ctx context.Context, fallback string) string {
+	if a := actor.FromContext(ctx); a != "" {
+		return a
+	}
+	return fallback
+}
+
+// withUpdatedBy adds the updated_by column for the request's actor to an updates map
+func withUpdatedBy(ctx context.Context, updates map[string]interface{}) map[string]interface{} {
+	if a := actor.FromContext(ctx); a != "" {
+		updates["updated_by"] = a
+	}
+	return updates
+}
@@ -0,0 +1,96 @@
+package repositories_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/usecases/category"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/usecases/section"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/entities"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/pgtest"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/repositories"
+	"gorm.io/gorm"
+)
+
+// positions returns the position of each row of model keyed by ID
+func positions(t *testing.T, db *gorm.DB, model interface{}) map[uint]uint {
+	t.Helper()
+
+	var rows []struct {
+		ID       uint
+		Position uint
+	}
+	if err := db.Model(model).Select("id, position").Scan(&rows).Error; err != nil {
+		t.Fatalf("read positions of %T: %v", model, err)
+	}
+	got := make(map[uint]uint, len(rows))
+	for _, row := range rows {
+		got[row.ID] = row.Position
+	}
+	return got
+}
+
+func TestBulkReorder_MixedPortfoliosPersistNothing(t *testing.T) {
+	tests := []struct {
+		name  string
+		model interface{}
+		// reorder runs the use case, then the repository alone, on the first item of each tree
+		reorder func(db *gorm.DB, first, second *tree) (useCaseErr, repoErr error)
+	}{
+		{
+			name:  "categories",
+			model: &entities.CategoryRecord{},
+			reorder: func(db *gorm.DB, first, second *tree) (error, error) {
+				repo := repositories.NewCategoryRepository(db)
+				input := dto.BulkUpdateCategoryPositionsInput{OwnerID: "alice", Items: []dto.BulkUpdatePositionItem{
+					{ID: first.Category.ID, Position: 2}, {ID: second.Category.ID, Position: 1},
+				}}
+				uc := category.NewBulkReorderCategoriesUseCase(repo, repositories.NewPortfolioRepository(db, false), repositories.NewTransactionManager(db), nil)
+				return uc.Execute(context.Background(), input), repo.BulkUpdatePositions(context.Background(), input)
+			},
+		},
+		{
+			name:  "sections",
+			model: &entities.SectionRecord{},
+			reorder: func(db *gorm.DB, first, second *tree) (error, error) {
+				repo := repositories.NewSectionRepository(db, pgtest.SearchConfig, false)
+				input := dto.BulkUpdateSectionPositionsInput{OwnerID: "alice", Items: []dto.BulkUpdatePositionItem{
+					{ID: first.Section.ID, Position: 2}, {ID: second.Section.ID, Position: 1},
+				}}
+				uc := section.NewBulkReorderSectionsUseCase(repo, repositories.NewPortfolioRepository(db, false), repositories.NewTransactionManager(db), nil)
+				return uc.Execute(context.Background(), input), repo.BulkUpdatePositions(context.Background(), input)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := pgtest.Open(t)
+			first := seedTree(t, db, "alice", "first")
+			second := seedTree(t, db, "alice", "second")
+			before := positions(t, db, tt.model)
+
+			useCaseErr, repoErr := tt.reorder(db, first, second)
+
+			appErr, ok := apperrors.As(useCaseErr)
+			if !ok || appErr.Kind != apperrors.KindUnprocessable || appErr.Code != apperrors.CodeReorderMixedParents {
+				t.Fatalf("use case error = %v, want %s", useCaseErr, apperrors.CodeReorderMixedParents)
+			}
+			groups, _ := appErr.Details["portfolios"].(map[uint][]uint)
+			if len(groups) != 2 || len(groups[first.Portfolio.ID]) != 1 || len(groups[second.Portfolio.ID]) != 1 {
+				t.Errorf("details portfolios = %v, want one item in each portfolio", appErr.Details["portfolios"])
+			}
+			// The repository enforces the invariant on its own as well
+			if repoErr == nil {
+				t.Error("BulkUpdatePositions accepted items of two portfolios")
+			}
+
+			if after := positions(t, db, tt.model); !reflect.DeepEqual(after, before) {
+				t.Errorf("positions = %v after the rejected reorder, want unchanged %v", after, before)
+			}
+		})
+	}
+}
@@ -3,11 +3,14 @@ package repositories
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
 	dto2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/titles"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/entities"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // categoryRepository is the GORM implementation of CategoryRepository
@@ -23,6 +26,8 @@ func NewCategoryRepository(db *gorm.DB) contracts.CategoryRepository {
 }
 
 // Create creates a new category in the database
+// When no position is given (0), the category is appended after the last one in
+// its portfolio (MAX+1) within the same transaction, so positions always start at 1.
 func (r *categoryRepository) Create(ctx context.Context, input dto2.CreateCategoryInput) (*dto2.CategoryDTO, error) {
 	// Convert application DTO to infrastructure entity
 	record := &entities.CategoryRecord{
@@ -31,10 +36,23 @@ func (r *categoryRepository) Create(ctx context.Context, input dto2.CreateCatego
 		Position:    input.Position,
 		OwnerID:     input.OwnerID,
 		PortfolioID: input.PortfolioID,
+		CreatedBy:   actorOr(ctx, input.OwnerID),
+		UpdatedBy:   actorOr(ctx, input.OwnerID),
 	}
 
 	// Persist to database
-	if err := r.db.WithContext(ctx).Create(record).Error; err != nil {
+	err := inTransaction(ctx, r.db, func(tx *gorm.DB) error {
+		if record.Position == 0 {
+			position, err := nextPosition(tx, "categories", "portfolios", "portfolio_id", record.PortfolioID)
+			if err != nil {
+				return err
+			}
+			record.Position = position
+		}
+
+		return tx.Create(record).Error
+	})
+	if err != nil {
 		return nil, fmt.Errorf("failed to create category: %w", err)
 	}
 
@@ -46,7 +64,7 @@ func (r *categoryRepository) Create(ctx context.Context, input dto2.CreateCatego
 func (r *categoryRepository) GetByID(ctx context.Context, id uint) (*dto2.CategoryDTO, error) {
 	var record entities.CategoryRecord
 
-	if err := r.db.WithContext(ctx).First(&record, id).Error; err != nil {
+	if err := conn(ctx, r.db).First(&record, id).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, fmt.Errorf("category with ID %d not found", id)
 		}
@@ -60,7 +78,7 @@ func (r *categoryRepository) GetByID(ctx context.Context, id uint) (*dto2.Catego
 func (r *categoryRepository) GetByIDs(ctx context.Context, ids []uint) ([]dto2.CategoryDTO, error) {
 	var records []entities.CategoryRecord
 
-	if err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&records).Error; err != nil {
+	if err := conn(ctx, r.db).Where("id IN ?", ids).Order("id ASC").Find(&records).Error; err != nil {
 		return nil, fmt.Errorf("failed to get categories by IDs: %w", err)
 	}
 
@@ -77,9 +95,9 @@ func (r *categoryRepository) GetByIDs(ctx context.Context, ids []uint) ([]dto2.C
 func (r *categoryRepository) GetByPortfolioID(ctx context.Context, portfolioID uint) ([]dto2.CategoryDTO, error) {
 	var records []entities.CategoryRecord
 
-	if err := r.db.WithContext(ctx).
+	if err := conn(ctx, r.db).
 		Where("portfolio_id = ?", portfolioID).
-		Order("position ASC, created_at ASC").
+		Order("position ASC, created_at ASC, id ASC").
 		Find(&records).Error; err != nil {
 		return nil, fmt.Errorf("failed to get categories by portfolio ID: %w", err)
 	}
@@ -93,13 +111,79 @@ func (r *categoryRepository) GetByPortfolioID(ctx context.Context, portfolioID u
 	return dtos, nil
 }
 
+// GetOwnerCategoryDetail retrieves a category with one page of its projects
+// Ownership is enforced in the query by joining through the portfolio owner, so a
+// category of another user is indistinguishable from a missing one.
+// Runs three queries regardless of the number of projects: category, count, page.
+func (r *categoryRepository) GetOwnerCategoryDetail(ctx context.Context, input dto2.CategoryDetailInput) (*dto2.CategoryDetailDTO, error) {
+	var record entities.CategoryRecord
+
+	result := conn(ctx, r.db).
+		Joins("JOIN portfolios ON portfolios.id = categories.portfolio_id AND portfolios.deleted_at IS NULL").
+		Where("categories.id = ? AND portfolios.owner_id = ?", input.CategoryID, input.OwnerID).
+		Limit(1).
+		Find(&record)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to get category: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, fmt.Errorf("category with ID %d not found", input.CategoryID)
+	}
+
+	var total int64
+	if err := conn(ctx, r.db).
+		Model(&entities.ProjectRecord{}).
+		Where("category_id = ?", record.ID).
+		Count(&total).Error; err != nil {
+		return nil, fmt.Errorf("failed to count category projects: %w", err)
+	}
+
+	// Only list-level columns are loaded for the dashboard
+	var projects []entities.ProjectRecord
+	offset := (input.Pagination.Page - 1) * input.Pagination.Limit
+	if err := conn(ctx, r.db).
+		Select("id", "title", "main_image", "skills", "client", "position", "hidden", "created_at", "updated_at").
+		Where("category_id = ?", record.ID).
+		Order("position ASC, id ASC").
+		Limit(input.Pagination.Limit).
+		Offset(offset).
+		Find(&projects).Error; err != nil {
+		return nil, fmt.Errorf("failed to get category projects: %w", err)
+	}
+
+	detail := &dto2.CategoryDetailDTO{
+		Category: *r.recordToDTO(&record),
+		Projects: make([]dto2.CategoryDetailProjectDTO, len(projects)),
+		Pagination: dto2.PaginatedResultDTO{
+			Total: total,
+			Page:  input.Pagination.Page,
+			Limit: input.Pagination.Limit,
+		},
+	}
+	for i, p := range projects {
+		detail.Projects[i] = dto2.CategoryDetailProjectDTO{
+			ID:        p.ID,
+			Title:     p.Title,
+			MainImage: p.MainImage,
+			Skills:    p.Skills,
+			Client:    p.Client,
+			Position:  p.Position,
+			Hidden:    p.Hidden,
+			CreatedAt: p.CreatedAt,
+			UpdatedAt: p.UpdatedAt,
+		}
+	}
+
+	return detail, nil
+}
+
 // GetByOwnerID retrieves all categories owned by a user with pagination
 func (r *categoryRepository) GetByOwnerID(ctx context.Context, ownerID string, pagination dto2.PaginationDTO) ([]dto2.CategoryDTO, int64, error) {
 	var records []entities.CategoryRecord
 	var total int64
 
 	// Count total categories for this owner
-	if err := r.db.WithContext(ctx).
+	if err := conn(ctx, r.db).
 		Model(&entities.CategoryRecord{}).
 		Where("owner_id = ?", ownerID).
 		Count(&total).Error; err != nil {
@@ -110,9 +194,9 @@ func (r *categoryRepository) GetByOwnerID(ctx context.Context, ownerID string, p
 	offset := (pagination.Page - 1) * pagination.Limit
 
 	// Get paginated results
-	if err := r.db.WithContext(ctx).
+	if err := conn(ctx, r.db).
 		Where("owner_id = ?", ownerID).
-		Order("created_at DESC").
+		Order("created_at DESC, id DESC").
 		Limit(pagination.Limit).
 		Offset(offset).
 		Find(&records).Error; err != nil {
@@ -145,8 +229,9 @@ func (r *categoryRepository) Update(ctx context.Context, input dto2.UpdateCatego
 	if len(updates) == 0 {
 		return nil // Nothing to update
 	}
+	withUpdatedBy(ctx, updates)
 
-	result := r.db.WithContext(ctx).
+	result := conn(ctx, r.db).
 		Model(&entities.CategoryRecord{}).
 		Where("id = ?", input.ID).
 		Updates(updates)
@@ -162,12 +247,46 @@ func (r *categoryRepository) Update(ctx context.Context, input dto2.UpdateCatego
 	return nil
 }
 
+// Patch updates the provided fields of a category
+// An empty description is stored as NULL.
+func (r *categoryRepository) Patch(ctx context.Context, input dto2.PatchCategoryInput) error {
+	updates := map[string]interface{}{}
+	if input.Title != nil {
+		updates["title"] = *input.Title
+	}
+	if input.Description != nil {
+		updates["description"] = nilIfEmpty(*input.Description)
+	}
+	if input.Position != nil {
+		updates["position"] = *input.Position
+	}
+
+	if len(updates) == 0 {
+		return nil // Nothing to update
+	}
+
+	result := conn(ctx, r.db).
+		Model(&entities.CategoryRecord{}).
+		Where("id = ?", input.ID).
+		Updates(withUpdatedBy(ctx, updates))
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to patch category: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("category with ID %d not found", input.ID)
+	}
+
+	return nil
+}
+
 // UpdatePosition updates only the position field of a category
 func (r *categoryRepository) UpdatePosition(ctx context.Context, id uint, position uint) error {
-	result := r.db.WithContext(ctx).
+	result := conn(ctx, r.db).
 		Model(&entities.CategoryRecord{}).
 		Where("id = ?", id).
-		Update("position", position)
+		Updates(withUpdatedBy(ctx, map[string]interface{}{"position": position}))
 
 	if result.Error != nil {
 		return fmt.Errorf("failed to update category position: %w", result.Error)
@@ -180,13 +299,28 @@ func (r *categoryRepository) UpdatePosition(ctx context.Context, id uint, positi
 	return nil
 }
 
-// BulkUpdatePositions updates positions for multiple categories in a transaction
+// SwapPositions exchanges the positions of two categories of the same portfolio in one transaction
+func (r *categoryRepository) SwapPositions(ctx context.Context, firstID, secondID uint) error {
+	return inTransaction(ctx, r.db, func(tx *gorm.DB) error {
+		return swapPositions(ctx, tx, "categories", "portfolio_id", firstID, secondID)
+	})
+}
+
+// BulkUpdatePositions updates positions for multiple categories in a transaction (the unit of work's, inside one)
 func (r *categoryRepository) BulkUpdatePositions(ctx context.Context, input dto2.BulkUpdateCategoryPositionsInput) error {
-	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	return inTransaction(ctx, r.db, func(tx *gorm.DB) error {
+		ids := make([]uint, len(input.Items))
+		for i, item := range input.Items {
+			ids[i] = item.ID
+		}
+		if err := assertSameParent(tx, "categories", "portfolio_id", ids); err != nil {
+			return err
+		}
+
 		for _, item := range input.Items {
 			if err := tx.Model(&entities.CategoryRecord{}).
 				Where("id = ?", item.ID).
-				Update("position", item.Position).Error; err != nil {
+				Updates(withUpdatedBy(ctx, map[string]interface{}{"position": item.Position})).Error; err != nil {
 				return fmt.Errorf("failed to update position for category %d: %w", item.ID, err)
 			}
 		}
@@ -194,21 +328,100 @@ func (r *categoryRepository) BulkUpdatePositions(ctx context.Context, input dto2
 	})
 }
 
-// Delete deletes a category by its ID (soft delete with GORM, cascade handled by GORM)
+// Delete deletes a category by its ID
+// Soft delete; its projects are soft-deleted in the same transaction and delete batch
 func (r *categoryRepository) Delete(ctx context.Context, id uint) error {
-	result := r.db.WithContext(ctx).Delete(&entities.CategoryRecord{}, id)
+	batchID, err := newDeleteBatchID()
+	if err != nil {
+		return err
+	}
 
-	if result.Error != nil {
-		return fmt.Errorf("failed to delete category: %w", result.Error)
+	var deleted int64
+	err = inTransaction(ctx, r.db, func(tx *gorm.DB) error {
+		var err error
+		deleted, err = softDeleteCategoryCascade(tx, batchID, time.Now(), "id = ?", id)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete category: %w", err)
 	}
 
-	if result.RowsAffected == 0 {
+	if deleted == 0 {
 		return fmt.Errorf("category with ID %d not found", id)
 	}
 
 	return nil
 }
 
+// DeleteMovingProjects moves the projects of a category to another one, then deletes the category
+func (r *categoryRepository) DeleteMovingProjects(ctx context.Context, id, targetID uint) ([]uint, error) {
+	batchID, err := newDeleteBatchID()
+	if err != nil {
+		return nil, err
+	}
+
+	var moved []uint
+	err = inTransaction(ctx, r.db, func(tx *gorm.DB) error {
+		// Lock the target's project titles so concurrent moves and creates don't race the suffixing
+		var existing []string
+		if err := tx.Model(&entities.ProjectRecord{}).
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("category_id = ?", targetID).
+			Pluck("title", &existing).Error; err != nil {
+			return err
+		}
+		taken := make(map[string]bool, len(existing))
+		for _, title := range existing {
+			taken[title] = true
+		}
+
+		var projects []entities.ProjectRecord
+		if err := tx.Select("id", "title").
+			Where("category_id = ?", id).
+			Order("position ASC, id ASC").
+			Find(&projects).Error; err != nil {
+			return err
+		}
+
+		// Moved projects keep their order, after the target's own projects
+		position, err := nextPosition(tx, "projects", "categories", "category_id", targetID)
+		if err != nil {
+			return err
+		}
+
+		for _, project := range projects {
+			title := titles.Unique(project.Title, taken)
+			taken[title] = true
+
+			if err := tx.Model(&entities.ProjectRecord{}).
+				Where("id = ?", project.ID).
+				Updates(withUpdatedBy(ctx, map[string]interface{}{
+					"category_id": targetID,
+					"title":       title,
+					"position":    position,
+				})).Error; err != nil {
+				return err
+			}
+			moved = append(moved, project.ID)
+			position++
+		}
+
+		deleted, err := softDeleteCategoryCascade(tx, batchID, time.Now(), "id = ?", id)
+		if err != nil {
+			return err
+		}
+		if deleted == 0 {
+			return fmt.Errorf("category with ID %d not found", id)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete category: %w", err)
+	}
+
+	return moved, nil
+}
+
 // recordToDTO converts a CategoryRecord (infrastructure) to CategoryDTO (application)
 func (r *categoryRepository) recordToDTO(record *entities.CategoryRecord) *dto2.CategoryDTO {
 	return &dto2.CategoryDTO{
@@ -220,5 +433,7 @@ func (r *categoryRepository) recordToDTO(record *entities.CategoryRecord) *dto2.
 		PortfolioID: record.PortfolioID,
 		CreatedAt:   record.CreatedAt,
 		UpdatedAt:   record.UpdatedAt,
+		CreatedBy:   record.CreatedBy,
+		UpdatedBy:   record.UpdatedBy,
 	}
 }
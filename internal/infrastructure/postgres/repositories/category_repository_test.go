@@ -0,0 +1,112 @@
+package repositories_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/entities"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/pgtest"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/repositories"
+)
+
+func TestCategoryRepository_DeleteMovingProjects(t *testing.T) {
+	db := pgtest.Open(t)
+	ctx := context.Background()
+	repo := repositories.NewCategoryRepository(db)
+
+	// The source holds a project titled like the target's and one titled like its suffixed copy
+	source := seedTree(t, db, "alice", "source")
+	target := seedTree(t, db, "alice", "target")
+	clash := entities.ProjectRecord{Title: "target project", Description: "clash", Position: 2, OwnerID: "alice", CategoryID: source.Category.ID}
+	create(t, db, &clash)
+	create(t, db, &entities.ProjectRecord{Title: "target project (2)", Description: "taken", Position: 2, OwnerID: "alice", CategoryID: target.Category.ID})
+
+	moved, err := repo.DeleteMovingProjects(ctx, source.Category.ID, target.Category.ID)
+	if err != nil {
+		t.Fatalf("DeleteMovingProjects: %v", err)
+	}
+	if want := []uint{source.Project.ID, clash.ID}; !reflect.DeepEqual(moved, want) {
+		t.Errorf("moved = %v, want %v in position order", moved, want)
+	}
+
+	var projects []entities.ProjectRecord
+	if err := db.Where("category_id = ?", target.Category.ID).Order("position, id").Find(&projects).Error; err != nil {
+		t.Fatalf("list target projects: %v", err)
+	}
+	var got []string
+	for _, project := range projects {
+		got = append(got, project.Title)
+	}
+	want := []string{"target project", "target project (2)", "source project", "target project (3)"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("target titles = %v, want %v", got, want)
+	}
+	if projects[2].Position != 3 || projects[3].Position != 4 {
+		t.Errorf("moved positions = %d, %d, want 3, 4 after the target's own", projects[2].Position, projects[3].Position)
+	}
+
+	var live int64
+	db.Model(&entities.CategoryRecord{}).Where("id = ?", source.Category.ID).Count(&live)
+	if live != 0 {
+		t.Error("source category is still live")
+	}
+
+	// An empty category is deleted without moving anything
+	empty := entities.CategoryRecord{Title: "empty", Position: 2, OwnerID: "alice", PortfolioID: target.Portfolio.ID}
+	create(t, db, &empty)
+	moved, err = repo.DeleteMovingProjects(ctx, empty.ID, target.Category.ID)
+	if err != nil || len(moved) != 0 {
+		t.Fatalf("DeleteMovingProjects(empty) = %v, %v, want nothing moved", moved, err)
+	}
+	db.Model(&entities.CategoryRecord{}).Where("id = ?", empty.ID).Count(&live)
+	if live != 0 {
+		t.Error("empty category is still live")
+	}
+}
+
+func TestCategoryRepository_Patch(t *testing.T) {
+	db := pgtest.Open(t)
+	ctx := context.Background()
+	repo := repositories.NewCategoryRepository(db)
+	tr := seedTree(t, db, "alice", "patch")
+
+	description := "kept"
+	if err := db.Model(&tr.Category).Update("description", description).Error; err != nil {
+		t.Fatalf("set description: %v", err)
+	}
+	strPtr := func(s string) *string { return &s }
+	load := func() *dto.CategoryDTO {
+		t.Helper()
+		category, err := repo.GetByID(ctx, tr.Category.ID)
+		if err != nil {
+			t.Fatalf("GetByID: %v", err)
+		}
+		return category
+	}
+
+	// Omitted fields keep their value, including the position
+	if err := repo.Patch(ctx, dto.PatchCategoryInput{ID: tr.Category.ID, Title: strPtr("renamed")}); err != nil {
+		t.Fatalf("Patch(title): %v", err)
+	}
+	if got := load(); got.Title != "renamed" || got.Description == nil || *got.Description != description || got.Position != tr.Category.Position {
+		t.Errorf("after a title patch = %q, %v, %d, want renamed, %q, %d", got.Title, got.Description, got.Position, description, tr.Category.Position)
+	}
+
+	// An empty description clears it
+	if err := repo.Patch(ctx, dto.PatchCategoryInput{ID: tr.Category.ID, Description: strPtr("")}); err != nil {
+		t.Fatalf("Patch(description): %v", err)
+	}
+	if got := load(); got.Description != nil || got.Title != "renamed" {
+		t.Errorf("after clearing the description = %q, %v, want renamed, nil", got.Title, got.Description)
+	}
+
+	// Nothing to write is not an error, even for an unknown ID; a real write to one is
+	if err := repo.Patch(ctx, dto.PatchCategoryInput{ID: 9999}); err != nil {
+		t.Errorf("empty Patch: %v", err)
+	}
+	if err := repo.Patch(ctx, dto.PatchCategoryInput{ID: 9999, Title: strPtr("ghost")}); err == nil {
+		t.Error("Patch of a missing category succeeded")
+	}
+}
@@ -0,0 +1,9 @@
+package repositories
+
+// Unexported helpers exercised by the repositories_test package, which can use pgtest
+// (pgtest migrates through this package, so an internal test can't import it)
+var (
+	InsertWithUniqueRetry = insertWithUniqueRetry
+	RandomCandidate       = randomCandidate
+	NumberedCandidate     = numberedCandidate
+)
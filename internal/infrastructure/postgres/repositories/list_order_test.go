@@ -0,0 +1,136 @@
+package repositories_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/entities"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/pgtest"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/repositories"
+)
+
+func TestRepositoryListsAreDeterministic(t *testing.T) {
+	db := pgtest.Open(t)
+	ctx := context.Background()
+	tr := seedTree(t, db, "alice", "order")
+
+	// Rows sharing a position and a creation timestamp can only be told apart by ID
+	createdAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	var categoryIDs, sectionIDs, projectIDs, portfolioIDs []uint
+	for i := 0; i < 4; i++ {
+		category := entities.CategoryRecord{Title: fmt.Sprintf("tied category %d", i), Position: 2, OwnerID: "alice", PortfolioID: tr.Portfolio.ID}
+		category.CreatedAt = createdAt
+		create(t, db, &category)
+		categoryIDs = append(categoryIDs, category.ID)
+
+		section := entities.SectionRecord{Title: fmt.Sprintf("tied section %d", i), Slug: fmt.Sprintf("tied-%d", i), Type: "text", Position: 2, OwnerID: "alice", PortfolioID: tr.Portfolio.ID}
+		section.CreatedAt = createdAt
+		create(t, db, &section)
+		sectionIDs = append(sectionIDs, section.ID)
+
+		project := entities.ProjectRecord{Title: fmt.Sprintf("tied project %d", i), Description: "tied", Position: 2, OwnerID: "alice", CategoryID: tr.Category.ID}
+		project.CreatedAt = createdAt
+		create(t, db, &project)
+		projectIDs = append(projectIDs, project.ID)
+
+		portfolio := entities.PortfolioRecord{Title: fmt.Sprintf("tied portfolio %d", i), Slug: fmt.Sprintf("tied-%d", i), OwnerID: "bob"}
+		portfolio.CreatedAt = createdAt
+		create(t, db, &portfolio)
+		portfolioIDs = append([]uint{portfolio.ID}, portfolioIDs...) // newest first
+	}
+
+	categories := repositories.NewCategoryRepository(db)
+	sections := repositories.NewSectionRepository(db, pgtest.SearchConfig, false)
+	projects := repositories.NewProjectRepository(db, pgtest.SearchConfig)
+	portfolios := repositories.NewPortfolioRepository(db, false)
+	page := dto.PaginationDTO{Page: 1, Limit: 50}
+
+	reversed := func(ids []uint) []uint {
+		out := make([]uint, len(ids))
+		for i, id := range ids {
+			out[len(ids)-1-i] = id
+		}
+		return out
+	}
+	withSeed := func(seedID uint, ids []uint) []uint { return append([]uint{seedID}, ids...) }
+
+	tests := []struct {
+		name    string
+		list    func() (interface{}, error)
+		wantIDs []uint
+	}{
+		{
+			name:    "categories of a portfolio",
+			list:    func() (interface{}, error) { return categories.GetByPortfolioID(ctx, tr.Portfolio.ID) },
+			wantIDs: withSeed(tr.Category.ID, categoryIDs),
+		},
+		{
+			name:    "categories by IDs",
+			list:    func() (interface{}, error) { return categories.GetByIDs(ctx, reversed(categoryIDs)) },
+			wantIDs: categoryIDs,
+		},
+		{
+			name:    "sections of a portfolio",
+			list:    func() (interface{}, error) { return sections.GetByPortfolioID(ctx, tr.Portfolio.ID) },
+			wantIDs: withSeed(tr.Section.ID, sectionIDs),
+		},
+		{
+			name:    "sections by IDs",
+			list:    func() (interface{}, error) { return sections.GetByIDs(ctx, reversed(sectionIDs)) },
+			wantIDs: sectionIDs,
+		},
+		{
+			name:    "projects by IDs",
+			list:    func() (interface{}, error) { return projects.GetByIDs(ctx, reversed(projectIDs)) },
+			wantIDs: projectIDs,
+		},
+		{
+			name: "portfolios of an owner",
+			list: func() (interface{}, error) {
+				list, _, err := portfolios.GetByOwnerID(ctx, "bob", page)
+				return list, err
+			},
+			wantIDs: portfolioIDs,
+		},
+		{
+			name:    "portfolio summaries",
+			list:    func() (interface{}, error) { return portfolios.GetSummariesByOwnerID(ctx, "bob") },
+			wantIDs: portfolioIDs,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var bodies [2][]byte
+			for i := range bodies {
+				list, err := tt.list()
+				if err != nil {
+					t.Fatalf("list: %v", err)
+				}
+				if bodies[i], err = json.Marshal(list); err != nil {
+					t.Fatalf("encode: %v", err)
+				}
+			}
+			if string(bodies[0]) != string(bodies[1]) {
+				t.Errorf("two identical calls returned different bodies:\n%s\n%s", bodies[0], bodies[1])
+			}
+
+			var rows []struct{ ID uint }
+			if err := json.Unmarshal(bodies[0], &rows); err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+			got := make([]uint, len(rows))
+			for i, row := range rows {
+				got[i] = row.ID
+			}
+			if !reflect.DeepEqual(got, tt.wantIDs) {
+				t.Errorf("IDs = %v, want %v", got, tt.wantIDs)
+			}
+		})
+	}
+}
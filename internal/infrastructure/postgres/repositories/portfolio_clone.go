@@ -0,0 +1,294 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/titles"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/domain/portfolio"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/entities"
+	"gorm.io/gorm"
+)
+
+// cloneBatchSize is the number of rows inserted per statement when copying children
+const cloneBatchSize = 200
+
+// Clone deep-copies a live portfolio in one transaction: its links, categories with their
+// projects and collaborators, and sections with their contents. Foreign keys point at the
+// copies and positions are renumbered from 1 in the source order. The copy takes the first
+// free " (2)", " (3)"... title among the owner's portfolios and a new slug.
+// Views, endorsements, content revisions and slug history are not copied.
+func (r *portfolioRepository) Clone(ctx context.Context, id uint) (*dto.PortfolioCloneDTO, error) {
+	clone := &dto.PortfolioCloneDTO{SourceID: id}
+
+	err := inTransaction(ctx, r.db, func(tx *gorm.DB) error {
+		var source entities.PortfolioRecord
+		if err := tx.First(&source, id).Error; err != nil {
+			return err
+		}
+		actorID := actorOr(ctx, source.OwnerID)
+
+		var existing []string
+		if err := tx.Model(&entities.PortfolioRecord{}).
+			Where("owner_id = ?", source.OwnerID).
+			Pluck("title", &existing).Error; err != nil {
+			return err
+		}
+		taken := make(map[string]bool, len(existing))
+		for _, title := range existing {
+			taken[title] = true
+		}
+
+		record := &entities.PortfolioRecord{
+			Title:               titles.Unique(source.Title, taken),
+			Description:         source.Description,
+			OwnerID:             source.OwnerID,
+			EndorsementsEnabled: source.EndorsementsEnabled,
+			CustomCSS:           source.CustomCSS,
+			CustomCSSSanitized:  source.CustomCSSSanitized,
+			CreatedBy:           actorID,
+			UpdatedBy:           actorID,
+		}
+		candidate := numberedCandidate(portfolio.PortfolioSlug(record.Title), takenPortfolioSlugs(tx, 0))
+		if _, err := insertWithUniqueRetry(tx, 0, candidate, func(tx *gorm.DB, slug string) error {
+			record.Slug = slug
+			return tx.Create(record).Error
+		}); err != nil {
+			return err
+		}
+
+		var err error
+		if clone.Copied.Links, err = cloneLinks(tx, source.ID, record.ID, actorID); err != nil {
+			return err
+		}
+		if err := cloneCategories(tx, source.ID, record.ID, actorID, &clone.Copied); err != nil {
+			return err
+		}
+		if err := cloneSections(tx, source.ID, record.ID, actorID, r.readContentPosition, &clone.Copied); err != nil {
+			return err
+		}
+
+		clone.Portfolio = *r.recordToDTO(record)
+		return nil
+	})
+	if err == gorm.ErrRecordNotFound {
+		return nil, fmt.Errorf("portfolio with ID %d not found", id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to clone portfolio: %w", err)
+	}
+
+	return clone, nil
+}
+
+// cloneLinks copies the live links of a portfolio, returning how many were copied
+func cloneLinks(tx *gorm.DB, sourceID, targetID uint, actorID string) (int, error) {
+	var links []entities.PortfolioLinkRecord
+	if err := tx.Where("portfolio_id = ?", sourceID).Order("position ASC, id ASC").Find(&links).Error; err != nil {
+		return 0, fmt.Errorf("failed to load links: %w", err)
+	}
+	if len(links) == 0 {
+		return 0, nil
+	}
+
+	copies := make([]entities.PortfolioLinkRecord, len(links))
+	for i, link := range links {
+		copies[i] = entities.PortfolioLinkRecord{
+			PortfolioID: targetID,
+			Kind:        link.Kind,
+			Label:       link.Label,
+			URL:         link.URL,
+			Position:    uint(i + 1),
+			OwnerID:     link.OwnerID,
+			CreatedBy:   actorID,
+			UpdatedBy:   actorID,
+		}
+	}
+	if err := tx.CreateInBatches(&copies, cloneBatchSize).Error; err != nil {
+		return 0, fmt.Errorf("failed to copy links: %w", err)
+	}
+
+	return len(copies), nil
+}
+
+// cloneCategories copies the live categories of a portfolio with their projects and collaborators
+func cloneCategories(tx *gorm.DB, sourceID, targetID uint, actorID string, copied *dto.PortfolioChildCountsDTO) error {
+	var categories []entities.CategoryRecord
+	if err := tx.Where("portfolio_id = ?", sourceID).Order("position ASC, id ASC").Find(&categories).Error; err != nil {
+		return fmt.Errorf("failed to load categories: %w", err)
+	}
+	if len(categories) == 0 {
+		return nil
+	}
+
+	copies := make([]entities.CategoryRecord, len(categories))
+	for i, category := range categories {
+		copies[i] = entities.CategoryRecord{
+			Title:       category.Title,
+			Description: category.Description,
+			Position:    uint(i + 1),
+			OwnerID:     category.OwnerID,
+			PortfolioID: targetID,
+			CreatedBy:   actorID,
+			UpdatedBy:   actorID,
+		}
+	}
+	if err := tx.CreateInBatches(&copies, cloneBatchSize).Error; err != nil {
+		return fmt.Errorf("failed to copy categories: %w", err)
+	}
+	copied.Categories = len(copies)
+
+	// Inserted IDs come back in slice order
+	categoryIDs := make(map[uint]uint, len(categories))
+	sourceIDs := make([]uint, len(categories))
+	for i, category := range categories {
+		categoryIDs[category.ID] = copies[i].ID
+		sourceIDs[i] = category.ID
+	}
+
+	var projects []entities.ProjectRecord
+	if err := tx.Where("category_id IN ?", sourceIDs).
+		Order("category_id ASC, position ASC, id ASC").
+		Find(&projects).Error; err != nil {
+		return fmt.Errorf("failed to load projects: %w", err)
+	}
+	if len(projects) == 0 {
+		return nil
+	}
+
+	projectCopies := make([]entities.ProjectRecord, len(projects))
+	positions := make(map[uint]uint, len(categories))
+	for i, project := range projects {
+		positions[project.CategoryID]++
+		projectCopies[i] = entities.ProjectRecord{
+			Title:       project.Title,
+			Description: project.Description,
+			MainImage:   project.MainImage,
+			Images:      project.Images,
+			Skills:      project.Skills,
+			Client:      project.Client,
+			Link:        project.Link,
+			Position:    positions[project.CategoryID],
+			CategoryID:  categoryIDs[project.CategoryID],
+			OwnerID:     project.OwnerID,
+			Hidden:      project.Hidden,
+			CreatedBy:   actorID,
+			UpdatedBy:   actorID,
+		}
+	}
+	if err := tx.CreateInBatches(&projectCopies, cloneBatchSize).Error; err != nil {
+		return fmt.Errorf("failed to copy projects: %w", err)
+	}
+	copied.Projects = len(projectCopies)
+
+	projectIDs := make(map[uint]uint, len(projects))
+	sourceProjectIDs := make([]uint, len(projects))
+	for i, project := range projects {
+		projectIDs[project.ID] = projectCopies[i].ID
+		sourceProjectIDs[i] = project.ID
+	}
+
+	var collaborators []entities.ProjectCollaboratorRecord
+	if err := tx.Where("project_id IN ?", sourceProjectIDs).
+		Order("project_id ASC, position ASC, id ASC").
+		Find(&collaborators).Error; err != nil {
+		return fmt.Errorf("failed to load project collaborators: %w", err)
+	}
+	if len(collaborators) == 0 {
+		return nil
+	}
+
+	collaboratorCopies := make([]entities.ProjectCollaboratorRecord, len(collaborators))
+	positions = make(map[uint]uint, len(projects))
+	for i, collaborator := range collaborators {
+		positions[collaborator.ProjectID]++
+		collaboratorCopies[i] = entities.ProjectCollaboratorRecord{
+			ProjectID: projectIDs[collaborator.ProjectID],
+			Name:      collaborator.Name,
+			Role:      collaborator.Role,
+			URL:       collaborator.URL,
+			Position:  positions[collaborator.ProjectID],
+			OwnerID:   collaborator.OwnerID,
+			CreatedBy: actorID,
+			UpdatedBy: actorID,
+		}
+	}
+	if err := tx.CreateInBatches(&collaboratorCopies, cloneBatchSize).Error; err != nil {
+		return fmt.Errorf("failed to copy project collaborators: %w", err)
+	}
+	copied.ProjectCollaborators = len(collaboratorCopies)
+
+	return nil
+}
+
+// cloneSections copies the live sections of a portfolio with their contents
+// Slugs are copied as is: they only have to be unique within the new portfolio.
+func cloneSections(tx *gorm.DB, sourceID, targetID uint, actorID string, readContentPosition bool, copied *dto.PortfolioChildCountsDTO) error {
+	var sections []entities.SectionRecord
+	if err := tx.Where("portfolio_id = ?", sourceID).Order("position ASC, id ASC").Find(&sections).Error; err != nil {
+		return fmt.Errorf("failed to load sections: %w", err)
+	}
+	if len(sections) == 0 {
+		return nil
+	}
+
+	copies := make([]entities.SectionRecord, len(sections))
+	for i, section := range sections {
+		copies[i] = entities.SectionRecord{
+			Title:       section.Title,
+			Slug:        section.Slug,
+			Description: section.Description,
+			Type:        section.Type,
+			Position:    uint(i + 1),
+			OwnerID:     section.OwnerID,
+			PortfolioID: targetID,
+			CreatedBy:   actorID,
+			UpdatedBy:   actorID,
+		}
+	}
+	if err := tx.CreateInBatches(&copies, cloneBatchSize).Error; err != nil {
+		return fmt.Errorf("failed to copy sections: %w", err)
+	}
+	copied.Sections = len(copies)
+
+	sectionIDs := make(map[uint]uint, len(sections))
+	sourceIDs := make([]uint, len(sections))
+	for i, section := range sections {
+		sectionIDs[section.ID] = copies[i].ID
+		sourceIDs[i] = section.ID
+	}
+
+	var contents []entities.SectionContentRecord
+	if err := tx.Where("section_id IN ?", sourceIDs).
+		Order("section_id ASC, " + sectionContentOrderColumn(readContentPosition) + " ASC, id ASC").
+		Find(&contents).Error; err != nil {
+		return fmt.Errorf("failed to load section contents: %w", err)
+	}
+	if len(contents) == 0 {
+		return nil
+	}
+
+	// Order is set rather than Position: BeforeSave mirrors it into position
+	contentCopies := make([]entities.SectionContentRecord, len(contents))
+	positions := make(map[uint]uint, len(sections))
+	for i, content := range contents {
+		positions[content.SectionID]++
+		contentCopies[i] = entities.SectionContentRecord{
+			SectionID: sectionIDs[content.SectionID],
+			Type:      content.Type,
+			Content:   content.Content,
+			Order:     positions[content.SectionID],
+			ImageID:   content.ImageID,
+			OwnerID:   content.OwnerID,
+			CreatedBy: actorID,
+			UpdatedBy: actorID,
+		}
+	}
+	if err := tx.CreateInBatches(&contentCopies, cloneBatchSize).Error; err != nil {
+		return fmt.Errorf("failed to copy section contents: %w", err)
+	}
+	copied.SectionContents = len(contentCopies)
+
+	return nil
+}
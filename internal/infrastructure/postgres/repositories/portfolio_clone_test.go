@@ -0,0 +1,86 @@
+package repositories_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/entities"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/pgtest"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/repositories"
+)
+
+func TestPortfolioRepository_Clone(t *testing.T) {
+	db := pgtest.Open(t)
+	ctx := context.Background()
+	repo := repositories.NewPortfolioRepository(db, false)
+	tr := seedTree(t, db, "alice", "source")
+
+	// A gap in the positions, and a trashed category that must stay behind
+	second := entities.CategoryRecord{Title: "second category", Position: 7, OwnerID: "alice", PortfolioID: tr.Portfolio.ID}
+	create(t, db, &second)
+	trashed := entities.CategoryRecord{Title: "trashed category", Position: 3, OwnerID: "alice", PortfolioID: tr.Portfolio.ID}
+	create(t, db, &trashed)
+	softDelete(t, db, &trashed)
+
+	clone, err := repo.Clone(ctx, tr.Portfolio.ID)
+	if err != nil {
+		t.Fatalf("Clone: %v", err)
+	}
+	again, err := repo.Clone(ctx, tr.Portfolio.ID)
+	if err != nil {
+		t.Fatalf("second Clone: %v", err)
+	}
+
+	if got := []string{clone.Portfolio.Title, again.Portfolio.Title}; !reflect.DeepEqual(got, []string{"source (2)", "source (3)"}) {
+		t.Errorf("clone titles = %v, want source (2), source (3)", got)
+	}
+	if clone.Portfolio.Slug == "" || clone.Portfolio.Slug == tr.Portfolio.Slug || clone.Portfolio.Slug == again.Portfolio.Slug {
+		t.Errorf("clone slugs = %q, %q, want fresh slugs distinct from %q", clone.Portfolio.Slug, again.Portfolio.Slug, tr.Portfolio.Slug)
+	}
+	wantCounts := dto.PortfolioChildCountsDTO{Links: 1, Categories: 2, Projects: 1, Sections: 1, SectionContents: 1}
+	if clone.Copied != wantCounts {
+		t.Errorf("copied = %+v, want %+v", clone.Copied, wantCounts)
+	}
+
+	// Categories keep the source order, renumbered from 1
+	var categories []entities.CategoryRecord
+	if err := db.Where("portfolio_id = ?", clone.Portfolio.ID).Order("position").Find(&categories).Error; err != nil {
+		t.Fatalf("load cloned categories: %v", err)
+	}
+	var titles []string
+	var positions []uint
+	for _, category := range categories {
+		titles = append(titles, category.Title)
+		positions = append(positions, category.Position)
+	}
+	if !reflect.DeepEqual(titles, []string{"source category", "second category"}) || !reflect.DeepEqual(positions, []uint{1, 2}) {
+		t.Errorf("cloned categories = %v at %v, want source category, second category at 1, 2", titles, positions)
+	}
+
+	// Children point at the copies, never at the source
+	var project entities.ProjectRecord
+	if err := db.Where("title = ? AND id <> ?", tr.Project.Title, tr.Project.ID).
+		Where("category_id IN (?)", db.Model(&entities.CategoryRecord{}).Select("id").Where("portfolio_id = ?", clone.Portfolio.ID)).
+		First(&project).Error; err != nil {
+		t.Fatalf("find cloned project: %v", err)
+	}
+	if project.CategoryID != categories[0].ID {
+		t.Errorf("cloned project is in category %d, want %d", project.CategoryID, categories[0].ID)
+	}
+
+	var content entities.SectionContentRecord
+	if err := db.Joins("JOIN sections ON sections.id = section_contents.section_id").
+		Where("sections.portfolio_id = ?", clone.Portfolio.ID).
+		First(&content).Error; err != nil {
+		t.Fatalf("find cloned section content: %v", err)
+	}
+	if content.ID == tr.SectionContent.ID || content.SectionID == tr.Section.ID || *content.Content != *tr.SectionContent.Content {
+		t.Errorf("cloned content = %+v, want a copy of %+v under the cloned section", content, tr.SectionContent)
+	}
+
+	if _, err := repo.Clone(ctx, 999); err == nil {
+		t.Error("Clone(999) succeeded, want not found")
+	}
+}
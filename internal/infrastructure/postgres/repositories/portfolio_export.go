@@ -0,0 +1,188 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/entities"
+	"gorm.io/gorm"
+)
+
+// Export loads a live portfolio with its links, categories, projects and collaborators,
+// sections and section contents. Each level is one query (children of all parents at once),
+// run in a read-only repeatable-read transaction so the document is a consistent snapshot
+// (inside a unit of work it reads through the surrounding transaction instead).
+func (r *portfolioRepository) Export(ctx context.Context, id uint) (*dto.PortfolioExportDTO, error) {
+	export := &dto.PortfolioExportDTO{}
+
+	err := inTransaction(ctx, r.db, func(tx *gorm.DB) error {
+		var record entities.PortfolioRecord
+		if err := tx.First(&record, id).Error; err != nil {
+			return err
+		}
+		export.Title = record.Title
+		export.Description = record.Description
+		export.Slug = record.Slug
+		export.EndorsementsEnabled = record.EndorsementsEnabled
+		export.CustomCSS = record.CustomCSS
+
+		var err error
+		if export.Links, err = exportLinks(tx, id); err != nil {
+			return err
+		}
+		if export.Categories, err = exportCategories(tx, id); err != nil {
+			return err
+		}
+		export.Sections, err = exportSections(tx, id, r.readContentPosition)
+		return err
+	}, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err == gorm.ErrRecordNotFound {
+		return nil, fmt.Errorf("portfolio with ID %d not found", id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to export portfolio: %w", err)
+	}
+
+	return export, nil
+}
+
+// exportLinks loads the live links of a portfolio
+func exportLinks(tx *gorm.DB, portfolioID uint) ([]dto.PortfolioLinkExportDTO, error) {
+	var records []entities.PortfolioLinkRecord
+	if err := tx.Where("portfolio_id = ?", portfolioID).Order("position ASC, id ASC").Find(&records).Error; err != nil {
+		return nil, fmt.Errorf("failed to load links: %w", err)
+	}
+
+	links := make([]dto.PortfolioLinkExportDTO, len(records))
+	for i, record := range records {
+		links[i] = dto.PortfolioLinkExportDTO{
+			Kind:     record.Kind,
+			Label:    record.Label,
+			URL:      record.URL,
+			Position: record.Position,
+		}
+	}
+	return links, nil
+}
+
+// exportCategories loads the live categories of a portfolio with their projects and collaborators
+func exportCategories(tx *gorm.DB, portfolioID uint) ([]dto.CategoryExportDTO, error) {
+	var records []entities.CategoryRecord
+	if err := tx.Where("portfolio_id = ?", portfolioID).Order("position ASC, id ASC").Find(&records).Error; err != nil {
+		return nil, fmt.Errorf("failed to load categories: %w", err)
+	}
+
+	categories := make([]dto.CategoryExportDTO, len(records))
+	categoryIndex := make(map[uint]int, len(records))
+	categoryIDs := make([]uint, len(records))
+	for i, record := range records {
+		categories[i] = dto.CategoryExportDTO{
+			Title:       record.Title,
+			Description: record.Description,
+			Position:    record.Position,
+			Projects:    []dto.ProjectExportDTO{},
+		}
+		categoryIndex[record.ID] = i
+		categoryIDs[i] = record.ID
+	}
+	if len(records) == 0 {
+		return categories, nil
+	}
+
+	var projects []entities.ProjectRecord
+	if err := tx.Where("category_id IN ?", categoryIDs).
+		Order("category_id ASC, position ASC, id ASC").
+		Find(&projects).Error; err != nil {
+		return nil, fmt.Errorf("failed to load projects: %w", err)
+	}
+	if len(projects) == 0 {
+		return categories, nil
+	}
+
+	// Collaborators are attached by project ID once every project has its place
+	type projectRef struct{ category, project int }
+	projectRefs := make(map[uint]projectRef, len(projects))
+	projectIDs := make([]uint, len(projects))
+	for i, project := range projects {
+		index := categoryIndex[project.CategoryID]
+		categories[index].Projects = append(categories[index].Projects, dto.ProjectExportDTO{
+			Title:         project.Title,
+			Description:   project.Description,
+			MainImage:     project.MainImage,
+			Images:        project.Images,
+			Skills:        project.Skills,
+			Client:        project.Client,
+			Link:          project.Link,
+			Position:      project.Position,
+			Hidden:        project.Hidden,
+			Collaborators: []dto.ProjectCollaboratorExportDTO{},
+		})
+		projectRefs[project.ID] = projectRef{index, len(categories[index].Projects) - 1}
+		projectIDs[i] = project.ID
+	}
+
+	var collaborators []entities.ProjectCollaboratorRecord
+	if err := tx.Where("project_id IN ?", projectIDs).
+		Order("project_id ASC, position ASC, id ASC").
+		Find(&collaborators).Error; err != nil {
+		return nil, fmt.Errorf("failed to load project collaborators: %w", err)
+	}
+	for _, collaborator := range collaborators {
+		ref := projectRefs[collaborator.ProjectID]
+		project := &categories[ref.category].Projects[ref.project]
+		project.Collaborators = append(project.Collaborators, dto.ProjectCollaboratorExportDTO{
+			Name:     collaborator.Name,
+			Role:     collaborator.Role,
+			URL:      collaborator.URL,
+			Position: collaborator.Position,
+		})
+	}
+
+	return categories, nil
+}
+
+// exportSections loads the live sections of a portfolio with their contents
+func exportSections(tx *gorm.DB, portfolioID uint, readContentPosition bool) ([]dto.SectionExportDTO, error) {
+	var records []entities.SectionRecord
+	if err := tx.Where("portfolio_id = ?", portfolioID).Order("position ASC, id ASC").Find(&records).Error; err != nil {
+		return nil, fmt.Errorf("failed to load sections: %w", err)
+	}
+
+	sections := make([]dto.SectionExportDTO, len(records))
+	sectionIndex := make(map[uint]int, len(records))
+	sectionIDs := make([]uint, len(records))
+	for i, record := range records {
+		sections[i] = dto.SectionExportDTO{
+			Title:       record.Title,
+			Slug:        record.Slug,
+			Description: record.Description,
+			Type:        record.Type,
+			Position:    record.Position,
+			Contents:    []dto.SectionContentExportDTO{},
+		}
+		sectionIndex[record.ID] = i
+		sectionIDs[i] = record.ID
+	}
+	if len(records) == 0 {
+		return sections, nil
+	}
+
+	var contents []entities.SectionContentRecord
+	if err := tx.Where("section_id IN ?", sectionIDs).
+		Order("section_id ASC, " + sectionContentOrderColumn(readContentPosition) + " ASC, id ASC").
+		Find(&contents).Error; err != nil {
+		return nil, fmt.Errorf("failed to load section contents: %w", err)
+	}
+	for _, content := range contents {
+		index := sectionIndex[content.SectionID]
+		sections[index].Contents = append(sections[index].Contents, dto.SectionContentExportDTO{
+			Type:     content.Type,
+			Content:  content.Content,
+			Position: sectionContentOrder(&content, readContentPosition),
+		})
+	}
+
+	return sections, nil
+}
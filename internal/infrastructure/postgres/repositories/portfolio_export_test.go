@@ -0,0 +1,66 @@
+package repositories_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/entities"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/pgtest"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/repositories"
+)
+
+func TestPortfolioRepository_Export(t *testing.T) {
+	db := pgtest.Open(t)
+	ctx := context.Background()
+	repo := repositories.NewPortfolioRepository(db, false)
+	tr := seedTree(t, db, "alice", "export")
+
+	// Positions are exported as stored, in order; trashed rows stay out
+	first := entities.ProjectRecord{Title: "first", Description: "d", Position: 0, OwnerID: "alice", CategoryID: tr.Category.ID}
+	create(t, db, &first)
+	trashed := entities.ProjectRecord{Title: "trashed", Description: "d", Position: 5, OwnerID: "alice", CategoryID: tr.Category.ID}
+	create(t, db, &trashed)
+	softDelete(t, db, &trashed)
+	url := "https://ada.example.com"
+	create(t, db, &entities.ProjectCollaboratorRecord{ProjectID: tr.Project.ID, Name: "Ada", Role: "Design", URL: &url, Position: 1, OwnerID: "alice"})
+	trashedSection := entities.SectionRecord{Title: "gone", Slug: "gone", Type: "text", Position: 2, OwnerID: "alice", PortfolioID: tr.Portfolio.ID}
+	create(t, db, &trashedSection)
+	softDelete(t, db, &trashedSection)
+
+	export, err := repo.Export(ctx, tr.Portfolio.ID)
+	if err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+
+	if export.Title != tr.Portfolio.Title || export.Slug != tr.Portfolio.Slug {
+		t.Errorf("portfolio = %q (%q), want %q (%q)", export.Title, export.Slug, tr.Portfolio.Title, tr.Portfolio.Slug)
+	}
+	if len(export.Links) != 1 || export.Links[0].URL != tr.Link.URL {
+		t.Errorf("links = %+v, want the seeded link", export.Links)
+	}
+	if len(export.Categories) != 1 {
+		t.Fatalf("categories = %+v, want the seeded one", export.Categories)
+	}
+	var titles []string
+	var positions []uint
+	for _, project := range export.Categories[0].Projects {
+		titles = append(titles, project.Title)
+		positions = append(positions, project.Position)
+	}
+	if !reflect.DeepEqual(titles, []string{"first", tr.Project.Title}) || !reflect.DeepEqual(positions, []uint{0, 1}) {
+		t.Errorf("projects = %v at %v, want first, %s at 0, 1", titles, positions, tr.Project.Title)
+	}
+	if collaborators := export.Categories[0].Projects[1].Collaborators; len(collaborators) != 1 || collaborators[0].Name != "Ada" || *collaborators[0].URL != url {
+		t.Errorf("collaborators = %+v, want Ada", collaborators)
+	}
+	if len(export.Sections) != 1 || export.Sections[0].Slug != tr.Section.Slug || len(export.Sections[0].Contents) != 1 ||
+		*export.Sections[0].Contents[0].Content != *tr.SectionContent.Content {
+		t.Errorf("sections = %+v, want the seeded section with its content", export.Sections)
+	}
+
+	softDelete(t, db, &tr.Portfolio)
+	if _, err := repo.Export(ctx, tr.Portfolio.ID); err == nil {
+		t.Error("Export of a trashed portfolio succeeded")
+	}
+}
@@ -0,0 +1,251 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/titles"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/domain/portfolio"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/entities"
+	"gorm.io/gorm"
+)
+
+// Import creates a portfolio from an export document in one transaction: its links, categories
+// with their projects and collaborators, and sections with their contents, so any failure leaves
+// nothing behind. Entries are inserted in document order with positions renumbered from 1.
+// The portfolio takes the first free " (2)", " (3)"... title among the owner's portfolios and
+// a free slug based on the exported one; section slugs are kept, duplicates are regenerated.
+func (r *portfolioRepository) Import(ctx context.Context, ownerID string, export *dto.PortfolioExportDTO, sanitizedCSS string) (*dto.PortfolioImportDTO, error) {
+	imported := &dto.PortfolioImportDTO{}
+
+	err := inTransaction(ctx, r.db, func(tx *gorm.DB) error {
+		actorID := actorOr(ctx, ownerID)
+
+		var existing []string
+		if err := tx.Model(&entities.PortfolioRecord{}).
+			Where("owner_id = ?", ownerID).
+			Pluck("title", &existing).Error; err != nil {
+			return err
+		}
+		taken := make(map[string]bool, len(existing))
+		for _, title := range existing {
+			taken[title] = true
+		}
+
+		record := &entities.PortfolioRecord{
+			Title:               titles.Unique(export.Title, taken),
+			Description:         export.Description,
+			OwnerID:             ownerID,
+			EndorsementsEnabled: export.EndorsementsEnabled,
+			CustomCSS:           export.CustomCSS,
+			CustomCSSSanitized:  sanitizedCSS,
+			CreatedBy:           actorID,
+			UpdatedBy:           actorID,
+		}
+		base := export.Slug
+		if base == "" {
+			base = record.Title
+		}
+		candidate := numberedCandidate(portfolio.PortfolioSlug(base), takenPortfolioSlugs(tx, 0))
+		if _, err := insertWithUniqueRetry(tx, 0, candidate, func(tx *gorm.DB, slug string) error {
+			record.Slug = slug
+			return tx.Create(record).Error
+		}); err != nil {
+			return err
+		}
+
+		var err error
+		if imported.Created.Links, err = importLinks(tx, export.Links, record.ID, ownerID, actorID); err != nil {
+			return err
+		}
+		if err := importCategories(tx, export.Categories, record.ID, ownerID, actorID, &imported.Created); err != nil {
+			return err
+		}
+		if err := importSections(tx, export.Sections, record.ID, ownerID, actorID, &imported.Created); err != nil {
+			return err
+		}
+
+		imported.Portfolio = *r.recordToDTO(record)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to import portfolio: %w", err)
+	}
+
+	return imported, nil
+}
+
+// importLinks creates the links of an imported portfolio, returning how many were created
+func importLinks(tx *gorm.DB, links []dto.PortfolioLinkExportDTO, portfolioID uint, ownerID, actorID string) (int, error) {
+	if len(links) == 0 {
+		return 0, nil
+	}
+
+	records := make([]entities.PortfolioLinkRecord, len(links))
+	for i, link := range links {
+		records[i] = entities.PortfolioLinkRecord{
+			PortfolioID: portfolioID,
+			Kind:        link.Kind,
+			Label:       link.Label,
+			URL:         link.URL,
+			Position:    uint(i + 1),
+			OwnerID:     ownerID,
+			CreatedBy:   actorID,
+			UpdatedBy:   actorID,
+		}
+	}
+	if err := tx.CreateInBatches(&records, cloneBatchSize).Error; err != nil {
+		return 0, fmt.Errorf("failed to create links: %w", err)
+	}
+
+	return len(records), nil
+}
+
+// importCategories creates the categories of an imported portfolio with their projects and collaborators
+func importCategories(tx *gorm.DB, categories []dto.CategoryExportDTO, portfolioID uint, ownerID, actorID string, created *dto.PortfolioChildCountsDTO) error {
+	if len(categories) == 0 {
+		return nil
+	}
+
+	records := make([]entities.CategoryRecord, len(categories))
+	for i, category := range categories {
+		records[i] = entities.CategoryRecord{
+			Title:       category.Title,
+			Description: category.Description,
+			Position:    uint(i + 1),
+			OwnerID:     ownerID,
+			PortfolioID: portfolioID,
+			CreatedBy:   actorID,
+			UpdatedBy:   actorID,
+		}
+	}
+	if err := tx.CreateInBatches(&records, cloneBatchSize).Error; err != nil {
+		return fmt.Errorf("failed to create categories: %w", err)
+	}
+	created.Categories = len(records)
+
+	// Inserted IDs come back in slice order
+	var projects []entities.ProjectRecord
+	var collaborators [][]dto.ProjectCollaboratorExportDTO
+	for i, category := range categories {
+		for j, project := range category.Projects {
+			projects = append(projects, entities.ProjectRecord{
+				Title:       project.Title,
+				Description: project.Description,
+				MainImage:   project.MainImage,
+				Images:      project.Images,
+				Skills:      project.Skills,
+				Client:      project.Client,
+				Link:        project.Link,
+				Position:    uint(j + 1),
+				CategoryID:  records[i].ID,
+				OwnerID:     ownerID,
+				Hidden:      project.Hidden,
+				CreatedBy:   actorID,
+				UpdatedBy:   actorID,
+			})
+			collaborators = append(collaborators, project.Collaborators)
+		}
+	}
+	if len(projects) == 0 {
+		return nil
+	}
+	if err := tx.CreateInBatches(&projects, cloneBatchSize).Error; err != nil {
+		return fmt.Errorf("failed to create projects: %w", err)
+	}
+	created.Projects = len(projects)
+
+	var collaboratorRecords []entities.ProjectCollaboratorRecord
+	for i, projectCollaborators := range collaborators {
+		for j, collaborator := range projectCollaborators {
+			collaboratorRecords = append(collaboratorRecords, entities.ProjectCollaboratorRecord{
+				ProjectID: projects[i].ID,
+				Name:      collaborator.Name,
+				Role:      collaborator.Role,
+				URL:       collaborator.URL,
+				Position:  uint(j + 1),
+				OwnerID:   ownerID,
+				CreatedBy: actorID,
+				UpdatedBy: actorID,
+			})
+		}
+	}
+	if len(collaboratorRecords) == 0 {
+		return nil
+	}
+	if err := tx.CreateInBatches(&collaboratorRecords, cloneBatchSize).Error; err != nil {
+		return fmt.Errorf("failed to create project collaborators: %w", err)
+	}
+	created.ProjectCollaborators = len(collaboratorRecords)
+
+	return nil
+}
+
+// importSections creates the sections of an imported portfolio with their contents
+// An empty or repeated slug is left blank and filled from the title once the sections exist.
+func importSections(tx *gorm.DB, sections []dto.SectionExportDTO, portfolioID uint, ownerID, actorID string, created *dto.PortfolioChildCountsDTO) error {
+	if len(sections) == 0 {
+		return nil
+	}
+
+	records := make([]entities.SectionRecord, len(sections))
+	slugs := make(map[string]bool, len(sections))
+	missingSlugs := false
+	for i, section := range sections {
+		slug := section.Slug
+		if slugs[slug] {
+			slug = ""
+		}
+		if slug == "" {
+			missingSlugs = true
+		} else {
+			slugs[slug] = true
+		}
+		records[i] = entities.SectionRecord{
+			Title:       section.Title,
+			Slug:        slug,
+			Description: section.Description,
+			Type:        section.Type,
+			Position:    uint(i + 1),
+			OwnerID:     ownerID,
+			PortfolioID: portfolioID,
+			CreatedBy:   actorID,
+			UpdatedBy:   actorID,
+		}
+	}
+	if err := tx.CreateInBatches(&records, cloneBatchSize).Error; err != nil {
+		return fmt.Errorf("failed to create sections: %w", err)
+	}
+	created.Sections = len(records)
+	if missingSlugs {
+		if _, err := fillSectionSlugs(tx, portfolioID); err != nil {
+			return err
+		}
+	}
+
+	// Order is set rather than Position: BeforeSave mirrors it into position
+	var contents []entities.SectionContentRecord
+	for i, section := range sections {
+		for j, content := range section.Contents {
+			contents = append(contents, entities.SectionContentRecord{
+				SectionID: records[i].ID,
+				Type:      content.Type,
+				Content:   content.Content,
+				Order:     uint(j + 1),
+				OwnerID:   ownerID,
+				CreatedBy: actorID,
+				UpdatedBy: actorID,
+			})
+		}
+	}
+	if len(contents) == 0 {
+		return nil
+	}
+	if err := tx.CreateInBatches(&contents, cloneBatchSize).Error; err != nil {
+		return fmt.Errorf("failed to create section contents: %w", err)
+	}
+	created.SectionContents = len(contents)
+
+	return nil
+}
@@ -0,0 +1,178 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/entities"
+	"gorm.io/gorm"
+)
+
+// portfolioLinkRepository is the GORM implementation of PortfolioLinkRepository
+type portfolioLinkRepository struct {
+	db *gorm.DB
+}
+
+// NewPortfolioLinkRepository creates a new portfolio link repository instance
+func NewPortfolioLinkRepository(db *gorm.DB) contracts.PortfolioLinkRepository {
+	return &portfolioLinkRepository{db: db}
+}
+
+// Create creates a new portfolio link
+// The portfolio row is locked (by nextPosition) before counting, so concurrent
+// creates cannot exceed maxLinks.
+func (r *portfolioLinkRepository) Create(ctx context.Context, input dto.CreatePortfolioLinkInput, maxLinks int) (*dto.PortfolioLinkDTO, int64, error) {
+	record := &entities.PortfolioLinkRecord{
+		PortfolioID: input.PortfolioID,
+		Kind:        input.Kind,
+		Label:       input.Label,
+		URL:         input.URL,
+		Position:    input.Position,
+		OwnerID:     input.OwnerID,
+		CreatedBy:   actorOr(ctx, input.OwnerID),
+		UpdatedBy:   actorOr(ctx, input.OwnerID),
+	}
+
+	var count int64
+	err := inTransaction(ctx, r.db, func(tx *gorm.DB) error {
+		position, err := nextPosition(tx, "portfolio_links", "portfolios", "portfolio_id", record.PortfolioID)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Model(&entities.PortfolioLinkRecord{}).
+			Where("portfolio_id = ?", record.PortfolioID).
+			Count(&count).Error; err != nil {
+			return err
+		}
+		if maxLinks > 0 && count >= int64(maxLinks) {
+			return contracts.ErrPortfolioLinkLimitReached
+		}
+
+		if record.Position == 0 {
+			record.Position = position
+		}
+
+		return tx.Create(record).Error
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to create portfolio link: %w", err)
+	}
+
+	return r.recordToDTO(record), count + 1, nil
+}
+
+// GetByID retrieves a portfolio link by its ID
+func (r *portfolioLinkRepository) GetByID(ctx context.Context, id uint) (*dto.PortfolioLinkDTO, error) {
+	var record entities.PortfolioLinkRecord
+
+	if err := r.db.WithContext(ctx).First(&record, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("portfolio link with ID %d not found", id)
+		}
+		return nil, fmt.Errorf("failed to get portfolio link: %w", err)
+	}
+
+	return r.recordToDTO(&record), nil
+}
+
+// GetByPortfolioID retrieves all links of a portfolio (ordered by position)
+func (r *portfolioLinkRepository) GetByPortfolioID(ctx context.Context, portfolioID uint) ([]dto.PortfolioLinkDTO, error) {
+	var records []entities.PortfolioLinkRecord
+
+	if err := r.db.WithContext(ctx).
+		Where("portfolio_id = ?", portfolioID).
+		Order("position ASC, id ASC").
+		Find(&records).Error; err != nil {
+		return nil, fmt.Errorf("failed to get portfolio links: %w", err)
+	}
+
+	dtos := make([]dto.PortfolioLinkDTO, len(records))
+	for i, record := range records {
+		dtos[i] = *r.recordToDTO(&record)
+	}
+
+	return dtos, nil
+}
+
+// Update updates an existing portfolio link
+func (r *portfolioLinkRepository) Update(ctx context.Context, input dto.UpdatePortfolioLinkInput) error {
+	updates := map[string]interface{}{
+		"kind":  input.Kind,
+		"label": input.Label,
+		"url":   input.URL,
+	}
+	if input.Position != 0 {
+		updates["position"] = input.Position
+	}
+
+	result := r.db.WithContext(ctx).
+		Model(&entities.PortfolioLinkRecord{}).
+		Where("id = ?", input.ID).
+		Updates(withUpdatedBy(ctx, updates))
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to update portfolio link: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("portfolio link with ID %d not found", input.ID)
+	}
+
+	return nil
+}
+
+// BulkUpdatePositions updates positions for multiple links of a portfolio in a transaction
+func (r *portfolioLinkRepository) BulkUpdatePositions(ctx context.Context, portfolioID uint, items []dto.BulkUpdatePositionItem) error {
+	return inTransaction(ctx, r.db, func(tx *gorm.DB) error {
+		for _, item := range items {
+			result := tx.Model(&entities.PortfolioLinkRecord{}).
+				Where("id = ? AND portfolio_id = ?", item.ID, portfolioID).
+				Updates(withUpdatedBy(ctx, map[string]interface{}{"position": item.Position}))
+			if result.Error != nil {
+				return fmt.Errorf("failed to update position for portfolio link %d: %w", item.ID, result.Error)
+			}
+			if result.RowsAffected == 0 {
+				return fmt.Errorf("portfolio link with ID %d not found in portfolio %d", item.ID, portfolioID)
+			}
+		}
+		return nil
+	})
+}
+
+// Delete deletes a portfolio link by its ID (soft delete)
+func (r *portfolioLinkRepository) Delete(ctx context.Context, id uint) error {
+	batchID, err := newDeleteBatchID()
+	if err != nil {
+		return err
+	}
+
+	deleted, err := softDeleteRows(r.db.WithContext(ctx), "portfolio_links", batchID, time.Now(), "id = ?", id)
+	if err != nil {
+		return fmt.Errorf("failed to delete portfolio link: %w", err)
+	}
+
+	if deleted == 0 {
+		return fmt.Errorf("portfolio link with ID %d not found", id)
+	}
+
+	return nil
+}
+
+// recordToDTO converts a PortfolioLinkRecord to PortfolioLinkDTO
+func (r *portfolioLinkRepository) recordToDTO(record *entities.PortfolioLinkRecord) *dto.PortfolioLinkDTO {
+	return &dto.PortfolioLinkDTO{
+		ID:          record.ID,
+		PortfolioID: record.PortfolioID,
+		Kind:        record.Kind,
+		Label:       record.Label,
+		URL:         record.URL,
+		Position:    record.Position,
+		OwnerID:     record.OwnerID,
+		CreatedAt:   record.CreatedAt,
+		UpdatedAt:   record.UpdatedAt,
+	}
+}
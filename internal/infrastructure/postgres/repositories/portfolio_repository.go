@@ -2,10 +2,13 @@ package repositories
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/domain/portfolio"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/entities"
 	"gorm.io/gorm"
 )
@@ -14,12 +17,15 @@ import (
 // It implements the contract defined in the application layer
 type portfolioRepository struct {
 	db *gorm.DB
+	// readContentPosition orders section contents by "position" instead of the legacy "order"
+	readContentPosition bool
 }
 
 // NewPortfolioRepository creates a new portfolio repository instance
 // Returns the interface type (contracts.PortfolioRepository), not the concrete type
-func NewPortfolioRepository(db *gorm.DB) contracts.PortfolioRepository {
-	return &portfolioRepository{db: db}
+// readContentPosition follows SECTION_CONTENT_READ_POSITION, like the section content repository
+func NewPortfolioRepository(db *gorm.DB, readContentPosition bool) contracts.PortfolioRepository {
+	return &portfolioRepository{db: db, readContentPosition: readContentPosition}
 }
 
 // Create creates a new portfolio in the database
@@ -29,10 +35,17 @@ func (r *portfolioRepository) Create(ctx context.Context, input dto.CreatePortfo
 		Title:       input.Title,
 		Description: input.Description,
 		OwnerID:     input.OwnerID,
+		CreatedBy:   actorOr(ctx, input.OwnerID),
+		UpdatedBy:   actorOr(ctx, input.OwnerID),
 	}
 
-	// Persist to database
-	if err := r.db.WithContext(ctx).Create(record).Error; err != nil {
+	// Persist to database, with the first free slug of the title
+	candidate := numberedCandidate(portfolio.PortfolioSlug(record.Title), takenPortfolioSlugs(conn(ctx, r.db), 0))
+	_, err := insertWithUniqueRetry(conn(ctx, r.db), 0, candidate, func(tx *gorm.DB, slug string) error {
+		record.Slug = slug
+		return tx.Create(record).Error
+	})
+	if err != nil {
 		return nil, fmt.Errorf("failed to create portfolio: %w", err)
 	}
 
@@ -40,11 +53,23 @@ func (r *portfolioRepository) Create(ctx context.Context, input dto.CreatePortfo
 	return r.recordToDTO(record), nil
 }
 
+// IsPublished reports whether a live, published portfolio exists
+func (r *portfolioRepository) IsPublished(ctx context.Context, id uint) (bool, error) {
+	var published bool
+	if err := conn(ctx, r.db).
+		Raw("SELECT EXISTS (SELECT 1 FROM portfolios WHERE id = ? AND deleted_at IS NULL AND is_published)", id).
+		Scan(&published).Error; err != nil {
+		return false, fmt.Errorf("failed to check portfolio: %w", err)
+	}
+
+	return published, nil
+}
+
 // GetByID retrieves a portfolio by its ID
 func (r *portfolioRepository) GetByID(ctx context.Context, id uint) (*dto.PortfolioDTO, error) {
 	var record entities.PortfolioRecord
 
-	if err := r.db.WithContext(ctx).First(&record, id).Error; err != nil {
+	if err := conn(ctx, r.db).First(&record, id).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, fmt.Errorf("portfolio with ID %d not found", id)
 		}
@@ -54,13 +79,27 @@ func (r *portfolioRepository) GetByID(ctx context.Context, id uint) (*dto.Portfo
 	return r.recordToDTO(&record), nil
 }
 
+// GetBySlug retrieves a live portfolio by its slug
+func (r *portfolioRepository) GetBySlug(ctx context.Context, slug string) (*dto.PortfolioDTO, error) {
+	var record entities.PortfolioRecord
+
+	if err := conn(ctx, r.db).Where("slug = ?", slug).First(&record).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("portfolio with slug %q not found", slug)
+		}
+		return nil, fmt.Errorf("failed to get portfolio: %w", err)
+	}
+
+	return r.recordToDTO(&record), nil
+}
+
 // GetByOwnerID retrieves all portfolios owned by a user with pagination
 func (r *portfolioRepository) GetByOwnerID(ctx context.Context, ownerID string, pagination dto.PaginationDTO) ([]dto.PortfolioDTO, int64, error) {
 	var records []entities.PortfolioRecord
 	var total int64
 
 	// Count total portfolios for this owner
-	if err := r.db.WithContext(ctx).
+	if err := conn(ctx, r.db).
 		Model(&entities.PortfolioRecord{}).
 		Where("owner_id = ?", ownerID).
 		Count(&total).Error; err != nil {
@@ -71,9 +110,9 @@ func (r *portfolioRepository) GetByOwnerID(ctx context.Context, ownerID string,
 	offset := (pagination.Page - 1) * pagination.Limit
 
 	// Get paginated results
-	if err := r.db.WithContext(ctx).
+	if err := conn(ctx, r.db).
 		Where("owner_id = ?", ownerID).
-		Order("created_at DESC").
+		Order("created_at DESC, id DESC").
 		Limit(pagination.Limit).
 		Offset(offset).
 		Find(&records).Error; err != nil {
@@ -89,6 +128,61 @@ func (r *portfolioRepository) GetByOwnerID(ctx context.Context, ownerID string,
 	return dtos, total, nil
 }
 
+// SearchPublic lists the published portfolios of every owner, optionally filtered on title and description
+func (r *portfolioRepository) SearchPublic(ctx context.Context, input dto.SearchPublicPortfoliosInput) ([]dto.PortfolioDTO, int64, error) {
+	filtered := func() *gorm.DB {
+		query := conn(ctx, r.db).Model(&entities.PortfolioRecord{}).Where("is_published")
+		if input.Query != "" {
+			query = query.Where("title ILIKE @p OR description ILIKE @p",
+				sql.Named("p", "%"+likeEscaper.Replace(input.Query)+"%"))
+		}
+		return query
+	}
+
+	var total int64
+	if err := filtered().Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count portfolios: %w", err)
+	}
+
+	var records []entities.PortfolioRecord
+	offset := (input.Pagination.Page - 1) * input.Pagination.Limit
+	if err := filtered().
+		Order("created_at DESC, id DESC").
+		Limit(input.Pagination.Limit).
+		Offset(offset).
+		Find(&records).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to search portfolios: %w", err)
+	}
+
+	dtos := make([]dto.PortfolioDTO, len(records))
+	for i, record := range records {
+		dtos[i] = *r.recordToDTO(&record)
+	}
+
+	return dtos, total, nil
+}
+
+// GetSummariesByOwnerID retrieves every portfolio of a user with its item counts
+func (r *portfolioRepository) GetSummariesByOwnerID(ctx context.Context, ownerID string) ([]dto.PortfolioSummaryDTO, error) {
+	var summaries []dto.PortfolioSummaryDTO
+
+	// Soft-deleted items are not counted; projects belong to the portfolio through their category
+	if err := conn(ctx, r.db).Raw(`
+		SELECT p.id, p.title, p.updated_at,
+			(SELECT COUNT(*) FROM categories c WHERE c.portfolio_id = p.id AND c.deleted_at IS NULL) AS categories,
+			(SELECT COUNT(*) FROM sections s WHERE s.portfolio_id = p.id AND s.deleted_at IS NULL) AS sections,
+			(SELECT COUNT(*) FROM projects pr JOIN categories c ON c.id = pr.category_id
+				WHERE c.portfolio_id = p.id AND pr.deleted_at IS NULL AND c.deleted_at IS NULL) AS projects
+		FROM portfolios p
+		WHERE p.owner_id = ? AND p.deleted_at IS NULL
+		ORDER BY p.created_at DESC, p.id DESC`, ownerID).
+		Scan(&summaries).Error; err != nil {
+		return nil, fmt.Errorf("failed to get portfolio summaries: %w", err)
+	}
+
+	return summaries, nil
+}
+
 // Update updates an existing portfolio
 func (r *portfolioRepository) Update(ctx context.Context, input dto.UpdatePortfolioInput) error {
 	updates := map[string]interface{}{}
@@ -100,33 +194,144 @@ func (r *portfolioRepository) Update(ctx context.Context, input dto.UpdatePortfo
 	if input.Description != "" {
 		updates["description"] = input.Description
 	}
+	if input.EndorsementsEnabled != nil {
+		updates["endorsements_enabled"] = *input.EndorsementsEnabled
+	}
+
+	return r.applyUpdates(ctx, input.ID, updates, input.RegenerateSlug)
+}
+
+// Patch updates the provided fields of a portfolio
+func (r *portfolioRepository) Patch(ctx context.Context, input dto.PatchPortfolioInput) error {
+	updates := map[string]interface{}{}
+	if input.Title != nil {
+		updates["title"] = *input.Title
+	}
+	if input.Description != nil {
+		updates["description"] = *input.Description
+	}
+	if input.EndorsementsEnabled != nil {
+		updates["endorsements_enabled"] = *input.EndorsementsEnabled
+	}
 
-	if len(updates) == 0 {
+	return r.applyUpdates(ctx, input.ID, updates, input.RegenerateSlug)
+}
+
+// applyUpdates writes column updates to a portfolio, regenerating the slug from the
+// (new) title when asked to
+func (r *portfolioRepository) applyUpdates(ctx context.Context, id uint, updates map[string]interface{}, regenerateSlug bool) error {
+	if len(updates) == 0 && !regenerateSlug {
 		return nil // Nothing to update
 	}
+	withUpdatedBy(ctx, updates)
+
+	err := inTransaction(ctx, r.db, func(tx *gorm.DB) error {
+		var record entities.PortfolioRecord
+		if err := tx.Select("id, title, slug").First(&record, id).Error; err != nil {
+			return err
+		}
+
+		// The slug only follows the title on request, so shared links keep working
+		title := record.Title
+		if newTitle, ok := updates["title"].(string); ok {
+			title = newTitle
+		}
+		if !regenerateSlug || portfolio.PortfolioSlug(title) == record.Slug {
+			return tx.Model(&entities.PortfolioRecord{}).Where("id = ?", id).Updates(updates).Error
+		}
+
+		candidate := numberedCandidate(portfolio.PortfolioSlug(title), takenPortfolioSlugs(tx, record.ID))
+		_, err := insertWithUniqueRetry(tx, 0, candidate, func(tx *gorm.DB, slug string) error {
+			updates["slug"] = slug
+			return tx.Model(&entities.PortfolioRecord{}).Where("id = ?", id).Updates(updates).Error
+		})
+		return err
+	})
+	if err == gorm.ErrRecordNotFound {
+		return fmt.Errorf("portfolio with ID %d not found", id)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update portfolio: %w", err)
+	}
 
-	result := r.db.WithContext(ctx).
+	return nil
+}
+
+// takenPortfolioSlugs lists the live portfolio slugs equal to base or numbered variants of it
+// excludeID leaves out the portfolio being updated (pass 0 when creating)
+func takenPortfolioSlugs(tx *gorm.DB, excludeID uint) func(base string) ([]string, error) {
+	return func(base string) ([]string, error) {
+		query := tx.Model(&entities.PortfolioRecord{}).
+			Where("slug = ? OR slug LIKE ?", base, likeEscaper.Replace(base)+"-%")
+		if excludeID > 0 {
+			query = query.Where("id != ?", excludeID)
+		}
+
+		var slugs []string
+		if err := query.Pluck("slug", &slugs).Error; err != nil {
+			return nil, fmt.Errorf("failed to look up portfolio slugs: %w", err)
+		}
+		return slugs, nil
+	}
+}
+
+// BackfillPortfolioSlugs gives a slug to the live portfolios created before slugs existed,
+// oldest first so the first of two same-titled portfolios keeps the plain slug.
+// Returns the number of portfolios updated.
+func BackfillPortfolioSlugs(db *gorm.DB) (int, error) {
+	var records []entities.PortfolioRecord
+	if err := db.Select("id, title").
+		Where("slug = ''").
+		Order("id ASC").
+		Find(&records).Error; err != nil {
+		return 0, fmt.Errorf("failed to find portfolios without slug: %w", err)
+	}
+
+	for i, record := range records {
+		candidate := numberedCandidate(portfolio.PortfolioSlug(record.Title), takenPortfolioSlugs(db, record.ID))
+		_, err := insertWithUniqueRetry(db, 0, candidate, func(tx *gorm.DB, slug string) error {
+			return tx.Model(&entities.PortfolioRecord{}).Where("id = ?", record.ID).UpdateColumn("slug", slug).Error
+		})
+		if err != nil {
+			return i, fmt.Errorf("failed to backfill slug of portfolio %d: %w", record.ID, err)
+		}
+	}
+
+	return len(records), nil
+}
+
+// SetCustomCSS stores the raw and sanitized custom stylesheet of a portfolio
+func (r *portfolioRepository) SetCustomCSS(ctx context.Context, id uint, raw, sanitized string) error {
+	result := conn(ctx, r.db).
 		Model(&entities.PortfolioRecord{}).
-		Where("id = ?", input.ID).
-		Updates(updates)
+		Where("id = ?", id).
+		Updates(withUpdatedBy(ctx, map[string]interface{}{
+			"custom_css":           raw,
+			"custom_css_sanitized": sanitized,
+		}))
 
 	if result.Error != nil {
-		return fmt.Errorf("failed to update portfolio: %w", result.Error)
+		return fmt.Errorf("failed to update portfolio custom CSS: %w", result.Error)
 	}
 
 	if result.RowsAffected == 0 {
-		return fmt.Errorf("portfolio with ID %d not found", input.ID)
+		return fmt.Errorf("portfolio with ID %d not found", id)
 	}
 
 	return nil
 }
 
-// Delete deletes a portfolio by its ID (soft delete with GORM)
-func (r *portfolioRepository) Delete(ctx context.Context, id uint) error {
-	result := r.db.WithContext(ctx).Delete(&entities.PortfolioRecord{}, id)
+// SetPublished publishes a portfolio or turns it back into a draft
+func (r *portfolioRepository) SetPublished(ctx context.Context, id uint, published bool) error {
+	result := conn(ctx, r.db).
+		Model(&entities.PortfolioRecord{}).
+		Where("id = ?", id).
+		Updates(withUpdatedBy(ctx, map[string]interface{}{
+			"is_published": published,
+		}))
 
 	if result.Error != nil {
-		return fmt.Errorf("failed to delete portfolio: %w", result.Error)
+		return fmt.Errorf("failed to update portfolio visibility: %w", result.Error)
 	}
 
 	if result.RowsAffected == 0 {
@@ -136,11 +341,37 @@ func (r *portfolioRepository) Delete(ctx context.Context, id uint) error {
 	return nil
 }
 
+// Delete deletes a portfolio by its ID (soft delete)
+// Categories, projects, sections and section contents are soft-deleted with it in
+// one transaction, all tagged with the same delete batch ID
+func (r *portfolioRepository) Delete(ctx context.Context, id uint) error {
+	batchID, err := newDeleteBatchID()
+	if err != nil {
+		return err
+	}
+
+	var deleted int64
+	err = inTransaction(ctx, r.db, func(tx *gorm.DB) error {
+		var err error
+		deleted, err = softDeletePortfolioCascade(tx, batchID, time.Now(), id)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete portfolio: %w", err)
+	}
+
+	if deleted == 0 {
+		return fmt.Errorf("portfolio with ID %d not found", id)
+	}
+
+	return nil
+}
+
 // CheckTitleDuplicate checks if a portfolio title already exists for a user
 func (r *portfolioRepository) CheckTitleDuplicate(ctx context.Context, title, ownerID string, excludeID uint) (bool, error) {
 	var count int64
 
-	query := r.db.WithContext(ctx).
+	query := conn(ctx, r.db).
 		Model(&entities.PortfolioRecord{}).
 		Where("title = ? AND owner_id = ?", title, ownerID)
 
@@ -162,8 +393,16 @@ func (r *portfolioRepository) recordToDTO(record *entities.PortfolioRecord) *dto
 		ID:          record.ID,
 		Title:       record.Title,
 		Description: record.Description,
+		Slug:        record.Slug,
 		OwnerID:     record.OwnerID,
 		CreatedAt:   record.CreatedAt,
 		UpdatedAt:   record.UpdatedAt,
+		CreatedBy:   record.CreatedBy,
+		UpdatedBy:   record.UpdatedBy,
+
+		IsPublished:         record.IsPublished,
+		EndorsementsEnabled: record.EndorsementsEnabled,
+		CustomCSS:           record.CustomCSS,
+		CustomCSSSanitized:  record.CustomCSSSanitized,
 	}
 }
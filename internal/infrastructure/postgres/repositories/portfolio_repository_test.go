@@ -0,0 +1,209 @@
+package repositories_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/entities"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/pgtest"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/repositories"
+)
+
+func TestPortfolioRepository_SearchPublic(t *testing.T) {
+	db := pgtest.Open(t)
+	ctx := context.Background()
+	repo := repositories.NewPortfolioRepository(db, false)
+
+	seed := func(owner, title, description, slug string, published bool) uint {
+		record := entities.PortfolioRecord{Title: title, Description: description, Slug: slug, OwnerID: owner, IsPublished: published}
+		create(t, db, &record)
+		return record.ID
+	}
+	rustNotes := seed("alice", "Rust Notes", "", "rust-notes", true)
+	rustTools := seed("bob", "Tools", "small RUST utilities", "tools", true)
+	seed("bob", "Rust draft", "", "rust-draft", false)
+	percent := seed("alice", "100% uptime", "", "uptime", true)
+	seed("alice", "1000 days", "", "days", true)
+	underscore := seed("bob", "a_b", "", "a-b", true)
+	seed("bob", "axb", "", "axb", true)
+
+	search := func(query string, page, limit int) ([]uint, int64) {
+		t.Helper()
+		portfolios, total, err := repo.SearchPublic(ctx, dto.SearchPublicPortfoliosInput{
+			Query:      query,
+			Pagination: dto.PaginationDTO{Page: page, Limit: limit},
+		})
+		if err != nil {
+			t.Fatalf("SearchPublic(%q): %v", query, err)
+		}
+		ids := make([]uint, len(portfolios))
+		for i, p := range portfolios {
+			ids[i] = p.ID
+		}
+		return ids, total
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		want  []uint
+	}{
+		{name: "title and description of every owner, case-insensitive", query: "rust", want: []uint{rustTools, rustNotes}},
+		{name: "percent is literal", query: "100%", want: []uint{percent}},
+		{name: "underscore is literal", query: "a_b", want: []uint{underscore}},
+		{name: "no match", query: "haskell", want: []uint{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ids, total := search(tt.query, 1, 50)
+			if !reflect.DeepEqual(ids, tt.want) || total != int64(len(tt.want)) {
+				t.Errorf("SearchPublic(%q) = %v (total %d), want %v", tt.query, ids, total, tt.want)
+			}
+		})
+	}
+
+	t.Run("pages keep the total of the whole match", func(t *testing.T) {
+		ids, total := search("rust", 2, 1)
+		if !reflect.DeepEqual(ids, []uint{rustNotes}) || total != 2 {
+			t.Errorf("page 2 = %v (total %d), want [%d] of 2", ids, total, rustNotes)
+		}
+	})
+}
+
+func TestPortfolioRepository_Slugs(t *testing.T) {
+	db := pgtest.Open(t)
+	ctx := context.Background()
+	repo := repositories.NewPortfolioRepository(db, false)
+
+	createPortfolio := func(owner, title string) *dto.PortfolioDTO {
+		t.Helper()
+		created, err := repo.Create(ctx, dto.CreatePortfolioInput{Title: title, OwnerID: owner})
+		if err != nil {
+			t.Fatalf("Create(%q): %v", title, err)
+		}
+		return created
+	}
+	slugOf := func(id uint) string {
+		t.Helper()
+		got, err := repo.GetByID(ctx, id)
+		if err != nil {
+			t.Fatalf("GetByID(%d): %v", id, err)
+		}
+		return got.Slug
+	}
+
+	// Slugs are unique across owners, not per owner
+	first := createPortfolio("alice", "Jane Doe")
+	second := createPortfolio("bob", "Jane  DOE!")
+	third := createPortfolio("alice", "Jane Doe")
+	if got := []string{first.Slug, second.Slug, third.Slug}; !reflect.DeepEqual(got, []string{"jane-doe", "jane-doe-2", "jane-doe-3"}) {
+		t.Fatalf("slugs = %v, want jane-doe, jane-doe-2, jane-doe-3", got)
+	}
+
+	found, err := repo.GetBySlug(ctx, "jane-doe-2")
+	if err != nil || found.ID != second.ID {
+		t.Fatalf("GetBySlug(jane-doe-2) = %+v, %v, want portfolio %d", found, err, second.ID)
+	}
+	if _, err := repo.GetBySlug(ctx, "nobody"); err == nil {
+		t.Error("GetBySlug(nobody) succeeded, want not found")
+	}
+
+	// A rename keeps the slug unless regeneration is asked for
+	if err := repo.Update(ctx, dto.UpdatePortfolioInput{ID: first.ID, Title: "Jane Smith", OwnerID: "alice"}); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if got := slugOf(first.ID); got != "jane-doe" {
+		t.Errorf("slug after rename = %q, want jane-doe", got)
+	}
+	if err := repo.Update(ctx, dto.UpdatePortfolioInput{ID: first.ID, Title: "Jane Smith", OwnerID: "alice", RegenerateSlug: true}); err != nil {
+		t.Fatalf("Update with regeneration: %v", err)
+	}
+	if got := slugOf(first.ID); got != "jane-smith" {
+		t.Errorf("regenerated slug = %q, want jane-smith", got)
+	}
+
+	// Regenerating an unchanged title keeps the portfolio's own numbered slug
+	if err := repo.Update(ctx, dto.UpdatePortfolioInput{ID: third.ID, OwnerID: "alice", RegenerateSlug: true}); err != nil {
+		t.Fatalf("Update with regeneration: %v", err)
+	}
+	if got := slugOf(third.ID); got != "jane-doe-3" {
+		t.Errorf("slug regenerated from the same title = %q, want jane-doe-3", got)
+	}
+}
+
+func TestBackfillPortfolioSlugs(t *testing.T) {
+	db := pgtest.Open(t)
+
+	// Rows from before slugs existed, inserted newest ID last
+	var ids []uint
+	for _, title := range []string{"Old Site", "old site", "???"} {
+		record := entities.PortfolioRecord{Title: title, OwnerID: "alice"}
+		create(t, db, &record)
+		ids = append(ids, record.ID)
+	}
+
+	updated, err := repositories.BackfillPortfolioSlugs(db)
+	if err != nil || updated != 3 {
+		t.Fatalf("BackfillPortfolioSlugs = %d, %v, want 3", updated, err)
+	}
+
+	var slugs []string
+	if err := db.Model(&entities.PortfolioRecord{}).Where("id IN ?", ids).Order("id").Pluck("slug", &slugs).Error; err != nil {
+		t.Fatalf("load slugs: %v", err)
+	}
+	if want := []string{"old-site", "old-site-2", "portfolio"}; !reflect.DeepEqual(slugs, want) {
+		t.Errorf("backfilled slugs = %v, want %v", slugs, want)
+	}
+
+	if again, err := repositories.BackfillPortfolioSlugs(db); err != nil || again != 0 {
+		t.Errorf("second backfill = %d, %v, want nothing to do", again, err)
+	}
+}
+
+func TestPortfolioRepository_Patch(t *testing.T) {
+	db := pgtest.Open(t)
+	ctx := context.Background()
+	repo := repositories.NewPortfolioRepository(db, false)
+
+	created, err := repo.Create(ctx, dto.CreatePortfolioInput{Title: "Work", Description: "jobs", OwnerID: "alice"})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	strPtr := func(s string) *string { return &s }
+	disabled := false
+	load := func() *dto.PortfolioDTO {
+		t.Helper()
+		portfolio, err := repo.GetByID(ctx, created.ID)
+		if err != nil {
+			t.Fatalf("GetByID: %v", err)
+		}
+		return portfolio
+	}
+
+	if err := repo.Patch(ctx, dto.PatchPortfolioInput{ID: created.ID, EndorsementsEnabled: &disabled}); err != nil {
+		t.Fatalf("Patch(endorsements): %v", err)
+	}
+	if got := load(); got.Title != "Work" || got.Description != "jobs" || got.EndorsementsEnabled {
+		t.Errorf("after an endorsements patch = %+v, want the title and description kept", got)
+	}
+
+	if err := repo.Patch(ctx, dto.PatchPortfolioInput{ID: created.ID, Title: strPtr("Jobs"), Description: strPtr("")}); err != nil {
+		t.Fatalf("Patch(title, description): %v", err)
+	}
+	if got := load(); got.Title != "Jobs" || got.Description != "" || got.Slug != created.Slug || got.EndorsementsEnabled {
+		t.Errorf("after a title patch = %+v, want Jobs with no description, slug %q and endorsements off", got, created.Slug)
+	}
+
+	if err := repo.Patch(ctx, dto.PatchPortfolioInput{ID: created.ID, RegenerateSlug: true}); err != nil {
+		t.Fatalf("Patch(regenerate_slug): %v", err)
+	}
+	if got := load(); got.Slug != "jobs" {
+		t.Errorf("regenerated slug = %q, want jobs", got.Slug)
+	}
+
+	if err := repo.Patch(ctx, dto.PatchPortfolioInput{ID: 9999, Title: strPtr("ghost")}); err == nil {
+		t.Error("Patch of a missing portfolio succeeded")
+	}
+}
@@ -0,0 +1,71 @@
+package repositories_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/entities"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/pgtest"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/repositories"
+)
+
+func TestSearchInPortfolio(t *testing.T) {
+	db := pgtest.Open(t)
+	ctx := context.Background()
+	projects := repositories.NewProjectRepository(db, pgtest.SearchConfig)
+	sections := repositories.NewSectionRepository(db, pgtest.SearchConfig, false)
+
+	tr := seedTree(t, db, "alice", "alpha")
+	seedTree(t, db, "bob", "beta") // same words, other portfolio
+
+	// A second matching content, and a trashed one that must not be reported
+	text := "more text here"
+	second := entities.SectionContentRecord{SectionID: tr.Section.ID, Type: "text", Content: &text, Order: 2, OwnerID: "alice"}
+	create(t, db, &second)
+	trashed := entities.SectionContentRecord{SectionID: tr.Section.ID, Type: "text", Content: &text, Order: 3, OwnerID: "alice"}
+	create(t, db, &trashed)
+	softDelete(t, db, &trashed)
+
+	sectionHits := func(query string) map[uint][]uint {
+		t.Helper()
+		hits, err := sections.SearchInPortfolio(ctx, tr.Portfolio.ID, query, 50)
+		if err != nil {
+			t.Fatalf("sections.SearchInPortfolio(%q): %v", query, err)
+		}
+		got := make(map[uint][]uint, len(hits))
+		for _, hit := range hits {
+			got[hit.Section.ID] = hit.ContentIDs
+		}
+		return got
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		want  map[uint][]uint
+	}{
+		{name: "through contents, in display order", query: "tex", want: map[uint][]uint{tr.Section.ID: {tr.SectionContent.ID, second.ID}}},
+		{name: "through its own title", query: "alph secti", want: map[uint][]uint{tr.Section.ID: {}}},
+		{name: "every word must match", query: "alpha nothing", want: map[uint][]uint{}},
+		{name: "no searchable word", query: "!!!", want: map[uint][]uint{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sectionHits(tt.query); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("section hits = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	found, err := projects.SearchInPortfolio(ctx, tr.Portfolio.ID, "alph proj", 50)
+	if err != nil {
+		t.Fatalf("projects.SearchInPortfolio: %v", err)
+	}
+	if got := projectIDs(found); !reflect.DeepEqual(got, []uint{tr.Project.ID}) {
+		t.Errorf("project hits = %v, want [%d]", got, tr.Project.ID)
+	}
+	if found, err := projects.SearchInPortfolio(ctx, tr.Portfolio.ID, "beta", 50); err != nil || len(found) != 0 {
+		t.Errorf("search for another portfolio's project = %v, %v, want none", projectIDs(found), err)
+	}
+}
@@ -0,0 +1,247 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/titles"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/domain/portfolio"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/entities"
+	"gorm.io/gorm"
+)
+
+// restoredSuffix is appended to the title of a restored portfolio whose title was taken meanwhile
+const restoredSuffix = " (restored)"
+
+// GetDeletedByOwnerID lists the soft-deleted portfolios of a user, most recently deleted first,
+// each with the number of children in its delete batch
+func (r *portfolioRepository) GetDeletedByOwnerID(ctx context.Context, ownerID string) ([]dto.DeletedPortfolioDTO, error) {
+	var records []entities.PortfolioRecord
+
+	if err := conn(ctx, r.db).
+		Unscoped().
+		Where("owner_id = ? AND deleted_at IS NOT NULL", ownerID).
+		Order("deleted_at DESC, id DESC").
+		Find(&records).Error; err != nil {
+		return nil, fmt.Errorf("failed to list deleted portfolios: %w", err)
+	}
+
+	var batchIDs []string
+	for _, record := range records {
+		if record.DeleteBatchID != nil {
+			batchIDs = append(batchIDs, *record.DeleteBatchID)
+		}
+	}
+	batches, err := r.countBatchChildren(ctx, batchIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deleted portfolios: %w", err)
+	}
+
+	dtos := make([]dto.DeletedPortfolioDTO, len(records))
+	for i, record := range records {
+		dtos[i] = dto.DeletedPortfolioDTO{
+			Portfolio: *r.recordToDTO(&record),
+			DeletedAt: record.DeletedAt.Time,
+		}
+		if record.DeleteBatchID != nil {
+			dtos[i].BatchID = *record.DeleteBatchID
+			dtos[i].DeletedWith = batches[*record.DeleteBatchID]
+		}
+	}
+
+	return dtos, nil
+}
+
+// countBatchChildren counts the soft-deleted child rows of each delete batch, one query per table
+func (r *portfolioRepository) countBatchChildren(ctx context.Context, batchIDs []string) (map[string]dto.PortfolioChildCountsDTO, error) {
+	counts := make(map[string]dto.PortfolioChildCountsDTO, len(batchIDs))
+	if len(batchIDs) == 0 {
+		return counts, nil
+	}
+
+	tables := []struct {
+		table string
+		count func(*dto.PortfolioChildCountsDTO) *int
+	}{
+		{"categories", func(c *dto.PortfolioChildCountsDTO) *int { return &c.Categories }},
+		{"projects", func(c *dto.PortfolioChildCountsDTO) *int { return &c.Projects }},
+		{"project_collaborators", func(c *dto.PortfolioChildCountsDTO) *int { return &c.ProjectCollaborators }},
+		{"sections", func(c *dto.PortfolioChildCountsDTO) *int { return &c.Sections }},
+		{"section_contents", func(c *dto.PortfolioChildCountsDTO) *int { return &c.SectionContents }},
+		{"portfolio_links", func(c *dto.PortfolioChildCountsDTO) *int { return &c.Links }},
+	}
+
+	for _, t := range tables {
+		var rows []struct {
+			DeleteBatchID string
+			Count         int
+		}
+		if err := conn(ctx, r.db).
+			Table(t.table).
+			Select("delete_batch_id, COUNT(*) AS count").
+			Where("delete_batch_id IN ? AND deleted_at IS NOT NULL", batchIDs).
+			Group("delete_batch_id").
+			Scan(&rows).Error; err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			batch := counts[row.DeleteBatchID]
+			*t.count(&batch) = row.Count
+			counts[row.DeleteBatchID] = batch
+		}
+	}
+
+	return counts, nil
+}
+
+// Restore brings back a soft-deleted portfolio in one transaction, with the categories, projects
+// (and their collaborators), sections, section contents and links of the same delete batch.
+// Children deleted on their own before the portfolio stay in the trash.
+// A title taken by a live portfolio of the owner gets a " (restored)" suffix, and a slug taken
+// meanwhile is replaced by the first free one of the title.
+func (r *portfolioRepository) Restore(ctx context.Context, id uint) (*dto.PortfolioRestoreDTO, error) {
+	restore := &dto.PortfolioRestoreDTO{}
+
+	err := inTransaction(ctx, r.db, func(tx *gorm.DB) error {
+		var record entities.PortfolioRecord
+		if err := tx.Unscoped().
+			Where("id = ? AND deleted_at IS NOT NULL", id).
+			First(&record).Error; err != nil {
+			return err
+		}
+		deletedAt := record.DeletedAt.Time
+
+		var existing []string
+		if err := tx.Model(&entities.PortfolioRecord{}).
+			Where("owner_id = ?", record.OwnerID).
+			Pluck("title", &existing).Error; err != nil {
+			return err
+		}
+		taken := make(map[string]bool, len(existing))
+		for _, title := range existing {
+			taken[title] = true
+		}
+
+		var slugTaken int64
+		if err := tx.Model(&entities.PortfolioRecord{}).
+			Where("slug = ?", record.Slug).
+			Count(&slugTaken).Error; err != nil {
+			return err
+		}
+
+		changes := map[string]interface{}{}
+		if taken[record.Title] {
+			restore.RenamedFrom = record.Title
+			record.Title = restoredTitle(record.Title, taken)
+			changes["title"] = record.Title
+		}
+		keepSlug := record.Slug != "" && slugTaken == 0
+		if !keepSlug {
+			record.Slug = ""
+			changes["slug"] = ""
+		}
+		if len(changes) > 0 {
+			if err := tx.Unscoped().
+				Model(&entities.PortfolioRecord{}).
+				Where("id = ?", id).
+				Updates(withUpdatedBy(ctx, changes)).Error; err != nil {
+				return fmt.Errorf("failed to rename portfolio: %w", err)
+			}
+		}
+
+		if _, err := restoreRows(tx, "portfolios", record.DeleteBatchID, deletedAt, "id = ?", id); err != nil {
+			return err
+		}
+		if err := restorePortfolioChildren(tx, record.DeleteBatchID, deletedAt, id, &restore.Restored); err != nil {
+			return err
+		}
+
+		if !keepSlug {
+			candidate := numberedCandidate(portfolio.PortfolioSlug(record.Title), takenPortfolioSlugs(tx, id))
+			slug, err := insertWithUniqueRetry(tx, 0, candidate, func(tx *gorm.DB, slug string) error {
+				return tx.Model(&entities.PortfolioRecord{}).Where("id = ?", id).UpdateColumn("slug", slug).Error
+			})
+			if err != nil {
+				return err
+			}
+			record.Slug = slug
+		}
+
+		// Sections deleted before slugs existed come back without one
+		if _, err := fillSectionSlugs(tx, id); err != nil {
+			return err
+		}
+
+		record.DeletedAt = gorm.DeletedAt{}
+		record.DeleteBatchID = nil
+		restore.Portfolio = *r.recordToDTO(&record)
+		return nil
+	})
+	if err == gorm.ErrRecordNotFound {
+		return nil, fmt.Errorf("portfolio with ID %d not found in trash", id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore portfolio: %w", err)
+	}
+
+	return restore, nil
+}
+
+// restorePortfolioChildren restores the children of a portfolio removed by the same delete
+// operation as the portfolio, counting them per kind
+func restorePortfolioChildren(tx *gorm.DB, batchID *string, deletedAt time.Time, portfolioID uint, restored *dto.PortfolioChildCountsDTO) error {
+	steps := []struct {
+		table string
+		where string
+		count *int
+	}{
+		{"categories", "portfolio_id = ?", &restored.Categories},
+		{"projects", "category_id IN (SELECT id FROM categories WHERE portfolio_id = ?)", &restored.Projects},
+		{"project_collaborators", "project_id IN (SELECT projects.id FROM projects JOIN categories ON categories.id = projects.category_id WHERE categories.portfolio_id = ?)", &restored.ProjectCollaborators},
+		{"sections", "portfolio_id = ?", &restored.Sections},
+		{"section_contents", "section_id IN (SELECT id FROM sections WHERE portfolio_id = ?)", &restored.SectionContents},
+		{"portfolio_links", "portfolio_id = ?", &restored.Links},
+	}
+
+	for _, step := range steps {
+		count, err := restoreRows(tx, step.table, batchID, deletedAt, step.where, portfolioID)
+		if err != nil {
+			return err
+		}
+		*step.count = int(count)
+	}
+
+	return nil
+}
+
+// restoredTitle returns title with the " (restored)" suffix, numbered when that is taken too
+// The base is shortened when needed so the result stays within titles.MaxLength characters.
+func restoredTitle(title string, taken map[string]bool) string {
+	if runes := []rune(title); len(runes)+len(restoredSuffix) > titles.MaxLength {
+		title = string(runes[:titles.MaxLength-len(restoredSuffix)])
+	}
+
+	return titles.Unique(title+restoredSuffix, taken)
+}
+
+// Purge permanently deletes a soft-deleted portfolio
+// Its categories, projects, sections, contents, links and the rest of its rows are removed by
+// the ON DELETE CASCADE foreign keys, whether they were live or in the trash.
+func (r *portfolioRepository) Purge(ctx context.Context, id uint) error {
+	result := conn(ctx, r.db).
+		Unscoped().
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Delete(&entities.PortfolioRecord{})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to purge portfolio: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("portfolio with ID %d not found in trash", id)
+	}
+
+	return nil
+}
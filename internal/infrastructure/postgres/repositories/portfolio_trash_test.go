@@ -0,0 +1,58 @@
+package repositories_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/entities"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/pgtest"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/repositories"
+)
+
+func TestPortfolioTrash_RestoreKeepsEarlierDeletesDeleted(t *testing.T) {
+	db := pgtest.Open(t)
+	ctx := context.Background()
+	portfolios := repositories.NewPortfolioRepository(db, false)
+	projects := repositories.NewProjectRepository(db, pgtest.SearchConfig)
+
+	tr := seedTree(t, db, "alice", "batched")
+	kept := entities.ProjectRecord{Title: "kept", Description: "deleted with the portfolio", Position: 2, CategoryID: tr.Category.ID, OwnerID: "alice"}
+	create(t, db, &kept)
+
+	// The project goes first on its own, then the portfolio with everything left under it
+	if err := projects.Delete(ctx, tr.Project.ID); err != nil {
+		t.Fatalf("delete project: %v", err)
+	}
+	if err := portfolios.Delete(ctx, tr.Portfolio.ID); err != nil {
+		t.Fatalf("delete portfolio: %v", err)
+	}
+
+	trash, err := portfolios.GetDeletedByOwnerID(ctx, "alice")
+	if err != nil {
+		t.Fatalf("GetDeletedByOwnerID: %v", err)
+	}
+	if len(trash) != 1 || trash[0].BatchID == "" {
+		t.Fatalf("trash = %+v, want one batch", trash)
+	}
+	wantBatch := dto.PortfolioChildCountsDTO{Links: 1, Categories: 1, Projects: 1, Sections: 1, SectionContents: 1}
+	if trash[0].DeletedWith != wantBatch {
+		t.Errorf("batch children = %+v, want %+v: the project deleted on its own is in another batch", trash[0].DeletedWith, wantBatch)
+	}
+
+	restore, err := portfolios.Restore(ctx, tr.Portfolio.ID)
+	if err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+	if restore.Restored.Projects != 1 {
+		t.Errorf("restored %d projects, want 1", restore.Restored.Projects)
+	}
+
+	var live []uint
+	if err := db.Model(&entities.ProjectRecord{}).Where("category_id = ?", tr.Category.ID).Pluck("id", &live).Error; err != nil {
+		t.Fatalf("list projects: %v", err)
+	}
+	if len(live) != 1 || live[0] != kept.ID {
+		t.Errorf("live projects = %v, want only %d: project %d was deleted before the portfolio", live, kept.ID, tr.Project.ID)
+	}
+}
@@ -0,0 +1,79 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/entities"
+	"gorm.io/gorm"
+)
+
+// portfolioViewRepository is the GORM implementation of PortfolioViewRepository
+type portfolioViewRepository struct {
+	db *gorm.DB
+}
+
+// NewPortfolioViewRepository creates a new portfolio view repository instance
+// Returns the interface type (contracts.PortfolioViewRepository), not the concrete type
+func NewPortfolioViewRepository(db *gorm.DB) contracts.PortfolioViewRepository {
+	return &portfolioViewRepository{db: db}
+}
+
+// Flush upserts the daily counts in one transaction, in chunks of projectViewFlushChunk rows
+func (r *portfolioViewRepository) Flush(ctx context.Context, batches []dto.PortfolioViewBatchDTO) error {
+	if len(batches) == 0 {
+		return nil
+	}
+
+	err := inTransaction(ctx, r.db, func(tx *gorm.DB) error {
+		for start := 0; start < len(batches); start += projectViewFlushChunk {
+			chunk := batches[start:min(start+projectViewFlushChunk, len(batches))]
+
+			values := make([]string, len(chunk))
+			args := make([]interface{}, 0, 3*len(chunk))
+			for i, batch := range chunk {
+				values[i] = "(?::bigint, ?::date, ?::bigint)"
+				args = append(args, batch.PortfolioID, batch.Day, batch.Views)
+			}
+
+			// Views of portfolios purged since they were recorded would fail the foreign key
+			if err := tx.Exec(
+				"INSERT INTO portfolio_view_days (portfolio_id, day, views) "+
+					"SELECT v.portfolio_id, v.day, v.views FROM (VALUES "+strings.Join(values, ", ")+") AS v(portfolio_id, day, views) "+
+					"WHERE EXISTS (SELECT 1 FROM portfolios WHERE portfolios.id = v.portfolio_id) "+
+					"ON CONFLICT (portfolio_id, day) DO UPDATE SET views = portfolio_view_days.views + EXCLUDED.views",
+				args...,
+			).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to flush portfolio views: %w", err)
+	}
+
+	return nil
+}
+
+// GetDays retrieves the stored daily counts of a portfolio in the range
+func (r *portfolioViewRepository) GetDays(ctx context.Context, portfolioID uint, from, to time.Time) ([]dto.PortfolioViewDayDTO, error) {
+	var records []entities.PortfolioViewDayRecord
+	if err := r.db.WithContext(ctx).
+		Where("portfolio_id = ? AND day BETWEEN ? AND ?", portfolioID, from, to).
+		Order("day ASC").
+		Find(&records).Error; err != nil {
+		return nil, fmt.Errorf("failed to get portfolio views: %w", err)
+	}
+
+	days := make([]dto.PortfolioViewDayDTO, len(records))
+	for i, record := range records {
+		days[i] = dto.PortfolioViewDayDTO{Day: record.Day.UTC(), Views: record.Views}
+	}
+
+	return days, nil
+}
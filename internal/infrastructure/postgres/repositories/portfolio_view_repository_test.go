@@ -0,0 +1,70 @@
+package repositories_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/entities"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/pgtest"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/repositories"
+)
+
+func TestPortfolioViewRepository_FlushAndGetDays(t *testing.T) {
+	db := pgtest.Open(t)
+	ctx := context.Background()
+	repo := repositories.NewPortfolioViewRepository(db)
+
+	viewed := seedTree(t, db, "alice", "viewed")
+	other := seedTree(t, db, "alice", "other")
+	purged := entities.PortfolioRecord{Title: "purged", OwnerID: "alice"}
+	create(t, db, &purged)
+
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 0, 0, 0, 0, time.UTC) }
+
+	// The purged portfolio is hard-deleted between the views and the flush
+	if err := db.Unscoped().Delete(&entities.PortfolioRecord{}, purged.ID).Error; err != nil {
+		t.Fatalf("purge portfolio: %v", err)
+	}
+
+	flushes := [][]dto.PortfolioViewBatchDTO{
+		{
+			{PortfolioID: viewed.Portfolio.ID, Day: day(2), Views: 3},
+			{PortfolioID: viewed.Portfolio.ID, Day: day(5), Views: 1},
+			{PortfolioID: other.Portfolio.ID, Day: day(2), Views: 9},
+			{PortfolioID: purged.ID, Day: day(2), Views: 1},
+		},
+		// A later flush (another instance) adds to the stored day
+		{{PortfolioID: viewed.Portfolio.ID, Day: day(2), Views: 2}},
+	}
+	for i, batches := range flushes {
+		if err := repo.Flush(ctx, batches); err != nil {
+			t.Fatalf("flush %d: %v", i+1, err)
+		}
+	}
+
+	days, err := repo.GetDays(ctx, viewed.Portfolio.ID, day(1), day(5))
+	if err != nil {
+		t.Fatalf("GetDays: %v", err)
+	}
+	var got []string
+	for _, d := range days {
+		got = append(got, fmt.Sprintf("%s:%d", d.Day.Format(time.DateOnly), d.Views))
+	}
+	if want := []string{"2026-03-02:5", "2026-03-05:1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("GetDays(1..5) = %v, want %v", got, want)
+	}
+
+	// Both ends are inclusive
+	if days, err := repo.GetDays(ctx, viewed.Portfolio.ID, day(3), day(5)); err != nil || len(days) != 1 || !days[0].Day.Equal(day(5)) {
+		t.Errorf("GetDays(3..5) = %+v, %v, want only day 5", days, err)
+	}
+
+	var purgedRows int64
+	if err := db.Model(&entities.PortfolioViewDayRecord{}).Where("portfolio_id = ?", purged.ID).Count(&purgedRows).Error; err != nil || purgedRows != 0 {
+		t.Errorf("purged portfolio has %d view rows (%v), want none", purgedRows, err)
+	}
+}
@@ -0,0 +1,97 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// swapPlaceholderPosition parks a row during a swap; real positions are never negative
+const swapPlaceholderPosition = -1
+
+// nextPosition returns the next free position (MAX+1, starting at 1) among the
+// live rows of table that belong to the given parent.
+// The parent row is locked first so concurrent inserts under the same parent
+// cannot compute the same position; call it inside a transaction.
+func nextPosition(tx *gorm.DB, table, parentTable, parentColumn string, parentID uint) (uint, error) {
+	var lockedID uint
+	if err := tx.Raw(
+		fmt.Sprintf("SELECT id FROM %s WHERE id = ? FOR UPDATE", parentTable), parentID,
+	).Scan(&lockedID).Error; err != nil {
+		return 0, fmt.Errorf("failed to lock %s row %d: %w", parentTable, parentID, err)
+	}
+
+	var maxPosition uint
+	if err := tx.Raw(
+		fmt.Sprintf("SELECT COALESCE(MAX(position), 0) FROM %s WHERE %s = ? AND deleted_at IS NULL", table, parentColumn),
+		parentID,
+	).Scan(&maxPosition).Error; err != nil {
+		return 0, fmt.Errorf("failed to compute next position in %s: %w", table, err)
+	}
+
+	return maxPosition + 1, nil
+}
+
+// swapPositions exchanges the positions of two live rows of table that share the same parent.
+// Both rows are locked, then rotated through a placeholder position so a unique
+// (parent, position) constraint never sees a duplicate. Call it inside a transaction.
+func swapPositions(ctx context.Context, tx *gorm.DB, table, parentColumn string, firstID, secondID uint) error {
+	var rows []struct {
+		ID       uint
+		ParentID uint
+		Position int64
+	}
+	if err := tx.Raw(
+		fmt.Sprintf("SELECT id, %s AS parent_id, position FROM %s WHERE id IN (?, ?) AND deleted_at IS NULL ORDER BY id FOR UPDATE", parentColumn, table),
+		firstID, secondID,
+	).Scan(&rows).Error; err != nil {
+		return fmt.Errorf("failed to lock %s rows: %w", table, err)
+	}
+	if len(rows) != 2 {
+		return fmt.Errorf("%s rows %d and %d not found", table, firstID, secondID)
+	}
+	if rows[0].ParentID != rows[1].ParentID {
+		return fmt.Errorf("%s rows %d and %d have different parents", table, firstID, secondID)
+	}
+
+	now := time.Now()
+	steps := []struct {
+		id       uint
+		position int64
+	}{
+		{rows[0].ID, swapPlaceholderPosition},
+		{rows[1].ID, rows[0].Position},
+		{rows[0].ID, rows[1].Position},
+	}
+	for _, step := range steps {
+		if err := tx.Table(table).
+			Where("id = ?", step.id).
+			Updates(withUpdatedBy(ctx, map[string]interface{}{"position": step.position, "updated_at": now})).Error; err != nil {
+			return fmt.Errorf("failed to update position of %s row %d: %w", table, step.id, err)
+		}
+	}
+
+	return nil
+}
+
+// assertSameParent fails unless every row of ids in table has the same parent
+// Positions are only meaningful per parent, so a batch update spanning parents is a bug.
+func assertSameParent(tx *gorm.DB, table, parentColumn string, ids []uint) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	var parents int64
+	if err := tx.Raw(
+		fmt.Sprintf("SELECT COUNT(DISTINCT %s) FROM %s WHERE id IN ?", parentColumn, table), ids,
+	).Scan(&parents).Error; err != nil {
+		return fmt.Errorf("failed to check %s parents: %w", table, err)
+	}
+	if parents > 1 {
+		return fmt.Errorf("%s to reorder belong to %d different parents", table, parents)
+	}
+
+	return nil
+}
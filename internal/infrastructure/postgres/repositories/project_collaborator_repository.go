@@ -0,0 +1,178 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/entities"
+	"gorm.io/gorm"
+)
+
+// projectCollaboratorRepository is the GORM implementation of ProjectCollaboratorRepository
+type projectCollaboratorRepository struct {
+	db *gorm.DB
+}
+
+// NewProjectCollaboratorRepository creates a new project collaborator repository instance
+func NewProjectCollaboratorRepository(db *gorm.DB) contracts.ProjectCollaboratorRepository {
+	return &projectCollaboratorRepository{db: db}
+}
+
+// Create creates a new project collaborator
+// The project row is locked (by nextPosition) before counting, so concurrent
+// creates cannot exceed maxCollaborators.
+func (r *projectCollaboratorRepository) Create(ctx context.Context, input dto.CreateProjectCollaboratorInput, maxCollaborators int) (*dto.ProjectCollaboratorDTO, int64, error) {
+	record := &entities.ProjectCollaboratorRecord{
+		ProjectID: input.ProjectID,
+		Name:      input.Name,
+		Role:      input.Role,
+		URL:       input.URL,
+		Position:  input.Position,
+		OwnerID:   input.OwnerID,
+		CreatedBy: actorOr(ctx, input.OwnerID),
+		UpdatedBy: actorOr(ctx, input.OwnerID),
+	}
+
+	var count int64
+	err := inTransaction(ctx, r.db, func(tx *gorm.DB) error {
+		position, err := nextPosition(tx, "project_collaborators", "projects", "project_id", record.ProjectID)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Model(&entities.ProjectCollaboratorRecord{}).
+			Where("project_id = ?", record.ProjectID).
+			Count(&count).Error; err != nil {
+			return err
+		}
+		if maxCollaborators > 0 && count >= int64(maxCollaborators) {
+			return contracts.ErrProjectCollaboratorLimitReached
+		}
+
+		if record.Position == 0 {
+			record.Position = position
+		}
+
+		return tx.Create(record).Error
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to create project collaborator: %w", err)
+	}
+
+	return r.recordToDTO(record), count + 1, nil
+}
+
+// GetByID retrieves a project collaborator by its ID
+func (r *projectCollaboratorRepository) GetByID(ctx context.Context, id uint) (*dto.ProjectCollaboratorDTO, error) {
+	var record entities.ProjectCollaboratorRecord
+
+	if err := r.db.WithContext(ctx).First(&record, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("project collaborator with ID %d not found", id)
+		}
+		return nil, fmt.Errorf("failed to get project collaborator: %w", err)
+	}
+
+	return r.recordToDTO(&record), nil
+}
+
+// GetByProjectID retrieves all collaborators of a project (ordered by position)
+func (r *projectCollaboratorRepository) GetByProjectID(ctx context.Context, projectID uint) ([]dto.ProjectCollaboratorDTO, error) {
+	var records []entities.ProjectCollaboratorRecord
+
+	if err := r.db.WithContext(ctx).
+		Where("project_id = ?", projectID).
+		Order("position ASC, id ASC").
+		Find(&records).Error; err != nil {
+		return nil, fmt.Errorf("failed to get project collaborators: %w", err)
+	}
+
+	dtos := make([]dto.ProjectCollaboratorDTO, len(records))
+	for i, record := range records {
+		dtos[i] = *r.recordToDTO(&record)
+	}
+
+	return dtos, nil
+}
+
+// Update updates an existing project collaborator
+func (r *projectCollaboratorRepository) Update(ctx context.Context, input dto.UpdateProjectCollaboratorInput) error {
+	updates := map[string]interface{}{
+		"name": input.Name,
+		"role": input.Role,
+		"url":  input.URL,
+	}
+	if input.Position != 0 {
+		updates["position"] = input.Position
+	}
+
+	result := r.db.WithContext(ctx).
+		Model(&entities.ProjectCollaboratorRecord{}).
+		Where("id = ?", input.ID).
+		Updates(withUpdatedBy(ctx, updates))
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to update project collaborator: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("project collaborator with ID %d not found", input.ID)
+	}
+
+	return nil
+}
+
+// BulkUpdatePositions updates positions for multiple collaborators of a project in a transaction
+func (r *projectCollaboratorRepository) BulkUpdatePositions(ctx context.Context, projectID uint, items []dto.BulkUpdatePositionItem) error {
+	return inTransaction(ctx, r.db, func(tx *gorm.DB) error {
+		for _, item := range items {
+			result := tx.Model(&entities.ProjectCollaboratorRecord{}).
+				Where("id = ? AND project_id = ?", item.ID, projectID).
+				Updates(withUpdatedBy(ctx, map[string]interface{}{"position": item.Position}))
+			if result.Error != nil {
+				return fmt.Errorf("failed to update position for project collaborator %d: %w", item.ID, result.Error)
+			}
+			if result.RowsAffected == 0 {
+				return fmt.Errorf("project collaborator with ID %d not found in project %d", item.ID, projectID)
+			}
+		}
+		return nil
+	})
+}
+
+// Delete deletes a project collaborator by its ID (soft delete)
+func (r *projectCollaboratorRepository) Delete(ctx context.Context, id uint) error {
+	batchID, err := newDeleteBatchID()
+	if err != nil {
+		return err
+	}
+
+	deleted, err := softDeleteRows(r.db.WithContext(ctx), "project_collaborators", batchID, time.Now(), "id = ?", id)
+	if err != nil {
+		return fmt.Errorf("failed to delete project collaborator: %w", err)
+	}
+
+	if deleted == 0 {
+		return fmt.Errorf("project collaborator with ID %d not found", id)
+	}
+
+	return nil
+}
+
+// recordToDTO converts a ProjectCollaboratorRecord to ProjectCollaboratorDTO
+func (r *projectCollaboratorRepository) recordToDTO(record *entities.ProjectCollaboratorRecord) *dto.ProjectCollaboratorDTO {
+	return &dto.ProjectCollaboratorDTO{
+		ID:        record.ID,
+		ProjectID: record.ProjectID,
+		Name:      record.Name,
+		Role:      record.Role,
+		URL:       record.URL,
+		Position:  record.Position,
+		OwnerID:   record.OwnerID,
+		CreatedAt: record.CreatedAt,
+		UpdatedAt: record.UpdatedAt,
+	}
+}
@@ -0,0 +1,129 @@
+package repositories_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	domainportfolio "github.com/JorgeSaicoski/portfolio-manager/backend/internal/domain/portfolio"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/entities"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/pgtest"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/repositories"
+)
+
+// collaboratorNames returns the names of the collaborators of a project in list order
+func collaboratorNames(collaborators []dto.ProjectCollaboratorDTO) []string {
+	names := make([]string, len(collaborators))
+	for i, collaborator := range collaborators {
+		names[i] = collaborator.Name
+	}
+	return names
+}
+
+func TestProjectCollaboratorRepository_CapAndOrder(t *testing.T) {
+	db := pgtest.Open(t)
+	ctx := context.Background()
+	repo := repositories.NewProjectCollaboratorRepository(db)
+	tr := seedTree(t, db, "alice", "credits")
+
+	add := func(name string, position uint) (*dto.ProjectCollaboratorDTO, int64, error) {
+		return repo.Create(ctx, dto.CreateProjectCollaboratorInput{ProjectID: tr.Project.ID, Name: name, Position: position, OwnerID: "alice"}, domainportfolio.MaxCollaboratorsPerProject)
+	}
+
+	// Appended collaborators are numbered in order; a manual position is kept
+	for i, name := range []string{"Ada", "Grace"} {
+		created, count, err := add(name, 0)
+		if err != nil {
+			t.Fatalf("Create(%s): %v", name, err)
+		}
+		if created.Position != uint(i+1) || count != int64(i+1) {
+			t.Errorf("Create(%s) = position %d, count %d, want %d, %d", name, created.Position, count, i+1, i+1)
+		}
+	}
+	if _, _, err := add("Linus", 1); err != nil {
+		t.Fatalf("Create(Linus): %v", err)
+	}
+
+	list, err := repo.GetByProjectID(ctx, tr.Project.ID)
+	if err != nil {
+		t.Fatalf("GetByProjectID: %v", err)
+	}
+	// Equal positions fall back to creation order
+	if got, want := collaboratorNames(list), []string{"Ada", "Linus", "Grace"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("collaborators = %v, want %v", got, want)
+	}
+
+	// Fill up to the cap; soft-deleted collaborators don't count
+	trashed, _, err := add("Trashed", 0)
+	if err != nil {
+		t.Fatalf("Create(Trashed): %v", err)
+	}
+	if err := repo.Delete(ctx, trashed.ID); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	for i := len(list); i < domainportfolio.MaxCollaboratorsPerProject; i++ {
+		if _, _, err := add(fmt.Sprintf("Person %d", i), 0); err != nil {
+			t.Fatalf("Create collaborator %d of %d: %v", i+1, domainportfolio.MaxCollaboratorsPerProject, err)
+		}
+	}
+	if _, _, err := add("One too many", 0); !errors.Is(err, contracts.ErrProjectCollaboratorLimitReached) {
+		t.Errorf("Create past the cap error = %v, want %v", err, contracts.ErrProjectCollaboratorLimitReached)
+	}
+	var live int64
+	db.Model(&entities.ProjectCollaboratorRecord{}).Where("project_id = ?", tr.Project.ID).Count(&live)
+	if live != domainportfolio.MaxCollaboratorsPerProject {
+		t.Errorf("%d live collaborators, want the cap of %d", live, domainportfolio.MaxCollaboratorsPerProject)
+	}
+}
+
+func TestProjectCollaboratorRepository_CloneGivesNewIDs(t *testing.T) {
+	db := pgtest.Open(t)
+	ctx := context.Background()
+	repo := repositories.NewProjectCollaboratorRepository(db)
+	tr := seedTree(t, db, "alice", "source")
+
+	for _, name := range []string{"Ada", "Grace"} {
+		if _, _, err := repo.Create(ctx, dto.CreateProjectCollaboratorInput{ProjectID: tr.Project.ID, Name: name, Role: "Design", OwnerID: "alice"}, domainportfolio.MaxCollaboratorsPerProject); err != nil {
+			t.Fatalf("Create(%s): %v", name, err)
+		}
+	}
+	source, err := repo.GetByProjectID(ctx, tr.Project.ID)
+	if err != nil {
+		t.Fatalf("GetByProjectID(source): %v", err)
+	}
+
+	clone, err := repositories.NewPortfolioRepository(db, false).Clone(ctx, tr.Portfolio.ID)
+	if err != nil {
+		t.Fatalf("Clone: %v", err)
+	}
+	if clone.Copied.ProjectCollaborators != len(source) {
+		t.Errorf("copied %d collaborators, want %d", clone.Copied.ProjectCollaborators, len(source))
+	}
+
+	var clonedProject entities.ProjectRecord
+	if err := db.Joins("JOIN categories ON categories.id = projects.category_id").
+		Where("categories.portfolio_id = ?", clone.Portfolio.ID).
+		First(&clonedProject).Error; err != nil {
+		t.Fatalf("find cloned project: %v", err)
+	}
+	copies, err := repo.GetByProjectID(ctx, clonedProject.ID)
+	if err != nil {
+		t.Fatalf("GetByProjectID(clone): %v", err)
+	}
+
+	if got, want := collaboratorNames(copies), collaboratorNames(source); !reflect.DeepEqual(got, want) {
+		t.Fatalf("cloned collaborators = %v, want %v", got, want)
+	}
+	for i, copied := range copies {
+		if copied.ID == source[i].ID || copied.ProjectID != clonedProject.ID {
+			t.Errorf("copy of %s has ID %d on project %d, want a new ID on project %d", copied.Name, copied.ID, copied.ProjectID, clonedProject.ID)
+		}
+		if copied.Role != source[i].Role || copied.Position != source[i].Position {
+			t.Errorf("copy of %s = role %q position %d, want %q %d", copied.Name, copied.Role, copied.Position, source[i].Role, source[i].Position)
+		}
+	}
+}
@@ -2,22 +2,29 @@ package repositories
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
 	dto2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/entities"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/searchindex"
+	"github.com/lib/pq"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // projectRepository implements the ProjectRepository interface using GORM
 type projectRepository struct {
-	db *gorm.DB
+	db           *gorm.DB
+	searchConfig string // Text search configuration of the search vectors (see searchindex)
 }
 
 // NewProjectRepository creates a new project repository instance
-func NewProjectRepository(db *gorm.DB) contracts.ProjectRepository {
-	return &projectRepository{db: db}
+func NewProjectRepository(db *gorm.DB, searchConfig string) contracts.ProjectRepository {
+	return &projectRepository{db: db, searchConfig: searchConfig}
 }
 
 // Create creates a new project
@@ -32,9 +39,22 @@ func (r *projectRepository) Create(ctx context.Context, input dto2.CreateProject
 		Link:        input.Link,
 		CategoryID:  input.CategoryID,
 		OwnerID:     input.OwnerID,
+		Hidden:      input.Hidden,
+		CreatedBy:   actorOr(ctx, input.OwnerID),
+		UpdatedBy:   actorOr(ctx, input.OwnerID),
 	}
 
-	if err := r.db.WithContext(ctx).Create(record).Error; err != nil {
+	// New projects go last in their category
+	err := inTransaction(ctx, r.db, func(tx *gorm.DB) error {
+		position, err := nextPosition(tx, "projects", "categories", "category_id", record.CategoryID)
+		if err != nil {
+			return err
+		}
+		record.Position = position
+
+		return tx.Create(record).Error
+	})
+	if err != nil {
 		return nil, fmt.Errorf("failed to create project: %w", err)
 	}
 
@@ -54,10 +74,97 @@ func (r *projectRepository) GetByID(ctx context.Context, id uint) (*dto2.Project
 	return r.recordToDTO(&record), nil
 }
 
+// projectContextColumns selects the breadcrumb context of a project from the joined tables
+const projectContextColumns = "categories.title AS category_title, categories.owner_id AS category_owner_id, " +
+	"categories.portfolio_id AS portfolio_id, portfolios.title AS portfolio_title"
+
+// projectContextRow is the scan target of GetContextByIDs
+type projectContextRow struct {
+	ProjectID       uint
+	CategoryID      uint
+	CategoryTitle   string
+	CategoryOwnerID string
+	PortfolioID     uint
+	PortfolioTitle  string
+}
+
+// withProjectContext joins the live category and portfolio of each project
+func withProjectContext(db *gorm.DB) *gorm.DB {
+	return db.
+		Joins("JOIN categories ON categories.id = projects.category_id AND categories.deleted_at IS NULL").
+		Joins("JOIN portfolios ON portfolios.id = categories.portfolio_id AND portfolios.deleted_at IS NULL")
+}
+
+// publishedCategoryIDs selects the live categories of live, published portfolios
+const publishedCategoryIDs = "SELECT categories.id FROM categories " +
+	"JOIN portfolios ON portfolios.id = categories.portfolio_id AND portfolios.deleted_at IS NULL " +
+	"WHERE categories.deleted_at IS NULL AND portfolios.is_published"
+
+// GetByIDWithContext retrieves a project with its category and portfolio context in one query
+func (r *projectRepository) GetByIDWithContext(ctx context.Context, id uint) (*dto2.ProjectDTO, error) {
+	var row struct {
+		entities.ProjectRecord
+		CategoryTitle   string
+		CategoryOwnerID string
+		PortfolioID     uint
+		PortfolioTitle  string
+	}
+
+	result := withProjectContext(r.db.WithContext(ctx).Model(&entities.ProjectRecord{})).
+		Select("projects.*, "+projectContextColumns).
+		Where("projects.id = ?", id).
+		Limit(1).
+		Scan(&row)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to get project: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, fmt.Errorf("project not found")
+	}
+
+	project := r.recordToDTO(&row.ProjectRecord)
+	project.Context = &dto2.ProjectContextDTO{
+		CategoryID:      row.CategoryID,
+		CategoryTitle:   row.CategoryTitle,
+		CategoryOwnerID: row.CategoryOwnerID,
+		PortfolioID:     row.PortfolioID,
+		PortfolioTitle:  row.PortfolioTitle,
+	}
+	return project, nil
+}
+
+// GetContextByIDs retrieves the category and portfolio context of several projects in one query
+func (r *projectRepository) GetContextByIDs(ctx context.Context, ids []uint) (map[uint]dto2.ProjectContextDTO, error) {
+	contexts := make(map[uint]dto2.ProjectContextDTO, len(ids))
+	if len(ids) == 0 {
+		return contexts, nil
+	}
+
+	var rows []projectContextRow
+	if err := withProjectContext(r.db.WithContext(ctx).Model(&entities.ProjectRecord{})).
+		Select("projects.id AS project_id, projects.category_id AS category_id, "+projectContextColumns).
+		Where("projects.id IN ?", ids).
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to get project context: %w", err)
+	}
+
+	for _, row := range rows {
+		contexts[row.ProjectID] = dto2.ProjectContextDTO{
+			CategoryID:      row.CategoryID,
+			CategoryTitle:   row.CategoryTitle,
+			CategoryOwnerID: row.CategoryOwnerID,
+			PortfolioID:     row.PortfolioID,
+			PortfolioTitle:  row.PortfolioTitle,
+		}
+	}
+
+	return contexts, nil
+}
+
 // GetByIDs retrieves multiple projects by their IDs
 func (r *projectRepository) GetByIDs(ctx context.Context, ids []uint) ([]dto2.ProjectDTO, error) {
 	var records []entities.ProjectRecord
-	if err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&records).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("id IN ?", ids).Order("id ASC").Find(&records).Error; err != nil {
 		return nil, fmt.Errorf("failed to get projects: %w", err)
 	}
 
@@ -69,12 +176,14 @@ func (r *projectRepository) GetByIDs(ctx context.Context, ids []uint) ([]dto2.Pr
 	return dtos, nil
 }
 
-// GetByCategoryID retrieves all projects for a specific category
+// GetByCategoryID retrieves all visible projects for a specific category of a published portfolio
 func (r *projectRepository) GetByCategoryID(ctx context.Context, categoryID uint) ([]dto2.ProjectDTO, error) {
 	var records []entities.ProjectRecord
 	if err := r.db.WithContext(ctx).
 		Where("category_id = ?", categoryID).
-		Order("id ASC").
+		Where("category_id IN (" + publishedCategoryIDs + ")").
+		Where("NOT hidden").
+		Order("position ASC, id ASC").
 		Find(&records).Error; err != nil {
 		return nil, fmt.Errorf("failed to get projects by category: %w", err)
 	}
@@ -87,23 +196,63 @@ func (r *projectRepository) GetByCategoryID(ctx context.Context, categoryID uint
 	return dtos, nil
 }
 
-// GetByOwnerID retrieves all projects owned by a specific user with pagination
-func (r *projectRepository) GetByOwnerID(ctx context.Context, ownerID string, pagination dto2.PaginationDTO) ([]dto2.ProjectDTO, int64, error) {
+// GetByPortfolioID retrieves all projects of a portfolio across its categories
+func (r *projectRepository) GetByPortfolioID(ctx context.Context, portfolioID uint) ([]dto2.ProjectDTO, error) {
+	var records []entities.ProjectRecord
+	if err := r.db.WithContext(ctx).
+		Joins("JOIN categories ON categories.id = projects.category_id AND categories.deleted_at IS NULL").
+		Where("categories.portfolio_id = ?", portfolioID).
+		Order("categories.position ASC, categories.id ASC, projects.position ASC, projects.id ASC").
+		Find(&records).Error; err != nil {
+		return nil, fmt.Errorf("failed to get projects by portfolio: %w", err)
+	}
+
+	dtos := make([]dto2.ProjectDTO, len(records))
+	for i, record := range records {
+		dtos[i] = *r.recordToDTO(&record)
+	}
+
+	return dtos, nil
+}
+
+// GetByOwnerIDFiltered retrieves the projects of a user matching the filter, with pagination
+func (r *projectRepository) GetByOwnerIDFiltered(ctx context.Context, ownerID string, filter dto2.ProjectFilter, pagination dto2.PaginationDTO, sort string) ([]dto2.ProjectDTO, int64, error) {
 	var records []entities.ProjectRecord
 	var total int64
 
-	// Count total
-	if err := r.db.WithContext(ctx).Model(&entities.ProjectRecord{}).
-		Where("owner_id = ?", ownerID).
-		Count(&total).Error; err != nil {
+	filtered := func() *gorm.DB {
+		query := r.db.WithContext(ctx).Model(&entities.ProjectRecord{}).Where("owner_id = ?", ownerID)
+		if filter.CategoryID > 0 {
+			query = query.Where("category_id = ?", filter.CategoryID)
+		}
+		if filter.Client != "" {
+			query = query.Where("client ILIKE ?", "%"+likeEscaper.Replace(filter.Client)+"%")
+		}
+		if filter.Skill != "" {
+			query = query.Where("EXISTS (SELECT 1 FROM unnest(skills) AS skill WHERE lower(skill) = lower(?))", filter.Skill)
+		}
+		return query
+	}
+
+	// Count total (of the filtered projects)
+	if err := filtered().Count(&total).Error; err != nil {
 		return nil, 0, fmt.Errorf("failed to count projects: %w", err)
 	}
 
 	// Get paginated results
+	var order interface{} = "id DESC"
+	if sort == dto2.ProjectListSortLeastViewed {
+		// Never-viewed projects tie at zero views and come first; id keeps pages stable
+		order = clause.OrderBy{Expression: clause.Expr{
+			SQL:                projectViewsInWindow + " ASC, last_viewed_at ASC NULLS FIRST, id DESC",
+			Vars:               []interface{}{projectViewWindowStart(time.Now())},
+			WithoutParentheses: true,
+		}}
+	}
+
 	offset := (pagination.Page - 1) * pagination.Limit
-	if err := r.db.WithContext(ctx).
-		Where("owner_id = ?", ownerID).
-		Order("id DESC").
+	if err := filtered().
+		Order(order).
 		Limit(pagination.Limit).
 		Offset(offset).
 		Find(&records).Error; err != nil {
@@ -118,13 +267,15 @@ func (r *projectRepository) GetByOwnerID(ctx context.Context, ownerID string, pa
 	return dtos, total, nil
 }
 
-// SearchBySkills retrieves projects matching ANY of the specified skills
+// SearchBySkills retrieves visible projects of published portfolios matching ANY of the specified skills
 func (r *projectRepository) SearchBySkills(ctx context.Context, skills []string) ([]dto2.ProjectDTO, error) {
 	var records []entities.ProjectRecord
 
 	// Use PostgreSQL array overlap operator (&&)
 	if err := r.db.WithContext(ctx).
 		Where("skills && ?", skills).
+		Where("category_id IN (" + publishedCategoryIDs + ")").
+		Where("NOT hidden").
 		Order("id DESC").
 		Find(&records).Error; err != nil {
 		return nil, fmt.Errorf("failed to search projects by skills: %w", err)
@@ -138,12 +289,14 @@ func (r *projectRepository) SearchBySkills(ctx context.Context, skills []string)
 	return dtos, nil
 }
 
-// SearchByClient retrieves projects by client name (case-insensitive partial match)
+// SearchByClient retrieves visible projects of published portfolios by client name (case-insensitive partial match)
 func (r *projectRepository) SearchByClient(ctx context.Context, client string) ([]dto2.ProjectDTO, error) {
 	var records []entities.ProjectRecord
 
 	if err := r.db.WithContext(ctx).
 		Where("client ILIKE ?", "%"+client+"%").
+		Where("category_id IN (" + publishedCategoryIDs + ")").
+		Where("NOT hidden").
 		Order("id DESC").
 		Find(&records).Error; err != nil {
 		return nil, fmt.Errorf("failed to search projects by client: %w", err)
@@ -157,6 +310,109 @@ func (r *projectRepository) SearchByClient(ctx context.Context, client string) (
 	return dtos, nil
 }
 
+// likeEscaper escapes the LIKE wildcards of user input (backslash is the default escape in Postgres)
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// SearchPublic searches the visible projects of all live, published portfolios, with their context attached
+func (r *projectRepository) SearchPublic(ctx context.Context, input dto2.SearchPublicProjectsInput) ([]dto2.ProjectDTO, int64, error) {
+	pattern := "%" + likeEscaper.Replace(input.Query) + "%"
+
+	filtered := func() *gorm.DB {
+		query := withProjectContext(r.db.WithContext(ctx).Model(&entities.ProjectRecord{})).
+			Where("portfolios.is_published AND NOT projects.hidden")
+		if input.Query != "" {
+			query = query.Where(
+				"projects.title ILIKE @p OR projects.description ILIKE @p OR projects.client ILIKE @p OR "+
+					"EXISTS (SELECT 1 FROM unnest(projects.skills) AS skill WHERE skill ILIKE @p)",
+				sql.Named("p", pattern),
+			)
+		}
+		if input.Skill != "" {
+			query = query.Where("EXISTS (SELECT 1 FROM unnest(projects.skills) AS skill WHERE lower(skill) = lower(?))", input.Skill)
+		}
+		return query
+	}
+
+	// Count at most TotalCap+1 rows; an exact count over every public project isn't worth the scan
+	var total int64
+	if err := r.db.WithContext(ctx).
+		Table("(?) AS matches", filtered().Select("projects.id").Limit(int(input.TotalCap)+1)).
+		Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count projects: %w", err)
+	}
+
+	query := filtered().Select("projects.*, " + projectContextColumns)
+	if input.Sort == dto2.ProjectSearchSortRelevant && input.Query != "" {
+		// Title matches first, then skills, then description/client
+		query = query.Order(clause.OrderBy{Expression: clause.Expr{
+			SQL: "CASE WHEN projects.title ILIKE ? THEN 0 " +
+				"WHEN EXISTS (SELECT 1 FROM unnest(projects.skills) AS skill WHERE skill ILIKE ?) THEN 1 ELSE 2 END",
+			Vars: []interface{}{pattern, pattern},
+		}})
+	}
+
+	var rows []struct {
+		entities.ProjectRecord
+		CategoryTitle   string
+		CategoryOwnerID string
+		PortfolioID     uint
+		PortfolioTitle  string
+	}
+	offset := (input.Pagination.Page - 1) * input.Pagination.Limit
+	if err := query.
+		Order("projects.created_at DESC, projects.id DESC").
+		Limit(input.Pagination.Limit).
+		Offset(offset).
+		Scan(&rows).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to search projects: %w", err)
+	}
+
+	dtos := make([]dto2.ProjectDTO, len(rows))
+	for i := range rows {
+		dtos[i] = *r.recordToDTO(&rows[i].ProjectRecord)
+		dtos[i].Context = &dto2.ProjectContextDTO{
+			CategoryID:      rows[i].CategoryID,
+			CategoryTitle:   rows[i].CategoryTitle,
+			CategoryOwnerID: rows[i].CategoryOwnerID,
+			PortfolioID:     rows[i].PortfolioID,
+			PortfolioTitle:  rows[i].PortfolioTitle,
+		}
+	}
+
+	return dtos, total, nil
+}
+
+// SearchInPortfolio retrieves the live, visible projects in live categories of a portfolio whose search
+// vector (title, skills, client, description) matches every word of query as a prefix, best ranked first
+func (r *projectRepository) SearchInPortfolio(ctx context.Context, portfolioID uint, query string, limit int) ([]dto2.ProjectDTO, error) {
+	tsquery := searchindex.PrefixQuery(query)
+	if tsquery == "" {
+		return []dto2.ProjectDTO{}, nil
+	}
+
+	var records []entities.ProjectRecord
+	if err := r.db.WithContext(ctx).
+		Joins("JOIN categories ON categories.id = projects.category_id AND categories.deleted_at IS NULL").
+		Where("categories.portfolio_id = ? AND NOT projects.hidden", portfolioID).
+		Where("projects.search_vector @@ to_tsquery(?::regconfig, ?)", r.searchConfig, tsquery).
+		Order(clause.OrderBy{Expression: clause.Expr{
+			SQL:  "ts_rank(projects.search_vector, to_tsquery(?::regconfig, ?)) DESC",
+			Vars: []interface{}{r.searchConfig, tsquery},
+		}}).
+		Order("projects.id ASC").
+		Limit(limit).
+		Find(&records).Error; err != nil {
+		return nil, fmt.Errorf("failed to search projects: %w", err)
+	}
+
+	dtos := make([]dto2.ProjectDTO, len(records))
+	for i, record := range records {
+		dtos[i] = *r.recordToDTO(&record)
+	}
+
+	return dtos, nil
+}
+
 // Update updates an existing project
 func (r *projectRepository) Update(ctx context.Context, input dto2.UpdateProjectInput) error {
 	updates := map[string]interface{}{
@@ -168,20 +424,132 @@ func (r *projectRepository) Update(ctx context.Context, input dto2.UpdateProject
 		"client":      input.Client,
 		"link":        input.Link,
 	}
+	if input.Hidden != nil {
+		updates["hidden"] = *input.Hidden
+	}
 
 	if err := r.db.WithContext(ctx).
 		Model(&entities.ProjectRecord{}).
 		Where("id = ?", input.ID).
-		Updates(updates).Error; err != nil {
+		Updates(withUpdatedBy(ctx, updates)).Error; err != nil {
 		return fmt.Errorf("failed to update project: %w", err)
 	}
 
 	return nil
 }
 
-// Delete deletes a project by ID
+// Patch updates the provided fields of a project
+// Empty main image, client and link are stored as NULL. Moving to another category
+// takes the next position there, in the same transaction.
+func (r *projectRepository) Patch(ctx context.Context, input dto2.PatchProjectInput) error {
+	updates := map[string]interface{}{}
+	if input.Title != nil {
+		updates["title"] = *input.Title
+	}
+	if input.Description != nil {
+		updates["description"] = *input.Description
+	}
+	if input.MainImage != nil {
+		updates["main_image"] = nilIfEmpty(*input.MainImage)
+	}
+	if input.Images != nil {
+		updates["images"] = pq.StringArray(*input.Images)
+	}
+	if input.Skills != nil {
+		updates["skills"] = pq.StringArray(*input.Skills)
+	}
+	if input.Client != nil {
+		updates["client"] = nilIfEmpty(*input.Client)
+	}
+	if input.Link != nil {
+		updates["link"] = nilIfEmpty(*input.Link)
+	}
+	if input.Hidden != nil {
+		updates["hidden"] = *input.Hidden
+	}
+
+	err := inTransaction(ctx, r.db, func(tx *gorm.DB) error {
+		if input.CategoryID != nil {
+			var current entities.ProjectRecord
+			if err := tx.Select("id, category_id").First(&current, input.ID).Error; err != nil {
+				return err
+			}
+			if current.CategoryID != *input.CategoryID {
+				position, err := nextPosition(tx, "projects", "categories", "category_id", *input.CategoryID)
+				if err != nil {
+					return err
+				}
+				updates["category_id"] = *input.CategoryID
+				updates["position"] = position
+			}
+		}
+		if len(updates) == 0 {
+			return nil
+		}
+
+		return tx.Model(&entities.ProjectRecord{}).
+			Where("id = ?", input.ID).
+			Updates(withUpdatedBy(ctx, updates)).Error
+	})
+	if err == gorm.ErrRecordNotFound {
+		return fmt.Errorf("project with ID %d not found", input.ID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to patch project: %w", err)
+	}
+
+	return nil
+}
+
+// nilIfEmpty maps an empty optional text column value to NULL
+func nilIfEmpty(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+// SwapPositions exchanges the positions of two projects of the same category in one transaction
+func (r *projectRepository) SwapPositions(ctx context.Context, firstID, secondID uint) error {
+	return inTransaction(ctx, r.db, func(tx *gorm.DB) error {
+		return swapPositions(ctx, tx, "projects", "category_id", firstID, secondID)
+	})
+}
+
+// BulkUpdatePositions updates positions for multiple projects in a transaction
+func (r *projectRepository) BulkUpdatePositions(ctx context.Context, input dto2.BulkUpdateProjectPositionsInput) error {
+	return inTransaction(ctx, r.db, func(tx *gorm.DB) error {
+		ids := make([]uint, len(input.Items))
+		for i, item := range input.Items {
+			ids[i] = item.ID
+		}
+		if err := assertSameParent(tx, "projects", "category_id", ids); err != nil {
+			return err
+		}
+
+		for _, item := range input.Items {
+			if err := tx.Model(&entities.ProjectRecord{}).
+				Where("id = ?", item.ID).
+				Updates(withUpdatedBy(ctx, map[string]interface{}{"position": item.Position})).Error; err != nil {
+				return fmt.Errorf("failed to update position for project %d: %w", item.ID, err)
+			}
+		}
+		return nil
+	})
+}
+
+// Delete deletes a project and its collaborators by ID (one soft-delete batch)
 func (r *projectRepository) Delete(ctx context.Context, id uint) error {
-	if err := r.db.WithContext(ctx).Delete(&entities.ProjectRecord{}, id).Error; err != nil {
+	batchID, err := newDeleteBatchID()
+	if err != nil {
+		return err
+	}
+
+	err = inTransaction(ctx, r.db, func(tx *gorm.DB) error {
+		_, err := softDeleteProjectCascade(tx, batchID, time.Now(), "id = ?", id)
+		return err
+	})
+	if err != nil {
 		return fmt.Errorf("failed to delete project: %w", err)
 	}
 
@@ -199,9 +567,13 @@ func (r *projectRepository) recordToDTO(record *entities.ProjectRecord) *dto2.Pr
 		Skills:      record.Skills,
 		Client:      record.Client,
 		Link:        record.Link,
+		Position:    record.Position,
 		CategoryID:  record.CategoryID,
 		OwnerID:     record.OwnerID,
+		Hidden:      record.Hidden,
 		CreatedAt:   record.CreatedAt,
 		UpdatedAt:   record.UpdatedAt,
+		CreatedBy:   record.CreatedBy,
+		UpdatedBy:   record.UpdatedBy,
 	}
 }
@@ -0,0 +1,243 @@
+package repositories_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/entities"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/pgtest"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/repositories"
+	"github.com/lib/pq"
+)
+
+func projectIDs(projects []dto.ProjectDTO) []uint {
+	ids := make([]uint, len(projects))
+	for i, project := range projects {
+		ids[i] = project.ID
+	}
+	return ids
+}
+
+func containsID(ids []uint, id uint) bool {
+	for _, candidate := range ids {
+		if candidate == id {
+			return true
+		}
+	}
+	return false
+}
+
+func TestProjectRepository_HiddenProjects(t *testing.T) {
+	db := pgtest.Open(t)
+	projects := repositories.NewProjectRepository(db, pgtest.SearchConfig)
+	categories := repositories.NewCategoryRepository(db)
+	ctx := context.Background()
+
+	tr := seedTree(t, db, "alice", "visible")
+	client := "Acme"
+	if err := db.Model(&tr.Project).Updates(map[string]interface{}{"skills": pq.StringArray{"go"}, "client": client}).Error; err != nil {
+		t.Fatalf("update project: %v", err)
+	}
+	hidden := entities.ProjectRecord{
+		Title: "Acme secret", Description: "under NDA", Skills: pq.StringArray{"go"}, Client: &client,
+		Position: 2, CategoryID: tr.Category.ID, OwnerID: "alice", Hidden: true,
+	}
+	create(t, db, &hidden)
+
+	page := dto.PaginationDTO{Page: 1, Limit: 10}
+	tests := []struct {
+		name       string
+		list       func() ([]uint, error)
+		wantHidden bool
+	}{
+		{name: "public category projects", list: func() ([]uint, error) {
+			found, err := projects.GetByCategoryID(ctx, tr.Category.ID)
+			return projectIDs(found), err
+		}},
+		{name: "public skill search", list: func() ([]uint, error) {
+			found, err := projects.SearchBySkills(ctx, []string{"go"})
+			return projectIDs(found), err
+		}},
+		{name: "public client search", list: func() ([]uint, error) {
+			found, err := projects.SearchByClient(ctx, "acme")
+			return projectIDs(found), err
+		}},
+		{name: "public discovery search", list: func() ([]uint, error) {
+			found, _, err := projects.SearchPublic(ctx, dto.SearchPublicProjectsInput{Query: "acme", Pagination: page, TotalCap: 100})
+			return projectIDs(found), err
+		}},
+		{name: "public portfolio search", list: func() ([]uint, error) {
+			found, err := projects.SearchInPortfolio(ctx, tr.Portfolio.ID, "acme", 10)
+			return projectIDs(found), err
+		}},
+		{name: "owner portfolio projects", wantHidden: true, list: func() ([]uint, error) {
+			found, err := projects.GetByPortfolioID(ctx, tr.Portfolio.ID)
+			return projectIDs(found), err
+		}},
+		{name: "owner project list", wantHidden: true, list: func() ([]uint, error) {
+			found, _, err := projects.GetByOwnerIDFiltered(ctx, "alice", dto.ProjectFilter{}, page, "")
+			return projectIDs(found), err
+		}},
+		{name: "owner category detail", wantHidden: true, list: func() ([]uint, error) {
+			detail, err := categories.GetOwnerCategoryDetail(ctx, dto.CategoryDetailInput{CategoryID: tr.Category.ID, OwnerID: "alice", Pagination: page})
+			if err != nil {
+				return nil, err
+			}
+			var ids []uint
+			for _, project := range detail.Projects {
+				if project.Hidden != (project.ID == hidden.ID) {
+					t.Errorf("category detail project %d: hidden = %v", project.ID, project.Hidden)
+				}
+				ids = append(ids, project.ID)
+			}
+			return ids, nil
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ids, err := tt.list()
+			if err != nil {
+				t.Fatalf("query: %v", err)
+			}
+			if !containsID(ids, tr.Project.ID) {
+				t.Errorf("visible project %d missing from %v", tr.Project.ID, ids)
+			}
+			if containsID(ids, hidden.ID) != tt.wantHidden {
+				t.Errorf("hidden project %d listed = %v, want %v (got %v)", hidden.ID, !tt.wantHidden, tt.wantHidden, ids)
+			}
+		})
+	}
+
+	// Owners read a hidden project by ID with the flag set
+	found, err := projects.GetByID(ctx, hidden.ID)
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	if !found.Hidden {
+		t.Error("GetByID() of a hidden project returned hidden = false")
+	}
+}
+
+func TestProjectRepository_PatchSkillsOnly(t *testing.T) {
+	db := pgtest.Open(t)
+	projects := repositories.NewProjectRepository(db, pgtest.SearchConfig)
+	ctx := context.Background()
+
+	tr := seedTree(t, db, "alice", "patched")
+	client, link, image := "Acme", "https://acme.example.com", "/uploads/cover.png"
+	if err := db.Model(&tr.Project).Updates(map[string]interface{}{
+		"client": client, "link": link, "main_image": image,
+		"images": pq.StringArray{"/uploads/a.png"}, "skills": pq.StringArray{"go"},
+	}).Error; err != nil {
+		t.Fatalf("update project: %v", err)
+	}
+	var before entities.ProjectRecord
+	if err := db.First(&before, tr.Project.ID).Error; err != nil {
+		t.Fatalf("load project: %v", err)
+	}
+
+	skills := []string{"go", "postgres"}
+	if err := projects.Patch(ctx, dto.PatchProjectInput{ID: tr.Project.ID, Skills: &skills, OwnerID: "alice"}); err != nil {
+		t.Fatalf("Patch: %v", err)
+	}
+
+	var after entities.ProjectRecord
+	if err := db.First(&after, tr.Project.ID).Error; err != nil {
+		t.Fatalf("load project: %v", err)
+	}
+	if !reflect.DeepEqual([]string(after.Skills), skills) {
+		t.Errorf("skills = %v, want %v", after.Skills, skills)
+	}
+	// Everything but the skills and the update bookkeeping is as before
+	after.Skills, after.UpdatedAt, after.UpdatedBy = before.Skills, before.UpdatedAt, before.UpdatedBy
+	if !reflect.DeepEqual(after, before) {
+		t.Errorf("patching skills changed other fields:\n got %+v\nwant %+v", after, before)
+	}
+}
+
+func TestProjectRepository_PatchMovesToEndOfCategory(t *testing.T) {
+	db := pgtest.Open(t)
+	projects := repositories.NewProjectRepository(db, pgtest.SearchConfig)
+	ctx := context.Background()
+
+	tr := seedTree(t, db, "alice", "moved")
+	target := entities.CategoryRecord{Title: "target", Position: 2, OwnerID: "alice", PortfolioID: tr.Portfolio.ID}
+	create(t, db, &target)
+	for position := 1; position <= 2; position++ {
+		create(t, db, &entities.ProjectRecord{
+			Title: "resident", Description: "already there", Position: uint(position), CategoryID: target.ID, OwnerID: "alice",
+		})
+	}
+
+	if err := projects.Patch(ctx, dto.PatchProjectInput{ID: tr.Project.ID, CategoryID: &target.ID, OwnerID: "alice"}); err != nil {
+		t.Fatalf("Patch: %v", err)
+	}
+
+	var moved entities.ProjectRecord
+	if err := db.First(&moved, tr.Project.ID).Error; err != nil {
+		t.Fatalf("load project: %v", err)
+	}
+	if moved.CategoryID != target.ID || moved.Position != 3 {
+		t.Errorf("project in category %d at %d, want category %d at 3", moved.CategoryID, moved.Position, target.ID)
+	}
+	if moved.Title != tr.Project.Title {
+		t.Errorf("title = %q, want it unchanged %q", moved.Title, tr.Project.Title)
+	}
+}
+
+func TestProjectRepository_GetByOwnerIDFiltered(t *testing.T) {
+	db := pgtest.Open(t)
+	projects := repositories.NewProjectRepository(db, pgtest.SearchConfig)
+	ctx := context.Background()
+
+	tr := seedTree(t, db, "alice", "filters")
+	other := entities.CategoryRecord{Title: "other category", Position: 2, OwnerID: "alice", PortfolioID: tr.Portfolio.ID}
+	create(t, db, &other)
+
+	seed := func(categoryID uint, client string, skills ...string) uint {
+		record := entities.ProjectRecord{Title: "filtered " + client, Description: "filtered", Skills: pq.StringArray(skills), Client: &client, Position: 9, OwnerID: "alice", CategoryID: categoryID}
+		create(t, db, &record)
+		return record.ID
+	}
+	acmeGo := seed(tr.Category.ID, "ACME Corp", "Go", "SQL")
+	acmeRust := seed(other.ID, "acme labs", "rust")
+	percent := seed(other.ID, "100% Growth", "go")
+	bobs := seedTree(t, db, "bob", "bobs")
+	if err := db.Model(&bobs.Project).Updates(map[string]interface{}{"skills": pq.StringArray{"go"}, "client": "Acme"}).Error; err != nil {
+		t.Fatalf("update bob's project: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		filter dto.ProjectFilter
+		want   []uint // newest first
+	}{
+		{name: "no filter", filter: dto.ProjectFilter{}, want: []uint{percent, acmeRust, acmeGo, tr.Project.ID}},
+		{name: "category", filter: dto.ProjectFilter{CategoryID: other.ID}, want: []uint{percent, acmeRust}},
+		{name: "client is a partial match ignoring case", filter: dto.ProjectFilter{Client: "acme"}, want: []uint{acmeRust, acmeGo}},
+		{name: "percent in client is literal", filter: dto.ProjectFilter{Client: "0%"}, want: []uint{percent}},
+		{name: "skill is an exact member ignoring case", filter: dto.ProjectFilter{Skill: "GO"}, want: []uint{percent, acmeGo}},
+		{name: "skill is not a prefix", filter: dto.ProjectFilter{Skill: "S"}, want: []uint{}},
+		{name: "filters combine with AND", filter: dto.ProjectFilter{CategoryID: other.ID, Client: "acme", Skill: "rust"}, want: []uint{acmeRust}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			found, total, err := projects.GetByOwnerIDFiltered(ctx, "alice", tt.filter, dto.PaginationDTO{Page: 1, Limit: 50}, "")
+			if err != nil {
+				t.Fatalf("GetByOwnerIDFiltered: %v", err)
+			}
+			if got := projectIDs(found); !reflect.DeepEqual(got, tt.want) || total != int64(len(tt.want)) {
+				t.Errorf("projects = %v (total %d), want %v", got, total, tt.want)
+			}
+		})
+	}
+
+	// The total counts the whole filtered list, not the page
+	found, total, err := projects.GetByOwnerIDFiltered(ctx, "alice", dto.ProjectFilter{Client: "acme"}, dto.PaginationDTO{Page: 1, Limit: 1}, "")
+	if err != nil || len(found) != 1 || total != 2 {
+		t.Errorf("first page of one = %v (total %d), %v, want one project of 2", projectIDs(found), total, err)
+	}
+}
@@ -0,0 +1,151 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/entities"
+	"gorm.io/gorm"
+)
+
+// projectViewFlushChunk bounds the rows of one flush statement (and so its bind parameters)
+const projectViewFlushChunk = 1000
+
+// projectViewRepository is the GORM implementation of ProjectViewRepository
+type projectViewRepository struct {
+	db *gorm.DB
+}
+
+// NewProjectViewRepository creates a new project view repository instance
+// Returns the interface type (contracts.ProjectViewRepository), not the concrete type
+func NewProjectViewRepository(db *gorm.DB) contracts.ProjectViewRepository {
+	return &projectViewRepository{db: db}
+}
+
+// projectViewWindowStart is the first day counted by the owner-facing view count (today included)
+func projectViewWindowStart(now time.Time) time.Time {
+	now = now.UTC()
+	return time.Date(now.Year(), now.Month(), now.Day()-(dto.ProjectViewWindowDays-1), 0, 0, 0, 0, time.UTC)
+}
+
+// projectViewsInWindow is the SQL view count of projects.id over the window (one bind: the window start)
+const projectViewsInWindow = "(SELECT COALESCE(SUM(project_view_days.views), 0) FROM project_view_days " +
+	"WHERE project_view_days.project_id = projects.id AND project_view_days.day >= ?)"
+
+// Flush writes the batches in one transaction: an upsert of the daily counts and a single
+// UPDATE of projects with one row per project, so a project viewed a thousand times is
+// written once. last_viewed_at only moves forward, whichever instance flushes first.
+func (r *projectViewRepository) Flush(ctx context.Context, batches []dto.ProjectViewBatchDTO) error {
+	if len(batches) == 0 {
+		return nil
+	}
+
+	lastViews := make(map[uint]time.Time)
+	for _, batch := range batches {
+		if batch.LastViewedAt.After(lastViews[batch.ProjectID]) {
+			lastViews[batch.ProjectID] = batch.LastViewedAt
+		}
+	}
+	projectIDs := make([]uint, 0, len(lastViews))
+	for id := range lastViews {
+		projectIDs = append(projectIDs, id)
+	}
+
+	err := inTransaction(ctx, r.db, func(tx *gorm.DB) error {
+		for start := 0; start < len(batches); start += projectViewFlushChunk {
+			chunk := batches[start:min(start+projectViewFlushChunk, len(batches))]
+
+			values := make([]string, len(chunk))
+			args := make([]interface{}, 0, 3*len(chunk))
+			for i, batch := range chunk {
+				values[i] = "(?::bigint, ?::date, ?::bigint)"
+				args = append(args, batch.ProjectID, batch.Day, batch.Views)
+			}
+
+			// Views of projects purged since they were recorded would fail the foreign key
+			if err := tx.Exec(
+				"INSERT INTO project_view_days (project_id, day, views) "+
+					"SELECT v.project_id, v.day, v.views FROM (VALUES "+strings.Join(values, ", ")+") AS v(project_id, day, views) "+
+					"WHERE EXISTS (SELECT 1 FROM projects WHERE projects.id = v.project_id) "+
+					"ON CONFLICT (project_id, day) DO UPDATE SET views = project_view_days.views + EXCLUDED.views",
+				args...,
+			).Error; err != nil {
+				return err
+			}
+		}
+
+		for start := 0; start < len(projectIDs); start += projectViewFlushChunk {
+			chunk := projectIDs[start:min(start+projectViewFlushChunk, len(projectIDs))]
+
+			values := make([]string, len(chunk))
+			args := make([]interface{}, 0, 2*len(chunk))
+			for i, id := range chunk {
+				values[i] = "(?::bigint, ?::timestamptz)"
+				args = append(args, id, lastViews[id])
+			}
+
+			// Raw UPDATE: updated_at and updated_by describe edits, not visits
+			if err := tx.Exec(
+				"UPDATE projects SET last_viewed_at = v.viewed_at "+
+					"FROM (VALUES "+strings.Join(values, ", ")+") AS v(id, viewed_at) "+
+					"WHERE projects.id = v.id AND (projects.last_viewed_at IS NULL OR projects.last_viewed_at < v.viewed_at)",
+				args...,
+			).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to flush project views: %w", err)
+	}
+
+	return nil
+}
+
+// projectViewStatsRow is the scan target of GetStats
+type projectViewStatsRow struct {
+	ID           uint
+	LastViewedAt *time.Time
+	Views        uint
+}
+
+// GetStats retrieves the last view and window count of the projects in one query
+func (r *projectViewRepository) GetStats(ctx context.Context, projectIDs []uint) (map[uint]dto.ProjectViewStatsDTO, error) {
+	stats := make(map[uint]dto.ProjectViewStatsDTO, len(projectIDs))
+	if len(projectIDs) == 0 {
+		return stats, nil
+	}
+
+	var rows []projectViewStatsRow
+	if err := r.db.WithContext(ctx).
+		Model(&entities.ProjectRecord{}).
+		Select("projects.id, projects.last_viewed_at, "+projectViewsInWindow+" AS views", projectViewWindowStart(time.Now())).
+		Where("projects.id IN ?", projectIDs).
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to get project views: %w", err)
+	}
+
+	for _, row := range rows {
+		stats[row.ID] = dto.ProjectViewStatsDTO{LastViewedAt: row.LastViewedAt, Last30Days: row.Views}
+	}
+
+	return stats, nil
+}
+
+// PurgeDaysBefore deletes the daily view counts older than day
+func (r *projectViewRepository) PurgeDaysBefore(ctx context.Context, day time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("day < ?", day).
+		Delete(&entities.ProjectViewDayRecord{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to purge project views: %w", result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,84 @@
+package repositories_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/entities"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/pgtest"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/repositories"
+)
+
+func TestProjectViewRepository_Flush(t *testing.T) {
+	db := pgtest.Open(t)
+	ctx := context.Background()
+	repo := repositories.NewProjectViewRepository(db)
+
+	viewed := seedTree(t, db, "alice", "viewed")
+	unviewed := seedTree(t, db, "alice", "unviewed")
+	purged := seedTree(t, db, "alice", "purged")
+
+	now := time.Now().UTC().Truncate(time.Second)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	lastMonth := today.AddDate(0, 0, -dto.ProjectViewWindowDays)
+
+	// The purged project is hard-deleted between the views and the flush
+	if err := db.Unscoped().Delete(&entities.ProjectRecord{}, purged.Project.ID).Error; err != nil {
+		t.Fatalf("purge project: %v", err)
+	}
+
+	var before entities.ProjectRecord
+	if err := db.First(&before, viewed.Project.ID).Error; err != nil {
+		t.Fatalf("load project: %v", err)
+	}
+
+	flushes := [][]dto.ProjectViewBatchDTO{
+		{
+			{ProjectID: viewed.Project.ID, Day: today, Views: 3, LastViewedAt: now},
+			{ProjectID: viewed.Project.ID, Day: lastMonth, Views: 5, LastViewedAt: lastMonth.Add(time.Hour)},
+			{ProjectID: purged.Project.ID, Day: today, Views: 1, LastViewedAt: now},
+		},
+		// A later flush of older views (another instance) adds to the day without moving last_viewed_at back
+		{{ProjectID: viewed.Project.ID, Day: today, Views: 2, LastViewedAt: now.Add(-time.Hour)}},
+	}
+	for i, batches := range flushes {
+		if err := repo.Flush(ctx, batches); err != nil {
+			t.Fatalf("flush %d: %v", i+1, err)
+		}
+	}
+
+	stats, err := repo.GetStats(ctx, []uint{viewed.Project.ID, unviewed.Project.ID})
+	if err != nil {
+		t.Fatalf("GetStats: %v", err)
+	}
+	got := stats[viewed.Project.ID]
+	// The day outside the window is stored but not counted
+	if got.Last30Days != 5 || got.LastViewedAt == nil || !got.LastViewedAt.Equal(now) {
+		t.Errorf("viewed stats = %d views, last %v, want 5 views, last %v", got.Last30Days, got.LastViewedAt, now)
+	}
+	if got, ok := stats[unviewed.Project.ID]; !ok || got.Last30Days != 0 || got.LastViewedAt != nil {
+		t.Errorf("unviewed stats = %+v (present %v), want zero values", got, ok)
+	}
+
+	var purgedDays int64
+	db.Model(&entities.ProjectViewDayRecord{}).Where("project_id = ?", purged.Project.ID).Count(&purgedDays)
+	if purgedDays != 0 {
+		t.Errorf("%d view days stored for a purged project, want 0", purgedDays)
+	}
+
+	// Visits are not edits
+	var project entities.ProjectRecord
+	if err := db.First(&project, viewed.Project.ID).Error; err != nil {
+		t.Fatalf("reload project: %v", err)
+	}
+	if !project.UpdatedAt.Equal(before.UpdatedAt) {
+		t.Errorf("updated_at moved from %v to %v on a flush", before.UpdatedAt, project.UpdatedAt)
+	}
+
+	purgedRows, err := repo.PurgeDaysBefore(ctx, today.AddDate(0, 0, -(dto.ProjectViewWindowDays-1)))
+	if err != nil || purgedRows != 1 {
+		t.Errorf("PurgeDaysBefore = %d, %v, want the day outside the window", purgedRows, err)
+	}
+}
@@ -0,0 +1,93 @@
+package repositories_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/pgtest"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/repositories"
+	"github.com/lib/pq"
+)
+
+func TestDraftPortfolios(t *testing.T) {
+	db := pgtest.Open(t)
+	ctx := context.Background()
+	portfolios := repositories.NewPortfolioRepository(db, false)
+	projects := repositories.NewProjectRepository(db, pgtest.SearchConfig)
+
+	tr := seedTree(t, db, "alice", "draft")
+	if err := db.Model(&tr.Project).Updates(map[string]interface{}{"skills": pq.StringArray{"go"}, "client": "Acme"}).Error; err != nil {
+		t.Fatalf("update project: %v", err)
+	}
+	if err := portfolios.SetPublished(ctx, tr.Portfolio.ID, false); err != nil {
+		t.Fatalf("SetPublished(false): %v", err)
+	}
+
+	page := dto.PaginationDTO{Page: 1, Limit: 10}
+	lists := []struct {
+		name      string
+		list      func() ([]uint, error)
+		wantDraft bool
+	}{
+		{name: "public category projects", list: func() ([]uint, error) {
+			found, err := projects.GetByCategoryID(ctx, tr.Category.ID)
+			return projectIDs(found), err
+		}},
+		{name: "public skill search", list: func() ([]uint, error) {
+			found, err := projects.SearchBySkills(ctx, []string{"go"})
+			return projectIDs(found), err
+		}},
+		{name: "public client search", list: func() ([]uint, error) {
+			found, err := projects.SearchByClient(ctx, "acme")
+			return projectIDs(found), err
+		}},
+		{name: "public discovery search", list: func() ([]uint, error) {
+			found, _, err := projects.SearchPublic(ctx, dto.SearchPublicProjectsInput{Query: "acme", Pagination: page, TotalCap: 100})
+			return projectIDs(found), err
+		}},
+		{name: "owner project list", wantDraft: true, list: func() ([]uint, error) {
+			found, _, err := projects.GetByOwnerIDFiltered(ctx, "alice", dto.ProjectFilter{}, page, "")
+			return projectIDs(found), err
+		}},
+		{name: "owner portfolio projects", wantDraft: true, list: func() ([]uint, error) {
+			found, err := projects.GetByPortfolioID(ctx, tr.Portfolio.ID)
+			return projectIDs(found), err
+		}},
+	}
+
+	for _, published := range []bool{false, true} {
+		if err := portfolios.SetPublished(ctx, tr.Portfolio.ID, published); err != nil {
+			t.Fatalf("SetPublished(%v): %v", published, err)
+		}
+		if got, err := portfolios.IsPublished(ctx, tr.Portfolio.ID); err != nil || got != published {
+			t.Errorf("IsPublished = %v, %v, want %v", got, err, published)
+		}
+
+		for _, tt := range lists {
+			ids, err := tt.list()
+			if err != nil {
+				t.Fatalf("%s: %v", tt.name, err)
+			}
+			if want := published || tt.wantDraft; containsID(ids, tr.Project.ID) != want {
+				t.Errorf("%s with published = %v: project listed = %v, want %v", tt.name, published, !want, want)
+			}
+		}
+	}
+
+	// New portfolios start as drafts; a trashed one is never published
+	created, err := portfolios.Create(ctx, dto.CreatePortfolioInput{Title: "fresh", OwnerID: "alice"})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if created.IsPublished {
+		t.Error("new portfolio is published, want a draft")
+	}
+	softDelete(t, db, &tr.Portfolio)
+	if got, err := portfolios.IsPublished(ctx, tr.Portfolio.ID); err != nil || got {
+		t.Errorf("IsPublished(trashed) = %v, %v, want false", got, err)
+	}
+	if err := portfolios.SetPublished(ctx, 9999, true); err == nil {
+		t.Error("SetPublished of a missing portfolio succeeded")
+	}
+}
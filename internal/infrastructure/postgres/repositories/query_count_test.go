@@ -0,0 +1,121 @@
+package repositories_test
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/usecases/project"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/entities"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/pgtest"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/repositories"
+	"gorm.io/gorm"
+)
+
+// countStatements counts the SQL statements db runs from now on
+func countStatements(t *testing.T, db *gorm.DB) *atomic.Int64 {
+	t.Helper()
+
+	var count atomic.Int64
+	inc := func(*gorm.DB) { count.Add(1) }
+	callbacks := db.Callback()
+	for name, err := range map[string]error{
+		"query": callbacks.Query().After("gorm:query").Register("test:count_query", inc),
+		"row":   callbacks.Row().After("gorm:row").Register("test:count_row", inc),
+		"raw":   callbacks.Raw().After("gorm:raw").Register("test:count_raw", inc),
+	} {
+		if err != nil {
+			t.Fatalf("register %s counter: %v", name, err)
+		}
+	}
+	return &count
+}
+
+// addProjects adds n projects after the one seedTree created in its category
+func addProjects(t *testing.T, db *gorm.DB, tr *tree, n int) {
+	t.Helper()
+
+	for i := 0; i < n; i++ {
+		create(t, db, &entities.ProjectRecord{
+			Title: fmt.Sprintf("extra %d", i), Description: "listed", Position: uint(i + 2),
+			CategoryID: tr.Category.ID, OwnerID: tr.Portfolio.OwnerID,
+		})
+	}
+}
+
+func TestListQueriesDoNotGrowWithItems(t *testing.T) {
+	lists := []struct {
+		name string
+		run  func(ctx context.Context, db *gorm.DB, tr *tree) (int, error)
+	}{
+		{
+			name: "own projects with context",
+			run: func(ctx context.Context, db *gorm.DB, tr *tree) (int, error) {
+				uc := project.NewListProjectsUseCase(
+					repositories.NewProjectRepository(db, pgtest.SearchConfig),
+					repositories.NewProjectViewRepository(db),
+				)
+				out, err := uc.Execute(ctx, dto.ListProjectsInput{
+					OwnerID:        tr.Portfolio.OwnerID,
+					Pagination:     dto.PaginationDTO{Page: 1, Limit: 50},
+					IncludeContext: true,
+				})
+				if err != nil {
+					return 0, err
+				}
+				return len(out.Projects), nil
+			},
+		},
+		{
+			name: "owner category detail",
+			run: func(ctx context.Context, db *gorm.DB, tr *tree) (int, error) {
+				detail, err := repositories.NewCategoryRepository(db).GetOwnerCategoryDetail(ctx, dto.CategoryDetailInput{
+					CategoryID: tr.Category.ID,
+					OwnerID:    tr.Portfolio.OwnerID,
+					Pagination: dto.PaginationDTO{Page: 1, Limit: 50},
+				})
+				if err != nil {
+					return 0, err
+				}
+				return len(detail.Projects), nil
+			},
+		},
+		{
+			name: "portfolio export",
+			run: func(ctx context.Context, db *gorm.DB, tr *tree) (int, error) {
+				export, err := repositories.NewPortfolioRepository(db, false).Export(ctx, tr.Portfolio.ID)
+				if err != nil {
+					return 0, err
+				}
+				return len(export.Categories[0].Projects), nil
+			},
+		},
+	}
+
+	for _, list := range lists {
+		t.Run(list.name, func(t *testing.T) {
+			statements := make(map[int]int64)
+			for _, n := range []int{1, 10} {
+				db := pgtest.Open(t)
+				tr := seedTree(t, db, "alice", "listed")
+				addProjects(t, db, tr, n-1)
+				count := countStatements(t, db)
+
+				listed, err := list.run(context.Background(), db, tr)
+				if err != nil {
+					t.Fatalf("list %d items: %v", n, err)
+				}
+				if listed != n {
+					t.Fatalf("listed %d items, want %d", listed, n)
+				}
+				statements[n] = count.Load()
+			}
+
+			if statements[10] != statements[1] {
+				t.Errorf("%d statements for 1 item, %d for 10: the count grows with the items", statements[1], statements[10])
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package repositories_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/entities"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/pgtest"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/searchindex"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+// matches reports whether the search vector of a row matches a prefix query
+func matches(t *testing.T, db *gorm.DB, table string, id uint, text string) bool {
+	t.Helper()
+
+	var match bool
+	if err := db.Raw(
+		"SELECT COALESCE("+searchindex.Column+" @@ to_tsquery(?, ?), false) FROM "+table+" WHERE id = ?",
+		pgtest.SearchConfig, searchindex.PrefixQuery(text), id,
+	).Scan(&match).Error; err != nil {
+		t.Fatalf("match %s %d: %v", table, id, err)
+	}
+	return match
+}
+
+// vectors returns the search vectors of a table as text keyed by ID
+func vectors(t *testing.T, db *gorm.DB, table string) map[uint]string {
+	t.Helper()
+
+	var rows []struct {
+		ID     uint
+		Vector *string
+	}
+	if err := db.Raw("SELECT id, " + searchindex.Column + "::text AS vector FROM " + table).Scan(&rows).Error; err != nil {
+		t.Fatalf("read vectors of %s: %v", table, err)
+	}
+	got := make(map[uint]string, len(rows))
+	for _, row := range rows {
+		if row.Vector != nil {
+			got[row.ID] = *row.Vector
+		}
+	}
+	return got
+}
+
+func TestSearchIndex_TriggersKeepVectorsCurrent(t *testing.T) {
+	db := pgtest.Open(t)
+	tr := seedTree(t, db, "alice", "alpha")
+
+	// Inserts get a vector from every source
+	if !matches(t, db, "projects", tr.Project.ID, "alpha proj") {
+		t.Error("inserted project does not match its title")
+	}
+	if !matches(t, db, "section_contents", tr.SectionContent.ID, "text of alpha") {
+		t.Error("inserted section content does not match its content")
+	}
+
+	// Updating a source column recomputes the vector
+	if err := db.Model(&entities.ProjectRecord{}).Where("id = ?", tr.Project.ID).
+		Updates(map[string]interface{}{"title": "Renamed", "skills": pq.StringArray{"kubernetes"}}).Error; err != nil {
+		t.Fatalf("update project: %v", err)
+	}
+	if !matches(t, db, "projects", tr.Project.ID, "renamed kube") {
+		t.Error("updated project does not match its new title and skill")
+	}
+	if matches(t, db, "projects", tr.Project.ID, "alpha proj") {
+		t.Error("updated project still matches its old title")
+	}
+
+	// Re-running the setup keeps the triggers working
+	if err := searchindex.EnsureTriggers(db, pgtest.SearchConfig); err != nil {
+		t.Fatalf("EnsureTriggers again: %v", err)
+	}
+	if err := db.Model(&entities.SectionRecord{}).Where("id = ?", tr.Section.ID).Update("title", "Biography").Error; err != nil {
+		t.Fatalf("update section: %v", err)
+	}
+	if !matches(t, db, "sections", tr.Section.ID, "bio") {
+		t.Error("section updated after a second setup does not match its new title")
+	}
+}
+
+func TestSearchIndex_RebuildIsIdempotent(t *testing.T) {
+	db := pgtest.Open(t)
+	ctx := context.Background()
+	seedTree(t, db, "alice", "alpha")
+	seedTree(t, db, "alice", "beta")
+	trashed := seedTree(t, db, "alice", "gamma")
+	softDelete(t, db, &trashed.Project)
+
+	index, _ := searchindex.Lookup("projects")
+	want := vectors(t, db, index.Table)
+
+	// Rows written while the triggers were missing have no vector; setting the column fires no trigger
+	if err := db.Exec("UPDATE " + index.Table + " SET " + searchindex.Column + " = NULL").Error; err != nil {
+		t.Fatalf("clear vectors: %v", err)
+	}
+	missing, err := searchindex.CountMissing(ctx, db)
+	if err != nil {
+		t.Fatalf("CountMissing: %v", err)
+	}
+	if missing[index.Table] != 2 {
+		t.Errorf("missing %s vectors = %d, want the 2 live rows", index.Table, missing[index.Table])
+	}
+
+	for run := 1; run <= 2; run++ {
+		var batches int
+		rebuilt, err := searchindex.Rebuild(ctx, db, index, pgtest.SearchConfig, 0, 2, func(uint, int64) { batches++ })
+		if err != nil {
+			t.Fatalf("run %d: Rebuild: %v", run, err)
+		}
+		// Soft-deleted rows are rebuilt too, so a restore stays searchable
+		if rebuilt != 3 || batches != 2 {
+			t.Errorf("run %d: rebuilt %d rows in %d batches, want 3 in 2", run, rebuilt, batches)
+		}
+		if got := vectors(t, db, index.Table); len(got) != len(want) {
+			t.Errorf("run %d: %d vectors, want %d", run, len(got), len(want))
+		} else {
+			for id, vector := range want {
+				if got[id] != vector {
+					t.Errorf("run %d: vector of %d = %q, want the trigger's %q", run, id, got[id], vector)
+				}
+			}
+		}
+	}
+
+	// Resuming after the last ID has nothing left to do
+	if rebuilt, err := searchindex.Rebuild(ctx, db, index, pgtest.SearchConfig, trashed.Project.ID, 2, nil); err != nil || rebuilt != 0 {
+		t.Errorf("resumed Rebuild = %d, %v, want 0 rows", rebuilt, err)
+	}
+	if missing, _ := searchindex.CountMissing(ctx, db); missing[index.Table] != 0 {
+		t.Errorf("missing %s vectors after rebuild = %d, want 0", index.Table, missing[index.Table])
+	}
+}
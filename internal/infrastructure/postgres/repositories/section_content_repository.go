@@ -3,6 +3,7 @@ package repositories
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
@@ -13,11 +14,37 @@ import (
 // sectionContentRepository implements the SectionContentRepository interface using GORM
 type sectionContentRepository struct {
 	db *gorm.DB
+	// readPosition switches reads from the legacy "order" column to "position"
+	readPosition bool
 }
 
-// NewSectionContentRepository creates a new section content repository instance
-func NewSectionContentRepository(db *gorm.DB) contracts.SectionContentRepository {
-	return &sectionContentRepository{db: db}
+// NewSectionContentRepository creates a new section content repository instance.
+// Writes always go to both "order" and "position"; readPosition selects the column reads use
+func NewSectionContentRepository(db *gorm.DB, readPosition bool) contracts.SectionContentRepository {
+	return &sectionContentRepository{db: db, readPosition: readPosition}
+}
+
+// orderColumn returns the column section contents are sorted by
+func (r *sectionContentRepository) orderColumn() string {
+	return sectionContentOrderColumn(r.readPosition)
+}
+
+// sectionContentOrderColumn returns the column section contents are read in order by:
+// "position" once readPosition is set, the legacy "order" until then.
+// Every repository reading section contents in order goes through it, so they agree.
+func sectionContentOrderColumn(readPosition bool) string {
+	if readPosition {
+		return "position"
+	}
+	return "\"order\""
+}
+
+// sectionContentOrder returns the order of a section content read from the column selected by readPosition
+func sectionContentOrder(record *entities.SectionContentRecord, readPosition bool) uint {
+	if readPosition {
+		return record.Position
+	}
+	return record.Order
 }
 
 // Create creates a new section content
@@ -29,9 +56,11 @@ func (r *sectionContentRepository) Create(ctx context.Context, input dto.CreateS
 		Order:     input.Order,
 		ImageID:   input.ImageID,
 		OwnerID:   input.OwnerID,
+		CreatedBy: actorOr(ctx, input.OwnerID),
+		UpdatedBy: actorOr(ctx, input.OwnerID),
 	}
 
-	if err := r.db.WithContext(ctx).Create(record).Error; err != nil {
+	if err := conn(ctx, r.db).Create(record).Error; err != nil {
 		return nil, fmt.Errorf("failed to create section content: %w", err)
 	}
 
@@ -41,7 +70,7 @@ func (r *sectionContentRepository) Create(ctx context.Context, input dto.CreateS
 // GetByID retrieves a section content by ID
 func (r *sectionContentRepository) GetByID(ctx context.Context, id uint) (*dto.SectionContentDTO, error) {
 	var record entities.SectionContentRecord
-	if err := r.db.WithContext(ctx).First(&record, id).Error; err != nil {
+	if err := conn(ctx, r.db).First(&record, id).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, fmt.Errorf("section content not found")
 		}
@@ -54,9 +83,9 @@ func (r *sectionContentRepository) GetByID(ctx context.Context, id uint) (*dto.S
 // GetBySectionID retrieves all section contents for a specific section
 func (r *sectionContentRepository) GetBySectionID(ctx context.Context, sectionID uint) ([]dto.SectionContentDTO, error) {
 	var records []entities.SectionContentRecord
-	if err := r.db.WithContext(ctx).
+	if err := conn(ctx, r.db).
 		Where("section_id = ?", sectionID).
-		Order("\"order\" ASC, id ASC").
+		Order(r.orderColumn() + " ASC, id ASC").
 		Find(&records).Error; err != nil {
 		return nil, fmt.Errorf("failed to get section contents: %w", err)
 	}
@@ -78,10 +107,10 @@ func (r *sectionContentRepository) Update(ctx context.Context, input dto.UpdateS
 		"image_id": input.ImageID,
 	}
 
-	if err := r.db.WithContext(ctx).
+	if err := conn(ctx, r.db).
 		Model(&entities.SectionContentRecord{}).
 		Where("id = ?", input.ID).
-		Updates(updates).Error; err != nil {
+		Updates(withUpdatedBy(ctx, updates)).Error; err != nil {
 		return fmt.Errorf("failed to update section content: %w", err)
 	}
 
@@ -90,10 +119,10 @@ func (r *sectionContentRepository) Update(ctx context.Context, input dto.UpdateS
 
 // UpdateOrder updates only the order field of a section content
 func (r *sectionContentRepository) UpdateOrder(ctx context.Context, id uint, order uint) error {
-	if err := r.db.WithContext(ctx).
+	if err := conn(ctx, r.db).
 		Model(&entities.SectionContentRecord{}).
 		Where("id = ?", id).
-		Update("order", order).Error; err != nil {
+		Updates(withUpdatedBy(ctx, map[string]interface{}{"order": order})).Error; err != nil {
 		return fmt.Errorf("failed to update section content order: %w", err)
 	}
 
@@ -102,7 +131,12 @@ func (r *sectionContentRepository) UpdateOrder(ctx context.Context, id uint, ord
 
 // Delete deletes a section content by ID
 func (r *sectionContentRepository) Delete(ctx context.Context, id uint) error {
-	if err := r.db.WithContext(ctx).Delete(&entities.SectionContentRecord{}, id).Error; err != nil {
+	batchID, err := newDeleteBatchID()
+	if err != nil {
+		return err
+	}
+
+	if _, err := softDeleteRows(conn(ctx, r.db), "section_contents", batchID, time.Now(), "id = ?", id); err != nil {
 		return fmt.Errorf("failed to delete section content: %w", err)
 	}
 
@@ -111,15 +145,19 @@ func (r *sectionContentRepository) Delete(ctx context.Context, id uint) error {
 
 // recordToDTO converts a SectionContentRecord to SectionContentDTO
 func (r *sectionContentRepository) recordToDTO(record *entities.SectionContentRecord) *dto.SectionContentDTO {
+	order := sectionContentOrder(record, r.readPosition)
+
 	return &dto.SectionContentDTO{
 		ID:        record.ID,
 		SectionID: record.SectionID,
 		Type:      record.Type,
 		Content:   record.Content,
-		Order:     record.Order,
+		Order:     order,
 		ImageID:   record.ImageID,
 		OwnerID:   record.OwnerID,
 		CreatedAt: record.CreatedAt,
 		UpdatedAt: record.UpdatedAt,
+		CreatedBy: record.CreatedBy,
+		UpdatedBy: record.UpdatedBy,
 	}
 }
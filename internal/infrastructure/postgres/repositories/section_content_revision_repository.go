@@ -0,0 +1,143 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/entities"
+	"gorm.io/gorm"
+)
+
+// sectionContentRevisionRepository is the GORM implementation of SectionContentRevisionRepository
+type sectionContentRevisionRepository struct {
+	db *gorm.DB
+}
+
+// NewSectionContentRevisionRepository creates a new section content revision repository instance
+func NewSectionContentRevisionRepository(db *gorm.DB) contracts.SectionContentRevisionRepository {
+	return &sectionContentRevisionRepository{db: db}
+}
+
+// Create records a revision and evicts the oldest revisions beyond maxRevisions
+func (r *sectionContentRevisionRepository) Create(ctx context.Context, input dto.CreateSectionContentRevisionInput, maxRevisions int) (*dto.SectionContentRevisionDTO, error) {
+	record := &entities.SectionContentRevisionRecord{
+		SectionContentID: input.SectionContentID,
+		Type:             input.Type,
+		Content:          input.Content,
+		ImageID:          input.ImageID,
+		Size:             contentSize(input.Content),
+		OwnerID:          input.OwnerID,
+	}
+
+	err := inTransaction(ctx, r.db, func(tx *gorm.DB) error {
+		if err := tx.Create(record).Error; err != nil {
+			return err
+		}
+
+		if maxRevisions <= 0 {
+			return nil
+		}
+
+		// Keep only the newest maxRevisions revisions of this content
+		return tx.Exec(
+			`DELETE FROM section_content_revisions
+			WHERE section_content_id = ? AND id NOT IN (
+				SELECT id FROM section_content_revisions
+				WHERE section_content_id = ?
+				ORDER BY created_at DESC, id DESC
+				LIMIT ?
+			)`,
+			input.SectionContentID, input.SectionContentID, maxRevisions,
+		).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create section content revision: %w", err)
+	}
+
+	return r.recordToDTO(record), nil
+}
+
+// GetByID retrieves a revision of a specific section content
+func (r *sectionContentRevisionRepository) GetByID(ctx context.Context, sectionContentID uint, id uint) (*dto.SectionContentRevisionDTO, error) {
+	var record entities.SectionContentRevisionRecord
+
+	if err := conn(ctx, r.db).
+		Where("id = ? AND section_content_id = ?", id, sectionContentID).
+		First(&record).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("revision with ID %d not found", id)
+		}
+		return nil, fmt.Errorf("failed to get section content revision: %w", err)
+	}
+
+	return r.recordToDTO(&record), nil
+}
+
+// GetLatest retrieves the most recent revision of a section content
+func (r *sectionContentRevisionRepository) GetLatest(ctx context.Context, sectionContentID uint) (*dto.SectionContentRevisionDTO, error) {
+	var records []entities.SectionContentRevisionRecord
+
+	if err := conn(ctx, r.db).
+		Where("section_content_id = ?", sectionContentID).
+		Order("created_at DESC, id DESC").
+		Limit(1).
+		Find(&records).Error; err != nil {
+		return nil, fmt.Errorf("failed to get latest section content revision: %w", err)
+	}
+
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	return r.recordToDTO(&records[0]), nil
+}
+
+// ListBySectionContentID retrieves all revisions of a section content (newest first)
+// SizeDelta is computed against the next older revision (the oldest is compared to empty content)
+func (r *sectionContentRevisionRepository) ListBySectionContentID(ctx context.Context, sectionContentID uint) ([]dto.SectionContentRevisionDTO, error) {
+	var records []entities.SectionContentRevisionRecord
+
+	if err := conn(ctx, r.db).
+		Where("section_content_id = ?", sectionContentID).
+		Order("created_at DESC, id DESC").
+		Find(&records).Error; err != nil {
+		return nil, fmt.Errorf("failed to list section content revisions: %w", err)
+	}
+
+	dtos := make([]dto.SectionContentRevisionDTO, len(records))
+	for i, record := range records {
+		dtos[i] = *r.recordToDTO(&record)
+
+		previousSize := 0
+		if i+1 < len(records) {
+			previousSize = records[i+1].Size
+		}
+		dtos[i].SizeDelta = record.Size - previousSize
+	}
+
+	return dtos, nil
+}
+
+// recordToDTO converts a SectionContentRevisionRecord to SectionContentRevisionDTO
+func (r *sectionContentRevisionRepository) recordToDTO(record *entities.SectionContentRevisionRecord) *dto.SectionContentRevisionDTO {
+	return &dto.SectionContentRevisionDTO{
+		ID:               record.ID,
+		SectionContentID: record.SectionContentID,
+		Type:             record.Type,
+		Content:          record.Content,
+		ImageID:          record.ImageID,
+		Size:             record.Size,
+		OwnerID:          record.OwnerID,
+		CreatedAt:        record.CreatedAt,
+	}
+}
+
+// contentSize returns the length of a nullable content in bytes
+func contentSize(content *string) int {
+	if content == nil {
+		return 0
+	}
+	return len(*content)
+}
@@ -0,0 +1,57 @@
+package repositories_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/pgtest"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/repositories"
+)
+
+func TestSectionContentRevisionRepository_EvictsOldest(t *testing.T) {
+	db := pgtest.Open(t)
+	ctx := context.Background()
+	repo := repositories.NewSectionContentRevisionRepository(db)
+	tr := seedTree(t, db, "user-1", "revised")
+	other := seedTree(t, db, "user-1", "other")
+
+	record := func(contentID uint, text string, maxRevisions int) {
+		t.Helper()
+		if _, err := repo.Create(ctx, dto.CreateSectionContentRevisionInput{
+			SectionContentID: contentID, Type: "text", Content: &text, OwnerID: "user-1",
+		}, maxRevisions); err != nil {
+			t.Fatalf("create revision %s: %v", text, err)
+		}
+	}
+	contents := func(contentID uint) string {
+		t.Helper()
+		revisions, err := repo.ListBySectionContentID(ctx, contentID)
+		if err != nil {
+			t.Fatalf("list revisions: %v", err)
+		}
+		got := ""
+		for _, revision := range revisions {
+			got += *revision.Content + " "
+		}
+		return got
+	}
+
+	record(other.SectionContent.ID, "kept", 1)
+	for i := 1; i <= 4; i++ {
+		record(tr.SectionContent.ID, fmt.Sprintf("r%d", i), 3)
+	}
+	if got, want := contents(tr.SectionContent.ID), "r4 r3 r2 "; got != want {
+		t.Errorf("revisions = %q, want %q (newest first, oldest evicted)", got, want)
+	}
+	if got, want := contents(other.SectionContent.ID), "kept "; got != want {
+		t.Errorf("revisions of another block = %q, want %q", got, want)
+	}
+
+	// No limit keeps every revision
+	record(tr.SectionContent.ID, "r5", 0)
+	if got, want := contents(tr.SectionContent.ID), "r5 r4 r3 r2 "; got != want {
+		t.Errorf("revisions = %q, want %q", got, want)
+	}
+}
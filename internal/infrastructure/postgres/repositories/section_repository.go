@@ -3,23 +3,31 @@ package repositories
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
 	dto2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/domain/portfolio"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/entities"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/searchindex"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // sectionRepository is the GORM implementation of SectionRepository
 // It implements the contract defined in the application layer
 type sectionRepository struct {
-	db *gorm.DB
+	db           *gorm.DB
+	searchConfig string // Text search configuration of the search vectors (see searchindex)
+	// readContentPosition orders section contents by "position" instead of the legacy "order"
+	readContentPosition bool
 }
 
 // NewSectionRepository creates a new section repository instance
 // Returns the interface type (contracts.SectionRepository), not the concrete type
-func NewSectionRepository(db *gorm.DB) contracts.SectionRepository {
-	return &sectionRepository{db: db}
+// readContentPosition follows SECTION_CONTENT_READ_POSITION, like the section content repository
+func NewSectionRepository(db *gorm.DB, searchConfig string, readContentPosition bool) contracts.SectionRepository {
+	return &sectionRepository{db: db, searchConfig: searchConfig, readContentPosition: readContentPosition}
 }
 
 // Create creates a new section in the database
@@ -32,10 +40,28 @@ func (r *sectionRepository) Create(ctx context.Context, input dto2.CreateSection
 		Position:    input.Position,
 		OwnerID:     input.OwnerID,
 		PortfolioID: input.PortfolioID,
+		CreatedBy:   actorOr(ctx, input.OwnerID),
+		UpdatedBy:   actorOr(ctx, input.OwnerID),
 	}
 
-	// Persist to database
-	if err := r.db.WithContext(ctx).Create(record).Error; err != nil {
+	// Persist to database (position 0 appends after the portfolio's other sections)
+	err := inTransaction(ctx, r.db, func(tx *gorm.DB) error {
+		if record.Position == 0 {
+			position, err := nextPosition(tx, "sections", "portfolios", "portfolio_id", record.PortfolioID)
+			if err != nil {
+				return err
+			}
+			record.Position = position
+		}
+
+		candidate := numberedCandidate(portfolio.SectionSlug(record.Title), takenSectionSlugs(tx, record.PortfolioID, 0))
+		_, err := insertWithUniqueRetry(tx, 0, candidate, func(tx *gorm.DB, slug string) error {
+			record.Slug = slug
+			return tx.Create(record).Error
+		})
+		return err
+	})
+	if err != nil {
 		return nil, fmt.Errorf("failed to create section: %w", err)
 	}
 
@@ -47,7 +73,7 @@ func (r *sectionRepository) Create(ctx context.Context, input dto2.CreateSection
 func (r *sectionRepository) GetByID(ctx context.Context, id uint) (*dto2.SectionDTO, error) {
 	var record entities.SectionRecord
 
-	if err := r.db.WithContext(ctx).First(&record, id).Error; err != nil {
+	if err := conn(ctx, r.db).First(&record, id).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, fmt.Errorf("section with ID %d not found", id)
 		}
@@ -61,7 +87,7 @@ func (r *sectionRepository) GetByID(ctx context.Context, id uint) (*dto2.Section
 func (r *sectionRepository) GetByIDs(ctx context.Context, ids []uint) ([]dto2.SectionDTO, error) {
 	var records []entities.SectionRecord
 
-	if err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&records).Error; err != nil {
+	if err := conn(ctx, r.db).Where("id IN ?", ids).Order("id ASC").Find(&records).Error; err != nil {
 		return nil, fmt.Errorf("failed to get sections by IDs: %w", err)
 	}
 
@@ -78,9 +104,9 @@ func (r *sectionRepository) GetByIDs(ctx context.Context, ids []uint) ([]dto2.Se
 func (r *sectionRepository) GetByPortfolioID(ctx context.Context, portfolioID uint) ([]dto2.SectionDTO, error) {
 	var records []entities.SectionRecord
 
-	if err := r.db.WithContext(ctx).
+	if err := conn(ctx, r.db).
 		Where("portfolio_id = ?", portfolioID).
-		Order("position ASC, created_at ASC").
+		Order("position ASC, created_at ASC, id ASC").
 		Find(&records).Error; err != nil {
 		return nil, fmt.Errorf("failed to get sections by portfolio ID: %w", err)
 	}
@@ -94,13 +120,81 @@ func (r *sectionRepository) GetByPortfolioID(ctx context.Context, portfolioID ui
 	return dtos, nil
 }
 
+// SearchInPortfolio retrieves the live sections of a portfolio whose search vector, or the search
+// vector of one of their live contents, matches every word of query as a prefix.
+// Sections are ranked by their own match plus their best content match.
+func (r *sectionRepository) SearchInPortfolio(ctx context.Context, portfolioID uint, query string, limit int) ([]dto2.SectionSearchHitDTO, error) {
+	tsquery := searchindex.PrefixQuery(query)
+	if tsquery == "" {
+		return []dto2.SectionSearchHitDTO{}, nil
+	}
+
+	args := map[string]interface{}{
+		"config":    r.searchConfig,
+		"query":     tsquery,
+		"portfolio": portfolioID,
+		"limit":     limit,
+	}
+
+	var records []entities.SectionRecord
+	if err := conn(ctx, r.db).Raw(`
+		SELECT sections.* FROM sections, to_tsquery(@config::regconfig, @query) AS q
+		WHERE sections.portfolio_id = @portfolio AND sections.deleted_at IS NULL
+		AND (sections.search_vector @@ q OR EXISTS (
+			SELECT 1 FROM section_contents
+			WHERE section_contents.section_id = sections.id AND section_contents.deleted_at IS NULL
+			AND section_contents.search_vector @@ q))
+		ORDER BY COALESCE(ts_rank(sections.search_vector, q), 0) + COALESCE((
+			SELECT max(ts_rank(section_contents.search_vector, q)) FROM section_contents
+			WHERE section_contents.section_id = sections.id AND section_contents.deleted_at IS NULL
+			AND section_contents.search_vector @@ q), 0) DESC,
+			sections.position ASC, sections.id ASC
+		LIMIT @limit`, args).
+		Scan(&records).Error; err != nil {
+		return nil, fmt.Errorf("failed to search sections: %w", err)
+	}
+	if len(records) == 0 {
+		return []dto2.SectionSearchHitDTO{}, nil
+	}
+
+	hits := make([]dto2.SectionSearchHitDTO, len(records))
+	index := make(map[uint]int, len(records))
+	sectionIDs := make([]uint, len(records))
+	for i, record := range records {
+		hits[i] = dto2.SectionSearchHitDTO{Section: *r.recordToDTO(&record), ContentIDs: []uint{}}
+		index[record.ID] = i
+		sectionIDs[i] = record.ID
+	}
+
+	var contents []struct {
+		ID        uint
+		SectionID uint
+	}
+	args["sections"] = sectionIDs
+	if err := conn(ctx, r.db).Raw(`
+		SELECT section_contents.id, section_contents.section_id
+		FROM section_contents, to_tsquery(@config::regconfig, @query) AS q
+		WHERE section_contents.section_id IN @sections AND section_contents.deleted_at IS NULL
+		AND section_contents.search_vector @@ q
+		ORDER BY section_contents.`+sectionContentOrderColumn(r.readContentPosition)+` ASC, section_contents.id ASC`, args).
+		Scan(&contents).Error; err != nil {
+		return nil, fmt.Errorf("failed to search section contents: %w", err)
+	}
+	for _, content := range contents {
+		hit := &hits[index[content.SectionID]]
+		hit.ContentIDs = append(hit.ContentIDs, content.ID)
+	}
+
+	return hits, nil
+}
+
 // GetByOwnerID retrieves all sections owned by a user with pagination
 func (r *sectionRepository) GetByOwnerID(ctx context.Context, ownerID string, pagination dto2.PaginationDTO) ([]dto2.SectionDTO, int64, error) {
 	var records []entities.SectionRecord
 	var total int64
 
 	// Count total sections for this owner
-	if err := r.db.WithContext(ctx).
+	if err := conn(ctx, r.db).
 		Model(&entities.SectionRecord{}).
 		Where("owner_id = ?", ownerID).
 		Count(&total).Error; err != nil {
@@ -111,9 +205,9 @@ func (r *sectionRepository) GetByOwnerID(ctx context.Context, ownerID string, pa
 	offset := (pagination.Page - 1) * pagination.Limit
 
 	// Get paginated results
-	if err := r.db.WithContext(ctx).
+	if err := conn(ctx, r.db).
 		Where("owner_id = ?", ownerID).
-		Order("created_at DESC").
+		Order("created_at DESC, id DESC").
 		Limit(pagination.Limit).
 		Offset(offset).
 		Find(&records).Error; err != nil {
@@ -129,15 +223,35 @@ func (r *sectionRepository) GetByOwnerID(ctx context.Context, ownerID string, pa
 	return dtos, total, nil
 }
 
-// GetByType retrieves all sections of a specific type
-func (r *sectionRepository) GetByType(ctx context.Context, sectionType string) ([]dto2.SectionDTO, error) {
+// GetByType retrieves an owner's sections of a specific type with pagination
+func (r *sectionRepository) GetByType(ctx context.Context, input dto2.ListSectionsByTypeInput) ([]dto2.SectionDTO, int64, error) {
 	var records []entities.SectionRecord
+	var total int64
 
-	if err := r.db.WithContext(ctx).
-		Where("type = ?", sectionType).
-		Order("position ASC, created_at ASC").
+	// Always scoped to the owner, whatever the other filters
+	filtered := func() *gorm.DB {
+		query := conn(ctx, r.db).
+			Model(&entities.SectionRecord{}).
+			Where("owner_id = ? AND type = ?", input.OwnerID, input.Type)
+		if input.PortfolioID != nil {
+			query = query.Where("portfolio_id = ?", *input.PortfolioID)
+		}
+		return query
+	}
+
+	if err := filtered().Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count sections by type: %w", err)
+	}
+
+	// Calculate offset
+	offset := (input.Pagination.Page - 1) * input.Pagination.Limit
+
+	if err := filtered().
+		Order("portfolio_id ASC, position ASC, id ASC").
+		Limit(input.Pagination.Limit).
+		Offset(offset).
 		Find(&records).Error; err != nil {
-		return nil, fmt.Errorf("failed to get sections by type: %w", err)
+		return nil, 0, fmt.Errorf("failed to get sections by type: %w", err)
 	}
 
 	// Convert records to DTOs
@@ -146,7 +260,7 @@ func (r *sectionRepository) GetByType(ctx context.Context, sectionType string) (
 		dtos[i] = *r.recordToDTO(&record)
 	}
 
-	return dtos, nil
+	return dtos, total, nil
 }
 
 // Update updates an existing section
@@ -169,29 +283,127 @@ func (r *sectionRepository) Update(ctx context.Context, input dto2.UpdateSection
 	if len(updates) == 0 {
 		return nil // Nothing to update
 	}
+	withUpdatedBy(ctx, updates)
 
-	result := r.db.WithContext(ctx).
-		Model(&entities.SectionRecord{}).
-		Where("id = ?", input.ID).
-		Updates(updates)
+	var record entities.SectionRecord
+	err := inTransaction(ctx, r.db, func(tx *gorm.DB) error {
+		if err := tx.Select("id, title, slug, portfolio_id").First(&record, input.ID).Error; err != nil {
+			return err
+		}
 
-	if result.Error != nil {
-		return fmt.Errorf("failed to update section: %w", result.Error)
-	}
+		// A new title gets a new slug; the old one is kept in the history so its anchors still resolve
+		newSlug := ""
+		if input.Title != "" && input.Title != record.Title {
+			newSlug = portfolio.SectionSlug(input.Title)
+		}
+		if newSlug == "" || newSlug == record.Slug {
+			return tx.Model(&entities.SectionRecord{}).Where("id = ?", input.ID).Updates(updates).Error
+		}
 
-	if result.RowsAffected == 0 {
+		candidate := numberedCandidate(newSlug, takenSectionSlugs(tx, record.PortfolioID, record.ID))
+		slug, err := insertWithUniqueRetry(tx, 0, candidate, func(tx *gorm.DB, slug string) error {
+			updates["slug"] = slug
+			return tx.Model(&entities.SectionRecord{}).Where("id = ?", input.ID).Updates(updates).Error
+		})
+		if err != nil {
+			return err
+		}
+		if slug == record.Slug {
+			return nil
+		}
+
+		return recordSectionSlugChange(tx, record.PortfolioID, record.ID, record.Slug, slug)
+	})
+	if err == gorm.ErrRecordNotFound {
 		return fmt.Errorf("section with ID %d not found", input.ID)
 	}
+	if err != nil {
+		return fmt.Errorf("failed to update section: %w", err)
+	}
 
 	return nil
 }
 
+// takenSectionSlugs lists the live slugs of a portfolio equal to base or numbered variants of it
+// excludeID leaves out the section being renamed (pass 0 when creating)
+func takenSectionSlugs(tx *gorm.DB, portfolioID, excludeID uint) func(base string) ([]string, error) {
+	return func(base string) ([]string, error) {
+		query := tx.Model(&entities.SectionRecord{}).
+			Where("portfolio_id = ? AND (slug = ? OR slug LIKE ?)", portfolioID, base, likeEscaper.Replace(base)+"-%")
+		if excludeID > 0 {
+			query = query.Where("id != ?", excludeID)
+		}
+
+		var slugs []string
+		if err := query.Pluck("slug", &slugs).Error; err != nil {
+			return nil, fmt.Errorf("failed to look up section slugs: %w", err)
+		}
+		return slugs, nil
+	}
+}
+
+// BackfillSectionSlugs gives a slug to the live sections created before slugs existed,
+// in position order so the first of two same-titled sections keeps the plain slug.
+// Returns the number of sections updated.
+func BackfillSectionSlugs(db *gorm.DB) (int, error) {
+	return fillSectionSlugs(db, 0)
+}
+
+// fillSectionSlugs gives a slug to the live sections without one, of a single portfolio or of
+// all of them when portfolioID is 0. Returns the number of sections updated.
+func fillSectionSlugs(db *gorm.DB, portfolioID uint) (int, error) {
+	query := db.Select("id, title, portfolio_id").Where("slug = ''")
+	if portfolioID > 0 {
+		query = query.Where("portfolio_id = ?", portfolioID)
+	}
+
+	var records []entities.SectionRecord
+	if err := query.
+		Order("portfolio_id ASC, position ASC, id ASC").
+		Find(&records).Error; err != nil {
+		return 0, fmt.Errorf("failed to find sections without slug: %w", err)
+	}
+
+	for i, record := range records {
+		candidate := numberedCandidate(portfolio.SectionSlug(record.Title), takenSectionSlugs(db, record.PortfolioID, record.ID))
+		_, err := insertWithUniqueRetry(db, 0, candidate, func(tx *gorm.DB, slug string) error {
+			return tx.Model(&entities.SectionRecord{}).Where("id = ?", record.ID).UpdateColumn("slug", slug).Error
+		})
+		if err != nil {
+			return i, fmt.Errorf("failed to backfill slug of section %d: %w", record.ID, err)
+		}
+	}
+
+	return len(records), nil
+}
+
+// recordSectionSlugChange points the former slug of a section at it in the history
+// The new slug no longer redirects anywhere: live slugs win over history entries.
+func recordSectionSlugChange(tx *gorm.DB, portfolioID, sectionID uint, oldSlug, newSlug string) error {
+	if err := tx.Where("portfolio_id = ? AND slug = ?", portfolioID, newSlug).
+		Delete(&entities.SectionSlugHistoryRecord{}).Error; err != nil {
+		return fmt.Errorf("failed to clear section slug history: %w", err)
+	}
+	if oldSlug == "" {
+		return nil
+	}
+
+	entry := entities.SectionSlugHistoryRecord{PortfolioID: portfolioID, Slug: oldSlug, SectionID: sectionID}
+	if err := tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "portfolio_id"}, {Name: "slug"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{"section_id": sectionID, "created_at": gorm.Expr("now()")}),
+	}).Create(&entry).Error; err != nil {
+		return fmt.Errorf("failed to record section slug history: %w", err)
+	}
+	return nil
+}
+
 // UpdatePosition updates only the position field of a section
 func (r *sectionRepository) UpdatePosition(ctx context.Context, id uint, position uint) error {
-	result := r.db.WithContext(ctx).
+	result := conn(ctx, r.db).
 		Model(&entities.SectionRecord{}).
 		Where("id = ?", id).
-		Update("position", position)
+		Updates(withUpdatedBy(ctx, map[string]interface{}{"position": position}))
 
 	if result.Error != nil {
 		return fmt.Errorf("failed to update section position: %w", result.Error)
@@ -204,13 +416,28 @@ func (r *sectionRepository) UpdatePosition(ctx context.Context, id uint, positio
 	return nil
 }
 
-// BulkUpdatePositions updates positions for multiple sections in a transaction
+// SwapPositions exchanges the positions of two sections of the same portfolio in one transaction
+func (r *sectionRepository) SwapPositions(ctx context.Context, firstID, secondID uint) error {
+	return inTransaction(ctx, r.db, func(tx *gorm.DB) error {
+		return swapPositions(ctx, tx, "sections", "portfolio_id", firstID, secondID)
+	})
+}
+
+// BulkUpdatePositions updates positions for multiple sections in a transaction (the unit of work's, inside one)
 func (r *sectionRepository) BulkUpdatePositions(ctx context.Context, input dto2.BulkUpdateSectionPositionsInput) error {
-	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	return inTransaction(ctx, r.db, func(tx *gorm.DB) error {
+		ids := make([]uint, len(input.Items))
+		for i, item := range input.Items {
+			ids[i] = item.ID
+		}
+		if err := assertSameParent(tx, "sections", "portfolio_id", ids); err != nil {
+			return err
+		}
+
 		for _, item := range input.Items {
 			if err := tx.Model(&entities.SectionRecord{}).
 				Where("id = ?", item.ID).
-				Update("position", item.Position).Error; err != nil {
+				Updates(withUpdatedBy(ctx, map[string]interface{}{"position": item.Position})).Error; err != nil {
 				return fmt.Errorf("failed to update position for section %d: %w", item.ID, err)
 			}
 		}
@@ -218,26 +445,61 @@ func (r *sectionRepository) BulkUpdatePositions(ctx context.Context, input dto2.
 	})
 }
 
-// Delete deletes a section by its ID (soft delete with GORM, cascade handled by GORM)
+// Delete deletes a section by its ID (soft delete)
+// Its contents are soft-deleted in the same transaction and delete batch
 func (r *sectionRepository) Delete(ctx context.Context, id uint) error {
-	result := r.db.WithContext(ctx).Delete(&entities.SectionRecord{}, id)
+	batchID, err := newDeleteBatchID()
+	if err != nil {
+		return err
+	}
 
-	if result.Error != nil {
-		return fmt.Errorf("failed to delete section: %w", result.Error)
+	var deleted int64
+	err = inTransaction(ctx, r.db, func(tx *gorm.DB) error {
+		var err error
+		deleted, err = softDeleteSectionCascade(tx, batchID, time.Now(), "id = ?", id)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete section: %w", err)
 	}
 
-	if result.RowsAffected == 0 {
+	if deleted == 0 {
 		return fmt.Errorf("section with ID %d not found", id)
 	}
 
 	return nil
 }
 
+// GetSlugRedirects maps the former slugs of a portfolio's live sections to their current slug
+// Former slugs now used by a live section are left out (the live section wins).
+func (r *sectionRepository) GetSlugRedirects(ctx context.Context, portfolioID uint) (map[string]string, error) {
+	var rows []struct {
+		OldSlug string
+		Slug    string
+	}
+
+	if err := conn(ctx, r.db).
+		Table("section_slug_history AS h").
+		Select("h.slug AS old_slug, s.slug").
+		Joins("JOIN sections s ON s.id = h.section_id AND s.deleted_at IS NULL").
+		Where("h.portfolio_id = ?", portfolioID).
+		Where("NOT EXISTS (SELECT 1 FROM sections live WHERE live.portfolio_id = h.portfolio_id AND live.slug = h.slug AND live.deleted_at IS NULL)").
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to get section slug redirects: %w", err)
+	}
+
+	redirects := make(map[string]string, len(rows))
+	for _, row := range rows {
+		redirects[row.OldSlug] = row.Slug
+	}
+	return redirects, nil
+}
+
 // CheckTitleDuplicate checks if a section title already exists for a portfolio
 func (r *sectionRepository) CheckTitleDuplicate(ctx context.Context, title string, portfolioID uint, excludeID uint) (bool, error) {
 	var count int64
 
-	query := r.db.WithContext(ctx).
+	query := conn(ctx, r.db).
 		Model(&entities.SectionRecord{}).
 		Where("title = ? AND portfolio_id = ?", title, portfolioID)
 
@@ -258,6 +520,7 @@ func (r *sectionRepository) recordToDTO(record *entities.SectionRecord) *dto2.Se
 	return &dto2.SectionDTO{
 		ID:          record.ID,
 		Title:       record.Title,
+		Slug:        record.Slug,
 		Description: record.Description,
 		Type:        record.Type,
 		Position:    record.Position,
@@ -265,5 +528,7 @@ func (r *sectionRepository) recordToDTO(record *entities.SectionRecord) *dto2.Se
 		PortfolioID: record.PortfolioID,
 		CreatedAt:   record.CreatedAt,
 		UpdatedAt:   record.UpdatedAt,
+		CreatedBy:   record.CreatedBy,
+		UpdatedBy:   record.UpdatedBy,
 	}
 }
@@ -0,0 +1,176 @@
+package repositories_test
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/usecases/portfolio"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/usecases/section"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/entities"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/pgtest"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/repositories"
+)
+
+func TestSectionRepository_GetByTypeIsolatesOwners(t *testing.T) {
+	db := pgtest.Open(t)
+	ctx := context.Background()
+	repo := repositories.NewSectionRepository(db, pgtest.SearchConfig, false)
+
+	// Both users own "text" sections (one per tree) and one "about" section
+	trees := map[string]*tree{
+		"alice": seedTree(t, db, "alice", "alice"),
+		"bob":   seedTree(t, db, "bob", "bob"),
+	}
+	for owner, tr := range trees {
+		create(t, db, &entities.SectionRecord{Title: owner + " about", Slug: owner + "-about", Type: "about", Position: 2, OwnerID: owner, PortfolioID: tr.Portfolio.ID})
+	}
+	second := seedTree(t, db, "alice", "alice-second")
+
+	titles := func(input dto.ListSectionsByTypeInput) ([]string, int64) {
+		t.Helper()
+		input.Pagination = dto.PaginationDTO{Page: 1, Limit: 50}
+		sections, total, err := repo.GetByType(ctx, input)
+		if err != nil {
+			t.Fatalf("GetByType(%+v): %v", input, err)
+		}
+		var got []string
+		for _, s := range sections {
+			got = append(got, s.Title)
+		}
+		sort.Strings(got)
+		return got, total
+	}
+
+	tests := []struct {
+		name  string
+		input dto.ListSectionsByTypeInput
+		want  []string
+	}{
+		{name: "alice's text sections across portfolios", input: dto.ListSectionsByTypeInput{OwnerID: "alice", Type: "text"}, want: []string{"alice section", "alice-second section"}},
+		{name: "bob's text sections", input: dto.ListSectionsByTypeInput{OwnerID: "bob", Type: "text"}, want: []string{"bob section"}},
+		{name: "bob's about section", input: dto.ListSectionsByTypeInput{OwnerID: "bob", Type: "about"}, want: []string{"bob about"}},
+		{name: "alice within one portfolio", input: dto.ListSectionsByTypeInput{OwnerID: "alice", Type: "text", PortfolioID: &second.Portfolio.ID}, want: []string{"alice-second section"}},
+		{name: "bob filtering on alice's portfolio", input: dto.ListSectionsByTypeInput{OwnerID: "bob", Type: "text", PortfolioID: &trees["alice"].Portfolio.ID}},
+		{name: "unknown owner", input: dto.ListSectionsByTypeInput{OwnerID: "mallory", Type: "text"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, total := titles(tt.input)
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("sections = %v, want %v", got, tt.want)
+			}
+			if total != int64(len(tt.want)) {
+				t.Errorf("total = %d, want %d (counted under the same owner scope)", total, len(tt.want))
+			}
+		})
+	}
+
+	// The use case refuses another user's portfolio before reaching the repository
+	uc := section.NewListSectionsByTypeUseCase(repo, repositories.NewPortfolioRepository(db, false))
+	if _, err := uc.Execute(ctx, dto.ListSectionsByTypeInput{OwnerID: "bob", Type: "text", PortfolioID: &trees["alice"].Portfolio.ID}); err == nil {
+		t.Error("listing another user's portfolio succeeded, want an error")
+	}
+}
+
+func TestSectionRepository_CreateNumbersPositionsPerPortfolio(t *testing.T) {
+	db := pgtest.Open(t)
+	ctx := context.Background()
+	repo := repositories.NewSectionRepository(db, pgtest.SearchConfig, false)
+
+	// Each tree already holds a section at position 1
+	first := seedTree(t, db, "alice", "first")
+	second := seedTree(t, db, "alice", "second")
+	trashed := entities.SectionRecord{Title: "trashed", Slug: "trashed", Type: "text", Position: 2, OwnerID: "alice", PortfolioID: first.Portfolio.ID}
+	create(t, db, &trashed)
+	softDelete(t, db, &trashed)
+
+	createAt := func(tr *tree, title string, position uint) uint {
+		t.Helper()
+		created, err := repo.Create(ctx, dto.CreateSectionInput{Title: title, Type: "text", Position: position, OwnerID: "alice", PortfolioID: tr.Portfolio.ID})
+		if err != nil {
+			t.Fatalf("Create(%q): %v", title, err)
+		}
+		return created.Position
+	}
+
+	tests := []struct {
+		name     string
+		tree     *tree
+		position uint // 0 appends
+		want     uint
+	}{
+		{name: "appended after the live sections", tree: first, want: 2},
+		{name: "numbered in its own portfolio", tree: second, want: 2},
+		{name: "appended again", tree: first, want: 3},
+		{name: "manual position kept", tree: first, position: 10, want: 10},
+		{name: "appended after the manual position", tree: first, want: 11},
+		{name: "other portfolio unaffected", tree: second, want: 3},
+	}
+
+	for _, tt := range tests {
+		if got := createAt(tt.tree, tt.name, tt.position); got != tt.want {
+			t.Errorf("%s: position = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSectionRepository_RenameKeepsOldSlugResolving(t *testing.T) {
+	db := pgtest.Open(t)
+	ctx := context.Background()
+	repo := repositories.NewSectionRepository(db, pgtest.SearchConfig, false)
+	tr := seedTree(t, db, "alice", "toc")
+
+	createSection := func(title string, position uint) *dto.SectionDTO {
+		t.Helper()
+		created, err := repo.Create(ctx, dto.CreateSectionInput{Title: title, Type: "text", Position: position, OwnerID: "alice", PortfolioID: tr.Portfolio.ID})
+		if err != nil {
+			t.Fatalf("Create(%q): %v", title, err)
+		}
+		return created
+	}
+	about := createSection("About me", 4)
+	contact := createSection("Contact", 2)
+	clash := createSection("About me!", 3)
+	if about.Slug != "about-me" || clash.Slug != "about-me-2" || contact.Slug != "contact" {
+		t.Fatalf("slugs = %q, %q, %q, want about-me, about-me-2, contact", about.Slug, clash.Slug, contact.Slug)
+	}
+
+	if err := repo.Update(ctx, dto.UpdateSectionInput{ID: about.ID, Title: "Biography", Position: 4, OwnerID: "alice"}); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+
+	toc, err := portfolio.NewGetPortfolioTOCUseCase(repositories.NewPortfolioRepository(db, false), repo).Execute(ctx, tr.Portfolio.ID)
+	if err != nil {
+		t.Fatalf("TOC: %v", err)
+	}
+	var got []string
+	for _, s := range toc.Sections {
+		got = append(got, s.Slug)
+	}
+	if want := []string{"toc-section", "contact", "about-me-2", "biography"}; strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("TOC slugs = %v, want %v in position order", got, want)
+	}
+	if want := map[string]string{"about-me": "biography"}; !reflect.DeepEqual(toc.Redirects, want) {
+		t.Errorf("redirects = %v, want %v", toc.Redirects, want)
+	}
+
+	// A rename that keeps the slug records no history
+	if err := repo.Update(ctx, dto.UpdateSectionInput{ID: contact.ID, Title: "CONTACT", Position: 2, OwnerID: "alice"}); err != nil {
+		t.Fatalf("Update(CONTACT): %v", err)
+	}
+	// A live section taking a former slug wins over the redirect
+	createSection("About me", 5)
+
+	redirects, err := repo.GetSlugRedirects(ctx, tr.Portfolio.ID)
+	if err != nil {
+		t.Fatalf("GetSlugRedirects: %v", err)
+	}
+	if len(redirects) != 0 {
+		t.Errorf("redirects = %v, want none once about-me is live again", redirects)
+	}
+}
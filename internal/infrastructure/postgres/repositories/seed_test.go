@@ -0,0 +1,99 @@
+package repositories_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/entities"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// tree is one portfolio with one item of each child resource
+type tree struct {
+	Portfolio      entities.PortfolioRecord
+	Category       entities.CategoryRecord
+	Project        entities.ProjectRecord
+	Section        entities.SectionRecord
+	SectionContent entities.SectionContentRecord
+	Link           entities.PortfolioLinkRecord
+}
+
+// seedTree inserts a published portfolio of ownerID with one category, project, section,
+// section content and link; name keeps titles and slugs apart between trees
+func seedTree(t *testing.T, db *gorm.DB, ownerID, name string) *tree {
+	t.Helper()
+
+	text := "text of " + name
+	tr := &tree{
+		Portfolio: entities.PortfolioRecord{Title: name, Slug: name, OwnerID: ownerID, IsPublished: true},
+	}
+	create(t, db, &tr.Portfolio)
+
+	tr.Category = entities.CategoryRecord{Title: name + " category", Position: 1, OwnerID: ownerID, PortfolioID: tr.Portfolio.ID}
+	create(t, db, &tr.Category)
+
+	tr.Project = entities.ProjectRecord{Title: name + " project", Description: "project of " + name, Position: 1, OwnerID: ownerID, CategoryID: tr.Category.ID}
+	create(t, db, &tr.Project)
+
+	tr.Section = entities.SectionRecord{Title: name + " section", Slug: name + "-section", Type: "text", Position: 1, OwnerID: ownerID, PortfolioID: tr.Portfolio.ID}
+	create(t, db, &tr.Section)
+
+	tr.SectionContent = entities.SectionContentRecord{SectionID: tr.Section.ID, Type: "text", Content: &text, Order: 1, OwnerID: ownerID}
+	create(t, db, &tr.SectionContent)
+
+	tr.Link = entities.PortfolioLinkRecord{PortfolioID: tr.Portfolio.ID, Kind: "website", URL: "https://" + name + ".example.com", Position: 1, OwnerID: ownerID}
+	create(t, db, &tr.Link)
+
+	return tr
+}
+
+// create inserts record without touching its associations
+func create(t *testing.T, db *gorm.DB, record interface{}) {
+	t.Helper()
+
+	if err := db.Omit(clause.Associations).Create(record).Error; err != nil {
+		t.Fatalf("insert %T: %v", record, err)
+	}
+}
+
+// softDelete soft-deletes record, as the delete use cases do
+func softDelete(t *testing.T, db *gorm.DB, record interface{}) {
+	t.Helper()
+
+	if err := db.Delete(record).Error; err != nil {
+		t.Fatalf("delete %T: %v", record, err)
+	}
+}
+
+// records returns the rows of the tree keyed by trash resource name
+func (tr *tree) records() map[string]interface{} {
+	return map[string]interface{}{
+		dto.TrashResourcePortfolio:      &tr.Portfolio,
+		dto.TrashResourceCategory:       &tr.Category,
+		dto.TrashResourceProject:        &tr.Project,
+		dto.TrashResourceSection:        &tr.Section,
+		dto.TrashResourceSectionContent: &tr.SectionContent,
+		dto.TrashResourcePortfolioLink:  &tr.Link,
+	}
+}
+
+// idOf returns the primary key of one of the tree's records
+func idOf(record interface{}) uint {
+	switch r := record.(type) {
+	case *entities.PortfolioRecord:
+		return r.ID
+	case *entities.CategoryRecord:
+		return r.ID
+	case *entities.ProjectRecord:
+		return r.ID
+	case *entities.SectionRecord:
+		return r.ID
+	case *entities.SectionContentRecord:
+		return r.ID
+	case *entities.PortfolioLinkRecord:
+		return r.ID
+	}
+	panic(fmt.Sprintf("unexpected record %T", record))
+}
@@ -0,0 +1,127 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/entities"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// endorsementLockNamespace is the first key of the advisory locks serializing the votes of a project
+const endorsementLockNamespace = 7301
+
+// errEndorsementLimit rolls back a vote refused by the daily limit
+var errEndorsementLimit = errors.New("endorsement limit reached")
+
+// skillEndorsementRepository is the GORM implementation of SkillEndorsementRepository
+type skillEndorsementRepository struct {
+	db *gorm.DB
+}
+
+// NewSkillEndorsementRepository creates a new skill endorsement repository instance
+// Returns the interface type (contracts.SkillEndorsementRepository), not the concrete type
+func NewSkillEndorsementRepository(db *gorm.DB) contracts.SkillEndorsementRepository {
+	return &skillEndorsementRepository{db: db}
+}
+
+// Endorse records the vote and increments the count atomically
+func (r *skillEndorsementRepository) Endorse(ctx context.Context, input dto.EndorseSkillVoteInput) (*dto.EndorseSkillResultDTO, error) {
+	result := &dto.EndorseSkillResultDTO{Skill: input.Skill}
+
+	err := inTransaction(ctx, r.db, func(tx *gorm.DB) error {
+		// Votes of the same project are serialized so the daily limit is exact;
+		// the count itself is incremented in place and would be safe without it
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(?, ?)", endorsementLockNamespace, int32(input.ProjectID)).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&entities.SkillEndorsementVoteRecord{}).
+			Where("project_id = ? AND day = ?", input.ProjectID, input.Day).
+			Count(&result.ProjectVotes).Error; err != nil {
+			return err
+		}
+
+		vote := &entities.SkillEndorsementVoteRecord{
+			ProjectID: input.ProjectID,
+			Skill:     input.Skill,
+			IPHash:    input.IPHash,
+			Day:       input.Day,
+		}
+		inserted := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(vote)
+		if inserted.Error != nil {
+			return inserted.Error
+		}
+
+		if inserted.RowsAffected == 0 {
+			// Already endorsed today: report the current count
+			return tx.Model(&entities.SkillEndorsementRecord{}).
+				Select("count").
+				Where("project_id = ? AND skill = ?", input.ProjectID, input.Skill).
+				Scan(&result.Count).Error
+		}
+		if input.DailyLimit > 0 && result.ProjectVotes >= input.DailyLimit {
+			return errEndorsementLimit
+		}
+
+		result.Counted = true
+		result.ProjectVotes++
+		return tx.Raw(
+			"INSERT INTO skill_endorsements (project_id, skill, count, updated_at) VALUES (?, ?, 1, ?) "+
+				"ON CONFLICT (project_id, skill) DO UPDATE SET count = skill_endorsements.count + 1, updated_at = EXCLUDED.updated_at "+
+				"RETURNING count",
+			input.ProjectID, input.Skill, time.Now(),
+		).Scan(&result.Count).Error
+	})
+
+	if errors.Is(err, errEndorsementLimit) {
+		result.LimitReached = true
+		return result, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to endorse skill: %w", err)
+	}
+
+	return result, nil
+}
+
+// GetCounts retrieves the endorsement counts of several projects
+func (r *skillEndorsementRepository) GetCounts(ctx context.Context, projectIDs []uint) (map[uint]map[string]uint, error) {
+	counts := make(map[uint]map[string]uint, len(projectIDs))
+	if len(projectIDs) == 0 {
+		return counts, nil
+	}
+
+	var records []entities.SkillEndorsementRecord
+	if err := r.db.WithContext(ctx).
+		Where("project_id IN ?", projectIDs).
+		Find(&records).Error; err != nil {
+		return nil, fmt.Errorf("failed to get skill endorsements: %w", err)
+	}
+
+	for _, record := range records {
+		if counts[record.ProjectID] == nil {
+			counts[record.ProjectID] = make(map[string]uint)
+		}
+		counts[record.ProjectID][record.Skill] = record.Count
+	}
+
+	return counts, nil
+}
+
+// PurgeVotesBefore deletes the dedup votes older than day
+func (r *skillEndorsementRepository) PurgeVotesBefore(ctx context.Context, day time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("day < ?", day).
+		Delete(&entities.SkillEndorsementVoteRecord{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to purge endorsement votes: %w", result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,79 @@
+package repositories_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/entities"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/pgtest"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/repositories"
+	"gorm.io/gorm"
+)
+
+// failStatements makes every statement of db whose SQL contains fragment fail with err
+// until the returned function is called
+func failStatements(t *testing.T, db *gorm.DB, fragment string, err error) (stop func()) {
+	t.Helper()
+
+	failing := true
+	inject := func(tx *gorm.DB) {
+		if failing && strings.Contains(tx.Statement.SQL.String(), fragment) {
+			_ = tx.AddError(err)
+		}
+	}
+	callbacks := db.Callback()
+	if err := callbacks.Row().Before("gorm:row").Register("test:fail_row", inject); err != nil {
+		t.Fatalf("register row failure: %v", err)
+	}
+	if err := callbacks.Raw().Before("gorm:raw").Register("test:fail_raw", inject); err != nil {
+		t.Fatalf("register raw failure: %v", err)
+	}
+	return func() { failing = false }
+}
+
+func TestSkillEndorsementRepository_FailedIncrementKeepsNoVote(t *testing.T) {
+	db := pgtest.Open(t)
+	ctx := context.Background()
+	repo := repositories.NewSkillEndorsementRepository(db)
+	tr := seedTree(t, db, "user-1", "endorsed")
+
+	input := dto.EndorseSkillVoteInput{
+		ProjectID:  tr.Project.ID,
+		Skill:      "Go",
+		IPHash:     "visitor",
+		Day:        time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+		DailyLimit: 10,
+	}
+
+	// The vote is inserted, then the count increment (the second step) fails
+	errIncrement := errors.New("increment failed")
+	stop := failStatements(t, db, "INSERT INTO skill_endorsements", errIncrement)
+	result, err := repo.Endorse(ctx, input)
+	if !errors.Is(err, errIncrement) {
+		t.Fatalf("Endorse error = %v, want the increment failure", err)
+	}
+	if result != nil {
+		t.Errorf("Endorse result = %+v, want none for a failed vote", result)
+	}
+
+	var votes, counts int64
+	db.Model(&entities.SkillEndorsementVoteRecord{}).Where("project_id = ?", tr.Project.ID).Count(&votes)
+	db.Model(&entities.SkillEndorsementRecord{}).Where("project_id = ?", tr.Project.ID).Count(&counts)
+	if votes != 0 || counts != 0 {
+		t.Fatalf("after the failure: %d votes and %d counts stored, want none", votes, counts)
+	}
+
+	// Had the vote survived, the retry would be taken for a repeat and not counted
+	stop()
+	result, err = repo.Endorse(ctx, input)
+	if err != nil {
+		t.Fatalf("retry: %v", err)
+	}
+	if !result.Counted || result.Count != 1 || result.ProjectVotes != 1 {
+		t.Errorf("retry = %+v, want the first counted vote", result)
+	}
+}
@@ -0,0 +1,116 @@
+package repositories
+
+import (
+	"crypto/rand"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// newDeleteBatchID generates the random (version 4) UUID shared by every row
+// soft-deleted by one delete operation
+func newDeleteBatchID() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", fmt.Errorf("failed to generate delete batch ID: %w", err)
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
+}
+
+// softDeleteRows soft-deletes the live rows of table matching where, tagging them with batchID
+// Rows that were already deleted keep their own deleted_at and batch ID, which is what
+// lets a restore bring back exactly the rows removed by one operation.
+func softDeleteRows(tx *gorm.DB, table, batchID string, deletedAt time.Time, where string, args ...interface{}) (int64, error) {
+	query := fmt.Sprintf(
+		"UPDATE %s SET deleted_at = ?, delete_batch_id = ? WHERE deleted_at IS NULL AND (%s)",
+		table, where,
+	)
+
+	result := tx.Exec(query, append([]interface{}{deletedAt, batchID}, args...)...)
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to soft delete %s: %w", table, result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
+
+// softDeleteSectionCascade soft-deletes the given sections and their contents as one batch
+func softDeleteSectionCascade(tx *gorm.DB, batchID string, deletedAt time.Time, where string, args ...interface{}) (int64, error) {
+	if _, err := softDeleteRows(tx, "section_contents", batchID, deletedAt,
+		"section_id IN (SELECT id FROM sections WHERE deleted_at IS NULL AND ("+where+"))", args...); err != nil {
+		return 0, err
+	}
+
+	return softDeleteRows(tx, "sections", batchID, deletedAt, where, args...)
+}
+
+// softDeleteProjectCascade soft-deletes the given projects and their collaborators as one batch
+func softDeleteProjectCascade(tx *gorm.DB, batchID string, deletedAt time.Time, where string, args ...interface{}) (int64, error) {
+	if _, err := softDeleteRows(tx, "project_collaborators", batchID, deletedAt,
+		"project_id IN (SELECT id FROM projects WHERE deleted_at IS NULL AND ("+where+"))", args...); err != nil {
+		return 0, err
+	}
+
+	return softDeleteRows(tx, "projects", batchID, deletedAt, where, args...)
+}
+
+// softDeleteCategoryCascade soft-deletes the given categories, their projects and the projects'
+// collaborators as one batch
+func softDeleteCategoryCascade(tx *gorm.DB, batchID string, deletedAt time.Time, where string, args ...interface{}) (int64, error) {
+	if _, err := softDeleteProjectCascade(tx, batchID, deletedAt,
+		"category_id IN (SELECT id FROM categories WHERE deleted_at IS NULL AND ("+where+"))", args...); err != nil {
+		return 0, err
+	}
+
+	return softDeleteRows(tx, "categories", batchID, deletedAt, where, args...)
+}
+
+// softDeletePortfolioCascade soft-deletes a portfolio with its categories, projects (and their
+// collaborators), sections, section contents and links as one batch
+func softDeletePortfolioCascade(tx *gorm.DB, batchID string, deletedAt time.Time, portfolioID uint) (int64, error) {
+	if _, err := softDeleteCategoryCascade(tx, batchID, deletedAt, "portfolio_id = ?", portfolioID); err != nil {
+		return 0, err
+	}
+	if _, err := softDeleteSectionCascade(tx, batchID, deletedAt, "portfolio_id = ?", portfolioID); err != nil {
+		return 0, err
+	}
+	if _, err := softDeleteRows(tx, "portfolio_links", batchID, deletedAt, "portfolio_id = ?", portfolioID); err != nil {
+		return 0, err
+	}
+
+	// A deleted portfolio can't stay anyone's default; resolution falls back to the only portfolio
+	if err := tx.Table("user_settings").
+		Where("default_portfolio_id = ?", portfolioID).
+		Update("default_portfolio_id", nil).Error; err != nil {
+		return 0, fmt.Errorf("failed to clear default portfolio: %w", err)
+	}
+
+	return softDeleteRows(tx, "portfolios", batchID, deletedAt, "id = ?", portfolioID)
+}
+
+// restoreRows brings back the rows of table removed by one delete operation: the rows of batchID,
+// or for rows deleted before batches existed (batchID nil), the rows deleted at deletedAt
+func restoreRows(tx *gorm.DB, table string, batchID *string, deletedAt time.Time, where string, args ...interface{}) (int64, error) {
+	batch := "delete_batch_id IS NULL AND deleted_at = ?"
+	batchArg := interface{}(deletedAt)
+	if batchID != nil {
+		batch = "delete_batch_id = ?"
+		batchArg = *batchID
+	}
+
+	query := fmt.Sprintf(
+		"UPDATE %s SET deleted_at = NULL, delete_batch_id = NULL WHERE deleted_at IS NOT NULL AND %s AND (%s)",
+		table, batch, where,
+	)
+
+	result := tx.Exec(query, append([]interface{}{batchArg}, args...)...)
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to restore %s: %w", table, result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,59 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"gorm.io/gorm"
+)
+
+// titleRepository is the GORM implementation of TitleRepository
+type titleRepository struct {
+	db *gorm.DB
+}
+
+// NewTitleRepository creates a new title repository instance
+func NewTitleRepository(db *gorm.DB) contracts.TitleRepository {
+	return &titleRepository{db: db}
+}
+
+// FindTitleVariants returns the titles of the scope equal to the title or suffixed variants of it
+func (r *titleRepository) FindTitleVariants(ctx context.Context, input dto.CheckTitleInput) (map[string]uint, error) {
+	query := r.db.WithContext(ctx).Where("deleted_at IS NULL")
+
+	switch input.Resource {
+	case dto.TitleResourcePortfolios:
+		query = query.Table("portfolios").Where("owner_id = ?", input.OwnerID)
+	case dto.TitleResourceCategories:
+		query = query.Table("categories").Where("portfolio_id = ?", input.ParentID)
+	case dto.TitleResourceSections:
+		query = query.Table("sections").Where("portfolio_id = ?", input.ParentID)
+	case dto.TitleResourceProjects:
+		query = query.Table("projects").Where("category_id = ?", input.ParentID)
+	default:
+		return nil, fmt.Errorf("unknown title resource %q", input.Resource)
+	}
+
+	if input.ExcludeID > 0 {
+		query = query.Where("id != ?", input.ExcludeID)
+	}
+
+	var rows []struct {
+		ID    uint
+		Title string
+	}
+	if err := query.
+		Select("id, title").
+		Where("title = ? OR title LIKE ?", input.Title, likeEscaper.Replace(input.Title)+" (%)").
+		Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to look up titles: %w", err)
+	}
+
+	variants := make(map[string]uint, len(rows))
+	for _, row := range rows {
+		variants[row.Title] = row.ID
+	}
+	return variants, nil
+}
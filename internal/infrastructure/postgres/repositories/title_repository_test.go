@@ -0,0 +1,60 @@
+package repositories_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/entities"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/pgtest"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/repositories"
+)
+
+func TestTitleRepository_FindTitleVariantsScope(t *testing.T) {
+	db := pgtest.Open(t)
+	repo := repositories.NewTitleRepository(db)
+
+	mine := seedTree(t, db, "alice", "mine")
+	other := seedTree(t, db, "alice", "other")
+	seedTree(t, db, "bob", "bob")
+
+	// Variants of "Blog" in mine, the same title in other, a trashed one and look-alikes
+	blog := entities.SectionRecord{Title: "Blog", Slug: "blog", Type: "text", Position: 2, OwnerID: "alice", PortfolioID: mine.Portfolio.ID}
+	create(t, db, &blog)
+	suffixed := entities.SectionRecord{Title: "Blog (2)", Slug: "blog-2", Type: "text", Position: 3, OwnerID: "alice", PortfolioID: mine.Portfolio.ID}
+	create(t, db, &suffixed)
+	trashed := entities.SectionRecord{Title: "Blog (3)", Slug: "blog-3", Type: "text", Position: 4, OwnerID: "alice", PortfolioID: mine.Portfolio.ID}
+	create(t, db, &trashed)
+	softDelete(t, db, &trashed)
+	create(t, db, &entities.SectionRecord{Title: "Blogroll", Slug: "blogroll", Type: "text", Position: 5, OwnerID: "alice", PortfolioID: mine.Portfolio.ID})
+	create(t, db, &entities.SectionRecord{Title: "Blog", Slug: "blog", Type: "text", Position: 2, OwnerID: "alice", PortfolioID: other.Portfolio.ID})
+	percent := entities.SectionRecord{Title: "100% (2)", Slug: "percent", Type: "text", Position: 6, OwnerID: "alice", PortfolioID: mine.Portfolio.ID}
+	create(t, db, &percent)
+
+	tests := []struct {
+		name  string
+		input dto.CheckTitleInput
+		want  map[string]uint
+	}{
+		{name: "section variants within the portfolio", input: dto.CheckTitleInput{Resource: dto.TitleResourceSections, Title: "Blog", ParentID: mine.Portfolio.ID}, want: map[string]uint{"Blog": blog.ID, "Blog (2)": suffixed.ID}},
+		{name: "edited item excluded", input: dto.CheckTitleInput{Resource: dto.TitleResourceSections, Title: "Blog", ParentID: mine.Portfolio.ID, ExcludeID: blog.ID}, want: map[string]uint{"Blog (2)": suffixed.ID}},
+		{name: "LIKE wildcards are literal", input: dto.CheckTitleInput{Resource: dto.TitleResourceSections, Title: "1_0%", ParentID: mine.Portfolio.ID}, want: map[string]uint{}},
+		{name: "percent in the title", input: dto.CheckTitleInput{Resource: dto.TitleResourceSections, Title: "100%", ParentID: mine.Portfolio.ID}, want: map[string]uint{"100% (2)": percent.ID}},
+		{name: "portfolio titles per owner", input: dto.CheckTitleInput{Resource: dto.TitleResourcePortfolios, Title: "bob", OwnerID: "alice"}, want: map[string]uint{}},
+		{name: "category titles per portfolio", input: dto.CheckTitleInput{Resource: dto.TitleResourceCategories, Title: "other category", ParentID: mine.Portfolio.ID}, want: map[string]uint{}},
+		{name: "project titles per category", input: dto.CheckTitleInput{Resource: dto.TitleResourceProjects, Title: "mine project", ParentID: mine.Category.ID}, want: map[string]uint{"mine project": mine.Project.ID}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := repo.FindTitleVariants(context.Background(), tt.input)
+			if err != nil {
+				t.Fatalf("FindTitleVariants: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FindTitleVariants = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
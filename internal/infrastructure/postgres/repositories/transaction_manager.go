@@ -0,0 +1,51 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"gorm.io/gorm"
+)
+
+// txContextKey carries the transaction of a unit of work through the context
+type txContextKey struct{}
+
+// transactionManager is the implementation of the TransactionManager contract
+type transactionManager struct {
+	db *gorm.DB
+}
+
+// NewTransactionManager creates a new transaction manager on db
+// Returns the interface type (contracts.TransactionManager), not the concrete type
+func NewTransactionManager(db *gorm.DB) contracts.TransactionManager {
+	return &transactionManager{db: db}
+}
+
+// WithinTransaction runs fn in a transaction carried by the context it receives
+func (m *transactionManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txContextKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+	return m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txContextKey{}, tx))
+	})
+}
+
+// conn returns the transaction of the unit of work in ctx, or db outside one
+// Repositories whose methods query through conn join WithinTransaction.
+func conn(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txContextKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
+
+// inTransaction runs fn in the transaction of the unit of work in ctx, or in a new one
+// opts only apply to a new transaction: a unit of work keeps the options it was started with.
+func inTransaction(ctx context.Context, db *gorm.DB, fn func(tx *gorm.DB) error, opts ...*sql.TxOptions) error {
+	if tx, ok := ctx.Value(txContextKey{}).(*gorm.DB); ok {
+		return fn(tx.WithContext(ctx))
+	}
+	return db.WithContext(ctx).Transaction(fn, opts...)
+}
@@ -0,0 +1,151 @@
+package repositories_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/entities"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/pgtest"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/repositories"
+	"gorm.io/gorm"
+)
+
+// portfolioTitles returns the titles of the live portfolios of ownerID
+func portfolioTitles(t *testing.T, db *gorm.DB, ownerID string) map[string]bool {
+	t.Helper()
+
+	var titles []string
+	if err := db.Model(&entities.PortfolioRecord{}).Where("owner_id = ?", ownerID).Pluck("title", &titles).Error; err != nil {
+		t.Fatalf("list portfolios: %v", err)
+	}
+	set := make(map[string]bool, len(titles))
+	for _, title := range titles {
+		set[title] = true
+	}
+	return set
+}
+
+func TestWithinTransaction_PortfolioWrites(t *testing.T) {
+	errSecondWrite := errors.New("second write failed")
+
+	tests := []struct {
+		name string
+		// fail makes the unit of work end with a failing write
+		fail bool
+	}{
+		{name: "failing second write rolls back the first", fail: true},
+		{name: "successful unit of work commits every write", fail: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := pgtest.Open(t)
+			ctx := context.Background()
+			repo := repositories.NewPortfolioRepository(db, false)
+			var txManager contracts.TransactionManager = repositories.NewTransactionManager(db)
+
+			source := seedTree(t, db, "user-1", "source")
+			trashed := seedTree(t, db, "user-1", "trashed")
+			softDelete(t, db, &trashed.Portfolio)
+
+			err := txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+				clone, err := repo.Clone(ctx, source.Portfolio.ID)
+				if err != nil {
+					return err
+				}
+				// Reads inside the unit of work see its own writes
+				if _, err := repo.Export(ctx, clone.Portfolio.ID); err != nil {
+					return err
+				}
+				if _, err := repo.Import(ctx, "user-1", &dto.PortfolioExportDTO{Title: "imported"}, ""); err != nil {
+					return err
+				}
+				if _, err := repo.Restore(ctx, trashed.Portfolio.ID); err != nil {
+					return err
+				}
+				deleted, err := repo.GetDeletedByOwnerID(ctx, "user-1")
+				if err != nil {
+					return err
+				}
+				if len(deleted) != 0 {
+					t.Errorf("trash inside the unit of work has %d portfolios, want 0 after the restore", len(deleted))
+				}
+
+				if tt.fail {
+					// The purge of a live portfolio finds nothing in the trash
+					if err := repo.Purge(ctx, source.Portfolio.ID); err == nil {
+						t.Fatal("Purge of a live portfolio succeeded")
+					}
+					return errSecondWrite
+				}
+				return nil
+			})
+
+			titles := portfolioTitles(t, db, "user-1")
+			deleted, listErr := repo.GetDeletedByOwnerID(ctx, "user-1")
+			if listErr != nil {
+				t.Fatalf("GetDeletedByOwnerID: %v", listErr)
+			}
+
+			if tt.fail {
+				if !errors.Is(err, errSecondWrite) {
+					t.Fatalf("WithinTransaction error = %v, want %v", err, errSecondWrite)
+				}
+				if len(titles) != 1 || !titles["source"] {
+					t.Errorf("live portfolios = %v, want only the source: the clone and import must be rolled back", titles)
+				}
+				if len(deleted) != 1 || deleted[0].Portfolio.ID != trashed.Portfolio.ID {
+					t.Errorf("trash = %v, want the trashed portfolio back in it", deleted)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("WithinTransaction: %v", err)
+			}
+			for _, title := range []string{"source", "source (2)", "imported", "trashed"} {
+				if !titles[title] {
+					t.Errorf("live portfolios = %v, want %q among them", titles, title)
+				}
+			}
+			if len(deleted) != 0 {
+				t.Errorf("trash has %d portfolios, want 0", len(deleted))
+			}
+		})
+	}
+}
+
+func TestWithinTransaction_RollsBackProjectPatch(t *testing.T) {
+	db := pgtest.Open(t)
+	ctx := context.Background()
+	repo := repositories.NewProjectRepository(db, pgtest.SearchConfig)
+	txManager := repositories.NewTransactionManager(db)
+
+	seeded := seedTree(t, db, "user-1", "patched")
+	target := entities.CategoryRecord{Title: "target", Position: 2, OwnerID: "user-1", PortfolioID: seeded.Portfolio.ID}
+	create(t, db, &target)
+
+	errLaterStep := errors.New("later step failed")
+	title := "renamed"
+	err := txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := repo.Patch(ctx, dto.PatchProjectInput{ID: seeded.Project.ID, Title: &title, CategoryID: &target.ID, OwnerID: "user-1"}); err != nil {
+			return err
+		}
+		return errLaterStep
+	})
+	if !errors.Is(err, errLaterStep) {
+		t.Fatalf("WithinTransaction error = %v, want %v", err, errLaterStep)
+	}
+
+	var stored entities.ProjectRecord
+	if err := db.First(&stored, seeded.Project.ID).Error; err != nil {
+		t.Fatalf("load project: %v", err)
+	}
+	if stored.Title != seeded.Project.Title || stored.CategoryID != seeded.Category.ID || stored.Position != seeded.Project.Position {
+		t.Errorf("project = %q in category %d at %d, want the patch rolled back to %q in %d at %d",
+			stored.Title, stored.CategoryID, stored.Position, seeded.Project.Title, seeded.Category.ID, seeded.Project.Position)
+	}
+}
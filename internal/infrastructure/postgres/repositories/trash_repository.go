@@ -0,0 +1,87 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"gorm.io/gorm"
+)
+
+// trashTables maps the trash resources to their tables
+var trashTables = map[string]string{
+	dto.TrashResourcePortfolio:      "portfolios",
+	dto.TrashResourceCategory:       "categories",
+	dto.TrashResourceSection:        "sections",
+	dto.TrashResourceProject:        "projects",
+	dto.TrashResourceSectionContent: "section_contents",
+	dto.TrashResourcePortfolioLink:  "portfolio_links",
+}
+
+// purgeTables are the soft-deletable tables, parents first: rows purged with a parent go
+// through ON DELETE CASCADE and aren't deleted (or counted) again
+var purgeTables = []string{
+	"portfolios",
+	"categories",
+	"sections",
+	"projects",
+	"project_collaborators",
+	"section_contents",
+	"portfolio_links",
+}
+
+// trashRepository is the GORM implementation of TrashRepository
+type trashRepository struct {
+	db *gorm.DB
+}
+
+// NewTrashRepository creates a new trash repository instance
+func NewTrashRepository(db *gorm.DB) contracts.TrashRepository {
+	return &trashRepository{db: db}
+}
+
+// FindDeleted returns the soft-deleted item of the owner, or nil
+func (r *trashRepository) FindDeleted(ctx context.Context, resource string, id uint, ownerID string) (*dto.DeletedItemDTO, error) {
+	table, ok := trashTables[resource]
+	if !ok {
+		return nil, fmt.Errorf("unknown trash resource %q", resource)
+	}
+
+	var deletedAt []time.Time
+	if err := r.db.WithContext(ctx).
+		Table(table).
+		Where("id = ? AND owner_id = ? AND deleted_at IS NOT NULL", id, ownerID).
+		Limit(1).
+		Pluck("deleted_at", &deletedAt).Error; err != nil {
+		return nil, fmt.Errorf("failed to look up deleted %s: %w", resource, err)
+	}
+	if len(deletedAt) == 0 {
+		return nil, nil
+	}
+
+	return &dto.DeletedItemDTO{Resource: resource, ID: id, DeletedAt: deletedAt[0]}, nil
+}
+
+// PurgeDeletedBefore permanently deletes, in one transaction, every row soft-deleted before cutoff
+// The rows of a delete batch share their deletion time, so a batch expires as a whole.
+func (r *trashRepository) PurgeDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	var purged int64
+
+	err := inTransaction(ctx, r.db, func(tx *gorm.DB) error {
+		for _, table := range purgeTables {
+			result := tx.Exec("DELETE FROM "+table+" WHERE deleted_at < ?", cutoff)
+			if result.Error != nil {
+				return fmt.Errorf("failed to purge %s: %w", table, result.Error)
+			}
+			purged += result.RowsAffected
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return purged, nil
+}
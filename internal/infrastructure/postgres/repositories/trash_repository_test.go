@@ -0,0 +1,114 @@
+package repositories_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/entities"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/pgtest"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/repositories"
+)
+
+func TestTrashRepository_FindDeleted(t *testing.T) {
+	db := pgtest.Open(t)
+	repo := repositories.NewTrashRepository(db)
+
+	live := seedTree(t, db, "alice", "live")
+	trashed := seedTree(t, db, "alice", "trashed")
+	othersTrashed := seedTree(t, db, "bob", "others")
+	for _, tr := range []*tree{trashed, othersTrashed} {
+		for _, record := range tr.records() {
+			softDelete(t, db, record)
+		}
+	}
+
+	for resource := range live.records() {
+		t.Run(resource, func(t *testing.T) {
+			tests := []struct {
+				name        string
+				id          uint
+				wantDeleted bool
+			}{
+				{name: "live", id: idOf(live.records()[resource])},
+				{name: "trashed own", id: idOf(trashed.records()[resource]), wantDeleted: true},
+				{name: "trashed other user", id: idOf(othersTrashed.records()[resource])},
+				{name: "never existed", id: 1 << 30},
+			}
+
+			for _, tt := range tests {
+				t.Run(tt.name, func(t *testing.T) {
+					item, err := repo.FindDeleted(context.Background(), resource, tt.id, "alice")
+					if err != nil {
+						t.Fatalf("FindDeleted: %v", err)
+					}
+					if (item != nil) != tt.wantDeleted {
+						t.Fatalf("FindDeleted() = %+v, want deleted %v", item, tt.wantDeleted)
+					}
+					if item != nil && (item.ID != tt.id || item.Resource != resource || item.DeletedAt.IsZero()) {
+						t.Errorf("FindDeleted() = %+v, want %s %d with its deletion time", item, resource, tt.id)
+					}
+				})
+			}
+		})
+	}
+}
+
+func TestTrashRepository_UnknownResource(t *testing.T) {
+	db := pgtest.Open(t)
+
+	if _, err := repositories.NewTrashRepository(db).FindDeleted(context.Background(), "users", 1, "alice"); err == nil {
+		t.Fatal("FindDeleted() of an unknown resource returned no error")
+	}
+}
+
+func TestTrashRepository_PurgeDeletedBefore(t *testing.T) {
+	db := pgtest.Open(t)
+	ctx := context.Background()
+	portfolios := repositories.NewPortfolioRepository(db, false)
+	now := time.Now()
+
+	live := seedTree(t, db, "alice", "live")
+	recent := seedTree(t, db, "alice", "recent")
+	expired := seedTree(t, db, "alice", "expired")
+	for _, tr := range []*tree{recent, expired} {
+		if err := portfolios.Delete(ctx, tr.Portfolio.ID); err != nil {
+			t.Fatalf("Delete: %v", err)
+		}
+	}
+	// The expired batch was deleted 40 days ago, every row of it at the same time
+	var batchID string
+	if err := db.Unscoped().Model(&entities.PortfolioRecord{}).Where("id = ?", expired.Portfolio.ID).Pluck("delete_batch_id", &batchID).Error; err != nil {
+		t.Fatalf("load batch ID: %v", err)
+	}
+	for _, table := range []string{"portfolios", "categories", "projects", "sections", "section_contents", "portfolio_links"} {
+		if err := db.Exec("UPDATE "+table+" SET deleted_at = ? WHERE delete_batch_id = ?", now.AddDate(0, 0, -40), batchID).Error; err != nil {
+			t.Fatalf("backdate %s: %v", table, err)
+		}
+	}
+
+	purged, err := repositories.NewTrashRepository(db).PurgeDeletedBefore(ctx, now.AddDate(0, 0, -30))
+	if err != nil {
+		t.Fatalf("PurgeDeletedBefore: %v", err)
+	}
+	// The portfolio row; its children went with it through the cascade
+	if purged != 1 {
+		t.Errorf("purged %d rows, want 1", purged)
+	}
+
+	for resource := range live.records() {
+		for _, tt := range []struct {
+			tr       *tree
+			wantKept bool
+		}{{live, true}, {recent, true}, {expired, false}} {
+			var count int64
+			record := tt.tr.records()[resource]
+			if err := db.Unscoped().Model(record).Where("id = ?", idOf(record)).Count(&count).Error; err != nil {
+				t.Fatalf("count %s: %v", resource, err)
+			}
+			if (count == 1) != tt.wantKept {
+				t.Errorf("%s of %q: %d rows left, want kept %v", resource, tt.tr.Portfolio.Title, count, tt.wantKept)
+			}
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/pkg/random"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+// defaultUniqueAttempts is how many candidates are tried before giving up
+const defaultUniqueAttempts = 5
+
+// pgUniqueViolation is the PostgreSQL SQLSTATE for unique constraint violations
+const pgUniqueViolation = "23505"
+
+// isUniqueViolation reports whether err was caused by a unique constraint violation
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == pgUniqueViolation
+	}
+	return errors.Is(err, gorm.ErrDuplicatedKey)
+}
+
+// uniqueCandidate produces the candidate for a given attempt (0-based)
+// Implementations should widen their randomness as attempt grows
+type uniqueCandidate func(attempt int) (string, error)
+
+// randomCandidate returns candidates of baseLength random characters, two
+// characters longer on every retry, drawn from gen
+func randomCandidate(gen *random.Generator, baseLength int) uniqueCandidate {
+	return func(attempt int) (string, error) {
+		return gen.String(baseLength + attempt*2)
+	}
+}
+
+// numberedCandidate returns base when it is free, otherwise the first free of base-2, base-3...
+// taken lists the values in use among base and its numbered variants; it is reloaded on
+// every attempt, so a retry after a concurrent insert moves past the value that won
+func numberedCandidate(base string, taken func(base string) ([]string, error)) uniqueCandidate {
+	return func(attempt int) (string, error) {
+		values, err := taken(base)
+		if err != nil {
+			return "", err
+		}
+
+		used := make(map[string]bool, len(values))
+		for _, value := range values {
+			used[value] = true
+		}
+
+		candidate := base
+		for n := 2; used[candidate]; n++ {
+			candidate = fmt.Sprintf("%s-%d", base, n)
+		}
+		return candidate, nil
+	}
+}
+
+// insertWithUniqueRetry runs insert with successive candidates until one does
+// not violate a unique constraint, returning the candidate that was stored.
+// Each attempt runs in its own (nested) transaction so a violation inside an
+// outer transaction only rolls back to a savepoint.
+// After maxAttempts collisions it returns contracts.ErrUniqueCandidatesExhausted.
+func insertWithUniqueRetry(db *gorm.DB, maxAttempts int, candidate uniqueCandidate, insert func(tx *gorm.DB, candidate string) error) (string, error) {
+	if maxAttempts <= 0 {
+		maxAttempts = defaultUniqueAttempts
+	}
+
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		value, err := candidate(attempt)
+		if err != nil {
+			return "", err
+		}
+
+		err = db.Transaction(func(tx *gorm.DB) error {
+			return insert(tx, value)
+		})
+		if err == nil {
+			return value, nil
+		}
+		if !isUniqueViolation(err) {
+			return "", err
+		}
+		// Frequent collisions mean the candidate space is too small; the value itself may be a secret
+		log.Printf("⚠️  Unique value collision on attempt %d of %d, retrying", attempt+1, maxAttempts)
+	}
+
+	return "", fmt.Errorf("%w (%d attempts)", contracts.ErrUniqueCandidatesExhausted, maxAttempts)
+}
@@ -0,0 +1,168 @@
+package repositories_test
+
+import (
+	"errors"
+	mathrand "math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/pgtest"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/repositories"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/pkg/random"
+	"gorm.io/gorm"
+)
+
+func TestRandomCandidateWidens(t *testing.T) {
+	candidate := repositories.RandomCandidate(random.NewGenerator(mathrand.New(mathrand.NewSource(1)), random.LowerAlphanumeric), 6)
+
+	for attempt, wantLength := range []int{6, 8, 10} {
+		value, err := candidate(attempt)
+		if err != nil {
+			t.Fatalf("attempt %d: %v", attempt, err)
+		}
+		if len(value) != wantLength {
+			t.Errorf("attempt %d: %q has %d characters, want %d", attempt, value, len(value), wantLength)
+		}
+	}
+}
+
+func TestInsertWithUniqueRetry(t *testing.T) {
+	db := pgtest.OpenEmpty(t)
+	if err := db.Exec("CREATE TABLE tokens (value text UNIQUE)").Error; err != nil {
+		t.Fatalf("create table: %v", err)
+	}
+	gen := func() *random.Generator {
+		return random.NewGenerator(mathrand.New(mathrand.NewSource(7)), random.LowerAlphanumeric)
+	}
+	// The values the seeded generator draws on successive attempts, to occupy them before the test
+	var drawn []string
+	draw := repositories.RandomCandidate(gen(), 4)
+	for attempt := 0; attempt < 3; attempt++ {
+		value, err := draw(attempt)
+		if err != nil {
+			t.Fatalf("draw: %v", err)
+		}
+		drawn = append(drawn, value)
+	}
+	insert := func(tx *gorm.DB, value string) error {
+		return tx.Exec("INSERT INTO tokens (value) VALUES (?)", value).Error
+	}
+	errBroken := errors.New("broken insert")
+
+	tests := []struct {
+		name        string
+		taken       int // how many of the drawn values are occupied
+		maxAttempts int
+		insert      func(tx *gorm.DB, value string) error
+		wantValue   int // index of the drawn value stored
+		wantErr     error
+		wantCalls   int
+	}{
+		{name: "free first candidate", taken: 0, maxAttempts: 3, insert: insert, wantValue: 0, wantCalls: 1},
+		{name: "two collisions widen the candidate", taken: 2, maxAttempts: 3, insert: insert, wantValue: 2, wantCalls: 3},
+		{name: "collisions exhaust the attempts", taken: 3, maxAttempts: 3, insert: insert, wantErr: contracts.ErrUniqueCandidatesExhausted, wantCalls: 3},
+		{name: "other errors are not retried", maxAttempts: 3, insert: func(*gorm.DB, string) error { return errBroken }, wantErr: errBroken, wantCalls: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := db.Exec("DELETE FROM tokens").Error; err != nil {
+				t.Fatalf("clear: %v", err)
+			}
+			for _, value := range drawn[:tt.taken] {
+				if err := insert(db, value); err != nil {
+					t.Fatalf("occupy %q: %v", value, err)
+				}
+			}
+
+			calls := 0
+			value, err := repositories.InsertWithUniqueRetry(db, tt.maxAttempts, repositories.RandomCandidate(gen(), 4), func(tx *gorm.DB, value string) error {
+				calls++
+				return tt.insert(tx, value)
+			})
+
+			if calls != tt.wantCalls {
+				t.Errorf("insert ran %d times, want %d", calls, tt.wantCalls)
+			}
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("InsertWithUniqueRetry: %v", err)
+			}
+			if value != drawn[tt.wantValue] {
+				t.Errorf("stored %q, want %q", value, drawn[tt.wantValue])
+			}
+		})
+	}
+}
+
+func TestNumberedCandidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		taken []string
+		want  string
+	}{
+		{name: "free base", taken: nil, want: "about"},
+		{name: "base taken", taken: []string{"about"}, want: "about-2"},
+		{name: "base and -2 taken", taken: []string{"about", "about-2"}, want: "about-3"},
+		{name: "gap in the numbering", taken: []string{"about", "about-3"}, want: "about-2"},
+		{name: "only a numbered variant taken", taken: []string{"about-2"}, want: "about"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var asked []string
+			candidate := repositories.NumberedCandidate("about", func(base string) ([]string, error) {
+				asked = append(asked, base)
+				return tt.taken, nil
+			})
+
+			got, err := candidate(0)
+			if err != nil {
+				t.Fatalf("candidate: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("candidate = %q, want %q", got, tt.want)
+			}
+			if !reflect.DeepEqual(asked, []string{"about"}) {
+				t.Errorf("taken asked for %v, want the base", asked)
+			}
+		})
+	}
+}
+
+func TestNumberedCandidateAfterConcurrentInsert(t *testing.T) {
+	// Another request stores each value right after it is handed out, like a racing insert
+	stored := []string{"about"}
+	candidate := repositories.NumberedCandidate("about", func(string) ([]string, error) {
+		return append([]string(nil), stored...), nil
+	})
+
+	var got []string
+	for attempt := 0; attempt < 3; attempt++ {
+		value, err := candidate(attempt)
+		if err != nil {
+			t.Fatalf("attempt %d: %v", attempt, err)
+		}
+		got = append(got, value)
+		stored = append(stored, value)
+	}
+
+	if want := []string{"about-2", "about-3", "about-4"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("candidates = %v, want %v", got, want)
+	}
+}
+
+func TestNumberedCandidateLookupError(t *testing.T) {
+	errLookup := errors.New("lookup failed")
+	candidate := repositories.NumberedCandidate("about", func(string) ([]string, error) { return nil, errLookup })
+
+	if _, err := candidate(0); !errors.Is(err, errLookup) {
+		t.Fatalf("candidate error = %v, want %v", err, errLookup)
+	}
+}
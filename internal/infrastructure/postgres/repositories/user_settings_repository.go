@@ -0,0 +1,118 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/postgres/entities"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// userSettingsRepository is the GORM implementation of UserSettingsRepository
+// It implements the contract defined in the application layer
+type userSettingsRepository struct {
+	db *gorm.DB
+}
+
+// NewUserSettingsRepository creates a new user settings repository instance
+// Returns the interface type (contracts.UserSettingsRepository), not the concrete type
+func NewUserSettingsRepository(db *gorm.DB) contracts.UserSettingsRepository {
+	return &userSettingsRepository{db: db}
+}
+
+// Get retrieves the settings of a user, or the defaults when no row exists yet
+func (r *userSettingsRepository) Get(ctx context.Context, userID string) (*dto.UserSettingsDTO, error) {
+	var records []entities.UserSettingsRecord
+
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Limit(1).
+		Find(&records).Error; err != nil {
+		return nil, fmt.Errorf("failed to get user settings: %w", err)
+	}
+
+	if len(records) == 0 {
+		return &dto.UserSettingsDTO{UserID: userID}, nil
+	}
+
+	return r.recordToDTO(&records[0]), nil
+}
+
+// SetDefaultPortfolio upserts the user's settings row with the given default portfolio
+func (r *userSettingsRepository) SetDefaultPortfolio(ctx context.Context, userID string, portfolioID *uint) error {
+	record := &entities.UserSettingsRecord{
+		UserID:             userID,
+		DefaultPortfolioID: portfolioID,
+	}
+
+	if err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"default_portfolio_id", "updated_at"}),
+		}).
+		Create(record).Error; err != nil {
+		return fmt.Errorf("failed to update user settings: %w", err)
+	}
+
+	return nil
+}
+
+// SetProjectDefaults upserts the user's settings row with the given project defaults
+func (r *userSettingsRepository) SetProjectDefaults(ctx context.Context, userID string, defaults dto.ProjectDefaultsDTO) error {
+	encoded, err := json.Marshal(projectDefaultsJSON{
+		Client:     defaults.Client,
+		Skills:     defaults.Skills,
+		Categories: defaults.Categories,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode project defaults: %w", err)
+	}
+
+	record := &entities.UserSettingsRecord{
+		UserID:          userID,
+		ProjectDefaults: string(encoded),
+	}
+
+	if err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"project_defaults", "updated_at"}),
+		}).
+		Create(record).Error; err != nil {
+		return fmt.Errorf("failed to update user settings: %w", err)
+	}
+
+	return nil
+}
+
+// projectDefaultsJSON is the stored shape of the project_defaults column
+type projectDefaultsJSON struct {
+	Client     *string       `json:"client,omitempty"`
+	Skills     []string      `json:"skills,omitempty"`
+	Categories map[uint]uint `json:"categories,omitempty"`
+}
+
+// recordToDTO converts a UserSettingsRecord (infrastructure) to UserSettingsDTO (application)
+func (r *userSettingsRepository) recordToDTO(record *entities.UserSettingsRecord) *dto.UserSettingsDTO {
+	// A malformed column (hand edits) reads as no defaults rather than failing every project create
+	var defaults projectDefaultsJSON
+	if record.ProjectDefaults != "" {
+		_ = json.Unmarshal([]byte(record.ProjectDefaults), &defaults)
+	}
+
+	return &dto.UserSettingsDTO{
+		UserID:             record.UserID,
+		DefaultPortfolioID: record.DefaultPortfolioID,
+		ProjectDefaults: dto.ProjectDefaultsDTO{
+			Client:     defaults.Client,
+			Skills:     defaults.Skills,
+			Categories: defaults.Categories,
+		},
+		CreatedAt: record.CreatedAt,
+		UpdatedAt: record.UpdatedAt,
+	}
+}
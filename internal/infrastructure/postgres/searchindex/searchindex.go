@@ -0,0 +1,208 @@
+package searchindex
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// Column is the tsvector column maintained on every indexed table
+const Column = "search_vector"
+
+// DefaultConfig is the text search configuration used when none is set
+const DefaultConfig = "simple"
+
+// Source is one column a search vector is computed from
+type Source struct {
+	Column string
+	Weight string // A (highest) to D
+	Array  bool   // text[] column, indexed as its space-joined elements
+}
+
+// Index describes the search vector of one table
+type Index struct {
+	Table   string
+	Sources []Source
+}
+
+// Indexes lists every table with a search vector
+var Indexes = []Index{
+	{Table: "projects", Sources: []Source{
+		{Column: "title", Weight: "A"},
+		{Column: "skills", Weight: "B", Array: true},
+		{Column: "client", Weight: "B"},
+		{Column: "description", Weight: "C"},
+	}},
+	{Table: "sections", Sources: []Source{
+		{Column: "title", Weight: "A"},
+		{Column: "description", Weight: "B"},
+	}},
+	{Table: "section_contents", Sources: []Source{
+		{Column: "content", Weight: "B"},
+	}},
+}
+
+var configPattern = regexp.MustCompile(`^[a-z_]+$`)
+
+// ValidateConfig checks a text search configuration name before it is inlined into SQL
+func ValidateConfig(config string) error {
+	if !configPattern.MatchString(config) {
+		return fmt.Errorf("invalid text search configuration %q", config)
+	}
+	return nil
+}
+
+var termPattern = regexp.MustCompile(`[\p{L}\p{N}]+`)
+
+// maxQueryTerms caps the words of a prefix query; later words are ignored
+const maxQueryTerms = 10
+
+// PrefixQuery turns free text into a to_tsquery expression matching documents that contain
+// every word as a word prefix ("react nat" -> "react:* & nat:*"). Punctuation is dropped, so
+// the result is always a valid expression; it is empty when the text has no words
+func PrefixQuery(text string) string {
+	terms := termPattern.FindAllString(strings.ToLower(text), maxQueryTerms)
+	for i, term := range terms {
+		terms[i] = term + ":*"
+	}
+	return strings.Join(terms, " & ")
+}
+
+// Lookup returns the index of a table
+func Lookup(table string) (Index, bool) {
+	for _, index := range Indexes {
+		if index.Table == table {
+			return index, true
+		}
+	}
+	return Index{}, false
+}
+
+// expression returns the SQL computing the vector from the source columns, qualified with prefix (NEW. or table.)
+func (i Index) expression(config, prefix string) string {
+	parts := make([]string, len(i.Sources))
+	for n, source := range i.Sources {
+		value := prefix + source.Column
+		if source.Array {
+			value = fmt.Sprintf("array_to_string(%s, ' ')", value)
+		}
+		parts[n] = fmt.Sprintf("setweight(to_tsvector('%s', coalesce(%s, '')), '%s')", config, value, source.Weight)
+	}
+	return strings.Join(parts, " || ")
+}
+
+func (i Index) sourceColumns() string {
+	columns := make([]string, len(i.Sources))
+	for n, source := range i.Sources {
+		columns[n] = source.Column
+	}
+	return strings.Join(columns, ", ")
+}
+
+// EnsureTriggers adds the search vector column, its GIN index and the trigger keeping it current
+// on insert and on update of the source columns. It is idempotent and re-creates the trigger
+// functions, so a changed config takes effect on the next start (existing rows need a rebuild)
+func EnsureTriggers(db *gorm.DB, config string) error {
+	if err := ValidateConfig(config); err != nil {
+		return err
+	}
+
+	for _, index := range Indexes {
+		function := fmt.Sprintf("%s_search_vector_update", index.Table)
+		trigger := fmt.Sprintf("trg_%s_search_vector", index.Table)
+
+		statements := []string{
+			fmt.Sprintf("ALTER TABLE %s ADD COLUMN IF NOT EXISTS %s tsvector", index.Table, Column),
+			fmt.Sprintf("CREATE INDEX IF NOT EXISTS idx_%s_%s ON %s USING GIN (%s)", index.Table, Column, index.Table, Column),
+			fmt.Sprintf(
+				"CREATE OR REPLACE FUNCTION %s() RETURNS trigger AS $$ BEGIN NEW.%s := %s; RETURN NEW; END $$ LANGUAGE plpgsql",
+				function, Column, index.expression(config, "NEW."),
+			),
+			fmt.Sprintf("DROP TRIGGER IF EXISTS %s ON %s", trigger, index.Table),
+			fmt.Sprintf(
+				"CREATE TRIGGER %s BEFORE INSERT OR UPDATE OF %s ON %s FOR EACH ROW EXECUTE FUNCTION %s()",
+				trigger, index.sourceColumns(), index.Table, function,
+			),
+		}
+
+		err := db.Transaction(func(tx *gorm.DB) error {
+			for _, statement := range statements {
+				if err := tx.Exec(statement).Error; err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to set up search index on %s: %w", index.Table, err)
+		}
+	}
+
+	return nil
+}
+
+// Progress is called after every rebuilt batch with the last rebuilt ID and the running total
+type Progress func(lastID uint, rebuilt int64)
+
+// Rebuild recomputes the search vectors of a table (soft-deleted rows included, so restores stay
+// searchable) in batches of batchSize rows in ID order, starting after afterID.
+// Recomputing from the source columns makes it safe to re-run, and afterID resumes an interrupted run
+func Rebuild(ctx context.Context, db *gorm.DB, index Index, config string, afterID uint, batchSize int, progress Progress) (int64, error) {
+	if err := ValidateConfig(config); err != nil {
+		return 0, err
+	}
+
+	query := fmt.Sprintf(
+		"WITH batch AS (SELECT id FROM %s WHERE id > ? ORDER BY id LIMIT ?) "+
+			"UPDATE %s SET %s = %s FROM batch WHERE %s.id = batch.id RETURNING %s.id",
+		index.Table, index.Table, Column, index.expression(config, index.Table+"."), index.Table, index.Table,
+	)
+
+	var rebuilt int64
+	lastID := afterID
+	for {
+		if err := ctx.Err(); err != nil {
+			return rebuilt, err
+		}
+
+		var ids []uint
+		if err := db.WithContext(ctx).Raw(query, lastID, batchSize).Scan(&ids).Error; err != nil {
+			return rebuilt, fmt.Errorf("failed to rebuild %s after id %d: %w", index.Table, lastID, err)
+		}
+		if len(ids) == 0 {
+			return rebuilt, nil
+		}
+
+		for _, id := range ids {
+			if id > lastID {
+				lastID = id
+			}
+		}
+		rebuilt += int64(len(ids))
+
+		if progress != nil {
+			progress(lastID, rebuilt)
+		}
+	}
+}
+
+// CountMissing counts the live rows of every indexed table that have no search vector.
+// Rows only end up there when the triggers are missing or the table was never rebuilt
+func CountMissing(ctx context.Context, db *gorm.DB) (map[string]int64, error) {
+	missing := make(map[string]int64, len(Indexes))
+	for _, index := range Indexes {
+		var count int64
+		if err := db.WithContext(ctx).
+			Table(index.Table).
+			Where(Column + " IS NULL AND deleted_at IS NULL").
+			Count(&count).Error; err != nil {
+			return nil, fmt.Errorf("failed to count missing search vectors in %s: %w", index.Table, err)
+		}
+		missing[index.Table] = count
+	}
+
+	return missing, nil
+}
@@ -0,0 +1,36 @@
+package searchindex
+
+import "testing"
+
+func TestPrefixQuery(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{text: "react nat", want: "react:* & nat:*"},
+		{text: "  Go,  (SQL) & !", want: "go:* & sql:*"},
+		{text: "café 2024", want: "café:* & 2024:*"},
+		{text: "'); DROP TABLE projects; --", want: "drop:* & table:* & projects:*"},
+		{text: "!!!", want: ""},
+		{text: "a b c d e f g h i j k l", want: "a:* & b:* & c:* & d:* & e:* & f:* & g:* & h:* & i:* & j:*"},
+	}
+
+	for _, tt := range tests {
+		if got := PrefixQuery(tt.text); got != tt.want {
+			t.Errorf("PrefixQuery(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestValidateConfig(t *testing.T) {
+	for _, config := range []string{"simple", "english", "portuguese"} {
+		if err := ValidateConfig(config); err != nil {
+			t.Errorf("ValidateConfig(%q) = %v, want nil", config, err)
+		}
+	}
+	for _, config := range []string{"", "English", "simple'; DROP TABLE projects; --", "pg_catalog.simple"} {
+		if err := ValidateConfig(config); err == nil {
+			t.Errorf("ValidateConfig(%q) = nil, want an error", config)
+		}
+	}
+}
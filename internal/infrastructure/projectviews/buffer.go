@@ -0,0 +1,75 @@
+package projectviews
+
+import (
+	"sync"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// bufferKey is one project on one UTC day
+type bufferKey struct {
+	projectID uint
+	day       time.Time
+}
+
+// buffer is the in-memory ProjectViewRecorder of one API instance
+// Views are only held until the next flush, so a crash loses at most one interval of them.
+type buffer struct {
+	mu      sync.Mutex
+	pending map[bufferKey]*dto.ProjectViewBatchDTO
+}
+
+// NewBuffer creates an empty view buffer
+func NewBuffer() contracts.ProjectViewRecorder {
+	return &buffer{pending: make(map[bufferKey]*dto.ProjectViewBatchDTO)}
+}
+
+// Record counts one view in the batch of the project and day
+func (b *buffer) Record(projectID uint, at time.Time) {
+	at = at.UTC()
+	key := bufferKey{projectID: projectID, day: time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, time.UTC)}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.addLocked(key, dto.ProjectViewBatchDTO{ProjectID: projectID, Day: key.day, Views: 1, LastViewedAt: at})
+}
+
+// Drain swaps the buffer for an empty one and returns its batches
+func (b *buffer) Drain() []dto.ProjectViewBatchDTO {
+	b.mu.Lock()
+	pending := b.pending
+	b.pending = make(map[bufferKey]*dto.ProjectViewBatchDTO)
+	b.mu.Unlock()
+
+	batches := make([]dto.ProjectViewBatchDTO, 0, len(pending))
+	for _, batch := range pending {
+		batches = append(batches, *batch)
+	}
+	return batches
+}
+
+// Requeue merges the batches back into views recorded since the drain
+func (b *buffer) Requeue(batches []dto.ProjectViewBatchDTO) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, batch := range batches {
+		b.addLocked(bufferKey{projectID: batch.ProjectID, day: batch.Day}, batch)
+	}
+}
+
+func (b *buffer) addLocked(key bufferKey, batch dto.ProjectViewBatchDTO) {
+	existing, ok := b.pending[key]
+	if !ok {
+		b.pending[key] = &batch
+		return
+	}
+
+	existing.Views += batch.Views
+	if batch.LastViewedAt.After(existing.LastViewedAt) {
+		existing.LastViewedAt = batch.LastViewedAt
+	}
+}
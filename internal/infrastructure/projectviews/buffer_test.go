@@ -0,0 +1,91 @@
+package projectviews
+
+import (
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+)
+
+// sorted orders batches by project, then day
+func sorted(batches []dto.ProjectViewBatchDTO) []dto.ProjectViewBatchDTO {
+	sort.Slice(batches, func(i, j int) bool {
+		if batches[i].ProjectID != batches[j].ProjectID {
+			return batches[i].ProjectID < batches[j].ProjectID
+		}
+		return batches[i].Day.Before(batches[j].Day)
+	})
+	return batches
+}
+
+func TestBuffer_RecordAndDrain(t *testing.T) {
+	b := NewBuffer()
+	day := time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)
+	saoPaulo := time.FixedZone("BRT", -3*60*60)
+
+	b.Record(1, day.Add(10*time.Hour))
+	b.Record(1, day.Add(9*time.Hour)) // older view: counted, last view unchanged
+	b.Record(2, day.Add(12*time.Hour))
+	// 22:30 in São Paulo is already the next UTC day
+	b.Record(1, time.Date(2026, 3, 9, 22, 30, 0, 0, saoPaulo))
+
+	got := sorted(b.Drain())
+	want := []dto.ProjectViewBatchDTO{
+		{ProjectID: 1, Day: day, Views: 2, LastViewedAt: day.Add(10 * time.Hour)},
+		{ProjectID: 1, Day: day.AddDate(0, 0, 1), Views: 1, LastViewedAt: day.Add(25*time.Hour + 30*time.Minute)},
+		{ProjectID: 2, Day: day, Views: 1, LastViewedAt: day.Add(12 * time.Hour)},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Drain = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i].ProjectID != want[i].ProjectID || !got[i].Day.Equal(want[i].Day) ||
+			got[i].Views != want[i].Views || !got[i].LastViewedAt.Equal(want[i].LastViewedAt) {
+			t.Errorf("batch %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+
+	if again := b.Drain(); len(again) != 0 {
+		t.Errorf("second Drain = %+v, want an empty buffer", again)
+	}
+}
+
+func TestBuffer_RequeueMergesWithNewViews(t *testing.T) {
+	b := NewBuffer()
+	day := time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)
+
+	b.Record(1, day.Add(time.Hour))
+	failed := b.Drain()
+
+	// Views recorded while the failed flush ran are kept alongside the requeued ones
+	b.Record(1, day.Add(2*time.Hour))
+	b.Requeue(failed)
+
+	got := b.Drain()
+	if len(got) != 1 || got[0].Views != 2 || !got[0].LastViewedAt.Equal(day.Add(2*time.Hour)) {
+		t.Errorf("Drain after Requeue = %+v, want one batch of 2 views last viewed at 02:00", got)
+	}
+}
+
+func TestBuffer_ConcurrentRecords(t *testing.T) {
+	b := NewBuffer()
+	now := time.Date(2026, 3, 9, 12, 0, 0, 0, time.UTC)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				b.Record(7, now)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := b.Drain(); len(got) != 1 || got[0].Views != 1000 {
+		t.Errorf("Drain = %+v, want one batch of 1000 views", got)
+	}
+}
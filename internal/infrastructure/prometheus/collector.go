@@ -36,6 +36,24 @@ type metricsCollector struct {
 	// HTTP metrics
 	httpRequestsTotal   *prometheus.CounterVec
 	httpRequestDuration *prometheus.HistogramVec
+
+	// Heavy operation limiter metrics
+	heavyOperationsInFlight  *prometheus.GaugeVec
+	heavyOperationRejections *prometheus.CounterVec
+
+	// Rate limiter metrics
+	rateLimitRejections *prometheus.CounterVec
+
+	// Response compression metrics
+	compressedResponses      prometheus.Counter
+	compressionOriginalBytes prometheus.Counter
+	compressionEncodedBytes  prometheus.Counter
+
+	// Change event stream metrics
+	eventStreamConnections prometheus.Gauge
+
+	// Search index metrics
+	searchVectorsMissing *prometheus.GaugeVec
 }
 
 // NewMetricsCollector creates a new Prometheus metrics collector
@@ -128,7 +146,63 @@ func NewMetricsCollector() contracts.MetricsCollector {
 				Help:    "HTTP request duration in seconds",
 				Buckets: prometheus.DefBuckets,
 			},
-			[]string{"method", "path"},
+			[]string{"method", "path", "status"},
+		),
+
+		// Heavy operation limiter metrics
+		heavyOperationsInFlight: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "heavy_operations_in_flight",
+				Help: "Number of heavy operations currently holding a per-user concurrency slot",
+			},
+			[]string{"operation"},
+		),
+		heavyOperationRejections: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "heavy_operation_rejections_total",
+				Help: "Total number of heavy operations rejected by the per-user concurrency limit",
+			},
+			[]string{"operation"},
+		),
+
+		// Rate limiter metrics
+		rateLimitRejections: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "rate_limit_rejections_total",
+				Help: "Total number of requests rejected with 429 by a rate limiter",
+			},
+			[]string{"limiter"},
+		),
+
+		// Response compression metrics
+		compressedResponses: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "compressed_responses_total",
+			Help: "Total number of responses sent gzip-encoded",
+		}),
+		compressionOriginalBytes: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "compression_original_bytes_total",
+			Help: "Total size of gzip-encoded responses before encoding",
+		}),
+		compressionEncodedBytes: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "compression_encoded_bytes_total",
+			Help: "Total size of gzip-encoded responses as sent (bytes saved = original - encoded)",
+		}),
+
+		// Change event stream metrics
+		eventStreamConnections: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Name: "event_stream_connections",
+				Help: "Number of open own-content change event streams (SSE)",
+			},
+		),
+
+		// Search index metrics
+		searchVectorsMissing: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "search_vectors_missing",
+				Help: "Live rows without a search vector (the search index triggers are missing or the index was never rebuilt)",
+			},
+			[]string{"table"},
 		),
 	}
 
@@ -161,6 +235,24 @@ func NewMetricsCollector() contracts.MetricsCollector {
 		// HTTP metrics
 		collector.httpRequestsTotal,
 		collector.httpRequestDuration,
+
+		// Heavy operation limiter metrics
+		collector.heavyOperationsInFlight,
+		collector.heavyOperationRejections,
+
+		// Rate limiter metrics
+		collector.rateLimitRejections,
+
+		// Response compression metrics
+		collector.compressedResponses,
+		collector.compressionOriginalBytes,
+		collector.compressionEncodedBytes,
+
+		// Change event stream metrics
+		collector.eventStreamConnections,
+
+		// Search index metrics
+		collector.searchVectorsMissing,
 	)
 
 	return collector
@@ -235,9 +327,45 @@ func (m *metricsCollector) IncrementJwtTokens(tokenType string) {
 // HTTP metrics implementation
 
 func (m *metricsCollector) RecordHttpDuration(method, path string, status int, duration float64) {
-	m.httpRequestDuration.WithLabelValues(method, path).Observe(duration)
+	m.httpRequestDuration.WithLabelValues(method, path, strconv.Itoa(status)).Observe(duration)
 }
 
 func (m *metricsCollector) IncrementHttpRequests(method, path string, status int) {
 	m.httpRequestsTotal.WithLabelValues(method, path, strconv.Itoa(status)).Inc()
 }
+
+// Heavy operation limiter metrics implementation
+
+func (m *metricsCollector) AddHeavyOperationsInFlight(operation string, delta int) {
+	m.heavyOperationsInFlight.WithLabelValues(operation).Add(float64(delta))
+}
+
+func (m *metricsCollector) IncrementHeavyOperationRejections(operation string) {
+	m.heavyOperationRejections.WithLabelValues(operation).Inc()
+}
+
+// Rate limiter metrics implementation
+
+func (m *metricsCollector) IncrementRateLimitRejections(limiter string) {
+	m.rateLimitRejections.WithLabelValues(limiter).Inc()
+}
+
+// Response compression metrics implementation
+
+func (m *metricsCollector) AddCompressedResponse(originalBytes, encodedBytes int) {
+	m.compressedResponses.Inc()
+	m.compressionOriginalBytes.Add(float64(originalBytes))
+	m.compressionEncodedBytes.Add(float64(encodedBytes))
+}
+
+// Change event stream metrics implementation
+
+func (m *metricsCollector) AddEventStreamConnections(delta int) {
+	m.eventStreamConnections.Add(float64(delta))
+}
+
+// Search index metrics implementation
+
+func (m *metricsCollector) SetSearchVectorsMissing(table string, count int64) {
+	m.searchVectorsMissing.WithLabelValues(table).Set(float64(count))
+}
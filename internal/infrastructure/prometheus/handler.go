@@ -0,0 +1,21 @@
+package prometheus
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Handler serves every registered metric in the Prometheus text exposition format
+// (gzip-encoded for scrapers that accept it)
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// RegisterDatabaseStats exposes the connection pool stats of db (go_sql_* metrics, labeled db_name)
+func RegisterDatabaseStats(db *sql.DB, name string) error {
+	return prometheus.Register(collectors.NewDBStatsCollector(db, name))
+}
@@ -0,0 +1,40 @@
+package storage
+
+import (
+	"strings"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+)
+
+// publicURLBuilder prefixes stored relative asset paths with a public base URL
+type publicURLBuilder struct {
+	baseURL string
+}
+
+// NewPublicURLBuilder creates a builder serving assets from baseURL (e.g. https://cdn.example.com)
+// With an empty base the paths are returned as stored (relative).
+// Returns the interface type (contracts.AssetURLBuilder), not the concrete type
+func NewPublicURLBuilder(baseURL string) contracts.AssetURLBuilder {
+	return &publicURLBuilder{baseURL: strings.TrimRight(strings.TrimSpace(baseURL), "/")}
+}
+
+// AbsoluteURL returns the absolute public URL of a stored path
+func (b *publicURLBuilder) AbsoluteURL(path string) string {
+	if path == "" || b.baseURL == "" || isAbsoluteURL(path) {
+		return path
+	}
+	return b.baseURL + "/" + strings.TrimLeft(path, "/")
+}
+
+// BaseURL returns the public base URL assets are served from ("" when paths stay relative)
+func (b *publicURLBuilder) BaseURL() string {
+	return b.baseURL
+}
+
+// isAbsoluteURL reports whether path already carries a scheme or is protocol-relative
+func isAbsoluteURL(path string) bool {
+	lower := strings.ToLower(path)
+	return strings.HasPrefix(lower, "http://") ||
+		strings.HasPrefix(lower, "https://") ||
+		strings.HasPrefix(lower, "//")
+}
@@ -0,0 +1,32 @@
+package storage
+
+import "testing"
+
+func TestPublicURLBuilder(t *testing.T) {
+	tests := []struct {
+		name     string
+		base     string
+		path     string
+		wantURL  string
+		wantBase string
+	}{
+		{name: "relative path", base: "https://cdn.example.com", path: "/uploads/a.png", wantURL: "https://cdn.example.com/uploads/a.png", wantBase: "https://cdn.example.com"},
+		{name: "trailing slash and spaces", base: " https://cdn.example.com/ ", path: "uploads/a.png", wantURL: "https://cdn.example.com/uploads/a.png", wantBase: "https://cdn.example.com"},
+		{name: "absolute path kept", base: "https://cdn.example.com", path: "HTTPS://other.example.com/a.png", wantURL: "HTTPS://other.example.com/a.png", wantBase: "https://cdn.example.com"},
+		{name: "protocol-relative path kept", base: "https://cdn.example.com", path: "//other.example.com/a.png", wantURL: "//other.example.com/a.png", wantBase: "https://cdn.example.com"},
+		{name: "no base keeps paths relative", path: "/uploads/a.png", wantURL: "/uploads/a.png"},
+		{name: "empty path", base: "https://cdn.example.com", wantBase: "https://cdn.example.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder := NewPublicURLBuilder(tt.base)
+			if got := builder.AbsoluteURL(tt.path); got != tt.wantURL {
+				t.Errorf("AbsoluteURL(%q) = %q, want %q", tt.path, got, tt.wantURL)
+			}
+			if got := builder.BaseURL(); got != tt.wantBase {
+				t.Errorf("BaseURL() = %q, want %q", got, tt.wantBase)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package controllers
+
+import (
+	"strconv"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	response2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/interfaces/dto/response"
+	"github.com/gin-gonic/gin"
+)
+
+// absoluteURLsRequested reports whether the client asked for absolute asset URLs (?absolute=true)
+// The SPA keeps the stored relative paths so a domain change doesn't bust its caches;
+// embedders and crawlers opt in to absolute ones.
+func absoluteURLsRequested(c *gin.Context) bool {
+	absolute, _ := strconv.ParseBool(c.Query("absolute"))
+	return absolute
+}
+
+// absoluteImage returns the absolute URL of an optional image path
+func absoluteImage(urls contracts.AssetURLBuilder, image *string) *string {
+	if urls == nil || image == nil {
+		return image
+	}
+	absolute := urls.AbsoluteURL(*image)
+	return &absolute
+}
+
+// absoluteImages returns the absolute URLs of image paths
+func absoluteImages(urls contracts.AssetURLBuilder, images []string) []string {
+	if urls == nil || images == nil {
+		return images
+	}
+	absolute := make([]string, len(images))
+	for i, image := range images {
+		absolute[i] = urls.AbsoluteURL(image)
+	}
+	return absolute
+}
+
+// withAbsoluteProjectImages rewrites the image paths of project responses into absolute URLs
+func withAbsoluteProjectImages(urls contracts.AssetURLBuilder, projects ...*response2.ProjectResponse) {
+	for _, project := range projects {
+		project.MainImage = absoluteImage(urls, project.MainImage)
+		project.Images = absoluteImages(urls, project.Images)
+	}
+}
@@ -0,0 +1,52 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	"github.com/gin-gonic/gin"
+)
+
+func TestAbsoluteURLRoutes_RejectBadIDs(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	// The IDs are rejected before any use case runs, so the controllers need no dependencies
+	projects := &ProjectController{}
+	portfolios := &PortfolioController{}
+	router := gin.New()
+	router.Use(func(c *gin.Context) { c.Set("userID", "user-1") })
+	router.GET("/projects/own/:id", projects.GetByID)
+	router.GET("/projects/category/:categoryId", projects.GetByCategory)
+	router.GET("/portfolios/public/:id/jsonld", portfolios.GetPublicJSONLD)
+
+	for _, path := range []string{
+		"/projects/own/3.5?absolute=true",
+		"/projects/own/-1?absolute=true",
+		"/projects/own/18446744073709551616?absolute=true",
+		"/projects/category/2.0?absolute=true",
+		"/projects/category/4294967296?absolute=true",
+		"/portfolios/public/1e3/jsonld",
+		"/portfolios/public/-7/jsonld",
+	} {
+		t.Run(path, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+
+			if w.Code != http.StatusBadRequest {
+				t.Fatalf("status = %d, want 400 (%s)", w.Code, w.Body.String())
+			}
+			var body struct {
+				Code string `json:"code"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if body.Code != apperrors.CodeValidationInvalidID {
+				t.Errorf("code = %q, want %q", body.Code, apperrors.CodeValidationInvalidID)
+			}
+		})
+	}
+}
@@ -4,13 +4,13 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
 	category2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/usecases/category"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/usecases/trash"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/interfaces/dto/request"
 	response2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/interfaces/dto/response"
 	"github.com/gin-gonic/gin"
-
-	pkgerrors "github.com/JorgeSaicoski/portfolio-manager/backend/pkg/errors"
 )
 
 // CategoryController handles HTTP requests for category operations
@@ -20,9 +20,13 @@ type CategoryController struct {
 	getPublicUseCase      *category2.GetCategoryPublicUseCase
 	listUseCase           *category2.ListCategoriesUseCase
 	updateUseCase         *category2.UpdateCategoryUseCase
+	patchUseCase          *category2.PatchCategoryUseCase
 	updatePositionUseCase *category2.UpdateCategoryPositionUseCase
 	bulkReorderUseCase    *category2.BulkReorderCategoriesUseCase
 	deleteUseCase         *category2.DeleteCategoryUseCase
+	detailUseCase         *category2.GetCategoryDetailUseCase
+	swapUseCase           *category2.SwapCategoryPositionsUseCase
+	findDeletedUseCase    *trash.FindDeletedItemUseCase
 }
 
 // NewCategoryController creates a new category controller instance
@@ -32,9 +36,13 @@ func NewCategoryController(
 	getPublicUC *category2.GetCategoryPublicUseCase,
 	listUC *category2.ListCategoriesUseCase,
 	updateUC *category2.UpdateCategoryUseCase,
+	patchUC *category2.PatchCategoryUseCase,
 	updatePositionUC *category2.UpdateCategoryPositionUseCase,
 	bulkReorderUC *category2.BulkReorderCategoriesUseCase,
 	deleteUC *category2.DeleteCategoryUseCase,
+	detailUC *category2.GetCategoryDetailUseCase,
+	swapUC *category2.SwapCategoryPositionsUseCase,
+	findDeletedUC *trash.FindDeletedItemUseCase,
 ) *CategoryController {
 	return &CategoryController{
 		createUseCase:         createUC,
@@ -42,9 +50,13 @@ func NewCategoryController(
 		getPublicUseCase:      getPublicUC,
 		listUseCase:           listUC,
 		updateUseCase:         updateUC,
+		patchUseCase:          patchUC,
 		updatePositionUseCase: updatePositionUC,
 		bulkReorderUseCase:    bulkReorderUC,
 		deleteUseCase:         deleteUC,
+		detailUseCase:         detailUC,
+		swapUseCase:           swapUC,
+		findDeletedUseCase:    findDeletedUC,
 	}
 }
 
@@ -53,14 +65,14 @@ func (ctrl *CategoryController) Create(c *gin.Context) {
 	// Extract userID from context (set by auth middleware)
 	userID := c.GetString("userID")
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, response2.ErrorResponse{Error: "unauthorized: missing user ID"})
+		respondUnauthenticated(c)
 		return
 	}
 
 	// Bind and validate HTTP request DTO
 	var req request.CreateCategoryRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: err.Error()})
+		respondBindingError(c, err)
 		return
 	}
 
@@ -76,8 +88,7 @@ func (ctrl *CategoryController) Create(c *gin.Context) {
 	// Execute use case
 	categoryDTO, err := ctrl.createUseCase.Execute(c.Request.Context(), input)
 	if err != nil {
-		status := pkgerrors.ToHTTPStatus(err)
-		c.JSON(status, response2.ErrorResponse{Error: err.Error()})
+		respondError(c, err)
 		return
 	}
 
@@ -88,6 +99,8 @@ func (ctrl *CategoryController) Create(c *gin.Context) {
 		Description: categoryDTO.Description,
 		Position:    categoryDTO.Position,
 		OwnerID:     categoryDTO.OwnerID,
+		CreatedBy:   categoryDTO.CreatedBy,
+		UpdatedBy:   categoryDTO.UpdatedBy,
 		PortfolioID: categoryDTO.PortfolioID,
 		CreatedAt:   categoryDTO.CreatedAt,
 		UpdatedAt:   categoryDTO.UpdatedAt,
@@ -105,39 +118,27 @@ func (ctrl *CategoryController) List(c *gin.Context) {
 	// Extract userID from context (set by auth middleware)
 	userID := c.GetString("userID")
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, response2.ErrorResponse{Error: "unauthorized: missing user ID"})
+		respondUnauthenticated(c)
 		return
 	}
 
 	// Bind and validate query parameters
 	var req request.ListCategoriesRequest
 	if err := c.ShouldBindQuery(&req); err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: err.Error()})
+		respondBindingError(c, err)
 		return
 	}
 
-	// Set default pagination values if not provided
-	if req.Page == 0 {
-		req.Page = 1
-	}
-	if req.Limit == 0 {
-		req.Limit = 10
-	}
-
 	// Map to application DTO
 	input := dto.ListCategoriesInput{
-		PortfolioID: 0, // List all categories for user
-		Pagination: dto.PaginationDTO{
-			Page:  req.Page,
-			Limit: req.Limit,
-		},
+		OwnerID:    userID,
+		Pagination: paginationInput(req.PaginationQuery),
 	}
 
 	// Execute use case
 	output, err := ctrl.listUseCase.Execute(c.Request.Context(), input)
 	if err != nil {
-		status := pkgerrors.ToHTTPStatus(err)
-		c.JSON(status, response2.ErrorResponse{Error: err.Error()})
+		respondError(c, err)
 		return
 	}
 
@@ -150,6 +151,8 @@ func (ctrl *CategoryController) List(c *gin.Context) {
 			Description: cat.Description,
 			Position:    cat.Position,
 			OwnerID:     cat.OwnerID,
+			CreatedBy:   cat.CreatedBy,
+			UpdatedBy:   cat.UpdatedBy,
 			PortfolioID: cat.PortfolioID,
 			CreatedAt:   cat.CreatedAt,
 			UpdatedAt:   cat.UpdatedAt,
@@ -171,7 +174,7 @@ func (ctrl *CategoryController) GetByID(c *gin.Context) {
 	// Extract userID from context (set by auth middleware)
 	userID := c.GetString("userID")
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, response2.ErrorResponse{Error: "unauthorized: missing user ID"})
+		respondUnauthenticated(c)
 		return
 	}
 
@@ -179,15 +182,14 @@ func (ctrl *CategoryController) GetByID(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := strconv.ParseUint(idStr, 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: "invalid category ID"})
+		respondInvalidID(c, "category")
 		return
 	}
 
 	// Execute use case
 	categoryDTO, err := ctrl.getUseCase.Execute(c.Request.Context(), uint(id), userID)
 	if err != nil {
-		status := pkgerrors.ToHTTPStatus(err)
-		c.JSON(status, response2.ErrorResponse{Error: err.Error()})
+		respondOwnItemError(c, ctrl.findDeletedUseCase, err, dto.TrashResourceCategory, uint(id))
 		return
 	}
 
@@ -198,6 +200,8 @@ func (ctrl *CategoryController) GetByID(c *gin.Context) {
 		Description: categoryDTO.Description,
 		Position:    categoryDTO.Position,
 		OwnerID:     categoryDTO.OwnerID,
+		CreatedBy:   categoryDTO.CreatedBy,
+		UpdatedBy:   categoryDTO.UpdatedBy,
 		PortfolioID: categoryDTO.PortfolioID,
 		CreatedAt:   categoryDTO.CreatedAt,
 		UpdatedAt:   categoryDTO.UpdatedAt,
@@ -210,12 +214,92 @@ func (ctrl *CategoryController) GetByID(c *gin.Context) {
 	})
 }
 
+// GetDetail handles GET /api/categories/own/:id/detail
+// Returns the category with one page of its projects (limit/offset via page and limit)
+func (ctrl *CategoryController) GetDetail(c *gin.Context) {
+	// Extract userID from context (set by auth middleware)
+	userID := c.GetString("userID")
+	if userID == "" {
+		respondUnauthenticated(c)
+		return
+	}
+
+	// Parse category ID from URL parameter
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		respondInvalidID(c, "category")
+		return
+	}
+
+	// Bind and validate query parameters
+	var req request.GetCategoryDetailRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		respondBindingError(c, err)
+		return
+	}
+
+	// Execute use case
+	detail, err := ctrl.detailUseCase.Execute(c.Request.Context(), dto.CategoryDetailInput{
+		CategoryID: uint(id),
+		OwnerID:    userID,
+		Pagination: paginationInput(req.PaginationQuery),
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// Map to HTTP response DTO
+	projects := make([]response2.CategoryDetailProjectResponse, len(detail.Projects))
+	for i, p := range detail.Projects {
+		projects[i] = response2.CategoryDetailProjectResponse{
+			ID:        p.ID,
+			Title:     p.Title,
+			MainImage: p.MainImage,
+			Skills:    p.Skills,
+			Client:    p.Client,
+			Position:  p.Position,
+			Hidden:    p.Hidden,
+			CreatedAt: p.CreatedAt,
+			UpdatedAt: p.UpdatedAt,
+		}
+	}
+
+	resp := response2.CategoryDetailResponse{
+		Category: response2.CategoryResponse{
+			ID:          detail.Category.ID,
+			Title:       detail.Category.Title,
+			Description: detail.Category.Description,
+			Position:    detail.Category.Position,
+			OwnerID:     detail.Category.OwnerID,
+			CreatedBy:   detail.Category.CreatedBy,
+			UpdatedBy:   detail.Category.UpdatedBy,
+			PortfolioID: detail.Category.PortfolioID,
+			CreatedAt:   detail.Category.CreatedAt,
+			UpdatedAt:   detail.Category.UpdatedAt,
+		},
+		Projects: projects,
+		Pagination: response2.PaginationResponse{
+			Total: detail.Pagination.Total,
+			Page:  detail.Pagination.Page,
+			Limit: detail.Pagination.Limit,
+		},
+	}
+
+	// Return HTTP response with API_OVERVIEW.md format
+	c.JSON(http.StatusOK, response2.DataResponse{
+		Data:    resp,
+		Message: "Success",
+	})
+}
+
 // Update handles PUT /api/categories/own/:id
 func (ctrl *CategoryController) Update(c *gin.Context) {
 	// Extract userID from context (set by auth middleware)
 	userID := c.GetString("userID")
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, response2.ErrorResponse{Error: "unauthorized: missing user ID"})
+		respondUnauthenticated(c)
 		return
 	}
 
@@ -223,14 +307,14 @@ func (ctrl *CategoryController) Update(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := strconv.ParseUint(idStr, 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: "invalid category ID"})
+		respondInvalidID(c, "category")
 		return
 	}
 
 	// Bind and validate HTTP request DTO
 	var req request.UpdateCategoryRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: err.Error()})
+		respondBindingError(c, err)
 		return
 	}
 
@@ -246,8 +330,55 @@ func (ctrl *CategoryController) Update(c *gin.Context) {
 	// Execute use case
 	err = ctrl.updateUseCase.Execute(c.Request.Context(), input)
 	if err != nil {
-		status := pkgerrors.ToHTTPStatus(err)
-		c.JSON(status, response2.ErrorResponse{Error: err.Error()})
+		respondOwnItemError(c, ctrl.findDeletedUseCase, err, dto.TrashResourceCategory, uint(id))
+		return
+	}
+
+	// Return success response with API_OVERVIEW.md format
+	c.JSON(http.StatusOK, response2.DataResponse{
+		Data:    nil,
+		Message: "Category updated successfully",
+	})
+}
+
+// Patch handles PATCH /api/categories/own/:id
+// Only the fields present in the body change, unlike PUT which always sets the position
+func (ctrl *CategoryController) Patch(c *gin.Context) {
+	// Extract userID from context (set by auth middleware)
+	userID := c.GetString("userID")
+	if userID == "" {
+		respondUnauthenticated(c)
+		return
+	}
+
+	// Parse category ID from URL parameter
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		respondInvalidID(c, "category")
+		return
+	}
+
+	// Bind and validate HTTP request DTO
+	var req request.PatchCategoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err)
+		return
+	}
+
+	// Map to application DTO
+	input := dto.PatchCategoryInput{
+		ID:          uint(id),
+		Title:       req.Title,
+		Description: req.Description,
+		Position:    req.Position,
+		OwnerID:     userID,
+	}
+
+	// Execute use case
+	err = ctrl.patchUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		respondOwnItemError(c, ctrl.findDeletedUseCase, err, dto.TrashResourceCategory, uint(id))
 		return
 	}
 
@@ -263,7 +394,7 @@ func (ctrl *CategoryController) UpdatePosition(c *gin.Context) {
 	// Extract userID from context (set by auth middleware)
 	userID := c.GetString("userID")
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, response2.ErrorResponse{Error: "unauthorized: missing user ID"})
+		respondUnauthenticated(c)
 		return
 	}
 
@@ -271,22 +402,21 @@ func (ctrl *CategoryController) UpdatePosition(c *gin.Context) {
 	idStr := c.Param("id")
 	categoryID, err := strconv.ParseUint(idStr, 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: "invalid category ID"})
+		respondInvalidID(c, "category")
 		return
 	}
 
 	// Bind and validate HTTP request DTO
 	var req request.UpdateCategoryPositionRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: err.Error()})
+		respondBindingError(c, err)
 		return
 	}
 
 	// Execute use case
 	err = ctrl.updatePositionUseCase.Execute(c.Request.Context(), uint(categoryID), req.Position, userID)
 	if err != nil {
-		status := pkgerrors.ToHTTPStatus(err)
-		c.JSON(status, response2.ErrorResponse{Error: err.Error()})
+		respondError(c, err)
 		return
 	}
 
@@ -302,14 +432,14 @@ func (ctrl *CategoryController) BulkReorder(c *gin.Context) {
 	// Extract userID from context (set by auth middleware)
 	userID := c.GetString("userID")
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, response2.ErrorResponse{Error: "unauthorized: missing user ID"})
+		respondUnauthenticated(c)
 		return
 	}
 
 	// Bind and validate HTTP request DTO
 	var req request.BulkReorderCategoriesRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: err.Error()})
+		respondBindingError(c, err)
 		return
 	}
 
@@ -329,8 +459,7 @@ func (ctrl *CategoryController) BulkReorder(c *gin.Context) {
 
 	// Execute use case
 	if err := ctrl.bulkReorderUseCase.Execute(c.Request.Context(), input); err != nil {
-		status := pkgerrors.ToHTTPStatus(err)
-		c.JSON(status, response2.ErrorResponse{Error: err.Error()})
+		respondError(c, err)
 		return
 	}
 
@@ -341,12 +470,64 @@ func (ctrl *CategoryController) BulkReorder(c *gin.Context) {
 	})
 }
 
-// Delete handles DELETE /api/categories/own/:id
+// Swap handles POST /api/categories/own/swap
+// Exchanges the positions of two categories of the same portfolio atomically
+func (ctrl *CategoryController) Swap(c *gin.Context) {
+	// Extract userID from context (set by auth middleware)
+	userID := c.GetString("userID")
+	if userID == "" {
+		respondUnauthenticated(c)
+		return
+	}
+
+	// Bind and validate HTTP request DTO
+	var req request.SwapPositionsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err)
+		return
+	}
+
+	// Execute use case
+	swapped, err := ctrl.swapUseCase.Execute(c.Request.Context(), dto.SwapPositionsInput{
+		FirstID:  req.FirstID,
+		SecondID: req.SecondID,
+		OwnerID:  userID,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// Map to HTTP response DTOs
+	resp := make([]response2.CategoryResponse, len(swapped))
+	for i, item := range swapped {
+		resp[i] = response2.CategoryResponse{
+			ID:          item.ID,
+			Title:       item.Title,
+			Description: item.Description,
+			Position:    item.Position,
+			OwnerID:     item.OwnerID,
+			CreatedBy:   item.CreatedBy,
+			UpdatedBy:   item.UpdatedBy,
+			PortfolioID: item.PortfolioID,
+			CreatedAt:   item.CreatedAt,
+			UpdatedAt:   item.UpdatedAt,
+		}
+	}
+
+	// Return HTTP response with API_OVERVIEW.md format
+	c.JSON(http.StatusOK, response2.DataResponse{
+		Data:    resp,
+		Message: "Categories swapped successfully",
+	})
+}
+
+// Delete handles DELETE /api/categories/own/:id?move_projects_to=&allow_other_portfolio=
 func (ctrl *CategoryController) Delete(c *gin.Context) {
 	// Extract userID from context (set by auth middleware)
 	userID := c.GetString("userID")
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, response2.ErrorResponse{Error: "unauthorized: missing user ID"})
+		respondUnauthenticated(c)
 		return
 	}
 
@@ -354,15 +535,26 @@ func (ctrl *CategoryController) Delete(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := strconv.ParseUint(idStr, 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: "invalid category ID"})
+		respondInvalidID(c, "category")
+		return
+	}
+
+	// Bind and validate query parameters
+	var req request.DeleteCategoryRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		respondBindingError(c, err)
 		return
 	}
 
 	// Execute use case
-	err = ctrl.deleteUseCase.Execute(c.Request.Context(), uint(id), userID)
+	err = ctrl.deleteUseCase.Execute(c.Request.Context(), dto.DeleteCategoryInput{
+		ID:                  uint(id),
+		OwnerID:             userID,
+		MoveProjectsTo:      req.MoveProjectsTo,
+		AllowOtherPortfolio: req.AllowOtherPortfolio,
+	})
 	if err != nil {
-		status := pkgerrors.ToHTTPStatus(err)
-		c.JSON(status, response2.ErrorResponse{Error: err.Error()})
+		respondOwnItemError(c, ctrl.findDeletedUseCase, err, dto.TrashResourceCategory, uint(id))
 		return
 	}
 
@@ -379,15 +571,17 @@ func (ctrl *CategoryController) GetPublicByID(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := strconv.ParseUint(idStr, 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: "invalid category ID"})
+		respondInvalidID(c, "category")
 		return
 	}
 
 	// Execute use case (no auth required for public access)
 	categoryDTO, err := ctrl.getPublicUseCase.Execute(c.Request.Context(), uint(id))
 	if err != nil {
-		status := pkgerrors.ToHTTPStatus(err)
-		c.JSON(status, response2.ErrorResponse{Error: err.Error()})
+		respondError(c, err)
+		return
+	}
+	if respondNotModified(c, weakETag(categoryDTO.ID, categoryDTO.UpdatedAt)) {
 		return
 	}
 
@@ -416,10 +610,10 @@ func (ctrl *CategoryController) GetPublicProjects(c *gin.Context) {
 	idStr := c.Param("id")
 	_, err := strconv.ParseUint(idStr, 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: "invalid category ID"})
+		respondInvalidID(c, "category")
 		return
 	}
 
 	// TODO: Implement projects retrieval when Project domain is ready
-	c.JSON(http.StatusNotImplemented, response2.ErrorResponse{Error: "not implemented yet"})
+	respondErrorCode(c, http.StatusNotImplemented, apperrors.CodeNotImplemented, "not implemented yet")
 }
@@ -0,0 +1,277 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"reflect"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	contracts2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/usecases/trash"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/validation"
+	response2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/interfaces/dto/response"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/interfaces/i18n"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/interfaces/middleware"
+	pkgerrors "github.com/JorgeSaicoski/portfolio-manager/backend/pkg/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+// bindingRuleCodes maps validator tags to error codes
+var bindingRuleCodes = map[string]string{
+	"required": apperrors.CodeValidationRequired,
+	"min":      apperrors.CodeValidationMin,
+	"max":      apperrors.CodeValidationMax,
+	"url":      apperrors.CodeValidationURL,
+	"oneof":    apperrors.CodeValidationOneOf,
+}
+
+// statusCodes are the error codes of responses whose error carries no specific code
+var statusCodes = map[int]string{
+	http.StatusBadRequest:            apperrors.CodeBadRequest,
+	http.StatusUnauthorized:          apperrors.CodeUnauthenticated,
+	http.StatusForbidden:             apperrors.CodeAccessDenied,
+	http.StatusNotFound:              apperrors.CodeNotFound,
+	http.StatusConflict:              apperrors.CodeConflict,
+	http.StatusRequestEntityTooLarge: apperrors.CodePayloadTooLarge,
+	http.StatusNotImplemented:        apperrors.CodeNotImplemented,
+	http.StatusServiceUnavailable:    apperrors.CodeServiceUnavailable,
+	http.StatusInternalServerError:   apperrors.CodeInternal,
+}
+
+// notFoundCodes are the not-found codes of the resources addressed by owner routes
+var notFoundCodes = map[string]string{
+	dto.TrashResourcePortfolio:      apperrors.CodePortfolioNotFound,
+	dto.TrashResourceCategory:       apperrors.CodeCategoryNotFound,
+	dto.TrashResourceProject:        apperrors.CodeProjectNotFound,
+	dto.TrashResourceSection:        apperrors.CodeSectionNotFound,
+	dto.TrashResourceSectionContent: apperrors.CodeSectionContentNotFound,
+	dto.TrashResourcePortfolioLink:  apperrors.CodePortfolioLinkNotFound,
+}
+
+// respondError writes a use case error as an HTTP error response
+// Typed application errors are mapped first (and localized), everything else goes through pkgerrors
+// and gets the code of its status
+func respondError(c *gin.Context, err error) {
+	respondErrorWithCode(c, err, "")
+}
+
+// RespondError writes err like the handlers do, for middleware that rejects requests
+// before they reach a controller (e.g. middleware.RateLimiter)
+func RespondError(c *gin.Context, err error) {
+	respondError(c, err)
+}
+
+// respondErrorWithCode writes err like respondError, using code instead of the status code
+// for errors without a specific one
+func respondErrorWithCode(c *gin.Context, err error, code string) {
+	status := pkgerrors.ToHTTPStatus(err)
+
+	switch {
+	case errors.Is(err, contracts2.ErrUniqueCandidatesExhausted):
+		status = http.StatusServiceUnavailable
+		code = apperrors.CodeServiceUnavailable
+	case errors.Is(err, contracts2.ErrDatabaseUnavailable):
+		status = http.StatusServiceUnavailable
+		code = apperrors.CodeDatabaseUnavailable
+		c.Header("Retry-After", middleware.DatabaseRetryAfterSeconds)
+		err = contracts2.ErrDatabaseUnavailable // without the driver details
+	case errors.Is(err, contracts2.ErrReferenceNotFound):
+		status = http.StatusBadRequest
+		code = apperrors.CodeReferenceNotFound
+		err = contracts2.ErrReferenceNotFound // without the driver details
+	}
+
+	if appErr, ok := apperrors.As(err); ok {
+		switch appErr.Kind {
+		case apperrors.KindValidation:
+			status = http.StatusBadRequest
+		case apperrors.KindUnauthenticated:
+			status = http.StatusUnauthorized
+		case apperrors.KindRateLimited:
+			status = http.StatusTooManyRequests
+		case apperrors.KindUnprocessable:
+			status = http.StatusUnprocessableEntity
+		case apperrors.KindGone:
+			status = http.StatusGone
+		case apperrors.KindConflict:
+			status = http.StatusConflict
+		case apperrors.KindTooLarge:
+			status = http.StatusRequestEntityTooLarge
+		}
+		if legacyErrors(c) {
+			writeLegacyError(c, status, appErr.Error())
+			return
+		}
+		c.JSON(status, response2.ErrorResponse{
+			Error:      localizedMessage(c, appErr.Code, appErr.Params, appErr.Error()),
+			Code:       appErr.Code,
+			Violations: violationResponses(c, err),
+			Details:    appErr.Details,
+		})
+		return
+	}
+
+	if code == "" {
+		code = statusCodes[status]
+	}
+	if status >= http.StatusInternalServerError {
+		// The request ID is in the X-Request-ID response header the user can report
+		log.Printf("request_id=%s %s %s: %d: %v", c.GetString("requestID"), c.Request.Method, c.FullPath(), status, err)
+	}
+	respondErrorCode(c, status, code, err.Error())
+}
+
+// respondOwnItemError writes the error of an owner route addressing one item by ID.
+// A not-found for an item the caller soft-deleted becomes 410 Gone with the deletion time,
+// so the UI can offer a restore; other users' and never-existing items stay plain 404s
+// with the not-found code of the resource
+func respondOwnItemError(c *gin.Context, findDeleted *trash.FindDeletedItemUseCase, err error, resource string, id uint) {
+	if pkgerrors.ToHTTPStatus(err) != http.StatusNotFound {
+		respondError(c, err)
+		return
+	}
+
+	if findDeleted != nil {
+		deleted, lookupErr := findDeleted.Execute(c.Request.Context(), resource, id, c.GetString("userID"))
+		if lookupErr == nil && deleted != nil {
+			err = apperrors.Deleted(resource, deleted.ID, deleted.DeletedAt)
+		}
+	}
+
+	respondErrorWithCode(c, err, notFoundCodes[resource])
+}
+
+// respondUnauthenticated writes the 401 of a protected handler reached without a user ID
+func respondUnauthenticated(c *gin.Context) {
+	respondLocalizedCode(c, http.StatusUnauthorized, apperrors.CodeUnauthenticated, nil, "unauthorized: missing user ID")
+}
+
+// respondInvalidID writes the 400 of a path ID that isn't a positive integer
+// resource names the item in the message, e.g. "project" for "invalid project ID"
+func respondInvalidID(c *gin.Context, resource string) {
+	respondLocalizedCode(c, http.StatusBadRequest, apperrors.CodeValidationInvalidID,
+		map[string]interface{}{"resource": resource}, "invalid "+resource+" ID")
+}
+
+// respondErrorCode writes an error response with an explicit code and message
+func respondErrorCode(c *gin.Context, status int, code, message string) {
+	respondLocalizedCode(c, status, code, nil, message)
+}
+
+// respondLocalizedCode writes an error response with an explicit code, localizing the
+// message when a catalog has the code
+func respondLocalizedCode(c *gin.Context, status int, code string, params map[string]interface{}, message string) {
+	if legacyErrors(c) {
+		writeLegacyError(c, status, message)
+		return
+	}
+
+	c.JSON(status, response2.ErrorResponse{
+		Error: localizedMessage(c, code, params, message),
+		Code:  code,
+	})
+}
+
+// respondBindingError writes a request binding/validation failure as a localized 400
+func respondBindingError(c *gin.Context, err error) {
+	code := apperrors.CodeValidationMalformedBody
+	var params map[string]interface{}
+
+	var validationErrs validator.ValidationErrors
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && isIntegerKind(typeErr.Type) {
+		// Fractional, negative or out-of-range numbers for integer fields (IDs, positions)
+		// are rejected by the decoder; report the field instead of a generic malformed body
+		code = apperrors.CodeValidationInteger
+		params = map[string]interface{}{"field": typeErr.Field}
+	} else if errors.As(err, &validationErrs) && len(validationErrs) > 0 {
+		fieldErr := validationErrs[0]
+		code = apperrors.CodeValidationInvalid
+		if ruleCode, ok := bindingRuleCodes[fieldErr.Tag()]; ok {
+			code = ruleCode
+		}
+		params = map[string]interface{}{
+			"field": fieldErr.Field(),
+			"param": fieldErr.Param(),
+		}
+	}
+
+	if legacyErrors(c) {
+		writeLegacyError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusBadRequest, response2.ErrorResponse{
+		Error: localizedMessage(c, code, params, err.Error()),
+		Code:  code,
+	})
+}
+
+// violationResponses renders the structured rule violations wrapped in err, if any
+func violationResponses(c *gin.Context, err error) []response2.FieldViolationResponse {
+	var violations validation.Violations
+	if !errors.As(err, &violations) {
+		return nil
+	}
+
+	resp := make([]response2.FieldViolationResponse, len(violations))
+	for i, violation := range violations {
+		resp[i] = response2.FieldViolationResponse{
+			Field:   violation.Field,
+			Rule:    violation.Rule,
+			Code:    violation.Code(),
+			Message: localizedMessage(c, violation.Code(), violation.Params, violation.Message),
+		}
+	}
+	return resp
+}
+
+// isIntegerKind reports whether t is an integer type (the target of an ID or position field)
+func isIntegerKind(t reflect.Type) bool {
+	if t == nil {
+		return false
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	}
+	return false
+}
+
+// legacyErrors reports whether the request came through the /api/v1 routes,
+// which keep the original error envelope (message only, no code)
+func legacyErrors(c *gin.Context) bool {
+	return middleware.APIVersionFrom(c) == middleware.APIVersionV1
+}
+
+// writeLegacyError writes the v1 error envelope
+// v1 clients only know 400 for client-side failures, so any 4xx besides the
+// auth/not-found/conflict statuses they already handle is reported as 400
+func writeLegacyError(c *gin.Context, status int, message string) {
+	switch status {
+	case http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound, http.StatusConflict:
+	default:
+		if status >= 400 && status < 500 {
+			status = http.StatusBadRequest
+		}
+	}
+	c.JSON(status, response2.ErrorResponse{Error: message})
+}
+
+// localizedMessage renders code in the locale negotiated from Accept-Language,
+// or returns fallback when no catalog has the code
+func localizedMessage(c *gin.Context, code string, params map[string]interface{}, fallback string) string {
+	locale := i18n.MatchLocale(c.GetHeader("Accept-Language"))
+	if message, ok := i18n.Localize(locale, code, params); ok {
+		return message
+	}
+	return fallback
+}
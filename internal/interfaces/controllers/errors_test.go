@@ -0,0 +1,238 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/interfaces/dto/request"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/interfaces/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// TestMain reports binding errors with the field names clients send, as the server does
+func TestMain(m *testing.M) {
+	request.UseJSONFieldNames()
+	os.Exit(m.Run())
+}
+
+func TestRespondError_RateLimited(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		path           string
+		acceptLanguage string
+		wantStatus     int
+		wantCode       string
+		wantError      string
+	}{
+		{
+			name:       "current clients",
+			path:       "/api/items",
+			wantStatus: http.StatusTooManyRequests,
+			wantCode:   apperrors.CodeRateLimited,
+			wantError:  "too many requests, slow down",
+		},
+		{
+			name:           "localized",
+			path:           "/api/items",
+			acceptLanguage: "pt-BR",
+			wantStatus:     http.StatusTooManyRequests,
+			wantCode:       apperrors.CodeRateLimited,
+			wantError:      "requisições demais, vá mais devagar",
+		},
+		{
+			name:       "v1 clients",
+			path:       "/api/v1/items",
+			wantStatus: http.StatusBadRequest,
+			wantError:  "too many requests, slow down",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter := middleware.NewRateLimiter("test", 1, 1, middleware.RateLimitByClientIP,
+				middleware.NewMemoryRateLimitStore(), nil, RespondError)
+
+			router := gin.New()
+			ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+			router.GET("/api/items", limiter.Limit(), ok)
+			router.GET("/api/v1/items", middleware.APIVersion(middleware.APIVersionV1), limiter.Limit(), ok)
+
+			var w *httptest.ResponseRecorder
+			for i := 0; i < 2; i++ {
+				req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+				req.Header.Set("Accept-Language", tt.acceptLanguage)
+				w = httptest.NewRecorder()
+				router.ServeHTTP(w, req)
+			}
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (%s)", w.Code, tt.wantStatus, w.Body.String())
+			}
+			if w.Header().Get("Retry-After") == "" {
+				t.Error("Retry-After header missing")
+			}
+			var body struct {
+				Error string `json:"error"`
+				Code  string `json:"code"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if body.Code != tt.wantCode {
+				t.Errorf("code = %q, want %q", body.Code, tt.wantCode)
+			}
+			if body.Error != tt.wantError {
+				t.Errorf("error = %q, want %q", body.Error, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestRespondError_Unauthenticated(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		path           string
+		acceptLanguage string
+		wantCode       string
+		wantError      string
+	}{
+		{name: "current clients", path: "/api/own", wantCode: apperrors.CodeUnauthenticated, wantError: "authentication required"},
+		{name: "localized", path: "/api/own", acceptLanguage: "pt-BR,en;q=0.5", wantCode: apperrors.CodeUnauthenticated, wantError: "autenticação necessária"},
+		{name: "v1 clients", path: "/api/v1/own", wantError: "missing authorization header"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auth := middleware.NewAuthMiddleware(nil, nil, RespondError)
+			router := gin.New()
+			ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+			auth.Protected(router.Group("/api"), "/own").GET("", ok)
+			auth.Protected(router.Group("/api/v1", middleware.APIVersion(middleware.APIVersionV1)), "/own").GET("", ok)
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			req.Header.Set("Accept-Language", tt.acceptLanguage)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != http.StatusUnauthorized {
+				t.Fatalf("status = %d, want 401 (%s)", w.Code, w.Body.String())
+			}
+			var body struct {
+				Error string `json:"error"`
+				Code  string `json:"code"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if body.Code != tt.wantCode || body.Error != tt.wantError {
+				t.Errorf("body = %+v, want error %q with code %q", body, tt.wantError, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestRespondError_DuplicateTitle(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	duplicate := apperrors.DuplicateTitle(apperrors.CodePortfolioDuplicateTitle, "portfolio", "Work",
+		"portfolio with title 'Work' already exists for this user")
+	wantDetails := map[string]interface{}{"reason": "duplicate_title", "resource": "portfolio", "field": "title"}
+
+	tests := []struct {
+		name        string
+		path        string
+		err         error
+		wantStatus  int
+		wantCode    string
+		wantDetails map[string]interface{}
+	}{
+		{name: "duplicate title", path: "/api/items", err: duplicate, wantStatus: http.StatusConflict, wantCode: apperrors.CodePortfolioDuplicateTitle, wantDetails: wantDetails},
+		{name: "duplicate title for v1 clients", path: "/api/v1/items", err: duplicate, wantStatus: http.StatusConflict},
+		{name: "validation stays a bad request", path: "/api/items", err: apperrors.New(apperrors.KindValidation, apperrors.CodeValidationRequired, "title is required", nil),
+			wantStatus: http.StatusBadRequest, wantCode: apperrors.CodeValidationRequired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			fail := func(c *gin.Context) { RespondError(c, tt.err) }
+			router.POST("/api/items", fail)
+			router.POST("/api/v1/items", middleware.APIVersion(middleware.APIVersionV1), fail)
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, tt.path, nil))
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (%s)", w.Code, tt.wantStatus, w.Body.String())
+			}
+			var body struct {
+				Error   string                 `json:"error"`
+				Code    string                 `json:"code"`
+				Details map[string]interface{} `json:"details"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if body.Error == "" || body.Code != tt.wantCode || !reflect.DeepEqual(body.Details, tt.wantDetails) {
+				t.Errorf("body = %+v, want code %q with details %v", body, tt.wantCode, tt.wantDetails)
+			}
+		})
+	}
+}
+
+func TestRespondBindingError_Integer(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name      string
+		body      string
+		wantCode  string
+		wantError string
+	}{
+		{name: "fractional ID", body: `{"items": [{"id": 3.7, "position": 1}]}`, wantCode: apperrors.CodeValidationInteger, wantError: "items.0.id must be a whole number within range"},
+		{name: "negative ID", body: `{"items": [{"id": -1, "position": 1}]}`, wantCode: apperrors.CodeValidationInteger, wantError: "items.0.id must be a whole number within range"},
+		{name: "ID above uint64", body: `{"items": [{"id": 18446744073709551616, "position": 1}]}`, wantCode: apperrors.CodeValidationInteger, wantError: "items.0.id must be a whole number within range"},
+		{name: "fractional position", body: `{"items": [{"id": 1, "position": 1}, {"id": 2, "position": 0.5}]}`, wantCode: apperrors.CodeValidationInteger, wantError: "items.1.position must be a whole number within range"},
+		{name: "ID as a string", body: `{"items": [{"id": "1", "position": 1}]}`, wantCode: apperrors.CodeValidationInteger, wantError: "items.0.id must be a whole number within range"},
+		{name: "items not a list", body: `{"items": 3}`, wantCode: apperrors.CodeValidationMalformedBody},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The body is rejected before the use case runs, so the controller needs no dependencies
+			ctrl := &ProjectController{}
+			router := gin.New()
+			router.PATCH("/reorder", func(c *gin.Context) { c.Set("userID", "user-1") }, ctrl.BulkReorder)
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodPatch, "/reorder", strings.NewReader(tt.body)))
+
+			if w.Code != http.StatusBadRequest {
+				t.Fatalf("status = %d, want 400 (%s)", w.Code, w.Body.String())
+			}
+			var body struct {
+				Error string `json:"error"`
+				Code  string `json:"code"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if body.Code != tt.wantCode {
+				t.Errorf("code = %q, want %q", body.Code, tt.wantCode)
+			}
+			if tt.wantError != "" && body.Error != tt.wantError {
+				t.Errorf("error = %q, want %q", body.Error, tt.wantError)
+			}
+		})
+	}
+}
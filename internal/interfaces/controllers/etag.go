@@ -0,0 +1,64 @@
+package controllers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// weakETag returns a weak ETag over the version parts of a public response: IDs, update times,
+// child counts and anything else the body shows that doesn't move an update time.
+// Counts matter because soft deletes don't touch updated_at.
+func weakETag(parts ...interface{}) string {
+	hash := sha256.New()
+	for _, part := range parts {
+		if t, ok := part.(time.Time); ok {
+			part = t.UnixNano()
+		}
+		fmt.Fprintf(hash, "%v|", part)
+	}
+	return `W/"` + hex.EncodeToString(hash.Sum(nil)[:16]) + `"`
+}
+
+// latestUpdate returns the most recent of the update times (zero when there are none)
+func latestUpdate(times ...time.Time) time.Time {
+	var latest time.Time
+	for _, t := range times {
+		if t.After(latest) {
+			latest = t
+		}
+	}
+	return latest
+}
+
+// respondNotModified sets the ETag of the response and, when the request's If-None-Match
+// lists it, writes a bodiless 304 and reports true
+func respondNotModified(c *gin.Context, etag string) bool {
+	c.Header("ETag", etag)
+	if !etagMatches(c.GetHeader("If-None-Match"), etag) {
+		return false
+	}
+	c.Status(http.StatusNotModified)
+	return true
+}
+
+// etagMatches applies the weak comparison of If-None-Match: any listed tag (or *) equal to
+// etag once the W/ prefixes are dropped
+func etagMatches(ifNoneMatch, etag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
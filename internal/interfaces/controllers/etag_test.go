@@ -0,0 +1,156 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	section_content2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/usecases/section_content"
+	"github.com/gin-gonic/gin"
+)
+
+type etagContentRepo struct {
+	contracts.SectionContentRepository
+	contents []dto.SectionContentDTO
+}
+
+func (r *etagContentRepo) GetByID(_ context.Context, id uint) (*dto.SectionContentDTO, error) {
+	for i := range r.contents {
+		if r.contents[i].ID == id {
+			content := r.contents[i]
+			return &content, nil
+		}
+	}
+	return nil, errors.New("not found")
+}
+
+func (r *etagContentRepo) GetBySectionID(_ context.Context, _ uint) ([]dto.SectionContentDTO, error) {
+	return append([]dto.SectionContentDTO(nil), r.contents...), nil
+}
+
+type etagSectionRepo struct {
+	contracts.SectionRepository
+}
+
+func (etagSectionRepo) GetByID(_ context.Context, id uint) (*dto.SectionDTO, error) {
+	return &dto.SectionDTO{ID: id, PortfolioID: 1}, nil
+}
+
+type etagPortfolioRepo struct {
+	contracts.PortfolioRepository
+}
+
+func (etagPortfolioRepo) IsPublished(_ context.Context, _ uint) (bool, error) {
+	return true, nil
+}
+
+func TestPublicETag_NotModifiedUntilUpdated(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	updatedAt := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		path   string
+		update func(repo *etagContentRepo)
+	}{
+		{
+			name:   "content updated",
+			path:   "/section-contents/1",
+			update: func(repo *etagContentRepo) { repo.contents[0].UpdatedAt = updatedAt.Add(time.Second) },
+		},
+		{
+			name:   "list item updated",
+			path:   "/section-contents/sections/7/contents",
+			update: func(repo *etagContentRepo) { repo.contents[1].UpdatedAt = updatedAt.Add(time.Second) },
+		},
+		{
+			// Soft deletes don't move any remaining update time, only the count
+			name:   "list item deleted",
+			path:   "/section-contents/sections/7/contents",
+			update: func(repo *etagContentRepo) { repo.contents = repo.contents[:1] },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &etagContentRepo{contents: []dto.SectionContentDTO{
+				{ID: 1, SectionID: 7, Type: "text", UpdatedAt: updatedAt},
+				{ID: 2, SectionID: 7, Type: "text", UpdatedAt: updatedAt},
+			}}
+			ctrl := &SectionContentController{
+				getPublicUseCase:     section_content2.NewGetSectionContentPublicUseCase(repo, etagSectionRepo{}, etagPortfolioRepo{}),
+				listBySectionUseCase: section_content2.NewListSectionContentsBySectionUseCase(repo, etagSectionRepo{}, etagPortfolioRepo{}),
+			}
+			router := gin.New()
+			router.GET("/section-contents/:id", ctrl.GetByID)
+			router.GET("/section-contents/sections/:sectionId/contents", ctrl.ListBySection)
+
+			get := func(ifNoneMatch string) *httptest.ResponseRecorder {
+				req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+				if ifNoneMatch != "" {
+					req.Header.Set("If-None-Match", ifNoneMatch)
+				}
+				w := httptest.NewRecorder()
+				router.ServeHTTP(w, req)
+				return w
+			}
+
+			first := get("")
+			etag := first.Header().Get("ETag")
+			if first.Code != http.StatusOK || etag == "" {
+				t.Fatalf("first request: status = %d, ETag = %q, want 200 with an ETag", first.Code, etag)
+			}
+
+			repeat := get(etag)
+			if repeat.Code != http.StatusNotModified {
+				t.Fatalf("repeat request: status = %d, want 304", repeat.Code)
+			}
+			if repeat.Body.Len() != 0 {
+				t.Errorf("304 body = %q, want empty", repeat.Body.String())
+			}
+			if got := repeat.Header().Get("ETag"); got != etag {
+				t.Errorf("304 ETag = %q, want %q", got, etag)
+			}
+
+			tt.update(repo)
+
+			changed := get(etag)
+			if changed.Code != http.StatusOK {
+				t.Fatalf("request after the change: status = %d, want 200", changed.Code)
+			}
+			if got := changed.Header().Get("ETag"); got == etag || got == "" {
+				t.Errorf("ETag after the change = %q, want a new one (was %q)", got, etag)
+			}
+		})
+	}
+}
+
+func TestETagMatches(t *testing.T) {
+	etag := `W/"abc"`
+
+	tests := []struct {
+		name        string
+		ifNoneMatch string
+		want        bool
+	}{
+		{name: "no header", ifNoneMatch: "", want: false},
+		{name: "same weak tag", ifNoneMatch: `W/"abc"`, want: true},
+		{name: "strong form of the tag", ifNoneMatch: `"abc"`, want: true},
+		{name: "listed among others", ifNoneMatch: `"xyz", W/"abc"`, want: true},
+		{name: "wildcard", ifNoneMatch: "*", want: true},
+		{name: "other tag", ifNoneMatch: `W/"xyz"`, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := etagMatches(tt.ifNoneMatch, etag); got != tt.want {
+				t.Errorf("etagMatches(%q) = %v, want %v", tt.ifNoneMatch, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	response2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/interfaces/dto/response"
+	"github.com/gin-gonic/gin"
+)
+
+// Event stream timing
+const (
+	eventStreamHeartbeat    = 25 * time.Second // Below common proxy idle timeouts (30s+)
+	eventStreamWriteTimeout = 10 * time.Second // A single write taking longer means the client is gone
+)
+
+// EventController handles the own-content change event stream
+type EventController struct {
+	bus contracts.ChangeEventBus
+}
+
+// NewEventController creates a new event controller instance
+func NewEventController(bus contracts.ChangeEventBus) *EventController {
+	return &EventController{bus: bus}
+}
+
+// Stream handles GET /api/events/own/stream (Server-Sent Events)
+// Sends a "change" event per mutation of the caller's content, a "resync" event when the
+// Last-Event-ID is too old to replay, and a comment heartbeat every 25s.
+func (ctrl *EventController) Stream(c *gin.Context) {
+	// Extract userID from context (set by auth middleware)
+	userID := c.GetString("userID")
+	if userID == "" {
+		respondUnauthenticated(c)
+		return
+	}
+
+	// EventSource sends the header on reconnect; the query parameter covers manual reconnects
+	lastEventID := c.GetHeader("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = c.Query("last_event_id")
+	}
+	var since uint64
+	if lastEventID != "" {
+		parsed, err := strconv.ParseUint(lastEventID, 10, 64)
+		if err != nil {
+			respondLocalizedCode(c, http.StatusBadRequest, apperrors.CodeValidationInvalid,
+				map[string]interface{}{"field": "Last-Event-ID"}, "invalid Last-Event-ID")
+			return
+		}
+		since = parsed
+	}
+
+	subscription, err := ctrl.bus.Subscribe(userID, since)
+	switch {
+	case errors.Is(err, contracts.ErrTooManyChangeStreams):
+		respondErrorCode(c, http.StatusTooManyRequests, apperrors.CodeTooManyEventStreams, err.Error())
+		return
+	case err != nil:
+		respondErrorCode(c, http.StatusServiceUnavailable, apperrors.CodeServiceUnavailable, err.Error())
+		return
+	}
+	defer subscription.Close()
+
+	header := c.Writer.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("Connection", "keep-alive")
+	header.Set("X-Accel-Buffering", "no") // nginx: don't buffer the stream
+	c.Status(http.StatusOK)
+
+	controller := http.NewResponseController(c.Writer)
+	write := func(chunk string) bool {
+		// Not every writer in the chain supports deadlines; the stream still works without them
+		_ = controller.SetWriteDeadline(time.Now().Add(eventStreamWriteTimeout))
+		if _, err := io.WriteString(c.Writer, chunk); err != nil {
+			return false
+		}
+		c.Writer.Flush()
+		return true
+	}
+
+	// Tell EventSource how fast to reconnect, then catch up
+	if !write("retry: 3000\n\n") {
+		return
+	}
+	if subscription.Resync() && !write("event: resync\ndata: {}\n\n") {
+		return
+	}
+	for _, event := range subscription.Replay() {
+		if !write(formatChangeEvent(event)) {
+			return
+		}
+	}
+
+	heartbeat := time.NewTicker(eventStreamHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case event, ok := <-subscription.Events():
+			if !ok {
+				// Server shutdown or the stream fell behind: the client reconnects and replays
+				return
+			}
+			if !write(formatChangeEvent(event)) {
+				return
+			}
+		case <-heartbeat.C:
+			if !write(": heartbeat\n\n") {
+				return
+			}
+		}
+	}
+}
+
+// formatChangeEvent renders a change event as an SSE message
+func formatChangeEvent(event dto.ChangeEventDTO) string {
+	data, _ := json.Marshal(response2.ChangeEventResponse{
+		Resource:   event.Resource,
+		ResourceID: event.ResourceID,
+		Action:     event.Action,
+		UpdatedAt:  event.UpdatedAt,
+	})
+	return fmt.Sprintf("id: %d\nevent: change\ndata: %s\n\n", event.ID, data)
+}
@@ -1,7 +1,9 @@
 package controllers
 
 import (
+	"context"
 	"net/http"
+	"os"
 	"time"
 
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/interfaces/dto/response"
@@ -13,16 +15,35 @@ import (
 // NOTE: These are infrastructure concerns, not business logic
 // Therefore, no use cases needed - direct database access is appropriate
 type HealthController struct {
-	db *gorm.DB
+	db      *gorm.DB
+	monitor DatabaseMonitor
+	logDir  string
+}
+
+// readinessPingTimeout bounds the database ping of the readiness probe
+const readinessPingTimeout = time.Second
+
+// DatabaseMonitor reports connection failures seen between health checks
+type DatabaseMonitor interface {
+	// Degraded is true while the database is unreachable or shortly after it was
+	Degraded() bool
 }
 
 // NewHealthController creates a new health controller instance
-func NewHealthController(db *gorm.DB) *HealthController {
+// logDir is the directory the logs are written to, checked by /readyz ("" when logging to stdout only)
+func NewHealthController(db *gorm.DB, monitor DatabaseMonitor, logDir string) *HealthController {
 	return &HealthController{
-		db: db,
+		db:      db,
+		monitor: monitor,
+		logDir:  logDir,
 	}
 }
 
+// degraded reports whether the monitor saw a recent connection failure
+func (ctrl *HealthController) degraded() bool {
+	return ctrl.monitor != nil && ctrl.monitor.Degraded()
+}
+
 // Health handles GET /health
 // Basic health check: returns 200 if service is up and database is connected
 func (ctrl *HealthController) Health(c *gin.Context) {
@@ -41,14 +62,82 @@ func (ctrl *HealthController) Health(c *gin.Context) {
 		return
 	}
 
-	// Return 200 OK if everything is healthy
+	// Return 200 OK while the service is up; "degraded" right after a database failover
+	status := "healthy"
+	if ctrl.degraded() {
+		status = "degraded"
+	}
 	c.JSON(http.StatusOK, response.HealthResponse{
-		Status:    "healthy",
+		Status:    status,
 		Database:  dbStatus,
 		Timestamp: time.Now(),
 	})
 }
 
+// Liveness handles GET /healthz
+// Liveness probe: 200 as long as the process serves requests; dependencies are /readyz's job,
+// so a database outage doesn't get the container restarted
+func (ctrl *HealthController) Liveness(c *gin.Context) {
+	c.JSON(http.StatusOK, response.LivenessResponse{
+		Status:    "alive",
+		Timestamp: time.Now(),
+	})
+}
+
+// Readiness handles GET /readyz and its alias GET /ready
+// Readiness probe with per-check results: the database answers a query within a second
+// (and isn't recovering from a connection failure) and the log directory is writable.
+// Any failing check makes it 503.
+func (ctrl *HealthController) Readiness(c *gin.Context) {
+	checks := map[string]response.ReadinessCheckResponse{
+		"database": ctrl.checkDatabase(c.Request.Context()),
+	}
+	if ctrl.logDir != "" {
+		checks["log_dir"] = checkWritable(ctrl.logDir)
+	}
+
+	status := "ready"
+	httpStatus := http.StatusOK
+	for _, check := range checks {
+		if check.Status != "ok" {
+			status = "not_ready"
+			httpStatus = http.StatusServiceUnavailable
+		}
+	}
+
+	c.JSON(httpStatus, response.ReadinessResponse{
+		Status:    status,
+		Checks:    checks,
+		Timestamp: time.Now(),
+	})
+}
+
+// checkDatabase runs SELECT 1 with the readiness timeout
+func (ctrl *HealthController) checkDatabase(ctx context.Context) response.ReadinessCheckResponse {
+	ctx, cancel := context.WithTimeout(ctx, readinessPingTimeout)
+	defer cancel()
+
+	var one int
+	if err := ctrl.db.WithContext(ctx).Raw("SELECT 1").Scan(&one).Error; err != nil {
+		return response.ReadinessCheckResponse{Status: "failed", Error: err.Error()}
+	}
+	if ctrl.degraded() {
+		return response.ReadinessCheckResponse{Status: "degraded", Error: "recovering from a connection failure"}
+	}
+	return response.ReadinessCheckResponse{Status: "ok"}
+}
+
+// checkWritable creates and removes a file in dir
+func checkWritable(dir string) response.ReadinessCheckResponse {
+	file, err := os.CreateTemp(dir, ".readyz-*")
+	if err != nil {
+		return response.ReadinessCheckResponse{Status: "failed", Error: err.Error()}
+	}
+	file.Close()
+	_ = os.Remove(file.Name())
+	return response.ReadinessCheckResponse{Status: "ok"}
+}
+
 // DatabaseHealth handles GET /health/db
 // Detailed database health check with connection pool stats
 func (ctrl *HealthController) DatabaseHealth(c *gin.Context) {
@@ -81,8 +170,12 @@ func (ctrl *HealthController) DatabaseHealth(c *gin.Context) {
 	stats := sqlDB.Stats()
 
 	// Return detailed health information
+	status := "healthy"
+	if ctrl.degraded() {
+		status = "degraded"
+	}
 	c.JSON(http.StatusOK, response.DatabaseHealthResponse{
-		Status: "healthy",
+		Status: status,
 		Database: response.DatabaseStatus{
 			Connected:         true,
 			MaxOpenConns:      stats.MaxOpenConnections,
@@ -0,0 +1,126 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/interfaces/dto/response"
+	"github.com/gin-gonic/gin"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// openClosedDB returns a GORM connection whose pool is already closed: every query fails
+// without reaching a server
+func openClosedDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(postgres.Open("host=127.0.0.1 port=1 dbname=none"), &gorm.Config{
+		Logger:               logger.Default.LogMode(logger.Silent),
+		DisableAutomaticPing: true,
+	})
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("DB: %v", err)
+	}
+	if err := sqlDB.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+	return db
+}
+
+func TestHealthController_ClosedDatabase(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := NewHealthController(openClosedDB(t), nil, filepath.Join(t.TempDir(), "missing"))
+	router := gin.New()
+	router.GET("/health", ctrl.Health)
+	router.GET("/healthz", ctrl.Liveness)
+	router.GET("/readyz", ctrl.Readiness)
+	router.GET("/ready", ctrl.Readiness)
+
+	tests := []struct {
+		path       string
+		wantStatus int
+	}{
+		{path: "/health", wantStatus: http.StatusServiceUnavailable},
+		{path: "/healthz", wantStatus: http.StatusOK},
+		{path: "/readyz", wantStatus: http.StatusServiceUnavailable},
+		{path: "/ready", wantStatus: http.StatusServiceUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.path, nil))
+			if w.Code != tt.wantStatus {
+				t.Fatalf("GET %s = %d, want %d: %s", tt.path, w.Code, tt.wantStatus, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestHealthController_ReadinessChecks(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name       string
+		logDir     func(t *testing.T) string
+		wantChecks map[string]string
+	}{
+		{
+			name:       "stdout logging",
+			logDir:     func(*testing.T) string { return "" },
+			wantChecks: map[string]string{"database": "failed"},
+		},
+		{
+			name:       "writable log directory",
+			logDir:     func(t *testing.T) string { return t.TempDir() },
+			wantChecks: map[string]string{"database": "failed", "log_dir": "ok"},
+		},
+		{
+			name:       "missing log directory",
+			logDir:     func(t *testing.T) string { return filepath.Join(t.TempDir(), "missing") },
+			wantChecks: map[string]string{"database": "failed", "log_dir": "failed"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := NewHealthController(openClosedDB(t), nil, tt.logDir(t))
+			router := gin.New()
+			router.GET("/readyz", ctrl.Readiness)
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+			if w.Code != http.StatusServiceUnavailable {
+				t.Fatalf("status = %d, want %d", w.Code, http.StatusServiceUnavailable)
+			}
+
+			var body response.ReadinessResponse
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+			if body.Status != "not_ready" {
+				t.Errorf("status = %q, want %q", body.Status, "not_ready")
+			}
+			if len(body.Checks) != len(tt.wantChecks) {
+				t.Errorf("checks = %v, want %v", body.Checks, tt.wantChecks)
+			}
+			for name, want := range tt.wantChecks {
+				check, ok := body.Checks[name]
+				if !ok || check.Status != want {
+					t.Errorf("check %s = %+v, want status %q", name, check, want)
+				}
+				if want != "ok" && check.Error == "" {
+					t.Errorf("check %s has no error message", name)
+				}
+			}
+		})
+	}
+}
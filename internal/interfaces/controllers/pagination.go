@@ -0,0 +1,13 @@
+package controllers
+
+import (
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/interfaces/dto/request"
+)
+
+// paginationInput maps the bound page/limit query parameters (already range-checked by
+// binding) to the application pagination, with the defaults applied
+func paginationInput(q request.PaginationQuery) dto.PaginationDTO {
+	page, limit := q.Resolve()
+	return dto.PaginationDTO{Page: page, Limit: limit}
+}
@@ -0,0 +1,86 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/interfaces/dto/request"
+	"github.com/gin-gonic/gin"
+)
+
+func TestListHandlers_RejectOutOfRangePagination(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	// The query is rejected before any use case runs, so the controllers need no dependencies
+	portfolios, categories, sections, projects := &PortfolioController{}, &CategoryController{}, &SectionController{}, &ProjectController{}
+	router := gin.New()
+	router.Use(func(c *gin.Context) { c.Set("userID", "user-1") })
+	router.GET("/portfolios/own", portfolios.List)
+	router.GET("/portfolios/public", portfolios.ListPublic)
+	router.GET("/categories/own", categories.List)
+	router.GET("/categories/own/:id/detail", categories.GetDetail)
+	router.GET("/sections/own", sections.List)
+	router.GET("/sections/own/type/:type", sections.ListByType)
+	router.GET("/projects/own", projects.List)
+
+	queries := []struct {
+		query     string
+		wantCode  string
+		wantError string
+	}{
+		{query: "limit=101", wantCode: apperrors.CodeValidationMax, wantError: "limit must be at most 100"},
+		{query: "limit=0", wantCode: apperrors.CodeValidationMin, wantError: "limit must be at least 1"},
+		{query: "page=0", wantCode: apperrors.CodeValidationMin, wantError: "page must be at least 1"},
+		{query: "page=1&limit=-5", wantCode: apperrors.CodeValidationMin, wantError: "limit must be at least 1"},
+	}
+
+	for _, path := range []string{
+		"/portfolios/own", "/portfolios/public", "/categories/own", "/categories/own/1/detail",
+		"/sections/own", "/sections/own/type/text", "/projects/own",
+	} {
+		for _, q := range queries {
+			t.Run(path+"?"+q.query, func(t *testing.T) {
+				w := httptest.NewRecorder()
+				router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path+"?"+q.query, nil))
+
+				if w.Code != http.StatusBadRequest {
+					t.Fatalf("status = %d, want 400 (%s)", w.Code, w.Body.String())
+				}
+				var body struct {
+					Error string `json:"error"`
+					Code  string `json:"code"`
+				}
+				if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+					t.Fatalf("decode response: %v", err)
+				}
+				if body.Code != q.wantCode || body.Error != q.wantError {
+					t.Errorf("error = %q (%s), want %q (%s)", body.Error, body.Code, q.wantError, q.wantCode)
+				}
+			})
+		}
+	}
+}
+
+func TestPaginationQuery_Resolve(t *testing.T) {
+	n := func(v int) *int { return &v }
+
+	tests := []struct {
+		name      string
+		query     request.PaginationQuery
+		wantPage  int
+		wantLimit int
+	}{
+		{name: "omitted", wantPage: 1, wantLimit: request.DefaultPageLimit},
+		{name: "page only", query: request.PaginationQuery{Page: n(3)}, wantPage: 3, wantLimit: request.DefaultPageLimit},
+		{name: "largest limit", query: request.PaginationQuery{Limit: n(request.MaxPageLimit)}, wantPage: 1, wantLimit: request.MaxPageLimit},
+	}
+
+	for _, tt := range tests {
+		if got := paginationInput(tt.query); got.Page != tt.wantPage || got.Limit != tt.wantLimit {
+			t.Errorf("%s: pagination = %+v, want page %d limit %d", tt.name, got, tt.wantPage, tt.wantLimit)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	appdto "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	portfolio2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/usecases/portfolio"
+	"github.com/gin-gonic/gin"
+)
+
+// publishedPortfolioRepo publishes portfolio 1, keeps portfolio 2 a draft and fails on portfolio 3
+type publishedPortfolioRepo struct {
+	contracts.PortfolioRepository
+	checked []uint
+}
+
+func (r *publishedPortfolioRepo) IsPublished(_ context.Context, id uint) (bool, error) {
+	r.checked = append(r.checked, id)
+	if id == 3 {
+		return false, errors.New("connection reset")
+	}
+	return id == 1, nil
+}
+
+func TestPortfolioController_GetPublicAvailability(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		wantStatus  int
+		wantBody    string // availability status; empty when no availability is returned
+		wantChecked bool
+	}{
+		{name: "published", method: http.MethodGet, path: "/portfolios/public/1/availability", wantStatus: http.StatusOK, wantBody: appdto.PortfolioAvailabilityPublished, wantChecked: true},
+		{name: "published probed with HEAD", method: http.MethodHead, path: "/portfolios/public/1/availability", wantStatus: http.StatusOK, wantChecked: true},
+		{name: "draft is hidden as not found", method: http.MethodGet, path: "/portfolios/public/2/availability", wantStatus: http.StatusNotFound, wantBody: appdto.PortfolioAvailabilityNotFound, wantChecked: true},
+		{name: "missing", method: http.MethodGet, path: "/portfolios/public/99/availability", wantStatus: http.StatusNotFound, wantBody: appdto.PortfolioAvailabilityNotFound, wantChecked: true},
+		{name: "malformed ID", method: http.MethodGet, path: "/portfolios/public/abc/availability", wantStatus: http.StatusNotFound, wantBody: appdto.PortfolioAvailabilityNotFound},
+		{name: "zero ID", method: http.MethodGet, path: "/portfolios/public/0/availability", wantStatus: http.StatusNotFound, wantBody: appdto.PortfolioAvailabilityNotFound},
+		{name: "lookup failure", method: http.MethodGet, path: "/portfolios/public/3/availability", wantStatus: http.StatusInternalServerError, wantChecked: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &publishedPortfolioRepo{}
+			ctrl := &PortfolioController{availabilityUC: portfolio2.NewGetPortfolioAvailabilityUseCase(repo)}
+			router := gin.New()
+			router.GET("/portfolios/public/:id/availability", ctrl.GetPublicAvailability)
+			router.HEAD("/portfolios/public/:id/availability", ctrl.GetPublicAvailability)
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(tt.method, tt.path, nil))
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (%s)", w.Code, tt.wantStatus, w.Body.String())
+			}
+			if checked := len(repo.checked) > 0; checked != tt.wantChecked {
+				t.Errorf("repository checked = %v, want %v", checked, tt.wantChecked)
+			}
+			if tt.wantBody == "" {
+				return
+			}
+
+			if got := w.Header().Get("Cache-Control"); got != "public, max-age=30" {
+				t.Errorf("Cache-Control = %q, want public, max-age=30", got)
+			}
+			var body map[string]interface{}
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if body["status"] != tt.wantBody {
+				t.Errorf("status field = %v, want %q", body["status"], tt.wantBody)
+			}
+			// No share tokens exist yet, so the field is present and always false
+			if requiresToken, ok := body["requires_token"]; !ok || requiresToken != false {
+				t.Errorf("requires_token = %v (present %v), want false", requiresToken, ok)
+			}
+		})
+	}
+}
+
+func TestPortfolioAvailabilityStatuses(t *testing.T) {
+	want := map[string]int{
+		appdto.PortfolioAvailabilityPublished: http.StatusOK,
+		appdto.PortfolioAvailabilityPrivate:   http.StatusForbidden,
+		appdto.PortfolioAvailabilityNotFound:  http.StatusNotFound,
+	}
+	for status, code := range want {
+		if got := portfolioAvailabilityStatuses[status]; got != code {
+			t.Errorf("%s maps to %d, want %d", status, got, code)
+		}
+	}
+}
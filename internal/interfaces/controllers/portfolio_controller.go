@@ -1,28 +1,54 @@
 package controllers
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
 	contracts2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
 	appdto "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
 	portfolio2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/usecases/portfolio"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/usecases/trash"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/interfaces/dto/request"
 	response2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/interfaces/dto/response"
-	pkgerrors "github.com/JorgeSaicoski/portfolio-manager/backend/pkg/errors"
 	"github.com/gin-gonic/gin"
 )
 
 // PortfolioController handles HTTP requests for portfolio operations
 type PortfolioController struct {
-	createUseCase    *portfolio2.CreatePortfolioUseCase
-	getUseCase       *portfolio2.GetPortfolioUseCase
-	getPublicUseCase *portfolio2.GetPortfolioPublicUseCase
-	listUseCase      *portfolio2.ListPortfoliosUseCase
-	updateUseCase    *portfolio2.UpdatePortfolioUseCase
-	deleteUseCase    *portfolio2.DeletePortfolioUseCase
-	categoryRepo     contracts2.CategoryRepository
-	sectionRepo      contracts2.SectionRepository
+	createUseCase      *portfolio2.CreatePortfolioUseCase
+	getUseCase         *portfolio2.GetPortfolioUseCase
+	getPublicUseCase   *portfolio2.GetPortfolioPublicUseCase
+	getPublicBySlugUC  *portfolio2.GetPortfolioPublicBySlugUseCase
+	listUseCase        *portfolio2.ListPortfoliosUseCase
+	searchPublicUC     *portfolio2.SearchPublicPortfoliosUseCase
+	updateUseCase      *portfolio2.UpdatePortfolioUseCase
+	patchUseCase       *portfolio2.PatchPortfolioUseCase
+	deleteUseCase      *portfolio2.DeletePortfolioUseCase
+	cloneUseCase       *portfolio2.ClonePortfolioUseCase
+	exportUseCase      *portfolio2.ExportPortfolioUseCase
+	publishUseCase     *portfolio2.SetPortfolioPublishedUseCase
+	importUseCase      *portfolio2.ImportPortfolioUseCase
+	trashUseCase       *portfolio2.ListDeletedPortfoliosUseCase
+	restoreUseCase     *portfolio2.RestorePortfolioUseCase
+	purgeUseCase       *portfolio2.PurgePortfolioUseCase
+	jsonldUseCase      *portfolio2.GetPortfolioStructuredDataUseCase
+	completenessUC     *portfolio2.GetPortfolioCompletenessUseCase
+	accessibilityUC    *portfolio2.GetPortfolioAccessibilityReportUseCase
+	customCSSUC        *portfolio2.UpdatePortfolioCustomCSSUseCase
+	availabilityUC     *portfolio2.GetPortfolioAvailabilityUseCase
+	tocUC              *portfolio2.GetPortfolioTOCUseCase
+	searchUC           *portfolio2.SearchPortfolioUseCase
+	analyticsUC        *portfolio2.GetPortfolioAnalyticsUseCase
+	recordViewUC       *portfolio2.RecordPortfolioViewUseCase
+	categoryRepo       contracts2.CategoryRepository
+	sectionRepo        contracts2.SectionRepository
+	assetURLs          contracts2.AssetURLBuilder
+	findDeletedUseCase *trash.FindDeletedItemUseCase
 }
 
 // NewPortfolioController creates a new portfolio controller instance
@@ -30,21 +56,63 @@ func NewPortfolioController(
 	createUC *portfolio2.CreatePortfolioUseCase,
 	getUC *portfolio2.GetPortfolioUseCase,
 	getPublicUC *portfolio2.GetPortfolioPublicUseCase,
+	getPublicBySlugUC *portfolio2.GetPortfolioPublicBySlugUseCase,
 	listUC *portfolio2.ListPortfoliosUseCase,
+	searchPublicUC *portfolio2.SearchPublicPortfoliosUseCase,
 	updateUC *portfolio2.UpdatePortfolioUseCase,
+	patchUC *portfolio2.PatchPortfolioUseCase,
 	deleteUC *portfolio2.DeletePortfolioUseCase,
+	cloneUC *portfolio2.ClonePortfolioUseCase,
+	exportUC *portfolio2.ExportPortfolioUseCase,
+	publishUC *portfolio2.SetPortfolioPublishedUseCase,
+	importUC *portfolio2.ImportPortfolioUseCase,
+	trashUC *portfolio2.ListDeletedPortfoliosUseCase,
+	restoreUC *portfolio2.RestorePortfolioUseCase,
+	purgeUC *portfolio2.PurgePortfolioUseCase,
+	jsonldUC *portfolio2.GetPortfolioStructuredDataUseCase,
+	completenessUC *portfolio2.GetPortfolioCompletenessUseCase,
+	accessibilityUC *portfolio2.GetPortfolioAccessibilityReportUseCase,
+	customCSSUC *portfolio2.UpdatePortfolioCustomCSSUseCase,
+	availabilityUC *portfolio2.GetPortfolioAvailabilityUseCase,
+	tocUC *portfolio2.GetPortfolioTOCUseCase,
+	searchUC *portfolio2.SearchPortfolioUseCase,
+	analyticsUC *portfolio2.GetPortfolioAnalyticsUseCase,
+	recordViewUC *portfolio2.RecordPortfolioViewUseCase,
+	findDeletedUC *trash.FindDeletedItemUseCase,
 	categoryRepo contracts2.CategoryRepository,
 	sectionRepo contracts2.SectionRepository,
+	assetURLs contracts2.AssetURLBuilder,
 ) *PortfolioController {
 	return &PortfolioController{
-		createUseCase:    createUC,
-		getUseCase:       getUC,
-		getPublicUseCase: getPublicUC,
-		listUseCase:      listUC,
-		updateUseCase:    updateUC,
-		deleteUseCase:    deleteUC,
-		categoryRepo:     categoryRepo,
-		sectionRepo:      sectionRepo,
+		createUseCase:      createUC,
+		getUseCase:         getUC,
+		getPublicUseCase:   getPublicUC,
+		getPublicBySlugUC:  getPublicBySlugUC,
+		listUseCase:        listUC,
+		searchPublicUC:     searchPublicUC,
+		updateUseCase:      updateUC,
+		patchUseCase:       patchUC,
+		deleteUseCase:      deleteUC,
+		cloneUseCase:       cloneUC,
+		exportUseCase:      exportUC,
+		publishUseCase:     publishUC,
+		importUseCase:      importUC,
+		trashUseCase:       trashUC,
+		restoreUseCase:     restoreUC,
+		purgeUseCase:       purgeUC,
+		jsonldUseCase:      jsonldUC,
+		completenessUC:     completenessUC,
+		accessibilityUC:    accessibilityUC,
+		customCSSUC:        customCSSUC,
+		availabilityUC:     availabilityUC,
+		tocUC:              tocUC,
+		searchUC:           searchUC,
+		analyticsUC:        analyticsUC,
+		recordViewUC:       recordViewUC,
+		categoryRepo:       categoryRepo,
+		sectionRepo:        sectionRepo,
+		assetURLs:          assetURLs,
+		findDeletedUseCase: findDeletedUC,
 	}
 }
 
@@ -53,14 +121,14 @@ func (ctrl *PortfolioController) Create(c *gin.Context) {
 	// 1. Extract userID from context (set by auth middleware)
 	userID := c.GetString("userID")
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, response2.ErrorResponse{Error: "unauthorized: missing user ID"})
+		respondUnauthenticated(c)
 		return
 	}
 
 	// 2. Bind and validate HTTP request DTO
 	var req request.CreatePortfolioRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: err.Error()})
+		respondBindingError(c, err)
 		return
 	}
 
@@ -74,8 +142,7 @@ func (ctrl *PortfolioController) Create(c *gin.Context) {
 	// 4. Execute use case
 	portfolioDTO, err := ctrl.createUseCase.Execute(c.Request.Context(), input)
 	if err != nil {
-		status := pkgerrors.ToHTTPStatus(err)
-		c.JSON(status, response2.ErrorResponse{Error: err.Error()})
+		respondError(c, err)
 		return
 	}
 
@@ -84,9 +151,15 @@ func (ctrl *PortfolioController) Create(c *gin.Context) {
 		ID:          portfolioDTO.ID,
 		Title:       portfolioDTO.Title,
 		Description: portfolioDTO.Description,
+		Slug:        portfolioDTO.Slug,
 		OwnerID:     portfolioDTO.OwnerID,
+		CreatedBy:   portfolioDTO.CreatedBy,
+		UpdatedBy:   portfolioDTO.UpdatedBy,
 		CreatedAt:   portfolioDTO.CreatedAt,
 		UpdatedAt:   portfolioDTO.UpdatedAt,
+
+		IsPublished:         portfolioDTO.IsPublished,
+		EndorsementsEnabled: portfolioDTO.EndorsementsEnabled,
 	}
 
 	// 6. Return HTTP response
@@ -98,39 +171,27 @@ func (ctrl *PortfolioController) List(c *gin.Context) {
 	// 1. Extract userID from context (set by auth middleware)
 	userID := c.GetString("userID")
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, response2.ErrorResponse{Error: "unauthorized: missing user ID"})
+		respondUnauthenticated(c)
 		return
 	}
 
 	// 2. Bind and validate query parameters
 	var req request.ListPortfoliosRequest
 	if err := c.ShouldBindQuery(&req); err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: err.Error()})
+		respondBindingError(c, err)
 		return
 	}
 
-	// Set default pagination values if not provided
-	if req.Page == 0 {
-		req.Page = 1
-	}
-	if req.Limit == 0 {
-		req.Limit = 10
-	}
-
 	// 3. Map to application DTO
 	input := appdto.ListPortfoliosInput{
-		OwnerID: userID,
-		Pagination: appdto.PaginationDTO{
-			Page:  req.Page,
-			Limit: req.Limit,
-		},
+		OwnerID:    userID,
+		Pagination: paginationInput(req.PaginationQuery),
 	}
 
 	// 4. Execute use case
 	output, err := ctrl.listUseCase.Execute(c.Request.Context(), input)
 	if err != nil {
-		status := pkgerrors.ToHTTPStatus(err)
-		c.JSON(status, response2.ErrorResponse{Error: err.Error()})
+		respondError(c, err)
 		return
 	}
 
@@ -141,9 +202,15 @@ func (ctrl *PortfolioController) List(c *gin.Context) {
 			ID:          p.ID,
 			Title:       p.Title,
 			Description: p.Description,
+			Slug:        p.Slug,
 			OwnerID:     p.OwnerID,
+			CreatedBy:   p.CreatedBy,
+			UpdatedBy:   p.UpdatedBy,
 			CreatedAt:   p.CreatedAt,
 			UpdatedAt:   p.UpdatedAt,
+
+			IsPublished:         p.IsPublished,
+			EndorsementsEnabled: p.EndorsementsEnabled,
 		}
 	}
 
@@ -165,7 +232,7 @@ func (ctrl *PortfolioController) GetByID(c *gin.Context) {
 	// 1. Extract userID from context (set by auth middleware)
 	userID := c.GetString("userID")
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, response2.ErrorResponse{Error: "unauthorized: missing user ID"})
+		respondUnauthenticated(c)
 		return
 	}
 
@@ -173,21 +240,20 @@ func (ctrl *PortfolioController) GetByID(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := strconv.ParseUint(idStr, 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: "invalid portfolio ID"})
+		respondInvalidID(c, "portfolio")
 		return
 	}
 
 	// 3. Execute use case
 	portfolioDTO, err := ctrl.getUseCase.Execute(c.Request.Context(), uint(id))
 	if err != nil {
-		status := pkgerrors.ToHTTPStatus(err)
-		c.JSON(status, response2.ErrorResponse{Error: err.Error()})
+		respondOwnItemError(c, ctrl.findDeletedUseCase, err, appdto.TrashResourcePortfolio, uint(id))
 		return
 	}
 
 	// 4. Authorization check: verify ownership
 	if portfolioDTO.OwnerID != userID {
-		c.JSON(http.StatusForbidden, response2.ErrorResponse{Error: "forbidden: you don't own this portfolio"})
+		respondErrorCode(c, http.StatusForbidden, apperrors.CodeAccessDenied, "forbidden: you don't own this portfolio")
 		return
 	}
 
@@ -196,9 +262,15 @@ func (ctrl *PortfolioController) GetByID(c *gin.Context) {
 		ID:          portfolioDTO.ID,
 		Title:       portfolioDTO.Title,
 		Description: portfolioDTO.Description,
+		Slug:        portfolioDTO.Slug,
 		OwnerID:     portfolioDTO.OwnerID,
+		CreatedBy:   portfolioDTO.CreatedBy,
+		UpdatedBy:   portfolioDTO.UpdatedBy,
 		CreatedAt:   portfolioDTO.CreatedAt,
 		UpdatedAt:   portfolioDTO.UpdatedAt,
+
+		IsPublished:         portfolioDTO.IsPublished,
+		EndorsementsEnabled: portfolioDTO.EndorsementsEnabled,
 	}
 
 	// 6. Return HTTP response
@@ -210,7 +282,7 @@ func (ctrl *PortfolioController) Update(c *gin.Context) {
 	// 1. Extract userID from context (set by auth middleware)
 	userID := c.GetString("userID")
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, response2.ErrorResponse{Error: "unauthorized: missing user ID"})
+		respondUnauthenticated(c)
 		return
 	}
 
@@ -218,14 +290,14 @@ func (ctrl *PortfolioController) Update(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := strconv.ParseUint(idStr, 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: "invalid portfolio ID"})
+		respondInvalidID(c, "portfolio")
 		return
 	}
 
 	// 3. Bind and validate HTTP request DTO
 	var req request.UpdatePortfolioRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: err.Error()})
+		respondBindingError(c, err)
 		return
 	}
 
@@ -235,13 +307,61 @@ func (ctrl *PortfolioController) Update(c *gin.Context) {
 		Title:       req.Title,
 		Description: req.Description,
 		OwnerID:     userID, // For authorization check in use case
+
+		EndorsementsEnabled: req.EndorsementsEnabled,
+		RegenerateSlug:      req.RegenerateSlug,
 	}
 
 	// 5. Execute use case (use case handles ownership check)
 	err = ctrl.updateUseCase.Execute(c.Request.Context(), input)
 	if err != nil {
-		status := pkgerrors.ToHTTPStatus(err)
-		c.JSON(status, response2.ErrorResponse{Error: err.Error()})
+		respondOwnItemError(c, ctrl.findDeletedUseCase, err, appdto.TrashResourcePortfolio, uint(id))
+		return
+	}
+
+	// 6. Return success response
+	c.JSON(http.StatusOK, response2.SuccessResponse{Message: "portfolio updated successfully"})
+}
+
+// Patch handles PATCH /api/portfolios/own/:id
+// Only the fields present in the body change, unlike PUT which ignores empty values
+func (ctrl *PortfolioController) Patch(c *gin.Context) {
+	// 1. Extract userID from context (set by auth middleware)
+	userID := c.GetString("userID")
+	if userID == "" {
+		respondUnauthenticated(c)
+		return
+	}
+
+	// 2. Parse portfolio ID from URL parameter
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		respondInvalidID(c, "portfolio")
+		return
+	}
+
+	// 3. Bind and validate HTTP request DTO
+	var req request.PatchPortfolioRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err)
+		return
+	}
+
+	// 4. Map to application DTO
+	input := appdto.PatchPortfolioInput{
+		ID:                  uint(id),
+		Title:               req.Title,
+		Description:         req.Description,
+		EndorsementsEnabled: req.EndorsementsEnabled,
+		OwnerID:             userID, // For authorization check in use case
+		RegenerateSlug:      req.RegenerateSlug,
+	}
+
+	// 5. Execute use case (use case handles ownership check)
+	err = ctrl.patchUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		respondOwnItemError(c, ctrl.findDeletedUseCase, err, appdto.TrashResourcePortfolio, uint(id))
 		return
 	}
 
@@ -249,12 +369,72 @@ func (ctrl *PortfolioController) Update(c *gin.Context) {
 	c.JSON(http.StatusOK, response2.SuccessResponse{Message: "portfolio updated successfully"})
 }
 
+// Publish handles PATCH /api/portfolios/own/:id/publish
+// Drafts are only visible through the owner routes; every public route reports them as not found
+func (ctrl *PortfolioController) Publish(c *gin.Context) {
+	// 1. Extract userID from context (set by auth middleware)
+	userID := c.GetString("userID")
+	if userID == "" {
+		respondUnauthenticated(c)
+		return
+	}
+
+	// 2. Parse portfolio ID from URL parameter
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		respondInvalidID(c, "portfolio")
+		return
+	}
+
+	// 3. Bind and validate request body
+	var req request.SetPortfolioPublishedRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err)
+		return
+	}
+
+	// 4. Execute use case (use case handles ownership check)
+	portfolioDTO, err := ctrl.publishUseCase.Execute(c.Request.Context(), appdto.SetPortfolioPublishedInput{
+		PortfolioID: uint(id),
+		OwnerID:     userID,
+		Published:   *req.IsPublished,
+	})
+	if err != nil {
+		respondOwnItemError(c, ctrl.findDeletedUseCase, err, appdto.TrashResourcePortfolio, uint(id))
+		return
+	}
+
+	// 5. Return HTTP response with API_OVERVIEW.md format
+	message := "Portfolio unpublished successfully"
+	if portfolioDTO.IsPublished {
+		message = "Portfolio published successfully"
+	}
+	c.JSON(http.StatusOK, response2.DataResponse{
+		Data: response2.PortfolioResponse{
+			ID:          portfolioDTO.ID,
+			Title:       portfolioDTO.Title,
+			Description: portfolioDTO.Description,
+			Slug:        portfolioDTO.Slug,
+			OwnerID:     portfolioDTO.OwnerID,
+			CreatedBy:   portfolioDTO.CreatedBy,
+			UpdatedBy:   portfolioDTO.UpdatedBy,
+			CreatedAt:   portfolioDTO.CreatedAt,
+			UpdatedAt:   portfolioDTO.UpdatedAt,
+
+			IsPublished:         portfolioDTO.IsPublished,
+			EndorsementsEnabled: portfolioDTO.EndorsementsEnabled,
+		},
+		Message: message,
+	})
+}
+
 // Delete handles DELETE /api/v2/portfolios/:id
 func (ctrl *PortfolioController) Delete(c *gin.Context) {
 	// 1. Extract userID from context (set by auth middleware)
 	userID := c.GetString("userID")
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, response2.ErrorResponse{Error: "unauthorized: missing user ID"})
+		respondUnauthenticated(c)
 		return
 	}
 
@@ -262,15 +442,14 @@ func (ctrl *PortfolioController) Delete(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := strconv.ParseUint(idStr, 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: "invalid portfolio ID"})
+		respondInvalidID(c, "portfolio")
 		return
 	}
 
 	// 3. Execute use case (use case handles ownership check)
 	err = ctrl.deleteUseCase.Execute(c.Request.Context(), uint(id), userID)
 	if err != nil {
-		status := pkgerrors.ToHTTPStatus(err)
-		c.JSON(status, response2.ErrorResponse{Error: err.Error()})
+		respondOwnItemError(c, ctrl.findDeletedUseCase, err, appdto.TrashResourcePortfolio, uint(id))
 		return
 	}
 
@@ -278,62 +457,662 @@ func (ctrl *PortfolioController) Delete(c *gin.Context) {
 	c.JSON(http.StatusOK, response2.SuccessResponse{Message: "portfolio deleted successfully"})
 }
 
+// Clone handles POST /api/portfolios/own/:id/clone
+func (ctrl *PortfolioController) Clone(c *gin.Context) {
+	// 1. Extract userID from context (set by auth middleware)
+	userID := c.GetString("userID")
+	if userID == "" {
+		respondUnauthenticated(c)
+		return
+	}
+
+	// 2. Parse portfolio ID from URL parameter
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		respondInvalidID(c, "portfolio")
+		return
+	}
+
+	// 3. Execute use case (use case handles ownership check)
+	clone, err := ctrl.cloneUseCase.Execute(c.Request.Context(), appdto.ClonePortfolioInput{
+		PortfolioID: uint(id),
+		OwnerID:     userID,
+	})
+	if err != nil {
+		respondOwnItemError(c, ctrl.findDeletedUseCase, err, appdto.TrashResourcePortfolio, uint(id))
+		return
+	}
+
+	// 4. Return HTTP response with API_OVERVIEW.md format
+	c.JSON(http.StatusCreated, response2.DataResponse{
+		Data: response2.PortfolioCloneResponse{
+			ID:       clone.Portfolio.ID,
+			Title:    clone.Portfolio.Title,
+			Slug:     clone.Portfolio.Slug,
+			SourceID: clone.SourceID,
+			Copied: response2.PortfolioChildCountsResponse{
+				Links:                clone.Copied.Links,
+				Categories:           clone.Copied.Categories,
+				Projects:             clone.Copied.Projects,
+				ProjectCollaborators: clone.Copied.ProjectCollaborators,
+				Sections:             clone.Copied.Sections,
+				SectionContents:      clone.Copied.SectionContents,
+			},
+		},
+		Message: "Portfolio cloned successfully",
+	})
+}
+
+// Export handles GET /api/portfolios/own/:id/export
+// The versioned document is sent as a file download rather than wrapped in the data envelope
+func (ctrl *PortfolioController) Export(c *gin.Context) {
+	// 1. Extract userID from context (set by auth middleware)
+	userID := c.GetString("userID")
+	if userID == "" {
+		respondUnauthenticated(c)
+		return
+	}
+
+	// 2. Parse portfolio ID from URL parameter
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		respondInvalidID(c, "portfolio")
+		return
+	}
+
+	// 3. Execute use case (use case handles ownership check)
+	export, err := ctrl.exportUseCase.Execute(c.Request.Context(), appdto.ExportPortfolioInput{
+		PortfolioID: uint(id),
+		OwnerID:     userID,
+	})
+	if err != nil {
+		respondOwnItemError(c, ctrl.findDeletedUseCase, err, appdto.TrashResourcePortfolio, uint(id))
+		return
+	}
+
+	// 4. Stream the document as an attachment
+	filename := fmt.Sprintf("portfolio-%d.json", id)
+	if export.Slug != "" {
+		filename = fmt.Sprintf("portfolio-%s.json", export.Slug)
+	}
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	c.Header("Content-Type", "application/json; charset=utf-8")
+	c.Status(http.StatusOK)
+
+	document := buildPortfolioExport(export, exportBaseURL(ctrl.assetURLs), time.Now())
+	// The status is already sent: a failed write can only leave the client a truncated file
+	_ = json.NewEncoder(c.Writer).Encode(document)
+}
+
+// MaxImportBodyBytes is the largest import document accepted; larger ones get 413
+const MaxImportBodyBytes = 10 << 20
+
+// Import handles POST /api/portfolios/own/import
+func (ctrl *PortfolioController) Import(c *gin.Context) {
+	// 1. Extract userID from context (set by auth middleware)
+	userID := c.GetString("userID")
+	if userID == "" {
+		respondUnauthenticated(c)
+		return
+	}
+
+	// 2. Bind and validate the document
+	var query request.ImportPortfolioQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		respondBindingError(c, err)
+		return
+	}
+	if query.DownloadImages {
+		respondErrorCode(c, http.StatusNotImplemented, apperrors.CodeNotImplemented,
+			"downloading images is not supported; image paths are imported as external URLs")
+		return
+	}
+
+	// The document is decoded whole, so its size is bounded before reading it
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, MaxImportBodyBytes)
+	var req request.ImportPortfolioRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			respondError(c, apperrors.PayloadTooLarge(tooLarge.Limit))
+			return
+		}
+		respondBindingError(c, err)
+		return
+	}
+
+	// 3. Execute use case
+	imported, err := ctrl.importUseCase.Execute(c.Request.Context(), appdto.ImportPortfolioInput{
+		OwnerID:       userID,
+		ExportVersion: req.ExportVersion,
+		SourceBaseURL: req.BaseURL,
+		LocalBaseURL:  exportBaseURL(ctrl.assetURLs),
+		Portfolio:     importDocumentToDTO(req.Portfolio),
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// 4. Return HTTP response with API_OVERVIEW.md format
+	skipped := make([]response2.ImportSkipResponse, len(imported.Skipped))
+	for i, skip := range imported.Skipped {
+		skipped[i] = response2.ImportSkipResponse{Path: skip.Path, Reason: skip.Reason}
+	}
+	c.JSON(http.StatusCreated, response2.DataResponse{
+		Data: response2.PortfolioImportResponse{
+			ID:    imported.Portfolio.ID,
+			Title: imported.Portfolio.Title,
+			Slug:  imported.Portfolio.Slug,
+			Created: response2.PortfolioChildCountsResponse{
+				Links:                imported.Created.Links,
+				Categories:           imported.Created.Categories,
+				Projects:             imported.Created.Projects,
+				ProjectCollaborators: imported.Created.ProjectCollaborators,
+				Sections:             imported.Created.Sections,
+				SectionContents:      imported.Created.SectionContents,
+			},
+			ExternalImages: imported.ExternalImages,
+			Skipped:        skipped,
+		},
+		Message: "Portfolio imported successfully",
+	})
+}
+
+// Trash handles GET /api/portfolios/own/trash
+func (ctrl *PortfolioController) Trash(c *gin.Context) {
+	// 1. Extract userID from context (set by auth middleware)
+	userID := c.GetString("userID")
+	if userID == "" {
+		respondUnauthenticated(c)
+		return
+	}
+
+	// 2. Execute use case
+	deleted, err := ctrl.trashUseCase.Execute(c.Request.Context(), userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// 3. Map to HTTP response DTOs
+	portfolios := make([]response2.DeletedPortfolioResponse, len(deleted))
+	for i, d := range deleted {
+		portfolios[i] = response2.DeletedPortfolioResponse{
+			ID:          d.Portfolio.ID,
+			Title:       d.Portfolio.Title,
+			Description: d.Portfolio.Description,
+			Slug:        d.Portfolio.Slug,
+			CreatedAt:   d.Portfolio.CreatedAt,
+			DeletedAt:   d.DeletedAt,
+			BatchID:     d.BatchID,
+			DeletedWith: response2.PortfolioChildCountsResponse{
+				Links:                d.DeletedWith.Links,
+				Categories:           d.DeletedWith.Categories,
+				Projects:             d.DeletedWith.Projects,
+				ProjectCollaborators: d.DeletedWith.ProjectCollaborators,
+				Sections:             d.DeletedWith.Sections,
+				SectionContents:      d.DeletedWith.SectionContents,
+			},
+		}
+	}
+
+	// 4. Return HTTP response with API_OVERVIEW.md format
+	c.JSON(http.StatusOK, response2.DataResponse{
+		Data:    portfolios,
+		Message: "Success",
+	})
+}
+
+// Restore handles POST /api/portfolios/own/:id/restore
+func (ctrl *PortfolioController) Restore(c *gin.Context) {
+	// 1. Extract userID from context (set by auth middleware)
+	userID := c.GetString("userID")
+	if userID == "" {
+		respondUnauthenticated(c)
+		return
+	}
+
+	// 2. Parse portfolio ID from URL parameter
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		respondInvalidID(c, "portfolio")
+		return
+	}
+
+	// 3. Execute use case (use case handles ownership check)
+	restore, err := ctrl.restoreUseCase.Execute(c.Request.Context(), appdto.RestorePortfolioInput{
+		PortfolioID: uint(id),
+		OwnerID:     userID,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// 4. Return HTTP response with API_OVERVIEW.md format
+	p := restore.Portfolio
+	c.JSON(http.StatusOK, response2.DataResponse{
+		Data: response2.PortfolioRestoreResponse{
+			Portfolio: response2.PortfolioResponse{
+				ID:          p.ID,
+				Title:       p.Title,
+				Description: p.Description,
+				Slug:        p.Slug,
+				OwnerID:     p.OwnerID,
+				CreatedBy:   p.CreatedBy,
+				UpdatedBy:   p.UpdatedBy,
+				CreatedAt:   p.CreatedAt,
+				UpdatedAt:   p.UpdatedAt,
+
+				IsPublished:         p.IsPublished,
+				EndorsementsEnabled: p.EndorsementsEnabled,
+			},
+			RenamedFrom: restore.RenamedFrom,
+			Restored: response2.PortfolioChildCountsResponse{
+				Links:                restore.Restored.Links,
+				Categories:           restore.Restored.Categories,
+				Projects:             restore.Restored.Projects,
+				ProjectCollaborators: restore.Restored.ProjectCollaborators,
+				Sections:             restore.Restored.Sections,
+				SectionContents:      restore.Restored.SectionContents,
+			},
+		},
+		Message: "Portfolio restored successfully",
+	})
+}
+
+// Purge handles DELETE /api/portfolios/own/:id/purge
+func (ctrl *PortfolioController) Purge(c *gin.Context) {
+	// 1. Extract userID from context (set by auth middleware)
+	userID := c.GetString("userID")
+	if userID == "" {
+		respondUnauthenticated(c)
+		return
+	}
+
+	// 2. Parse portfolio ID from URL parameter
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		respondInvalidID(c, "portfolio")
+		return
+	}
+
+	// 3. Execute use case (use case handles ownership check)
+	if err := ctrl.purgeUseCase.Execute(c.Request.Context(), uint(id), userID); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// 4. Return success response
+	c.JSON(http.StatusOK, response2.SuccessResponse{Message: "portfolio permanently deleted"})
+}
+
+// GetAnalytics handles GET /api/portfolios/own/:id/analytics?from=&to=
+func (ctrl *PortfolioController) GetAnalytics(c *gin.Context) {
+	// 1. Extract userID from context (set by auth middleware)
+	userID := c.GetString("userID")
+	if userID == "" {
+		respondUnauthenticated(c)
+		return
+	}
+
+	// 2. Parse portfolio ID from URL parameter
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		respondInvalidID(c, "portfolio")
+		return
+	}
+
+	// 3. Bind and validate query parameters (dates are validated by the binding)
+	var req request.GetPortfolioAnalyticsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		respondBindingError(c, err)
+		return
+	}
+	input := appdto.PortfolioAnalyticsInput{PortfolioID: uint(id), OwnerID: userID}
+	if req.From != "" {
+		input.From, _ = time.Parse(time.DateOnly, req.From)
+	}
+	if req.To != "" {
+		input.To, _ = time.Parse(time.DateOnly, req.To)
+	}
+
+	// 4. Execute use case (use case handles ownership check)
+	output, err := ctrl.analyticsUC.Execute(c.Request.Context(), input)
+	if err != nil {
+		respondOwnItemError(c, ctrl.findDeletedUseCase, err, appdto.TrashResourcePortfolio, uint(id))
+		return
+	}
+
+	// 5. Map to HTTP response DTO
+	days := make([]response2.PortfolioViewsOnDayResponse, len(output.Days))
+	for i, day := range output.Days {
+		days[i] = response2.PortfolioViewsOnDayResponse{Day: day.Day.Format(time.DateOnly), Views: day.Views}
+	}
+
+	c.JSON(http.StatusOK, response2.DataResponse{
+		Data: response2.PortfolioAnalyticsResponse{
+			PortfolioID: output.PortfolioID,
+			From:        output.From.Format(time.DateOnly),
+			To:          output.To.Format(time.DateOnly),
+			Total:       output.Total,
+			Days:        days,
+		},
+		Message: "Success",
+	})
+}
+
+// GetCompleteness handles GET /api/portfolios/own/:id/completeness
+func (ctrl *PortfolioController) GetCompleteness(c *gin.Context) {
+	// 1. Extract userID from context (set by auth middleware)
+	userID := c.GetString("userID")
+	if userID == "" {
+		respondUnauthenticated(c)
+		return
+	}
+
+	// 2. Parse portfolio ID from URL parameter
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		respondInvalidID(c, "portfolio")
+		return
+	}
+
+	// 3. Execute use case (use case handles ownership check)
+	output, err := ctrl.completenessUC.Execute(c.Request.Context(), uint(id), userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// 4. Map to HTTP response DTO
+	checks := make([]response2.CompletenessCheckResponse, len(output.Checks))
+	for i, check := range output.Checks {
+		checks[i] = response2.CompletenessCheckResponse{
+			Name:         check.Name,
+			Passed:       check.Passed,
+			Weight:       check.Weight,
+			Message:      check.Message,
+			ResourceType: check.ResourceType,
+			ResourceIDs:  check.ResourceIDs,
+		}
+	}
+
+	// 5. Return HTTP response with API_OVERVIEW.md format
+	c.JSON(http.StatusOK, response2.DataResponse{
+		Data: response2.PortfolioCompletenessResponse{
+			PortfolioID: output.PortfolioID,
+			Score:       output.Score,
+			Checks:      checks,
+		},
+		Message: "Success",
+	})
+}
+
+// ListPublic handles GET /api/portfolios/public?q=&page=&limit=
+func (ctrl *PortfolioController) ListPublic(c *gin.Context) {
+	// Bind and validate query parameters
+	var req request.ListPublicPortfoliosRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		respondBindingError(c, err)
+		return
+	}
+
+	// Execute use case (no auth required for public access)
+	output, err := ctrl.searchPublicUC.Execute(c.Request.Context(), appdto.SearchPublicPortfoliosInput{
+		Query:      req.Query,
+		Pagination: paginationInput(req.PaginationQuery),
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// Map to list-level HTTP response DTOs (no OwnerID or actors in public responses)
+	portfolios := make([]response2.PortfolioResponse, len(output.Portfolios))
+	for i, p := range output.Portfolios {
+		portfolios[i] = response2.PortfolioResponse{
+			ID:          p.ID,
+			Title:       p.Title,
+			Description: p.Description,
+			Slug:        p.Slug,
+			CreatedAt:   p.CreatedAt,
+			UpdatedAt:   p.UpdatedAt,
+
+			IsPublished:         p.IsPublished,
+			EndorsementsEnabled: p.EndorsementsEnabled,
+		}
+	}
+
+	c.JSON(http.StatusOK, response2.ListPortfoliosResponse{
+		Portfolios: portfolios,
+		Pagination: response2.PaginationResponse{
+			Total: output.Pagination.Total,
+			Page:  output.Pagination.Page,
+			Limit: output.Pagination.Limit,
+		},
+	})
+}
+
 // GetPublicByID handles GET /api/portfolios/id/:id and GET /api/portfolios/public/:id
 func (ctrl *PortfolioController) GetPublicByID(c *gin.Context) {
 	// Parse portfolio ID from URL parameter
 	idStr := c.Param("id")
 	id, err := strconv.ParseUint(idStr, 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: "invalid portfolio ID"})
+		respondInvalidID(c, "portfolio")
 		return
 	}
 
 	// Execute use case (no auth required for public access)
 	portfolioDTO, err := ctrl.getPublicUseCase.Execute(c.Request.Context(), uint(id))
 	if err != nil {
-		status := pkgerrors.ToHTTPStatus(err)
-		c.JSON(status, response2.ErrorResponse{Error: err.Error()})
+		respondError(c, err)
+		return
+	}
+	ctrl.recordView(c, portfolioDTO.ID)
+	if respondNotModified(c, publicPortfolioETag(portfolioDTO)) {
 		return
 	}
 
-	// Map to HTTP response DTO (don't include OwnerID in public response)
-	resp := response2.PortfolioResponse{
+	// Return HTTP response with API_OVERVIEW.md format
+	c.JSON(http.StatusOK, response2.DataResponse{
+		Data:    publicPortfolioResponse(portfolioDTO),
+		Message: "Success",
+	})
+}
+
+// GetPublicBySlug handles GET /api/portfolios/public/slug/:slug
+func (ctrl *PortfolioController) GetPublicBySlug(c *gin.Context) {
+	// Execute use case (no auth required for public access)
+	portfolioDTO, err := ctrl.getPublicBySlugUC.Execute(c.Request.Context(), c.Param("slug"))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+	ctrl.recordView(c, portfolioDTO.ID)
+	if respondNotModified(c, publicPortfolioETag(portfolioDTO)) {
+		return
+	}
+
+	c.JSON(http.StatusOK, response2.DataResponse{
+		Data:    publicPortfolioResponse(portfolioDTO),
+		Message: "Success",
+	})
+}
+
+// recordView counts a public page view; it is only buffered, never written on the request path
+func (ctrl *PortfolioController) recordView(c *gin.Context, portfolioID uint) {
+	if ctrl.recordViewUC == nil {
+		return
+	}
+	ctrl.recordViewUC.Execute(appdto.RecordPortfolioViewInput{
+		PortfolioID: portfolioID,
+		ClientIP:    c.ClientIP(),
+		UserAgent:   c.Request.UserAgent(),
+	})
+}
+
+// publicPortfolioETag is the version of a public portfolio response: the portfolio and its links
+func publicPortfolioETag(portfolioDTO *appdto.PortfolioDTO) string {
+	linkUpdates := make([]time.Time, len(portfolioDTO.Links))
+	for i, link := range portfolioDTO.Links {
+		linkUpdates[i] = link.UpdatedAt
+	}
+	return weakETag(portfolioDTO.ID, portfolioDTO.UpdatedAt, len(portfolioDTO.Links), latestUpdate(linkUpdates...))
+}
+
+// publicPortfolioResponse maps a portfolio to its public HTTP response (no OwnerID, no actors)
+func publicPortfolioResponse(portfolioDTO *appdto.PortfolioDTO) response2.PortfolioResponse {
+	return response2.PortfolioResponse{
 		ID:          portfolioDTO.ID,
 		Title:       portfolioDTO.Title,
 		Description: portfolioDTO.Description,
+		Slug:        portfolioDTO.Slug,
 		CreatedAt:   portfolioDTO.CreatedAt,
 		UpdatedAt:   portfolioDTO.UpdatedAt,
+		Links:       portfolioLinkResponses(portfolioDTO.Links),
+		CustomCSS:   portfolioDTO.CustomCSSSanitized, // Never the raw stylesheet
+
+		IsPublished:         portfolioDTO.IsPublished,
+		EndorsementsEnabled: portfolioDTO.EndorsementsEnabled,
+	}
+}
+
+// GetCustomCSS handles GET /api/portfolios/own/:id/custom-css
+func (ctrl *PortfolioController) GetCustomCSS(c *gin.Context) {
+	// Extract userID from context (set by auth middleware)
+	userID := c.GetString("userID")
+	if userID == "" {
+		respondUnauthenticated(c)
+		return
+	}
+
+	// Parse portfolio ID from URL parameter
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		respondInvalidID(c, "portfolio")
+		return
+	}
+
+	// Execute use case
+	portfolioDTO, err := ctrl.getUseCase.Execute(c.Request.Context(), uint(id))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// Authorization check: verify ownership
+	if portfolioDTO.OwnerID != userID {
+		respondErrorCode(c, http.StatusForbidden, apperrors.CodeAccessDenied, "forbidden: you don't own this portfolio")
+		return
 	}
 
-	// Return HTTP response with API_OVERVIEW.md format
 	c.JSON(http.StatusOK, response2.DataResponse{
-		Data:    resp,
+		Data: response2.PortfolioCustomCSSResponse{
+			CSS:          portfolioDTO.CustomCSS,
+			SanitizedCSS: portfolioDTO.CustomCSSSanitized,
+		},
 		Message: "Success",
 	})
 }
 
-// GetPublicCategories handles GET /api/portfolios/public/:id/categories
+// UpdateCustomCSS handles PUT /api/portfolios/own/:id/custom-css
+// With ?dry_run=true the stylesheet is only sanitized, so the owner can see what would be removed
+func (ctrl *PortfolioController) UpdateCustomCSS(c *gin.Context) {
+	// Extract userID from context (set by auth middleware)
+	userID := c.GetString("userID")
+	if userID == "" {
+		respondUnauthenticated(c)
+		return
+	}
+
+	// Parse portfolio ID from URL parameter
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		respondInvalidID(c, "portfolio")
+		return
+	}
+
+	// Bind and validate HTTP request DTO
+	var req request.UpdatePortfolioCustomCSSRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err)
+		return
+	}
+	dryRun, _ := strconv.ParseBool(c.Query("dry_run"))
+
+	// Execute use case
+	output, err := ctrl.customCSSUC.Execute(c.Request.Context(), appdto.UpdatePortfolioCustomCSSInput{
+		PortfolioID: uint(id),
+		OwnerID:     userID,
+		CSS:         *req.CSS,
+		DryRun:      dryRun,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// Map to HTTP response DTO
+	removed := make([]response2.CSSRemovalResponse, len(output.Removed))
+	for i, removal := range output.Removed {
+		removed[i] = response2.CSSRemovalResponse{Kind: removal.Kind, Snippet: removal.Snippet}
+	}
+
+	message := "Custom CSS updated successfully"
+	if !output.Saved {
+		message = "Custom CSS validated (not saved)"
+	}
+
+	c.JSON(http.StatusOK, response2.DataResponse{
+		Data: response2.PortfolioCustomCSSResponse{
+			CSS:          output.CSS,
+			SanitizedCSS: output.SanitizedCSS,
+			Removed:      removed,
+			Saved:        output.Saved,
+		},
+		Message: message,
+	})
+}
+
+// publicPortfolioParam returns the portfolio ID of a public list route, named :portfolioId
+// under /categories and /sections and :id under /portfolios
+func publicPortfolioParam(c *gin.Context) string {
+	if id := c.Param("portfolioId"); id != "" {
+		return id
+	}
+	return c.Param("id")
+}
+
+// GetPublicCategories handles GET /api/portfolios/public/:id/categories and GET /api/categories/portfolio/:portfolioId
 func (ctrl *PortfolioController) GetPublicCategories(c *gin.Context) {
 	// Parse portfolio ID from URL parameter
-	idStr := c.Param("id")
+	idStr := publicPortfolioParam(c)
 	id, err := strconv.ParseUint(idStr, 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: "invalid portfolio ID"})
+		respondInvalidID(c, "portfolio")
 		return
 	}
 
 	// Verify portfolio exists
 	_, err = ctrl.getPublicUseCase.Execute(c.Request.Context(), uint(id))
 	if err != nil {
-		status := pkgerrors.ToHTTPStatus(err)
-		c.JSON(status, response2.ErrorResponse{Error: err.Error()})
+		respondError(c, err)
 		return
 	}
 
 	// Get all categories for the portfolio
 	categories, err := ctrl.categoryRepo.GetByPortfolioID(c.Request.Context(), uint(id))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, response2.ErrorResponse{Error: "failed to retrieve categories"})
+		respondErrorCode(c, http.StatusInternalServerError, apperrors.CodeInternal, "failed to retrieve categories")
 		return
 	}
 
@@ -358,28 +1137,27 @@ func (ctrl *PortfolioController) GetPublicCategories(c *gin.Context) {
 	})
 }
 
-// GetPublicSections handles GET /api/portfolios/public/:id/sections
+// GetPublicSections handles GET /api/portfolios/public/:id/sections and GET /api/sections/portfolio/:portfolioId
 func (ctrl *PortfolioController) GetPublicSections(c *gin.Context) {
 	// Parse portfolio ID from URL parameter
-	idStr := c.Param("id")
+	idStr := publicPortfolioParam(c)
 	id, err := strconv.ParseUint(idStr, 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: "invalid portfolio ID"})
+		respondInvalidID(c, "portfolio")
 		return
 	}
 
 	// Verify portfolio exists
 	_, err = ctrl.getPublicUseCase.Execute(c.Request.Context(), uint(id))
 	if err != nil {
-		status := pkgerrors.ToHTTPStatus(err)
-		c.JSON(status, response2.ErrorResponse{Error: err.Error()})
+		respondError(c, err)
 		return
 	}
 
 	// Get all sections for the portfolio
 	sections, err := ctrl.sectionRepo.GetByPortfolioID(c.Request.Context(), uint(id))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, response2.ErrorResponse{Error: "failed to retrieve sections"})
+		respondErrorCode(c, http.StatusInternalServerError, apperrors.CodeInternal, "failed to retrieve sections")
 		return
 	}
 
@@ -389,6 +1167,7 @@ func (ctrl *PortfolioController) GetPublicSections(c *gin.Context) {
 		sectionResponses[i] = response2.SectionResponse{
 			ID:          sec.ID,
 			Title:       sec.Title,
+			Slug:        sec.Slug,
 			Description: sec.Description,
 			Position:    sec.Position,
 			Type:        sec.Type,
@@ -404,3 +1183,227 @@ func (ctrl *PortfolioController) GetPublicSections(c *gin.Context) {
 		Message: "Success",
 	})
 }
+
+// SearchPublic handles GET /api/portfolios/public/:id/search?q=
+func (ctrl *PortfolioController) SearchPublic(c *gin.Context) {
+	// Parse portfolio ID from URL parameter
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		respondInvalidID(c, "portfolio")
+		return
+	}
+
+	// Bind and validate query parameters
+	var req request.SearchPortfolioRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		respondBindingError(c, err)
+		return
+	}
+
+	// Execute use case (no auth required for public access)
+	output, err := ctrl.searchUC.Execute(c.Request.Context(), appdto.SearchPortfolioInput{
+		PortfolioID: uint(id),
+		Query:       req.Query,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// Map to HTTP response DTOs (no owner or actor fields in public responses)
+	resp := response2.PortfolioSearchResponse{
+		Projects: make([]response2.ProjectResponse, len(output.Projects)),
+		Sections: make([]response2.SectionSearchHitResponse, len(output.Sections)),
+	}
+	for i, proj := range output.Projects {
+		resp.Projects[i] = response2.ProjectResponse{
+			ID:          proj.ID,
+			Title:       proj.Title,
+			Description: proj.Description,
+			MainImage:   proj.MainImage,
+			Skills:      proj.Skills,
+			Client:      proj.Client,
+			Link:        proj.Link,
+			Position:    proj.Position,
+			CategoryID:  proj.CategoryID,
+			CreatedAt:   proj.CreatedAt,
+			UpdatedAt:   proj.UpdatedAt,
+		}
+		if absoluteURLsRequested(c) {
+			withAbsoluteProjectImages(ctrl.assetURLs, &resp.Projects[i])
+		}
+	}
+	for i, hit := range output.Sections {
+		sec := hit.Section
+		resp.Sections[i] = response2.SectionSearchHitResponse{
+			SectionResponse: response2.SectionResponse{
+				ID:          sec.ID,
+				Title:       sec.Title,
+				Slug:        sec.Slug,
+				Description: sec.Description,
+				Position:    sec.Position,
+				Type:        sec.Type,
+				PortfolioID: sec.PortfolioID,
+				CreatedAt:   sec.CreatedAt,
+				UpdatedAt:   sec.UpdatedAt,
+			},
+			MatchedContentIDs: hit.ContentIDs,
+		}
+	}
+
+	// Return HTTP response with API_OVERVIEW.md format
+	c.JSON(http.StatusOK, response2.DataResponse{
+		Data:    resp,
+		Message: "Success",
+	})
+}
+
+// GetPublicTOC handles GET /api/portfolios/public/:id/toc
+// Sections in display order with their anchors, for navigation menus without the contents
+func (ctrl *PortfolioController) GetPublicTOC(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		respondInvalidID(c, "portfolio")
+		return
+	}
+
+	toc, err := ctrl.tocUC.Execute(c.Request.Context(), uint(id))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	entries := make([]response2.TOCEntryResponse, len(toc.Sections))
+	for i, sec := range toc.Sections {
+		entries[i] = response2.TOCEntryResponse{
+			ID:       sec.ID,
+			Slug:     sec.Slug,
+			Title:    sec.Title,
+			Type:     sec.Type,
+			Position: sec.Position,
+		}
+	}
+
+	c.JSON(http.StatusOK, response2.DataResponse{
+		Data: response2.PortfolioTOCResponse{
+			Sections:  entries,
+			Redirects: toc.Redirects,
+		},
+		Message: "Success",
+	})
+}
+
+// GetAccessibilityReport handles GET /api/portfolios/own/:id/accessibility-report
+func (ctrl *PortfolioController) GetAccessibilityReport(c *gin.Context) {
+	// 1. Extract userID from context (set by auth middleware)
+	userID := c.GetString("userID")
+	if userID == "" {
+		respondUnauthenticated(c)
+		return
+	}
+
+	// 2. Parse portfolio ID from URL parameter
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		respondInvalidID(c, "portfolio")
+		return
+	}
+
+	// 3. Bind and validate the theme colors
+	var req request.GetAccessibilityReportRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		respondBindingError(c, err)
+		return
+	}
+
+	// 4. Execute use case (use case handles ownership check)
+	output, err := ctrl.accessibilityUC.Execute(c.Request.Context(), appdto.AccessibilityReportInput{
+		PortfolioID:     uint(id),
+		OwnerID:         userID,
+		TextColor:       req.TextColor,
+		BackgroundColor: req.BackgroundColor,
+		AccentColor:     req.AccentColor,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// 5. Map to HTTP response DTO
+	findings := make([]response2.AccessibilityFindingResponse, len(output.Findings))
+	for i, finding := range output.Findings {
+		findings[i] = response2.AccessibilityFindingResponse{
+			Name:         finding.Name,
+			Severity:     finding.Severity,
+			Message:      finding.Message,
+			ResourceType: finding.ResourceType,
+			ResourceIDs:  finding.ResourceIDs,
+			Values:       finding.Values,
+		}
+	}
+
+	// 6. Return HTTP response with API_OVERVIEW.md format
+	c.JSON(http.StatusOK, response2.DataResponse{
+		Data: response2.PortfolioAccessibilityReportResponse{
+			PortfolioID: output.PortfolioID,
+			Findings:    findings,
+		},
+		Message: "Success",
+	})
+}
+
+// portfolioAvailabilityMaxAge is how long clients and proxies may cache an availability probe
+const portfolioAvailabilityMaxAge = 30
+
+// portfolioAvailabilityStatuses maps availability statuses to HTTP statuses
+var portfolioAvailabilityStatuses = map[string]int{
+	appdto.PortfolioAvailabilityPublished: http.StatusOK,
+	appdto.PortfolioAvailabilityPrivate:   http.StatusForbidden,
+	appdto.PortfolioAvailabilityNotFound:  http.StatusNotFound,
+}
+
+// GetPublicAvailability handles GET/HEAD /api/portfolios/public/:id/availability
+// Tells the SPA router whether to render the portfolio, a private page or a 404
+// before it fetches the portfolio tree.
+func (ctrl *PortfolioController) GetPublicAvailability(c *gin.Context) {
+	// Parse portfolio ID from URL parameter (a malformed ID parses to 0, which is not found)
+	id, _ := strconv.ParseUint(c.Param("id"), 10, 32)
+
+	// Execute use case (no auth required for public access)
+	availability, err := ctrl.availabilityUC.Execute(c.Request.Context(), uint(id))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", fmt.Sprintf("public, max-age=%d", portfolioAvailabilityMaxAge))
+	c.JSON(portfolioAvailabilityStatuses[availability.Status], response2.PortfolioAvailabilityResponse{
+		Status:        availability.Status,
+		RequiresToken: availability.RequiresToken,
+	})
+}
+
+// GetPublicJSONLD handles GET /api/portfolios/public/:id/jsonld
+// Returns the portfolio as a schema.org JSON-LD document (not wrapped in the data envelope)
+func (ctrl *PortfolioController) GetPublicJSONLD(c *gin.Context) {
+	// Parse portfolio ID from URL parameter
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		respondInvalidID(c, "portfolio")
+		return
+	}
+
+	// Execute use case (no auth required for public access)
+	data, err := ctrl.jsonldUseCase.Execute(c.Request.Context(), uint(id))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// Return the JSON-LD document with its own media type
+	c.Header("Content-Type", "application/ld+json; charset=utf-8")
+	c.JSON(http.StatusOK, buildPortfolioJSONLD(data, ctrl.assetURLs))
+}
@@ -0,0 +1,189 @@
+package controllers
+
+import (
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	appdto "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/interfaces/dto/request"
+	response2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/interfaces/dto/response"
+)
+
+// buildPortfolioExport maps an exported portfolio tree into the versioned export document
+// Empty lists are written as [] so importers never have to tell null from empty.
+func buildPortfolioExport(export *appdto.PortfolioExportDTO, baseURL string, exportedAt time.Time) response2.PortfolioExportResponse {
+	links := make([]response2.PortfolioLinkExport, len(export.Links))
+	for i, link := range export.Links {
+		links[i] = response2.PortfolioLinkExport{
+			Kind:     link.Kind,
+			Label:    link.Label,
+			URL:      link.URL,
+			Position: link.Position,
+		}
+	}
+
+	categories := make([]response2.CategoryExport, len(export.Categories))
+	for i, category := range export.Categories {
+		projects := make([]response2.ProjectExport, len(category.Projects))
+		for j, project := range category.Projects {
+			collaborators := make([]response2.ProjectCollaboratorExport, len(project.Collaborators))
+			for k, collaborator := range project.Collaborators {
+				collaborators[k] = response2.ProjectCollaboratorExport{
+					Name:     collaborator.Name,
+					Role:     collaborator.Role,
+					URL:      collaborator.URL,
+					Position: collaborator.Position,
+				}
+			}
+			projects[j] = response2.ProjectExport{
+				Title:         project.Title,
+				Description:   project.Description,
+				MainImage:     project.MainImage,
+				Images:        nonNilStrings(project.Images),
+				Skills:        nonNilStrings(project.Skills),
+				Client:        project.Client,
+				Link:          project.Link,
+				Position:      project.Position,
+				Hidden:        project.Hidden,
+				Collaborators: collaborators,
+			}
+		}
+		categories[i] = response2.CategoryExport{
+			Title:       category.Title,
+			Description: category.Description,
+			Position:    category.Position,
+			Projects:    projects,
+		}
+	}
+
+	sections := make([]response2.SectionExport, len(export.Sections))
+	for i, section := range export.Sections {
+		contents := make([]response2.SectionContentExport, len(section.Contents))
+		for j, content := range section.Contents {
+			contents[j] = response2.SectionContentExport{
+				Type:     content.Type,
+				Content:  content.Content,
+				Position: content.Position,
+			}
+		}
+		sections[i] = response2.SectionExport{
+			Title:       section.Title,
+			Slug:        section.Slug,
+			Description: section.Description,
+			Type:        section.Type,
+			Position:    section.Position,
+			Contents:    contents,
+		}
+	}
+
+	return response2.PortfolioExportResponse{
+		ExportVersion: appdto.PortfolioExportVersion,
+		ExportedAt:    exportedAt.UTC(),
+		BaseURL:       baseURL,
+		Portfolio: response2.PortfolioExportDocument{
+			Title:               export.Title,
+			Description:         export.Description,
+			Slug:                export.Slug,
+			EndorsementsEnabled: export.EndorsementsEnabled,
+			CustomCSS:           export.CustomCSS,
+			Links:               links,
+			Categories:          categories,
+			Sections:            sections,
+		},
+	}
+}
+
+// nonNilStrings returns values, or an empty slice when it is nil
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
+// exportBaseURL is the base relative image paths of an export resolve against: the public
+// asset base URL, or none when it isn't configured. The request's Host and forwarding headers
+// are client-controlled, so they never name it
+func exportBaseURL(urls contracts.AssetURLBuilder) string {
+	if urls == nil {
+		return ""
+	}
+	return urls.BaseURL()
+}
+
+// importDocumentToDTO maps an uploaded export document into the application export tree
+func importDocumentToDTO(document request.ImportPortfolioDocument) appdto.PortfolioExportDTO {
+	export := appdto.PortfolioExportDTO{
+		Title:               document.Title,
+		Description:         document.Description,
+		Slug:                document.Slug,
+		EndorsementsEnabled: document.EndorsementsEnabled,
+		CustomCSS:           document.CustomCSS,
+		Links:               make([]appdto.PortfolioLinkExportDTO, len(document.Links)),
+		Categories:          make([]appdto.CategoryExportDTO, len(document.Categories)),
+		Sections:            make([]appdto.SectionExportDTO, len(document.Sections)),
+	}
+
+	for i, link := range document.Links {
+		export.Links[i] = appdto.PortfolioLinkExportDTO{
+			Kind:     link.Kind,
+			Label:    link.Label,
+			URL:      link.URL,
+			Position: link.Position,
+		}
+	}
+
+	for i, category := range document.Categories {
+		projects := make([]appdto.ProjectExportDTO, len(category.Projects))
+		for j, project := range category.Projects {
+			collaborators := make([]appdto.ProjectCollaboratorExportDTO, len(project.Collaborators))
+			for k, collaborator := range project.Collaborators {
+				collaborators[k] = appdto.ProjectCollaboratorExportDTO{
+					Name:     collaborator.Name,
+					Role:     collaborator.Role,
+					URL:      collaborator.URL,
+					Position: collaborator.Position,
+				}
+			}
+			projects[j] = appdto.ProjectExportDTO{
+				Title:         project.Title,
+				Description:   project.Description,
+				MainImage:     project.MainImage,
+				Images:        project.Images,
+				Skills:        project.Skills,
+				Client:        project.Client,
+				Link:          project.Link,
+				Position:      project.Position,
+				Hidden:        project.Hidden,
+				Collaborators: collaborators,
+			}
+		}
+		export.Categories[i] = appdto.CategoryExportDTO{
+			Title:       category.Title,
+			Description: category.Description,
+			Position:    category.Position,
+			Projects:    projects,
+		}
+	}
+
+	for i, section := range document.Sections {
+		contents := make([]appdto.SectionContentExportDTO, len(section.Contents))
+		for j, content := range section.Contents {
+			contents[j] = appdto.SectionContentExportDTO{
+				Type:     content.Type,
+				Content:  content.Content,
+				Position: content.Position,
+			}
+		}
+		export.Sections[i] = appdto.SectionExportDTO{
+			Title:       section.Title,
+			Slug:        section.Slug,
+			Description: section.Description,
+			Type:        section.Type,
+			Position:    section.Position,
+			Contents:    contents,
+		}
+	}
+
+	return export
+}
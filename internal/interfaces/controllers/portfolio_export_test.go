@@ -0,0 +1,125 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	appdto "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	portfolio2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/usecases/portfolio"
+	"github.com/gin-gonic/gin"
+)
+
+// exportedPortfolioRepo gives portfolio 1 to "user-1" and portfolio 2 to "bob", and counts exports
+type exportedPortfolioRepo struct {
+	contracts.PortfolioRepository
+	exports int
+}
+
+func (r *exportedPortfolioRepo) GetByID(_ context.Context, id uint) (*appdto.PortfolioDTO, error) {
+	owner := "user-1"
+	if id == 2 {
+		owner = "bob"
+	}
+	return &appdto.PortfolioDTO{ID: id, OwnerID: owner}, nil
+}
+
+func (r *exportedPortfolioRepo) Export(context.Context, uint) (*appdto.PortfolioExportDTO, error) {
+	r.exports++
+	mainImage := "/uploads/api.png"
+	return &appdto.PortfolioExportDTO{
+		Title: "Work",
+		Slug:  "work",
+		Links: []appdto.PortfolioLinkExportDTO{{Kind: "website", URL: "https://example.com", Position: 1}},
+		Categories: []appdto.CategoryExportDTO{{Title: "Web", Position: 1, Projects: []appdto.ProjectExportDTO{
+			{Title: "API", Description: "d", MainImage: &mainImage, Position: 1},
+		}}},
+	}, nil
+}
+
+// fixedAssetURLs serves assets from a fixed base URL
+type fixedAssetURLs struct {
+	contracts.AssetURLBuilder
+	base string
+}
+
+func (u fixedAssetURLs) BaseURL() string { return u.base }
+
+// jsonKeys collects every object key of a decoded JSON document
+func jsonKeys(value interface{}, keys map[string]bool) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			keys[key] = true
+			jsonKeys(child, keys)
+		}
+	case []interface{}:
+		for _, child := range v {
+			jsonKeys(child, keys)
+		}
+	}
+}
+
+func TestPortfolioController_Export(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	repo := &exportedPortfolioRepo{}
+	ctrl := &PortfolioController{
+		exportUseCase: portfolio2.NewExportPortfolioUseCase(repo, nil),
+		assetURLs:     fixedAssetURLs{base: "https://cdn.example.com"},
+	}
+	router := gin.New()
+	router.GET("/portfolios/own/:id/export", func(c *gin.Context) { c.Set("userID", "user-1") }, ctrl.Export)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/portfolios/own/1/export", nil)
+	req.Host = "attacker.example"
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200 (%s)", w.Code, w.Body.String())
+	}
+	if got := w.Header().Get("Content-Disposition"); got != `attachment; filename="portfolio-work.json"` {
+		t.Errorf("Content-Disposition = %q, want an attachment named after the slug", got)
+	}
+
+	var document map[string]interface{}
+	if err := json.Unmarshal(w.Body.Bytes(), &document); err != nil {
+		t.Fatalf("decode export: %v", err)
+	}
+	if document["export_version"] != float64(appdto.PortfolioExportVersion) || document["base_url"] != "https://cdn.example.com" {
+		t.Errorf("export_version, base_url = %v, %v, want %d and the asset base, never the request host",
+			document["export_version"], document["base_url"], appdto.PortfolioExportVersion)
+	}
+	keys := map[string]bool{}
+	jsonKeys(document, keys)
+	for _, key := range []string{"id", "portfolio_id", "category_id", "owner_id"} {
+		if keys[key] {
+			t.Errorf("export carries %q, want no instance IDs", key)
+		}
+	}
+	// Missing lists are written as [] so importers never have to tell null from empty
+	body := w.Body.String()
+	for _, empty := range []string{`"images":[]`, `"skills":[]`, `"collaborators":[]`, `"sections":[]`} {
+		if !strings.Contains(body, empty) {
+			t.Errorf("export lacks %s: %s", empty, body)
+		}
+	}
+
+	t.Run("another owner's portfolio", func(t *testing.T) {
+		before := repo.exports
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/portfolios/own/2/export", nil))
+
+		if w.Code == http.StatusOK || repo.exports != before {
+			t.Errorf("status = %d after %d exports, want a rejection without export", w.Code, repo.exports-before)
+		}
+		if w.Header().Get("Content-Disposition") != "" {
+			t.Error("rejected export still sent as an attachment")
+		}
+	})
+}
@@ -0,0 +1,61 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	"github.com/gin-gonic/gin"
+)
+
+func TestPortfolioController_ImportBodyLimit(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name     string
+		body     string
+		wantCode int
+		wantErr  string
+	}{
+		{
+			name:     "over the limit",
+			body:     `{"export_version": 1, "portfolio": {"title": "` + strings.Repeat("a", MaxImportBodyBytes) + `"}}`,
+			wantCode: http.StatusRequestEntityTooLarge,
+			wantErr:  apperrors.CodePayloadTooLarge,
+		},
+		{
+			name:     "malformed under the limit",
+			body:     `{"export_version": `,
+			wantCode: http.StatusBadRequest,
+			wantErr:  apperrors.CodeValidationMalformedBody,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The body is rejected before the use case runs, so the controller needs no dependencies
+			ctrl := &PortfolioController{}
+			router := gin.New()
+			router.POST("/import", func(c *gin.Context) { c.Set("userID", "user-1") }, ctrl.Import)
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/import", strings.NewReader(tt.body)))
+
+			if w.Code != tt.wantCode {
+				t.Fatalf("status = %d, want %d (%s)", w.Code, tt.wantCode, w.Body.String())
+			}
+			var body struct {
+				Code string `json:"code"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if body.Code != tt.wantErr {
+				t.Errorf("code = %q, want %q", body.Code, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package controllers
+
+import (
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	appdto "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	domainportfolio "github.com/JorgeSaicoski/portfolio-manager/backend/internal/domain/portfolio"
+	response2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/interfaces/dto/response"
+)
+
+const schemaOrgContext = "https://schema.org"
+
+// buildPortfolioJSONLD serializes a public portfolio and its projects into a
+// schema.org ProfilePage/Person document with one CreativeWork per project
+// Links become the person's sameAs URLs (the first email link becomes its email)
+// Crawlers need absolute URLs, so images are always resolved through the asset URL builder
+func buildPortfolioJSONLD(data *appdto.PortfolioStructuredDataOutput, assetURLs contracts.AssetURLBuilder) response2.PortfolioJSONLDResponse {
+	works := make([]response2.CreativeWorkJSONLD, len(data.Projects))
+	for i, p := range data.Projects {
+		work := response2.CreativeWorkJSONLD{
+			Type:        "CreativeWork",
+			Name:        p.Title,
+			Description: p.Description,
+			DateCreated: p.CreatedAt.UTC().Format(time.RFC3339),
+			Keywords:    p.Skills,
+		}
+		if p.MainImage != nil {
+			work.Image = *absoluteImage(assetURLs, p.MainImage)
+		}
+		if p.Link != nil {
+			work.URL = *p.Link
+		}
+		works[i] = work
+	}
+
+	person := response2.PersonJSONLD{
+		Type:        "Person",
+		Name:        data.OwnerName,
+		Description: data.Portfolio.Description,
+	}
+	for _, link := range data.Links {
+		if link.Kind == domainportfolio.LinkKindEmail {
+			if person.Email == "" {
+				person.Email = link.URL
+			}
+			continue
+		}
+		person.SameAs = append(person.SameAs, link.URL)
+	}
+
+	return response2.PortfolioJSONLDResponse{
+		Context:      schemaOrgContext,
+		Type:         "ProfilePage",
+		Name:         data.Portfolio.Title,
+		Description:  data.Portfolio.Description,
+		DateCreated:  data.Portfolio.CreatedAt.UTC().Format(time.RFC3339),
+		DateModified: data.Portfolio.UpdatedAt.UTC().Format(time.RFC3339),
+		MainEntity:   person,
+		HasPart:      works,
+	}
+}
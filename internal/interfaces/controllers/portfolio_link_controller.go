@@ -0,0 +1,259 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	portfolio_link2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/usecases/portfolio_link"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/usecases/trash"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/interfaces/dto/request"
+	response2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/interfaces/dto/response"
+	"github.com/gin-gonic/gin"
+)
+
+// PortfolioLinkController handles HTTP requests for portfolio contact/social links
+type PortfolioLinkController struct {
+	createUseCase      *portfolio_link2.CreatePortfolioLinkUseCase
+	listUseCase        *portfolio_link2.ListPortfolioLinksUseCase
+	updateUseCase      *portfolio_link2.UpdatePortfolioLinkUseCase
+	reorderUseCase     *portfolio_link2.ReorderPortfolioLinksUseCase
+	deleteUseCase      *portfolio_link2.DeletePortfolioLinkUseCase
+	findDeletedUseCase *trash.FindDeletedItemUseCase
+}
+
+// NewPortfolioLinkController creates a new portfolio link controller instance
+func NewPortfolioLinkController(
+	createUC *portfolio_link2.CreatePortfolioLinkUseCase,
+	listUC *portfolio_link2.ListPortfolioLinksUseCase,
+	updateUC *portfolio_link2.UpdatePortfolioLinkUseCase,
+	reorderUC *portfolio_link2.ReorderPortfolioLinksUseCase,
+	deleteUC *portfolio_link2.DeletePortfolioLinkUseCase,
+	findDeletedUC *trash.FindDeletedItemUseCase,
+) *PortfolioLinkController {
+	return &PortfolioLinkController{
+		createUseCase:      createUC,
+		listUseCase:        listUC,
+		updateUseCase:      updateUC,
+		reorderUseCase:     reorderUC,
+		deleteUseCase:      deleteUC,
+		findDeletedUseCase: findDeletedUC,
+	}
+}
+
+// List handles GET /api/portfolios/own/:id/links
+func (ctrl *PortfolioLinkController) List(c *gin.Context) {
+	// Extract userID from context (set by auth middleware)
+	userID := c.GetString("userID")
+	if userID == "" {
+		respondUnauthenticated(c)
+		return
+	}
+
+	portfolioID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		respondInvalidID(c, "portfolio")
+		return
+	}
+
+	links, err := ctrl.listUseCase.Execute(c.Request.Context(), uint(portfolioID), userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response2.DataResponse{
+		Data:    portfolioLinkResponses(links),
+		Message: "Success",
+	})
+}
+
+// Create handles POST /api/portfolios/own/:id/links
+func (ctrl *PortfolioLinkController) Create(c *gin.Context) {
+	// Extract userID from context (set by auth middleware)
+	userID := c.GetString("userID")
+	if userID == "" {
+		respondUnauthenticated(c)
+		return
+	}
+
+	portfolioID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		respondInvalidID(c, "portfolio")
+		return
+	}
+
+	// Bind and validate HTTP request DTO
+	var req request.CreatePortfolioLinkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err)
+		return
+	}
+
+	output, err := ctrl.createUseCase.Execute(c.Request.Context(), dto.CreatePortfolioLinkInput{
+		PortfolioID: uint(portfolioID),
+		Kind:        req.Kind,
+		Label:       req.Label,
+		URL:         req.URL,
+		Position:    req.Position,
+		OwnerID:     userID,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, response2.DataResponse{
+		Data:     toPortfolioLinkResponse(output.Link),
+		Message:  "Link created successfully",
+		Warnings: toQuotaWarningResponses(output.Warnings),
+	})
+}
+
+// Update handles PUT /api/portfolios/own/:id/links/:linkId
+func (ctrl *PortfolioLinkController) Update(c *gin.Context) {
+	// Extract userID from context (set by auth middleware)
+	userID := c.GetString("userID")
+	if userID == "" {
+		respondUnauthenticated(c)
+		return
+	}
+
+	portfolioID, linkID, ok := parsePortfolioLinkParams(c)
+	if !ok {
+		return
+	}
+
+	// Bind and validate HTTP request DTO
+	var req request.UpdatePortfolioLinkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err)
+		return
+	}
+
+	if err := ctrl.updateUseCase.Execute(c.Request.Context(), dto.UpdatePortfolioLinkInput{
+		ID:          linkID,
+		PortfolioID: portfolioID,
+		Kind:        req.Kind,
+		Label:       req.Label,
+		URL:         req.URL,
+		Position:    req.Position,
+		OwnerID:     userID,
+	}); err != nil {
+		respondOwnItemError(c, ctrl.findDeletedUseCase, err, dto.TrashResourcePortfolioLink, linkID)
+		return
+	}
+
+	c.JSON(http.StatusOK, response2.DataResponse{
+		Data:    nil,
+		Message: "Link updated successfully",
+	})
+}
+
+// Reorder handles POST /api/portfolios/own/:id/links/reorder
+func (ctrl *PortfolioLinkController) Reorder(c *gin.Context) {
+	// Extract userID from context (set by auth middleware)
+	userID := c.GetString("userID")
+	if userID == "" {
+		respondUnauthenticated(c)
+		return
+	}
+
+	portfolioID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		respondInvalidID(c, "portfolio")
+		return
+	}
+
+	// Bind and validate HTTP request DTO
+	var req request.ReorderPortfolioLinksRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err)
+		return
+	}
+
+	items := make([]dto.BulkUpdatePositionItem, len(req.Items))
+	for i, item := range req.Items {
+		items[i] = dto.BulkUpdatePositionItem{ID: item.ID, Position: item.Position}
+	}
+
+	if err := ctrl.reorderUseCase.Execute(c.Request.Context(), dto.ReorderPortfolioLinksInput{
+		PortfolioID: uint(portfolioID),
+		Items:       items,
+		OwnerID:     userID,
+	}); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response2.DataResponse{
+		Data:    nil,
+		Message: "Links reordered successfully",
+	})
+}
+
+// Delete handles DELETE /api/portfolios/own/:id/links/:linkId
+func (ctrl *PortfolioLinkController) Delete(c *gin.Context) {
+	// Extract userID from context (set by auth middleware)
+	userID := c.GetString("userID")
+	if userID == "" {
+		respondUnauthenticated(c)
+		return
+	}
+
+	portfolioID, linkID, ok := parsePortfolioLinkParams(c)
+	if !ok {
+		return
+	}
+
+	if err := ctrl.deleteUseCase.Execute(c.Request.Context(), portfolioID, linkID, userID); err != nil {
+		respondOwnItemError(c, ctrl.findDeletedUseCase, err, dto.TrashResourcePortfolioLink, linkID)
+		return
+	}
+
+	c.JSON(http.StatusOK, response2.DataResponse{
+		Data:    nil,
+		Message: "Link deleted successfully",
+	})
+}
+
+// parsePortfolioLinkParams parses the :id and :linkId URL parameters, writing a 400 on failure
+func parsePortfolioLinkParams(c *gin.Context) (uint, uint, bool) {
+	portfolioID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		respondInvalidID(c, "portfolio")
+		return 0, 0, false
+	}
+
+	linkID, err := strconv.ParseUint(c.Param("linkId"), 10, 32)
+	if err != nil {
+		respondInvalidID(c, "portfolio link")
+		return 0, 0, false
+	}
+
+	return uint(portfolioID), uint(linkID), true
+}
+
+// toPortfolioLinkResponse maps a link DTO to its HTTP response
+func toPortfolioLinkResponse(link dto.PortfolioLinkDTO) response2.PortfolioLinkResponse {
+	return response2.PortfolioLinkResponse{
+		ID:       link.ID,
+		Kind:     link.Kind,
+		Label:    link.Label,
+		URL:      link.URL,
+		Position: link.Position,
+	}
+}
+
+// portfolioLinkResponses maps link DTOs to their HTTP responses
+func portfolioLinkResponses(links []dto.PortfolioLinkDTO) []response2.PortfolioLinkResponse {
+	if links == nil {
+		return nil
+	}
+
+	resp := make([]response2.PortfolioLinkResponse, len(links))
+	for i, link := range links {
+		resp[i] = toPortfolioLinkResponse(link)
+	}
+	return resp
+}
@@ -0,0 +1,66 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	"github.com/gin-gonic/gin"
+)
+
+func TestPortfolioLinkController_RejectsBadIDs(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name      string
+		method    string
+		path      string
+		body      string
+		wantCode  string
+		wantError string
+	}{
+		{name: "fractional link ID in a reorder", method: http.MethodPost, path: "/portfolios/1/links/reorder", body: `{"items": [{"id": 2.5, "position": 1}]}`, wantCode: apperrors.CodeValidationInteger, wantError: "items.0.id must be a whole number within range"},
+		{name: "negative link ID in a reorder", method: http.MethodPost, path: "/portfolios/1/links/reorder", body: `{"items": [{"id": 1, "position": 1}, {"id": -2, "position": 2}]}`, wantCode: apperrors.CodeValidationInteger, wantError: "items.1.id must be a whole number within range"},
+		{name: "link ID above uint64 in a reorder", method: http.MethodPost, path: "/portfolios/1/links/reorder", body: `{"items": [{"id": 18446744073709551616, "position": 1}]}`, wantCode: apperrors.CodeValidationInteger, wantError: "items.0.id must be a whole number within range"},
+		{name: "fractional position on create", method: http.MethodPost, path: "/portfolios/1/links", body: `{"kind": "github", "url": "https://github.com/x", "position": 1.5}`, wantCode: apperrors.CodeValidationInteger, wantError: "position must be a whole number within range"},
+		{name: "fractional portfolio ID in the path", method: http.MethodPost, path: "/portfolios/1.5/links/reorder", body: `{"items": [{"id": 1, "position": 1}]}`, wantCode: apperrors.CodeValidationInvalidID},
+		{name: "link ID above 32 bits in the path", method: http.MethodDelete, path: "/portfolios/1/links/4294967296", wantCode: apperrors.CodeValidationInvalidID},
+		{name: "negative link ID in the path", method: http.MethodPut, path: "/portfolios/1/links/-3", body: `{"kind": "github", "url": "https://github.com/x"}`, wantCode: apperrors.CodeValidationInvalidID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The request is rejected before any use case runs, so the controller needs no dependencies
+			ctrl := &PortfolioLinkController{}
+			router := gin.New()
+			own := router.Group("/portfolios", func(c *gin.Context) { c.Set("userID", "user-1") })
+			own.POST("/:id/links", ctrl.Create)
+			own.POST("/:id/links/reorder", ctrl.Reorder)
+			own.PUT("/:id/links/:linkId", ctrl.Update)
+			own.DELETE("/:id/links/:linkId", ctrl.Delete)
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body)))
+
+			if w.Code != http.StatusBadRequest {
+				t.Fatalf("status = %d, want 400 (%s)", w.Code, w.Body.String())
+			}
+			var body struct {
+				Error string `json:"error"`
+				Code  string `json:"code"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if body.Code != tt.wantCode {
+				t.Errorf("code = %q, want %q", body.Code, tt.wantCode)
+			}
+			if tt.wantError != "" && body.Error != tt.wantError {
+				t.Errorf("error = %q, want %q", body.Error, tt.wantError)
+			}
+		})
+	}
+}
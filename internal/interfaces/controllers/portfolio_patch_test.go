@@ -0,0 +1,90 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	appdto "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	portfolio2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/usecases/portfolio"
+	"github.com/gin-gonic/gin"
+)
+
+// patchedPortfolioRepo gives portfolio 1 to "user-1" and records the patches that reach it
+type patchedPortfolioRepo struct {
+	contracts.PortfolioRepository
+	patched *appdto.PatchPortfolioInput
+}
+
+func (r *patchedPortfolioRepo) GetByID(_ context.Context, id uint) (*appdto.PortfolioDTO, error) {
+	return &appdto.PortfolioDTO{ID: id, Title: "Work", Description: "jobs", OwnerID: "user-1"}, nil
+}
+
+func (r *patchedPortfolioRepo) CheckTitleDuplicate(context.Context, string, string, uint) (bool, error) {
+	return false, nil
+}
+
+func (r *patchedPortfolioRepo) Patch(_ context.Context, input appdto.PatchPortfolioInput) error {
+	r.patched = &input
+	return nil
+}
+
+func TestPortfolioController_PatchBinding(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	strPtr := func(s string) *string { return &s }
+
+	tests := []struct {
+		name            string
+		body            string
+		wantStatus      int
+		wantTitle       *string // nil when the title must be left out of the patch
+		wantDescription *string // nil when the description must be left out of the patch
+	}{
+		{name: "empty body", body: `{}`, wantStatus: http.StatusOK},
+		{name: "null title is kept, empty description clears", body: `{"title": null, "description": ""}`, wantStatus: http.StatusOK, wantDescription: strPtr("")},
+		{name: "new title only", body: `{"title": "Jobs"}`, wantStatus: http.StatusOK, wantTitle: strPtr("Jobs")},
+		{name: "empty title", body: `{"title": ""}`, wantStatus: http.StatusBadRequest},
+		{name: "title too long", body: `{"title": "` + strings.Repeat("a", 256) + `"}`, wantStatus: http.StatusBadRequest},
+		{name: "malformed JSON", body: `{"title":`, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &patchedPortfolioRepo{}
+			ctrl := &PortfolioController{patchUseCase: portfolio2.NewPatchPortfolioUseCase(repo, nil, nil)}
+			router := gin.New()
+			router.PATCH("/portfolios/own/:id", func(c *gin.Context) { c.Set("userID", "user-1") }, ctrl.Patch)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPatch, "/portfolios/own/1", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (%s)", w.Code, tt.wantStatus, w.Body.String())
+			}
+			if tt.wantStatus != http.StatusOK {
+				if repo.patched != nil {
+					t.Errorf("rejected body still patched %+v", *repo.patched)
+				}
+				return
+			}
+			if repo.patched == nil {
+				t.Fatal("accepted body never reached the repository")
+			}
+			if !equalStringPtr(repo.patched.Title, tt.wantTitle) || !equalStringPtr(repo.patched.Description, tt.wantDescription) {
+				t.Errorf("patched title, description = %v, %v, want %v, %v", repo.patched.Title, repo.patched.Description, tt.wantTitle, tt.wantDescription)
+			}
+		})
+	}
+}
+
+func equalStringPtr(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,85 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	appdto "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	portfolio2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/usecases/portfolio"
+	"github.com/gin-gonic/gin"
+)
+
+// searchablePortfolioRepo returns one portfolio of another owner and records the search it served
+type searchablePortfolioRepo struct {
+	contracts.PortfolioRepository
+	input *appdto.SearchPublicPortfoliosInput
+}
+
+func (r *searchablePortfolioRepo) SearchPublic(_ context.Context, input appdto.SearchPublicPortfoliosInput) ([]appdto.PortfolioDTO, int64, error) {
+	r.input = &input
+	return []appdto.PortfolioDTO{{
+		ID: 7, Title: "Rust Notes", Slug: "rust-notes", OwnerID: "bob",
+		CreatedBy: "bob", UpdatedBy: "bob", IsPublished: true,
+	}}, 1, nil
+}
+
+func TestPortfolioController_ListPublic(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+		wantInput  *appdto.SearchPublicPortfoliosInput // nil when the request is rejected before the search
+	}{
+		{name: "defaults", wantStatus: http.StatusOK, wantInput: &appdto.SearchPublicPortfoliosInput{Pagination: appdto.PaginationDTO{Page: 1, Limit: 10}}},
+		{name: "trimmed query and explicit page", query: "?q=%20rust%20&page=2&limit=5", wantStatus: http.StatusOK, wantInput: &appdto.SearchPublicPortfoliosInput{Query: "rust", Pagination: appdto.PaginationDTO{Page: 2, Limit: 5}}},
+		{name: "query too long", query: "?q=" + strings.Repeat("a", 101), wantStatus: http.StatusBadRequest},
+		{name: "limit out of range", query: "?limit=101", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &searchablePortfolioRepo{}
+			ctrl := &PortfolioController{searchPublicUC: portfolio2.NewSearchPublicPortfoliosUseCase(repo)}
+			router := gin.New()
+			router.GET("/portfolios/public", ctrl.ListPublic)
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/portfolios/public"+tt.query, nil))
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (%s)", w.Code, tt.wantStatus, w.Body.String())
+			}
+			if tt.wantInput == nil {
+				if repo.input != nil {
+					t.Errorf("rejected request still searched with %+v", *repo.input)
+				}
+				return
+			}
+			if repo.input == nil || *repo.input != *tt.wantInput {
+				t.Fatalf("search input = %+v, want %+v", repo.input, *tt.wantInput)
+			}
+
+			var body struct {
+				Portfolios []map[string]interface{} `json:"portfolios"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if len(body.Portfolios) != 1 {
+				t.Fatalf("portfolios = %v, want one", body.Portfolios)
+			}
+			for _, private := range []string{"owner_id", "created_by", "updated_by"} {
+				if value, ok := body.Portfolios[0][private]; ok {
+					t.Errorf("public item carries %s = %v", private, value)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,273 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	project_collaborator2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/usecases/project_collaborator"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/interfaces/dto/request"
+	response2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/interfaces/dto/response"
+	"github.com/gin-gonic/gin"
+)
+
+// ProjectCollaboratorController handles HTTP requests for the collaborators credited on projects
+type ProjectCollaboratorController struct {
+	createUseCase  *project_collaborator2.CreateProjectCollaboratorUseCase
+	listUseCase    *project_collaborator2.ListProjectCollaboratorsUseCase
+	updateUseCase  *project_collaborator2.UpdateProjectCollaboratorUseCase
+	reorderUseCase *project_collaborator2.ReorderProjectCollaboratorsUseCase
+	deleteUseCase  *project_collaborator2.DeleteProjectCollaboratorUseCase
+}
+
+// NewProjectCollaboratorController creates a new project collaborator controller instance
+func NewProjectCollaboratorController(
+	createUC *project_collaborator2.CreateProjectCollaboratorUseCase,
+	listUC *project_collaborator2.ListProjectCollaboratorsUseCase,
+	updateUC *project_collaborator2.UpdateProjectCollaboratorUseCase,
+	reorderUC *project_collaborator2.ReorderProjectCollaboratorsUseCase,
+	deleteUC *project_collaborator2.DeleteProjectCollaboratorUseCase,
+) *ProjectCollaboratorController {
+	return &ProjectCollaboratorController{
+		createUseCase:  createUC,
+		listUseCase:    listUC,
+		updateUseCase:  updateUC,
+		reorderUseCase: reorderUC,
+		deleteUseCase:  deleteUC,
+	}
+}
+
+// List handles GET /api/projects/own/:id/collaborators
+func (ctrl *ProjectCollaboratorController) List(c *gin.Context) {
+	// Extract userID from context (set by auth middleware)
+	userID := c.GetString("userID")
+	if userID == "" {
+		respondUnauthenticated(c)
+		return
+	}
+
+	projectID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		respondInvalidID(c, "project")
+		return
+	}
+
+	collaborators, err := ctrl.listUseCase.Execute(c.Request.Context(), uint(projectID), userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response2.DataResponse{
+		Data:    projectCollaboratorResponses(collaborators),
+		Message: "Success",
+	})
+}
+
+// Create handles POST /api/projects/own/:id/collaborators
+func (ctrl *ProjectCollaboratorController) Create(c *gin.Context) {
+	// Extract userID from context (set by auth middleware)
+	userID := c.GetString("userID")
+	if userID == "" {
+		respondUnauthenticated(c)
+		return
+	}
+
+	projectID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		respondInvalidID(c, "project")
+		return
+	}
+
+	// Bind and validate HTTP request DTO
+	var req request.CreateProjectCollaboratorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err)
+		return
+	}
+
+	output, err := ctrl.createUseCase.Execute(c.Request.Context(), dto.CreateProjectCollaboratorInput{
+		ProjectID: uint(projectID),
+		Name:      req.Name,
+		Role:      req.Role,
+		URL:       req.URL,
+		Position:  req.Position,
+		OwnerID:   userID,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, response2.DataResponse{
+		Data:     toProjectCollaboratorResponse(output.Collaborator),
+		Message:  "Collaborator created successfully",
+		Warnings: toQuotaWarningResponses(output.Warnings),
+	})
+}
+
+// Update handles PUT /api/projects/own/:id/collaborators/:collaboratorId
+func (ctrl *ProjectCollaboratorController) Update(c *gin.Context) {
+	// Extract userID from context (set by auth middleware)
+	userID := c.GetString("userID")
+	if userID == "" {
+		respondUnauthenticated(c)
+		return
+	}
+
+	projectID, collaboratorID, ok := parseProjectCollaboratorParams(c)
+	if !ok {
+		return
+	}
+
+	// Bind and validate HTTP request DTO
+	var req request.UpdateProjectCollaboratorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err)
+		return
+	}
+
+	if err := ctrl.updateUseCase.Execute(c.Request.Context(), dto.UpdateProjectCollaboratorInput{
+		ID:        collaboratorID,
+		ProjectID: projectID,
+		Name:      req.Name,
+		Role:      req.Role,
+		URL:       req.URL,
+		Position:  req.Position,
+		OwnerID:   userID,
+	}); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response2.DataResponse{
+		Data:    nil,
+		Message: "Collaborator updated successfully",
+	})
+}
+
+// Reorder handles POST /api/projects/own/:id/collaborators/reorder
+func (ctrl *ProjectCollaboratorController) Reorder(c *gin.Context) {
+	// Extract userID from context (set by auth middleware)
+	userID := c.GetString("userID")
+	if userID == "" {
+		respondUnauthenticated(c)
+		return
+	}
+
+	projectID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		respondInvalidID(c, "project")
+		return
+	}
+
+	// Bind and validate HTTP request DTO
+	var req request.ReorderProjectCollaboratorsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err)
+		return
+	}
+
+	items := make([]dto.BulkUpdatePositionItem, len(req.Items))
+	for i, item := range req.Items {
+		items[i] = dto.BulkUpdatePositionItem{ID: item.ID, Position: item.Position}
+	}
+
+	if err := ctrl.reorderUseCase.Execute(c.Request.Context(), dto.ReorderProjectCollaboratorsInput{
+		ProjectID: uint(projectID),
+		Items:     items,
+		OwnerID:   userID,
+	}); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response2.DataResponse{
+		Data:    nil,
+		Message: "Collaborators reordered successfully",
+	})
+}
+
+// Delete handles DELETE /api/projects/own/:id/collaborators/:collaboratorId
+func (ctrl *ProjectCollaboratorController) Delete(c *gin.Context) {
+	// Extract userID from context (set by auth middleware)
+	userID := c.GetString("userID")
+	if userID == "" {
+		respondUnauthenticated(c)
+		return
+	}
+
+	projectID, collaboratorID, ok := parseProjectCollaboratorParams(c)
+	if !ok {
+		return
+	}
+
+	if err := ctrl.deleteUseCase.Execute(c.Request.Context(), projectID, collaboratorID, userID); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response2.DataResponse{
+		Data:    nil,
+		Message: "Collaborator deleted successfully",
+	})
+}
+
+// parseProjectCollaboratorParams parses the :id and :collaboratorId URL parameters, writing a 400 on failure
+func parseProjectCollaboratorParams(c *gin.Context) (uint, uint, bool) {
+	projectID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		respondInvalidID(c, "project")
+		return 0, 0, false
+	}
+
+	collaboratorID, err := strconv.ParseUint(c.Param("collaboratorId"), 10, 32)
+	if err != nil {
+		respondInvalidID(c, "project collaborator")
+		return 0, 0, false
+	}
+
+	return uint(projectID), uint(collaboratorID), true
+}
+
+// toProjectCollaboratorResponse maps a collaborator DTO to its owner-facing HTTP response
+func toProjectCollaboratorResponse(collaborator dto.ProjectCollaboratorDTO) response2.ProjectCollaboratorResponse {
+	return response2.ProjectCollaboratorResponse{
+		ID:       collaborator.ID,
+		Name:     collaborator.Name,
+		Role:     collaborator.Role,
+		URL:      collaborator.URL,
+		Position: collaborator.Position,
+	}
+}
+
+// projectCollaboratorResponses maps collaborator DTOs to their owner-facing HTTP responses
+func projectCollaboratorResponses(collaborators []dto.ProjectCollaboratorDTO) []response2.ProjectCollaboratorResponse {
+	if collaborators == nil {
+		return nil
+	}
+
+	resp := make([]response2.ProjectCollaboratorResponse, len(collaborators))
+	for i, collaborator := range collaborators {
+		resp[i] = toProjectCollaboratorResponse(collaborator)
+	}
+	return resp
+}
+
+// publicProjectCollaboratorResponses maps collaborator DTOs to their public HTTP responses
+// (name, role and url only)
+func publicProjectCollaboratorResponses(collaborators []dto.ProjectCollaboratorDTO) []response2.ProjectCollaboratorResponse {
+	if collaborators == nil {
+		return nil
+	}
+
+	resp := make([]response2.ProjectCollaboratorResponse, len(collaborators))
+	for i, collaborator := range collaborators {
+		resp[i] = response2.ProjectCollaboratorResponse{
+			Name: collaborator.Name,
+			Role: collaborator.Role,
+			URL:  collaborator.URL,
+		}
+	}
+	return resp
+}
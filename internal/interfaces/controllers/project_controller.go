@@ -3,26 +3,36 @@ package controllers
 import (
 	"net/http"
 	"strconv"
+	"time"
 
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
 	project2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/usecases/project"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/usecases/trash"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/interfaces/dto/request"
 	response2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/interfaces/dto/response"
 	"github.com/gin-gonic/gin"
-
-	pkgerrors "github.com/JorgeSaicoski/portfolio-manager/backend/pkg/errors"
 )
 
 // ProjectController handles HTTP requests for project operations
 type ProjectController struct {
-	createUseCase    *project2.CreateProjectUseCase
-	getUseCase       *project2.GetProjectUseCase
-	getPublicUseCase *project2.GetProjectPublicUseCase
-	listUseCase      *project2.ListProjectsUseCase
-	updateUseCase    *project2.UpdateProjectUseCase
-	deleteUseCase    *project2.DeleteProjectUseCase
-	projectRepo      contracts.ProjectRepository
+	createUseCase      *project2.CreateProjectUseCase
+	getUseCase         *project2.GetProjectUseCase
+	getPublicUseCase   *project2.GetProjectPublicUseCase
+	listUseCase        *project2.ListProjectsUseCase
+	updateUseCase      *project2.UpdateProjectUseCase
+	patchUseCase       *project2.PatchProjectUseCase
+	deleteUseCase      *project2.DeleteProjectUseCase
+	searchUseCase      *project2.SearchPublicProjectsUseCase
+	endorseUseCase     *project2.EndorseProjectSkillUseCase
+	endorsementsUC     *project2.GetProjectEndorsementsUseCase
+	compareUseCase     *project2.CompareProjectsUseCase
+	bulkReorderUseCase *project2.BulkReorderProjectsUseCase
+	swapUseCase        *project2.SwapProjectPositionsUseCase
+	projectRepo        contracts.ProjectRepository
+	assetURLs          contracts.AssetURLBuilder
+	findDeletedUseCase *trash.FindDeletedItemUseCase
 }
 
 // NewProjectController creates a new project controller instance
@@ -32,17 +42,35 @@ func NewProjectController(
 	getPublicUC *project2.GetProjectPublicUseCase,
 	listUC *project2.ListProjectsUseCase,
 	updateUC *project2.UpdateProjectUseCase,
+	patchUC *project2.PatchProjectUseCase,
 	deleteUC *project2.DeleteProjectUseCase,
+	searchUC *project2.SearchPublicProjectsUseCase,
+	endorseUC *project2.EndorseProjectSkillUseCase,
+	endorsementsUC *project2.GetProjectEndorsementsUseCase,
+	compareUC *project2.CompareProjectsUseCase,
+	bulkReorderUC *project2.BulkReorderProjectsUseCase,
+	swapUC *project2.SwapProjectPositionsUseCase,
+	findDeletedUC *trash.FindDeletedItemUseCase,
 	projectRepo contracts.ProjectRepository,
+	assetURLs contracts.AssetURLBuilder,
 ) *ProjectController {
 	return &ProjectController{
-		createUseCase:    createUC,
-		getUseCase:       getUC,
-		getPublicUseCase: getPublicUC,
-		listUseCase:      listUC,
-		updateUseCase:    updateUC,
-		deleteUseCase:    deleteUC,
-		projectRepo:      projectRepo,
+		createUseCase:      createUC,
+		getUseCase:         getUC,
+		getPublicUseCase:   getPublicUC,
+		listUseCase:        listUC,
+		updateUseCase:      updateUC,
+		patchUseCase:       patchUC,
+		deleteUseCase:      deleteUC,
+		searchUseCase:      searchUC,
+		endorseUseCase:     endorseUC,
+		endorsementsUC:     endorsementsUC,
+		compareUseCase:     compareUC,
+		bulkReorderUseCase: bulkReorderUC,
+		swapUseCase:        swapUC,
+		projectRepo:        projectRepo,
+		assetURLs:          assetURLs,
+		findDeletedUseCase: findDeletedUC,
 	}
 }
 
@@ -51,14 +79,14 @@ func (ctrl *ProjectController) Create(c *gin.Context) {
 	// Extract userID from context (set by auth middleware)
 	userID := c.GetString("userID")
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, response2.ErrorResponse{Error: "unauthorized: missing user ID"})
+		respondUnauthenticated(c)
 		return
 	}
 
 	// Bind and validate HTTP request DTO
 	var req request.CreateProjectRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: err.Error()})
+		respondBindingError(c, err)
 		return
 	}
 
@@ -72,14 +100,15 @@ func (ctrl *ProjectController) Create(c *gin.Context) {
 		Client:      req.Client,
 		Link:        req.Link,
 		CategoryID:  req.CategoryID,
+		PortfolioID: req.PortfolioID,
+		Hidden:      req.Hidden,
 		OwnerID:     userID,
 	}
 
 	// Execute use case
 	projectDTO, err := ctrl.createUseCase.Execute(c.Request.Context(), input)
 	if err != nil {
-		status := pkgerrors.ToHTTPStatus(err)
-		c.JSON(status, response2.ErrorResponse{Error: err.Error()})
+		respondError(c, err)
 		return
 	}
 
@@ -93,11 +122,16 @@ func (ctrl *ProjectController) Create(c *gin.Context) {
 		Skills:      projectDTO.Skills,
 		Client:      projectDTO.Client,
 		Link:        projectDTO.Link,
+		Position:    projectDTO.Position,
 		CategoryID:  projectDTO.CategoryID,
 		OwnerID:     projectDTO.OwnerID,
+		Hidden:      projectDTO.Hidden,
+		CreatedBy:   projectDTO.CreatedBy,
+		UpdatedBy:   projectDTO.UpdatedBy,
 		CreatedAt:   projectDTO.CreatedAt,
 		UpdatedAt:   projectDTO.UpdatedAt,
 	}
+	resp.DefaultedFields = projectDTO.DefaultedFields
 
 	// Return HTTP response with API_OVERVIEW.md format
 	c.JSON(http.StatusCreated, response2.DataResponse{
@@ -111,39 +145,34 @@ func (ctrl *ProjectController) List(c *gin.Context) {
 	// Extract userID from context (set by auth middleware)
 	userID := c.GetString("userID")
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, response2.ErrorResponse{Error: "unauthorized: missing user ID"})
+		respondUnauthenticated(c)
 		return
 	}
 
 	// Bind and validate query parameters
 	var req request.ListProjectsRequest
 	if err := c.ShouldBindQuery(&req); err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: err.Error()})
+		respondBindingError(c, err)
 		return
 	}
 
-	// Set default pagination values if not provided
-	if req.Page == 0 {
-		req.Page = 1
-	}
-	if req.Limit == 0 {
-		req.Limit = 10
-	}
-
 	// Map to application DTO
 	input := dto.ListProjectsInput{
-		OwnerID: userID,
-		Pagination: dto.PaginationDTO{
-			Page:  req.Page,
-			Limit: req.Limit,
+		OwnerID:        userID,
+		Pagination:     paginationInput(req.PaginationQuery),
+		IncludeContext: req.Include == "context",
+		Sort:           req.Sort,
+		Filter: dto.ProjectFilter{
+			CategoryID: req.CategoryID,
+			Client:     req.Client,
+			Skill:      req.Skill,
 		},
 	}
 
 	// Execute use case
 	output, err := ctrl.listUseCase.Execute(c.Request.Context(), input)
 	if err != nil {
-		status := pkgerrors.ToHTTPStatus(err)
-		c.JSON(status, response2.ErrorResponse{Error: err.Error()})
+		respondError(c, err)
 		return
 	}
 
@@ -159,11 +188,17 @@ func (ctrl *ProjectController) List(c *gin.Context) {
 			Skills:      proj.Skills,
 			Client:      proj.Client,
 			Link:        proj.Link,
+			Position:    proj.Position,
 			CategoryID:  proj.CategoryID,
 			OwnerID:     proj.OwnerID,
+			Hidden:      proj.Hidden,
+			CreatedBy:   proj.CreatedBy,
+			UpdatedBy:   proj.UpdatedBy,
 			CreatedAt:   proj.CreatedAt,
 			UpdatedAt:   proj.UpdatedAt,
 		}
+		projects[i].Category, projects[i].Portfolio = projectContextResponse(proj.Context)
+		projects[i].LastViewedAt, projects[i].Views30d = projectViewsResponse(proj.Views)
 	}
 
 	// Return HTTP response with API_OVERVIEW.md format
@@ -181,7 +216,7 @@ func (ctrl *ProjectController) GetByID(c *gin.Context) {
 	// Extract userID from context (set by auth middleware)
 	userID := c.GetString("userID")
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, response2.ErrorResponse{Error: "unauthorized: missing user ID"})
+		respondUnauthenticated(c)
 		return
 	}
 
@@ -189,15 +224,14 @@ func (ctrl *ProjectController) GetByID(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := strconv.ParseUint(idStr, 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: "invalid project ID"})
+		respondInvalidID(c, "project")
 		return
 	}
 
 	// Execute use case
 	projectDTO, err := ctrl.getUseCase.Execute(c.Request.Context(), uint(id), userID)
 	if err != nil {
-		status := pkgerrors.ToHTTPStatus(err)
-		c.JSON(status, response2.ErrorResponse{Error: err.Error()})
+		respondOwnItemError(c, ctrl.findDeletedUseCase, err, dto.TrashResourceProject, uint(id))
 		return
 	}
 
@@ -211,11 +245,21 @@ func (ctrl *ProjectController) GetByID(c *gin.Context) {
 		Skills:      projectDTO.Skills,
 		Client:      projectDTO.Client,
 		Link:        projectDTO.Link,
+		Position:    projectDTO.Position,
 		CategoryID:  projectDTO.CategoryID,
 		OwnerID:     projectDTO.OwnerID,
+		Hidden:      projectDTO.Hidden,
+		CreatedBy:   projectDTO.CreatedBy,
+		UpdatedBy:   projectDTO.UpdatedBy,
 		CreatedAt:   projectDTO.CreatedAt,
 		UpdatedAt:   projectDTO.UpdatedAt,
 	}
+	resp.Category, resp.Portfolio = projectContextResponse(projectDTO.Context)
+	resp.LastViewedAt, resp.Views30d = projectViewsResponse(projectDTO.Views)
+	resp.Collaborators = projectCollaboratorResponses(projectDTO.Collaborators)
+	if absoluteURLsRequested(c) {
+		withAbsoluteProjectImages(ctrl.assetURLs, &resp)
+	}
 
 	// Return HTTP response with API_OVERVIEW.md format
 	c.JSON(http.StatusOK, response2.DataResponse{
@@ -229,7 +273,7 @@ func (ctrl *ProjectController) Update(c *gin.Context) {
 	// Extract userID from context (set by auth middleware)
 	userID := c.GetString("userID")
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, response2.ErrorResponse{Error: "unauthorized: missing user ID"})
+		respondUnauthenticated(c)
 		return
 	}
 
@@ -237,14 +281,14 @@ func (ctrl *ProjectController) Update(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := strconv.ParseUint(idStr, 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: "invalid project ID"})
+		respondInvalidID(c, "project")
 		return
 	}
 
 	// Bind and validate HTTP request DTO
 	var req request.UpdateProjectRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: err.Error()})
+		respondBindingError(c, err)
 		return
 	}
 
@@ -258,14 +302,68 @@ func (ctrl *ProjectController) Update(c *gin.Context) {
 		Skills:      req.Skills,
 		Client:      req.Client,
 		Link:        req.Link,
+		Hidden:      req.Hidden,
 		OwnerID:     userID,
 	}
 
 	// Execute use case
 	err = ctrl.updateUseCase.Execute(c.Request.Context(), input)
 	if err != nil {
-		status := pkgerrors.ToHTTPStatus(err)
-		c.JSON(status, response2.ErrorResponse{Error: err.Error()})
+		respondOwnItemError(c, ctrl.findDeletedUseCase, err, dto.TrashResourceProject, uint(id))
+		return
+	}
+
+	// Return success response with API_OVERVIEW.md format
+	c.JSON(http.StatusOK, response2.DataResponse{
+		Data:    nil,
+		Message: "Project updated successfully",
+	})
+}
+
+// Patch handles PATCH /api/projects/own/:id
+// Only the fields present in the body change, unlike PUT which replaces them all
+func (ctrl *ProjectController) Patch(c *gin.Context) {
+	// Extract userID from context (set by auth middleware)
+	userID := c.GetString("userID")
+	if userID == "" {
+		respondUnauthenticated(c)
+		return
+	}
+
+	// Parse project ID from URL parameter
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		respondInvalidID(c, "project")
+		return
+	}
+
+	// Bind and validate HTTP request DTO
+	var req request.PatchProjectRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err)
+		return
+	}
+
+	// Map to application DTO
+	input := dto.PatchProjectInput{
+		ID:          uint(id),
+		Title:       req.Title,
+		Description: req.Description,
+		MainImage:   req.MainImage,
+		Images:      req.Images,
+		Skills:      req.Skills,
+		Client:      req.Client,
+		Link:        req.Link,
+		CategoryID:  req.CategoryID,
+		Hidden:      req.Hidden,
+		OwnerID:     userID,
+	}
+
+	// Execute use case
+	err = ctrl.patchUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		respondOwnItemError(c, ctrl.findDeletedUseCase, err, dto.TrashResourceProject, uint(id))
 		return
 	}
 
@@ -281,7 +379,7 @@ func (ctrl *ProjectController) Delete(c *gin.Context) {
 	// Extract userID from context (set by auth middleware)
 	userID := c.GetString("userID")
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, response2.ErrorResponse{Error: "unauthorized: missing user ID"})
+		respondUnauthenticated(c)
 		return
 	}
 
@@ -289,15 +387,14 @@ func (ctrl *ProjectController) Delete(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := strconv.ParseUint(idStr, 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: "invalid project ID"})
+		respondInvalidID(c, "project")
 		return
 	}
 
 	// Execute use case
 	err = ctrl.deleteUseCase.Execute(c.Request.Context(), uint(id), userID)
 	if err != nil {
-		status := pkgerrors.ToHTTPStatus(err)
-		c.JSON(status, response2.ErrorResponse{Error: err.Error()})
+		respondOwnItemError(c, ctrl.findDeletedUseCase, err, dto.TrashResourceProject, uint(id))
 		return
 	}
 
@@ -308,21 +405,124 @@ func (ctrl *ProjectController) Delete(c *gin.Context) {
 	})
 }
 
+// BulkReorder handles PATCH /api/projects/own/reorder
+// All items must belong to the same category
+func (ctrl *ProjectController) BulkReorder(c *gin.Context) {
+	// Extract userID from context (set by auth middleware)
+	userID := c.GetString("userID")
+	if userID == "" {
+		respondUnauthenticated(c)
+		return
+	}
+
+	// Bind and validate HTTP request DTO
+	var req request.BulkReorderProjectsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err)
+		return
+	}
+
+	// Map to application DTO
+	items := make([]dto.BulkUpdatePositionItem, len(req.Items))
+	for i, item := range req.Items {
+		items[i] = dto.BulkUpdatePositionItem{
+			ID:       item.ID,
+			Position: item.Position,
+		}
+	}
+
+	input := dto.BulkUpdateProjectPositionsInput{
+		Items:   items,
+		OwnerID: userID,
+	}
+
+	// Execute use case
+	if err := ctrl.bulkReorderUseCase.Execute(c.Request.Context(), input); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response2.DataResponse{
+		Data:    nil,
+		Message: "Projects reordered successfully",
+	})
+}
+
+// Swap handles POST /api/projects/own/swap
+// Exchanges the positions of two projects of the same category atomically
+func (ctrl *ProjectController) Swap(c *gin.Context) {
+	// Extract userID from context (set by auth middleware)
+	userID := c.GetString("userID")
+	if userID == "" {
+		respondUnauthenticated(c)
+		return
+	}
+
+	// Bind and validate HTTP request DTO
+	var req request.SwapPositionsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err)
+		return
+	}
+
+	// Execute use case
+	swapped, err := ctrl.swapUseCase.Execute(c.Request.Context(), dto.SwapPositionsInput{
+		FirstID:  req.FirstID,
+		SecondID: req.SecondID,
+		OwnerID:  userID,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// Map to HTTP response DTOs
+	resp := make([]response2.ProjectResponse, len(swapped))
+	for i, proj := range swapped {
+		resp[i] = response2.ProjectResponse{
+			ID:          proj.ID,
+			Title:       proj.Title,
+			Description: proj.Description,
+			MainImage:   proj.MainImage,
+			Images:      proj.Images,
+			Skills:      proj.Skills,
+			Client:      proj.Client,
+			Link:        proj.Link,
+			Position:    proj.Position,
+			CategoryID:  proj.CategoryID,
+			OwnerID:     proj.OwnerID,
+			Hidden:      proj.Hidden,
+			CreatedBy:   proj.CreatedBy,
+			UpdatedBy:   proj.UpdatedBy,
+			CreatedAt:   proj.CreatedAt,
+			UpdatedAt:   proj.UpdatedAt,
+		}
+	}
+
+	// Return HTTP response with API_OVERVIEW.md format
+	c.JSON(http.StatusOK, response2.DataResponse{
+		Data:    resp,
+		Message: "Projects swapped successfully",
+	})
+}
+
 // GetPublicByID handles GET /api/projects/public/:id
 func (ctrl *ProjectController) GetPublicByID(c *gin.Context) {
 	// Parse project ID from URL parameter
 	idStr := c.Param("id")
 	id, err := strconv.ParseUint(idStr, 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: "invalid project ID"})
+		respondInvalidID(c, "project")
 		return
 	}
 
 	// Execute use case (no auth required for public access)
 	projectDTO, err := ctrl.getPublicUseCase.Execute(c.Request.Context(), uint(id))
 	if err != nil {
-		status := pkgerrors.ToHTTPStatus(err)
-		c.JSON(status, response2.ErrorResponse{Error: err.Error()})
+		respondError(c, err)
+		return
+	}
+	if respondNotModified(c, publicProjectETag(projectDTO)) {
 		return
 	}
 
@@ -336,10 +536,14 @@ func (ctrl *ProjectController) GetPublicByID(c *gin.Context) {
 		Skills:      projectDTO.Skills,
 		Client:      projectDTO.Client,
 		Link:        projectDTO.Link,
+		Position:    projectDTO.Position,
 		CategoryID:  projectDTO.CategoryID,
 		CreatedAt:   projectDTO.CreatedAt,
 		UpdatedAt:   projectDTO.UpdatedAt,
 	}
+	resp.Category, resp.Portfolio = projectContextResponse(projectDTO.Context)
+	resp.Endorsements = projectDTO.Endorsements
+	resp.Collaborators = publicProjectCollaboratorResponses(projectDTO.Collaborators)
 
 	// Return HTTP response with API_OVERVIEW.md format
 	c.JSON(http.StatusOK, response2.DataResponse{
@@ -348,20 +552,199 @@ func (ctrl *ProjectController) GetPublicByID(c *gin.Context) {
 	})
 }
 
+// SearchPublic handles GET /api/projects/public/search?q=&skill=&sort=recent|relevant&page=
+func (ctrl *ProjectController) SearchPublic(c *gin.Context) {
+	// Bind and validate query parameters
+	var req request.SearchPublicProjectsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		respondBindingError(c, err)
+		return
+	}
+
+	// Set default pagination values if not provided
+	if req.Page == 0 {
+		req.Page = 1
+	}
+	if req.Limit == 0 {
+		req.Limit = 20
+	}
+
+	// Execute use case (no auth required for public access)
+	output, err := ctrl.searchUseCase.Execute(c.Request.Context(), dto.SearchPublicProjectsInput{
+		Query: req.Query,
+		Skill: req.Skill,
+		Sort:  req.Sort,
+		Pagination: dto.PaginationDTO{
+			Page:  req.Page,
+			Limit: req.Limit,
+		},
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// Map to list-level HTTP response DTOs (no OwnerID in public responses)
+	projects := make([]response2.ProjectResponse, len(output.Projects))
+	for i, proj := range output.Projects {
+		projects[i] = response2.ProjectResponse{
+			ID:          proj.ID,
+			Title:       proj.Title,
+			Description: proj.Description,
+			MainImage:   proj.MainImage,
+			Skills:      proj.Skills,
+			Client:      proj.Client,
+			Link:        proj.Link,
+			Position:    proj.Position,
+			CategoryID:  proj.CategoryID,
+			CreatedAt:   proj.CreatedAt,
+			UpdatedAt:   proj.UpdatedAt,
+		}
+		projects[i].Category, projects[i].Portfolio = projectContextResponse(proj.Context)
+		if absoluteURLsRequested(c) {
+			withAbsoluteProjectImages(ctrl.assetURLs, &projects[i])
+		}
+	}
+
+	c.JSON(http.StatusOK, response2.ProjectSearchResponse{
+		Data:        projects,
+		Page:        output.Pagination.Page,
+		Limit:       output.Pagination.Limit,
+		Total:       output.Pagination.Total,
+		TotalCapped: output.TotalCapped,
+		Message:     "Success",
+	})
+}
+
+// EndorseSkill handles POST /api/projects/public/:id/skills/:skill/endorse
+// Counts once per visitor IP, skill and day; repeats return the current count with counted=false
+func (ctrl *ProjectController) EndorseSkill(c *gin.Context) {
+	// Parse project ID from URL parameter
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		respondInvalidID(c, "project")
+		return
+	}
+
+	// Execute use case (no auth required for public access)
+	result, err := ctrl.endorseUseCase.Execute(c.Request.Context(), dto.EndorseSkillInput{
+		ProjectID: uint(id),
+		Skill:     c.Param("skill"),
+		ClientIP:  c.ClientIP(),
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response2.DataResponse{
+		Data: response2.EndorseSkillResponse{
+			Skill:   result.Skill,
+			Count:   result.Count,
+			Counted: result.Counted,
+		},
+		Message: "Success",
+	})
+}
+
+// Compare handles GET /api/projects/own/compare?left=<id>&right=<id>
+// Lists what differs between two of the caller's projects (left is the reference)
+func (ctrl *ProjectController) Compare(c *gin.Context) {
+	// Extract userID from context (set by auth middleware)
+	userID := c.GetString("userID")
+	if userID == "" {
+		respondUnauthenticated(c)
+		return
+	}
+
+	// Bind and validate query parameters
+	var req request.CompareProjectsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		respondBindingError(c, err)
+		return
+	}
+
+	// Execute use case
+	result, err := ctrl.compareUseCase.Execute(c.Request.Context(), req.Left, req.Right, userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// Map to HTTP response DTO
+	fields := make([]response2.FieldChangeResponse, len(result.Fields))
+	for i, field := range result.Fields {
+		fields[i] = response2.FieldChangeResponse{
+			Field: field.Field,
+			Left:  field.Left,
+			Right: field.Right,
+		}
+	}
+
+	c.JSON(http.StatusOK, response2.DataResponse{
+		Data: response2.ProjectComparisonResponse{
+			LeftID:  result.LeftID,
+			RightID: result.RightID,
+			Fields:  fields,
+			Skills:  response2.SetChangeResponse{Added: result.Skills.Added, Removed: result.Skills.Removed},
+			Images:  response2.SetChangeResponse{Added: result.Images.Added, Removed: result.Images.Removed},
+		},
+		Message: "Success",
+	})
+}
+
+// GetEndorsements handles GET /api/projects/own/:id/endorsements
+func (ctrl *ProjectController) GetEndorsements(c *gin.Context) {
+	// Extract userID from context (set by auth middleware)
+	userID := c.GetString("userID")
+	if userID == "" {
+		respondUnauthenticated(c)
+		return
+	}
+
+	// Parse project ID from URL parameter
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		respondInvalidID(c, "project")
+		return
+	}
+
+	// Execute use case
+	output, err := ctrl.endorsementsUC.Execute(c.Request.Context(), uint(id), userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	skills := make([]response2.SkillEndorsementResponse, len(output.Skills))
+	for i, skill := range output.Skills {
+		skills[i] = response2.SkillEndorsementResponse{Skill: skill.Skill, Count: skill.Count}
+	}
+
+	c.JSON(http.StatusOK, response2.DataResponse{
+		Data: response2.ProjectEndorsementsResponse{
+			ProjectID:           output.ProjectID,
+			EndorsementsEnabled: output.EndorsementsEnabled,
+			Skills:              skills,
+		},
+		Message: "Success",
+	})
+}
+
 // GetByCategory handles GET /api/projects/category/:categoryId
 func (ctrl *ProjectController) GetByCategory(c *gin.Context) {
 	// Parse category ID from URL parameter
 	idStr := c.Param("categoryId")
 	categoryID, err := strconv.ParseUint(idStr, 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: "invalid category ID"})
+		respondInvalidID(c, "category")
 		return
 	}
 
 	// Get all projects for the category
 	projects, err := ctrl.projectRepo.GetByCategoryID(c.Request.Context(), uint(categoryID))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, response2.ErrorResponse{Error: "failed to retrieve projects"})
+		respondErrorCode(c, http.StatusInternalServerError, apperrors.CodeInternal, "failed to retrieve projects")
 		return
 	}
 
@@ -377,12 +760,19 @@ func (ctrl *ProjectController) GetByCategory(c *gin.Context) {
 			Skills:      proj.Skills,
 			Client:      proj.Client,
 			Link:        proj.Link,
+			Position:    proj.Position,
 			CategoryID:  proj.CategoryID,
 			CreatedAt:   proj.CreatedAt,
 			UpdatedAt:   proj.UpdatedAt,
 		}
 	}
 
+	if absoluteURLsRequested(c) {
+		for i := range projectResponses {
+			withAbsoluteProjectImages(ctrl.assetURLs, &projectResponses[i])
+		}
+	}
+
 	// Return HTTP response with API_OVERVIEW.md format
 	c.JSON(http.StatusOK, response2.DataResponse{
 		Data:    projectResponses,
@@ -395,14 +785,14 @@ func (ctrl *ProjectController) SearchBySkills(c *gin.Context) {
 	// Bind and validate query parameters
 	var req request.SearchProjectsBySkillsRequest
 	if err := c.ShouldBindQuery(&req); err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: err.Error()})
+		respondBindingError(c, err)
 		return
 	}
 
 	// Search projects by skills
 	projects, err := ctrl.projectRepo.SearchBySkills(c.Request.Context(), req.Skills)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, response2.ErrorResponse{Error: "failed to search projects"})
+		respondErrorCode(c, http.StatusInternalServerError, apperrors.CodeInternal, "failed to search projects")
 		return
 	}
 
@@ -418,12 +808,19 @@ func (ctrl *ProjectController) SearchBySkills(c *gin.Context) {
 			Skills:      proj.Skills,
 			Client:      proj.Client,
 			Link:        proj.Link,
+			Position:    proj.Position,
 			CategoryID:  proj.CategoryID,
 			CreatedAt:   proj.CreatedAt,
 			UpdatedAt:   proj.UpdatedAt,
 		}
 	}
 
+	if absoluteURLsRequested(c) {
+		for i := range projectResponses {
+			withAbsoluteProjectImages(ctrl.assetURLs, &projectResponses[i])
+		}
+	}
+
 	// Return HTTP response with API_OVERVIEW.md format
 	c.JSON(http.StatusOK, response2.DataResponse{
 		Data:    projectResponses,
@@ -436,14 +833,14 @@ func (ctrl *ProjectController) SearchByClient(c *gin.Context) {
 	// Bind and validate query parameters
 	var req request.SearchProjectsByClientRequest
 	if err := c.ShouldBindQuery(&req); err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: err.Error()})
+		respondBindingError(c, err)
 		return
 	}
 
 	// Search projects by client
 	projects, err := ctrl.projectRepo.SearchByClient(c.Request.Context(), req.Client)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, response2.ErrorResponse{Error: "failed to search projects"})
+		respondErrorCode(c, http.StatusInternalServerError, apperrors.CodeInternal, "failed to search projects")
 		return
 	}
 
@@ -459,15 +856,76 @@ func (ctrl *ProjectController) SearchByClient(c *gin.Context) {
 			Skills:      proj.Skills,
 			Client:      proj.Client,
 			Link:        proj.Link,
+			Position:    proj.Position,
 			CategoryID:  proj.CategoryID,
 			CreatedAt:   proj.CreatedAt,
 			UpdatedAt:   proj.UpdatedAt,
 		}
 	}
 
+	if absoluteURLsRequested(c) {
+		for i := range projectResponses {
+			withAbsoluteProjectImages(ctrl.assetURLs, &projectResponses[i])
+		}
+	}
+
 	// Return HTTP response with API_OVERVIEW.md format
 	c.JSON(http.StatusOK, response2.DataResponse{
 		Data:    projectResponses,
 		Message: "Success",
 	})
 }
+
+// projectContextResponse maps the breadcrumb context of a project to its response objects
+// Owner IDs are intentionally left out so the same mapping is safe for public responses
+func projectContextResponse(projectContext *dto.ProjectContextDTO) (*response2.ProjectCategoryContextResponse, *response2.ProjectPortfolioContextResponse) {
+	if projectContext == nil {
+		return nil, nil
+	}
+
+	category := &response2.ProjectCategoryContextResponse{
+		ID:          projectContext.CategoryID,
+		Title:       projectContext.CategoryTitle,
+		PortfolioID: projectContext.PortfolioID,
+	}
+	portfolio := &response2.ProjectPortfolioContextResponse{
+		ID:    projectContext.PortfolioID,
+		Title: projectContext.PortfolioTitle,
+	}
+
+	return category, portfolio
+}
+
+// projectViewsResponse maps the owner-facing view stats of a project
+// Public handlers never call it, and public reads never populate Views anyway
+func projectViewsResponse(views *dto.ProjectViewStatsDTO) (*time.Time, *uint) {
+	if views == nil {
+		return nil, nil
+	}
+
+	last30Days := views.Last30Days
+	return views.LastViewedAt, &last30Days
+}
+
+// publicProjectETag is the version of a public project response: the project, the titles of its
+// category and portfolio, its collaborators and its endorsement counts
+func publicProjectETag(projectDTO *dto.ProjectDTO) string {
+	collaboratorUpdates := make([]time.Time, len(projectDTO.Collaborators))
+	for i, collaborator := range projectDTO.Collaborators {
+		collaboratorUpdates[i] = collaborator.UpdatedAt
+	}
+	var endorsements uint
+	for _, count := range projectDTO.Endorsements {
+		endorsements += count
+	}
+
+	parts := []interface{}{
+		projectDTO.ID, projectDTO.UpdatedAt,
+		len(projectDTO.Collaborators), latestUpdate(collaboratorUpdates...),
+		projectDTO.Endorsements != nil, len(projectDTO.Endorsements), endorsements,
+	}
+	if projectDTO.Context != nil {
+		parts = append(parts, projectDTO.Context.CategoryTitle, projectDTO.Context.PortfolioTitle)
+	}
+	return weakETag(parts...)
+}
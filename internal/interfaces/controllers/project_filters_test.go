@@ -0,0 +1,65 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	appdto "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	project2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/usecases/project"
+	"github.com/gin-gonic/gin"
+)
+
+// filteredProjectRepo records the filter of the own project list it served
+type filteredProjectRepo struct {
+	contracts.ProjectRepository
+	filter *appdto.ProjectFilter
+}
+
+func (r *filteredProjectRepo) GetByOwnerIDFiltered(_ context.Context, _ string, filter appdto.ProjectFilter, _ appdto.PaginationDTO, _ string) ([]appdto.ProjectDTO, int64, error) {
+	r.filter = &filter
+	return []appdto.ProjectDTO{}, 0, nil
+}
+
+func TestProjectController_ListFilters(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+		wantFilter *appdto.ProjectFilter // nil when the request is rejected before the list
+	}{
+		{name: "no filter", wantStatus: http.StatusOK, wantFilter: &appdto.ProjectFilter{}},
+		{name: "all filters, trimmed", query: "?category_id=3&client=%20Acme%20&skill=Go", wantStatus: http.StatusOK, wantFilter: &appdto.ProjectFilter{CategoryID: 3, Client: "Acme", Skill: "Go"}},
+		{name: "non-numeric category", query: "?category_id=abc", wantStatus: http.StatusBadRequest},
+		{name: "negative category", query: "?category_id=-1", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &filteredProjectRepo{}
+			ctrl := &ProjectController{listUseCase: project2.NewListProjectsUseCase(repo, nil)}
+			router := gin.New()
+			router.GET("/projects/own", func(c *gin.Context) { c.Set("userID", "user-1") }, ctrl.List)
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/projects/own"+tt.query, nil))
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (%s)", w.Code, tt.wantStatus, w.Body.String())
+			}
+			if tt.wantFilter == nil {
+				if repo.filter != nil {
+					t.Errorf("rejected request still listed with %+v", *repo.filter)
+				}
+				return
+			}
+			if repo.filter == nil || *repo.filter != *tt.wantFilter {
+				t.Errorf("filter = %+v, want %+v", repo.filter, *tt.wantFilter)
+			}
+		})
+	}
+}
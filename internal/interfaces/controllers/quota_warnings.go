@@ -0,0 +1,24 @@
+package controllers
+
+import (
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	response2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/interfaces/dto/response"
+)
+
+// toQuotaWarningResponses maps the quota warnings of a write for the success envelope
+// Returns nil when there are none, so the warnings field is omitted
+func toQuotaWarningResponses(warnings []dto.QuotaWarningDTO) []response2.QuotaWarningResponse {
+	if len(warnings) == 0 {
+		return nil
+	}
+
+	responses := make([]response2.QuotaWarningResponse, len(warnings))
+	for i, warning := range warnings {
+		responses[i] = response2.QuotaWarningResponse{
+			Quota: warning.Quota,
+			Usage: warning.Usage,
+			Limit: warning.Limit,
+		}
+	}
+	return responses
+}
@@ -0,0 +1,96 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	portfolio_link2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/usecases/portfolio_link"
+	domainportfolio "github.com/JorgeSaicoski/portfolio-manager/backend/internal/domain/portfolio"
+	"github.com/gin-gonic/gin"
+)
+
+// countingLinkRepo creates links as if the portfolio then held count links
+type countingLinkRepo struct {
+	contracts.PortfolioLinkRepository
+	count int64
+}
+
+func (r countingLinkRepo) Create(_ context.Context, input dto.CreatePortfolioLinkInput, _ int) (*dto.PortfolioLinkDTO, int64, error) {
+	return &dto.PortfolioLinkDTO{ID: 1, PortfolioID: input.PortfolioID, Kind: input.Kind, URL: input.URL}, r.count, nil
+}
+
+// ownPortfolioRepo makes every portfolio belong to "user-1"
+type ownPortfolioRepo struct{ contracts.PortfolioRepository }
+
+func (ownPortfolioRepo) GetByID(_ context.Context, id uint) (*dto.PortfolioDTO, error) {
+	return &dto.PortfolioDTO{ID: id, OwnerID: "user-1"}, nil
+}
+
+func TestPortfolioLinkController_CreateQuotaWarnings(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	// The soft threshold is 80% of MaxLinksPerPortfolio
+	threshold := int64(domainportfolio.MaxLinksPerPortfolio * 8 / 10)
+
+	tests := []struct {
+		name         string
+		count        int64
+		wantWarnings []map[string]interface{}
+	}{
+		{name: "just below the threshold", count: threshold - 1},
+		{name: "at the threshold", count: threshold},
+		{
+			name:  "just above the threshold",
+			count: threshold + 1,
+			wantWarnings: []map[string]interface{}{
+				{"quota": "portfolio_links", "usage": float64(threshold + 1), "limit": float64(domainportfolio.MaxLinksPerPortfolio)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := &PortfolioLinkController{
+				createUseCase: portfolio_link2.NewCreatePortfolioLinkUseCase(countingLinkRepo{count: tt.count}, ownPortfolioRepo{}, nil),
+			}
+			router := gin.New()
+			router.POST("/portfolios/:id/links", func(c *gin.Context) { c.Set("userID", "user-1") }, ctrl.Create)
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/portfolios/1/links",
+				strings.NewReader(`{"kind": "github", "url": "https://github.com/x"}`)))
+
+			// A warning never turns the write into a failure
+			if w.Code != http.StatusCreated {
+				t.Fatalf("status = %d, want 201 (%s)", w.Code, w.Body.String())
+			}
+			var body struct {
+				Data     interface{}               `json:"data"`
+				Warnings *[]map[string]interface{} `json:"warnings"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if body.Data == nil {
+				t.Error("response has no data")
+			}
+
+			if tt.wantWarnings == nil {
+				if body.Warnings != nil {
+					t.Errorf("warnings = %v, want the field omitted", *body.Warnings)
+				}
+				return
+			}
+			if body.Warnings == nil || !reflect.DeepEqual(*body.Warnings, tt.wantWarnings) {
+				t.Errorf("warnings = %v, want %v", body.Warnings, tt.wantWarnings)
+			}
+		})
+	}
+}
@@ -3,14 +3,14 @@ package controllers
 import (
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
 	section_content2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/usecases/section_content"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/usecases/trash"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/interfaces/dto/request"
 	response2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/interfaces/dto/response"
 	"github.com/gin-gonic/gin"
-
-	pkgerrors "github.com/JorgeSaicoski/portfolio-manager/backend/pkg/errors"
 )
 
 // SectionContentController handles HTTP requests for section contents
@@ -21,6 +21,10 @@ type SectionContentController struct {
 	deleteUseCase        *section_content2.DeleteSectionContentUseCase
 	getPublicUseCase     *section_content2.GetSectionContentPublicUseCase
 	listBySectionUseCase *section_content2.ListSectionContentsBySectionUseCase
+	listRevisionsUseCase *section_content2.ListSectionContentRevisionsUseCase
+	getRevisionUseCase   *section_content2.GetSectionContentRevisionUseCase
+	revertUseCase        *section_content2.RevertSectionContentRevisionUseCase
+	findDeletedUseCase   *trash.FindDeletedItemUseCase
 }
 
 // NewSectionContentController creates a new section content controller instance
@@ -31,6 +35,10 @@ func NewSectionContentController(
 	deleteUC *section_content2.DeleteSectionContentUseCase,
 	getPublicUC *section_content2.GetSectionContentPublicUseCase,
 	listBySectionUC *section_content2.ListSectionContentsBySectionUseCase,
+	listRevisionsUC *section_content2.ListSectionContentRevisionsUseCase,
+	getRevisionUC *section_content2.GetSectionContentRevisionUseCase,
+	revertUC *section_content2.RevertSectionContentRevisionUseCase,
+	findDeletedUC *trash.FindDeletedItemUseCase,
 ) *SectionContentController {
 	return &SectionContentController{
 		createUseCase:        createUC,
@@ -39,6 +47,10 @@ func NewSectionContentController(
 		deleteUseCase:        deleteUC,
 		getPublicUseCase:     getPublicUC,
 		listBySectionUseCase: listBySectionUC,
+		listRevisionsUseCase: listRevisionsUC,
+		getRevisionUseCase:   getRevisionUC,
+		revertUseCase:        revertUC,
+		findDeletedUseCase:   findDeletedUC,
 	}
 }
 
@@ -46,13 +58,13 @@ func NewSectionContentController(
 func (ctrl *SectionContentController) Create(c *gin.Context) {
 	userID := c.GetString("userID")
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, response2.ErrorResponse{Error: "unauthorized"})
+		respondUnauthenticated(c)
 		return
 	}
 
 	var req request.CreateSectionContentRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: err.Error()})
+		respondBindingError(c, err)
 		return
 	}
 
@@ -67,8 +79,7 @@ func (ctrl *SectionContentController) Create(c *gin.Context) {
 
 	content, err := ctrl.createUseCase.Execute(c.Request.Context(), input)
 	if err != nil {
-		status := pkgerrors.ToHTTPStatus(err)
-		c.JSON(status, response2.ErrorResponse{Error: err.Error()})
+		respondError(c, err)
 		return
 	}
 
@@ -80,6 +91,8 @@ func (ctrl *SectionContentController) Create(c *gin.Context) {
 		Order:     content.Order,
 		ImageID:   content.ImageID,
 		OwnerID:   content.OwnerID,
+		CreatedBy: content.CreatedBy,
+		UpdatedBy: content.UpdatedBy,
 		CreatedAt: content.CreatedAt,
 		UpdatedAt: content.UpdatedAt,
 	}
@@ -94,20 +107,20 @@ func (ctrl *SectionContentController) Create(c *gin.Context) {
 func (ctrl *SectionContentController) Update(c *gin.Context) {
 	userID := c.GetString("userID")
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, response2.ErrorResponse{Error: "unauthorized"})
+		respondUnauthenticated(c)
 		return
 	}
 
 	idParam := c.Param("id")
 	id, err := strconv.ParseUint(idParam, 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: "invalid section content ID"})
+		respondInvalidID(c, "section content")
 		return
 	}
 
 	var req request.UpdateSectionContentRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: err.Error()})
+		respondBindingError(c, err)
 		return
 	}
 
@@ -121,8 +134,7 @@ func (ctrl *SectionContentController) Update(c *gin.Context) {
 	}
 
 	if err := ctrl.updateUseCase.Execute(c.Request.Context(), input); err != nil {
-		status := pkgerrors.ToHTTPStatus(err)
-		c.JSON(status, response2.ErrorResponse{Error: err.Error()})
+		respondOwnItemError(c, ctrl.findDeletedUseCase, err, dto.TrashResourceSectionContent, uint(id))
 		return
 	}
 
@@ -136,26 +148,25 @@ func (ctrl *SectionContentController) Update(c *gin.Context) {
 func (ctrl *SectionContentController) UpdateOrder(c *gin.Context) {
 	userID := c.GetString("userID")
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, response2.ErrorResponse{Error: "unauthorized"})
+		respondUnauthenticated(c)
 		return
 	}
 
 	idParam := c.Param("id")
 	id, err := strconv.ParseUint(idParam, 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: "invalid section content ID"})
+		respondInvalidID(c, "section content")
 		return
 	}
 
 	var req request.UpdateSectionContentOrderRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: err.Error()})
+		respondBindingError(c, err)
 		return
 	}
 
 	if err := ctrl.updateOrderUseCase.Execute(c.Request.Context(), uint(id), req.Order, userID); err != nil {
-		status := pkgerrors.ToHTTPStatus(err)
-		c.JSON(status, response2.ErrorResponse{Error: err.Error()})
+		respondOwnItemError(c, ctrl.findDeletedUseCase, err, dto.TrashResourceSectionContent, uint(id))
 		return
 	}
 
@@ -169,20 +180,19 @@ func (ctrl *SectionContentController) UpdateOrder(c *gin.Context) {
 func (ctrl *SectionContentController) Delete(c *gin.Context) {
 	userID := c.GetString("userID")
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, response2.ErrorResponse{Error: "unauthorized"})
+		respondUnauthenticated(c)
 		return
 	}
 
 	idParam := c.Param("id")
 	id, err := strconv.ParseUint(idParam, 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: "invalid section content ID"})
+		respondInvalidID(c, "section content")
 		return
 	}
 
 	if err := ctrl.deleteUseCase.Execute(c.Request.Context(), uint(id), userID); err != nil {
-		status := pkgerrors.ToHTTPStatus(err)
-		c.JSON(status, response2.ErrorResponse{Error: err.Error()})
+		respondOwnItemError(c, ctrl.findDeletedUseCase, err, dto.TrashResourceSectionContent, uint(id))
 		return
 	}
 
@@ -192,19 +202,152 @@ func (ctrl *SectionContentController) Delete(c *gin.Context) {
 	})
 }
 
+// ListRevisions handles GET /api/section-contents/own/:id/revisions
+func (ctrl *SectionContentController) ListRevisions(c *gin.Context) {
+	userID := c.GetString("userID")
+	if userID == "" {
+		respondUnauthenticated(c)
+		return
+	}
+
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		respondInvalidID(c, "section content")
+		return
+	}
+
+	revisions, err := ctrl.listRevisionsUseCase.Execute(c.Request.Context(), uint(id), userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// The listing carries metadata only; full content is fetched per revision
+	resp := make([]response2.SectionContentRevisionResponse, len(revisions))
+	for i, revision := range revisions {
+		resp[i] = toSectionContentRevisionResponse(revision, false)
+	}
+
+	c.JSON(http.StatusOK, response2.DataResponse{
+		Data:    resp,
+		Message: "Success",
+	})
+}
+
+// GetRevision handles GET /api/section-contents/own/:id/revisions/:revId
+func (ctrl *SectionContentController) GetRevision(c *gin.Context) {
+	userID := c.GetString("userID")
+	if userID == "" {
+		respondUnauthenticated(c)
+		return
+	}
+
+	id, revisionID, ok := parseRevisionParams(c)
+	if !ok {
+		return
+	}
+
+	revision, err := ctrl.getRevisionUseCase.Execute(c.Request.Context(), id, revisionID, userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response2.DataResponse{
+		Data:    toSectionContentRevisionResponse(*revision, true),
+		Message: "Success",
+	})
+}
+
+// RevertRevision handles POST /api/section-contents/own/:id/revisions/:revId/revert
+func (ctrl *SectionContentController) RevertRevision(c *gin.Context) {
+	userID := c.GetString("userID")
+	if userID == "" {
+		respondUnauthenticated(c)
+		return
+	}
+
+	id, revisionID, ok := parseRevisionParams(c)
+	if !ok {
+		return
+	}
+
+	content, err := ctrl.revertUseCase.Execute(c.Request.Context(), id, revisionID, userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	resp := response2.SectionContentResponse{
+		ID:        content.ID,
+		SectionID: content.SectionID,
+		Type:      content.Type,
+		Content:   content.Content,
+		Order:     content.Order,
+		ImageID:   content.ImageID,
+		OwnerID:   content.OwnerID,
+		CreatedBy: content.CreatedBy,
+		UpdatedBy: content.UpdatedBy,
+		CreatedAt: content.CreatedAt,
+		UpdatedAt: content.UpdatedAt,
+	}
+
+	c.JSON(http.StatusOK, response2.DataResponse{
+		Data:    resp,
+		Message: "Section content reverted",
+	})
+}
+
+// parseRevisionParams parses the :id and :revId URL parameters, writing a 400 on failure
+func parseRevisionParams(c *gin.Context) (uint, uint, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		respondInvalidID(c, "section content")
+		return 0, 0, false
+	}
+
+	revisionID, err := strconv.ParseUint(c.Param("revId"), 10, 32)
+	if err != nil {
+		respondInvalidID(c, "revision")
+		return 0, 0, false
+	}
+
+	return uint(id), uint(revisionID), true
+}
+
+// toSectionContentRevisionResponse maps a revision DTO, optionally with its full content
+func toSectionContentRevisionResponse(revision dto.SectionContentRevisionDTO, withContent bool) response2.SectionContentRevisionResponse {
+	resp := response2.SectionContentRevisionResponse{
+		ID:               revision.ID,
+		SectionContentID: revision.SectionContentID,
+		Type:             revision.Type,
+		ImageID:          revision.ImageID,
+		Size:             revision.Size,
+		SizeDelta:        revision.SizeDelta,
+		CreatedAt:        revision.CreatedAt,
+	}
+	if withContent {
+		resp.Content = revision.Content
+	}
+	return resp
+}
+
 // GetByID handles GET /api/section-contents/:id
 func (ctrl *SectionContentController) GetByID(c *gin.Context) {
 	idParam := c.Param("id")
 	id, err := strconv.ParseUint(idParam, 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: "invalid section content ID"})
+		respondInvalidID(c, "section content")
 		return
 	}
 
 	content, err := ctrl.getPublicUseCase.Execute(c.Request.Context(), uint(id))
 	if err != nil {
-		status := pkgerrors.ToHTTPStatus(err)
-		c.JSON(status, response2.ErrorResponse{Error: err.Error()})
+		respondError(c, err)
+		return
+	}
+	if respondNotModified(c, weakETag(content.ID, content.UpdatedAt)) {
 		return
 	}
 
@@ -231,14 +374,20 @@ func (ctrl *SectionContentController) ListBySection(c *gin.Context) {
 	sectionIDParam := c.Param("sectionId")
 	sectionID, err := strconv.ParseUint(sectionIDParam, 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: "invalid section ID"})
+		respondInvalidID(c, "section")
 		return
 	}
 
 	contents, err := ctrl.listBySectionUseCase.Execute(c.Request.Context(), uint(sectionID))
 	if err != nil {
-		status := pkgerrors.ToHTTPStatus(err)
-		c.JSON(status, response2.ErrorResponse{Error: err.Error()})
+		respondError(c, err)
+		return
+	}
+	updates := make([]time.Time, len(contents))
+	for i, content := range contents {
+		updates[i] = content.UpdatedAt
+	}
+	if respondNotModified(c, weakETag(sectionID, len(contents), latestUpdate(updates...))) {
 		return
 	}
 
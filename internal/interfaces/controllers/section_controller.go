@@ -4,13 +4,13 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
 	section2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/usecases/section"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/usecases/trash"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/interfaces/dto/request"
 	response2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/interfaces/dto/response"
 	"github.com/gin-gonic/gin"
-
-	pkgerrors "github.com/JorgeSaicoski/portfolio-manager/backend/pkg/errors"
 )
 
 // SectionController handles HTTP requests for section operations
@@ -23,6 +23,9 @@ type SectionController struct {
 	updatePositionUseCase *section2.UpdateSectionPositionUseCase
 	bulkReorderUseCase    *section2.BulkReorderSectionsUseCase
 	deleteUseCase         *section2.DeleteSectionUseCase
+	listByTypeUseCase     *section2.ListSectionsByTypeUseCase
+	swapUseCase           *section2.SwapSectionPositionsUseCase
+	findDeletedUseCase    *trash.FindDeletedItemUseCase
 }
 
 // NewSectionController creates a new section controller instance
@@ -35,6 +38,9 @@ func NewSectionController(
 	updatePositionUC *section2.UpdateSectionPositionUseCase,
 	bulkReorderUC *section2.BulkReorderSectionsUseCase,
 	deleteUC *section2.DeleteSectionUseCase,
+	listByTypeUC *section2.ListSectionsByTypeUseCase,
+	swapUC *section2.SwapSectionPositionsUseCase,
+	findDeletedUC *trash.FindDeletedItemUseCase,
 ) *SectionController {
 	return &SectionController{
 		createUseCase:         createUC,
@@ -45,6 +51,9 @@ func NewSectionController(
 		updatePositionUseCase: updatePositionUC,
 		bulkReorderUseCase:    bulkReorderUC,
 		deleteUseCase:         deleteUC,
+		listByTypeUseCase:     listByTypeUC,
+		swapUseCase:           swapUC,
+		findDeletedUseCase:    findDeletedUC,
 	}
 }
 
@@ -53,14 +62,14 @@ func (ctrl *SectionController) Create(c *gin.Context) {
 	// Extract userID from context (set by auth middleware)
 	userID := c.GetString("userID")
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, response2.ErrorResponse{Error: "unauthorized: missing user ID"})
+		respondUnauthenticated(c)
 		return
 	}
 
 	// Bind and validate HTTP request DTO
 	var req request.CreateSectionRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: err.Error()})
+		respondBindingError(c, err)
 		return
 	}
 
@@ -77,8 +86,7 @@ func (ctrl *SectionController) Create(c *gin.Context) {
 	// Execute use case
 	sectionDTO, err := ctrl.createUseCase.Execute(c.Request.Context(), input)
 	if err != nil {
-		status := pkgerrors.ToHTTPStatus(err)
-		c.JSON(status, response2.ErrorResponse{Error: err.Error()})
+		respondError(c, err)
 		return
 	}
 
@@ -86,10 +94,13 @@ func (ctrl *SectionController) Create(c *gin.Context) {
 	resp := response2.SectionResponse{
 		ID:          sectionDTO.ID,
 		Title:       sectionDTO.Title,
+		Slug:        sectionDTO.Slug,
 		Description: sectionDTO.Description,
 		Position:    sectionDTO.Position,
 		Type:        sectionDTO.Type,
 		OwnerID:     sectionDTO.OwnerID,
+		CreatedBy:   sectionDTO.CreatedBy,
+		UpdatedBy:   sectionDTO.UpdatedBy,
 		PortfolioID: sectionDTO.PortfolioID,
 		CreatedAt:   sectionDTO.CreatedAt,
 		UpdatedAt:   sectionDTO.UpdatedAt,
@@ -107,39 +118,85 @@ func (ctrl *SectionController) List(c *gin.Context) {
 	// Extract userID from context (set by auth middleware)
 	userID := c.GetString("userID")
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, response2.ErrorResponse{Error: "unauthorized: missing user ID"})
+		respondUnauthenticated(c)
 		return
 	}
 
 	// Bind and validate query parameters
 	var req request.ListSectionsRequest
 	if err := c.ShouldBindQuery(&req); err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: err.Error()})
+		respondBindingError(c, err)
 		return
 	}
 
-	// Set default pagination values if not provided
-	if req.Page == 0 {
-		req.Page = 1
-	}
-	if req.Limit == 0 {
-		req.Limit = 10
-	}
-
 	// Map to application DTO
 	input := dto.ListSectionsInput{
-		PortfolioID: 0, // List all sections for user
-		Pagination: dto.PaginationDTO{
-			Page:  req.Page,
-			Limit: req.Limit,
-		},
+		OwnerID:    userID,
+		Pagination: paginationInput(req.PaginationQuery),
 	}
 
 	// Execute use case
 	output, err := ctrl.listUseCase.Execute(c.Request.Context(), input)
 	if err != nil {
-		status := pkgerrors.ToHTTPStatus(err)
-		c.JSON(status, response2.ErrorResponse{Error: err.Error()})
+		respondError(c, err)
+		return
+	}
+
+	// Map to HTTP response DTOs
+	sections := make([]response2.SectionResponse, len(output.Sections))
+	for i, sec := range output.Sections {
+		sections[i] = response2.SectionResponse{
+			ID:          sec.ID,
+			Title:       sec.Title,
+			Slug:        sec.Slug,
+			Description: sec.Description,
+			Position:    sec.Position,
+			Type:        sec.Type,
+			OwnerID:     sec.OwnerID,
+			CreatedBy:   sec.CreatedBy,
+			UpdatedBy:   sec.UpdatedBy,
+			PortfolioID: sec.PortfolioID,
+			CreatedAt:   sec.CreatedAt,
+			UpdatedAt:   sec.UpdatedAt,
+		}
+	}
+
+	// Return HTTP response with API_OVERVIEW.md format
+	c.JSON(http.StatusOK, response2.PaginatedDataResponse{
+		Data:    sections,
+		Page:    output.Pagination.Page,
+		Limit:   output.Pagination.Limit,
+		Total:   output.Pagination.Total,
+		Message: "Success",
+	})
+}
+
+// ListByType handles GET /api/sections/own/type/:type
+// Only ever returns the caller's sections; portfolio_id narrows them to one of the caller's portfolios
+func (ctrl *SectionController) ListByType(c *gin.Context) {
+	// Extract userID from context (set by auth middleware)
+	userID := c.GetString("userID")
+	if userID == "" {
+		respondUnauthenticated(c)
+		return
+	}
+
+	// Bind and validate query parameters
+	var req request.ListSectionsByTypeRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		respondBindingError(c, err)
+		return
+	}
+
+	// Execute use case
+	output, err := ctrl.listByTypeUseCase.Execute(c.Request.Context(), dto.ListSectionsByTypeInput{
+		OwnerID:     userID,
+		Type:        c.Param("type"),
+		PortfolioID: req.PortfolioID,
+		Pagination:  paginationInput(req.PaginationQuery),
+	})
+	if err != nil {
+		respondError(c, err)
 		return
 	}
 
@@ -149,10 +206,13 @@ func (ctrl *SectionController) List(c *gin.Context) {
 		sections[i] = response2.SectionResponse{
 			ID:          sec.ID,
 			Title:       sec.Title,
+			Slug:        sec.Slug,
 			Description: sec.Description,
 			Position:    sec.Position,
 			Type:        sec.Type,
 			OwnerID:     sec.OwnerID,
+			CreatedBy:   sec.CreatedBy,
+			UpdatedBy:   sec.UpdatedBy,
 			PortfolioID: sec.PortfolioID,
 			CreatedAt:   sec.CreatedAt,
 			UpdatedAt:   sec.UpdatedAt,
@@ -174,7 +234,7 @@ func (ctrl *SectionController) GetByID(c *gin.Context) {
 	// Extract userID from context (set by auth middleware)
 	userID := c.GetString("userID")
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, response2.ErrorResponse{Error: "unauthorized: missing user ID"})
+		respondUnauthenticated(c)
 		return
 	}
 
@@ -182,15 +242,14 @@ func (ctrl *SectionController) GetByID(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := strconv.ParseUint(idStr, 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: "invalid section ID"})
+		respondInvalidID(c, "section")
 		return
 	}
 
 	// Execute use case
 	sectionDTO, err := ctrl.getUseCase.Execute(c.Request.Context(), uint(id), userID)
 	if err != nil {
-		status := pkgerrors.ToHTTPStatus(err)
-		c.JSON(status, response2.ErrorResponse{Error: err.Error()})
+		respondOwnItemError(c, ctrl.findDeletedUseCase, err, dto.TrashResourceSection, uint(id))
 		return
 	}
 
@@ -198,10 +257,13 @@ func (ctrl *SectionController) GetByID(c *gin.Context) {
 	resp := response2.SectionResponse{
 		ID:          sectionDTO.ID,
 		Title:       sectionDTO.Title,
+		Slug:        sectionDTO.Slug,
 		Description: sectionDTO.Description,
 		Position:    sectionDTO.Position,
 		Type:        sectionDTO.Type,
 		OwnerID:     sectionDTO.OwnerID,
+		CreatedBy:   sectionDTO.CreatedBy,
+		UpdatedBy:   sectionDTO.UpdatedBy,
 		PortfolioID: sectionDTO.PortfolioID,
 		CreatedAt:   sectionDTO.CreatedAt,
 		UpdatedAt:   sectionDTO.UpdatedAt,
@@ -219,7 +281,7 @@ func (ctrl *SectionController) Update(c *gin.Context) {
 	// Extract userID from context (set by auth middleware)
 	userID := c.GetString("userID")
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, response2.ErrorResponse{Error: "unauthorized: missing user ID"})
+		respondUnauthenticated(c)
 		return
 	}
 
@@ -227,14 +289,14 @@ func (ctrl *SectionController) Update(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := strconv.ParseUint(idStr, 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: "invalid section ID"})
+		respondInvalidID(c, "section")
 		return
 	}
 
 	// Bind and validate HTTP request DTO
 	var req request.UpdateSectionRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: err.Error()})
+		respondBindingError(c, err)
 		return
 	}
 
@@ -251,8 +313,7 @@ func (ctrl *SectionController) Update(c *gin.Context) {
 	// Execute use case
 	err = ctrl.updateUseCase.Execute(c.Request.Context(), input)
 	if err != nil {
-		status := pkgerrors.ToHTTPStatus(err)
-		c.JSON(status, response2.ErrorResponse{Error: err.Error()})
+		respondOwnItemError(c, ctrl.findDeletedUseCase, err, dto.TrashResourceSection, uint(id))
 		return
 	}
 
@@ -268,7 +329,7 @@ func (ctrl *SectionController) UpdatePosition(c *gin.Context) {
 	// Extract userID from context (set by auth middleware)
 	userID := c.GetString("userID")
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, response2.ErrorResponse{Error: "unauthorized: missing user ID"})
+		respondUnauthenticated(c)
 		return
 	}
 
@@ -276,22 +337,21 @@ func (ctrl *SectionController) UpdatePosition(c *gin.Context) {
 	idStr := c.Param("id")
 	sectionID, err := strconv.ParseUint(idStr, 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: "invalid section ID"})
+		respondInvalidID(c, "section")
 		return
 	}
 
 	// Bind and validate HTTP request DTO
 	var req request.UpdateSectionPositionRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: err.Error()})
+		respondBindingError(c, err)
 		return
 	}
 
 	// Execute use case
 	err = ctrl.updatePositionUseCase.Execute(c.Request.Context(), uint(sectionID), req.Position, userID)
 	if err != nil {
-		status := pkgerrors.ToHTTPStatus(err)
-		c.JSON(status, response2.ErrorResponse{Error: err.Error()})
+		respondError(c, err)
 		return
 	}
 
@@ -307,14 +367,14 @@ func (ctrl *SectionController) BulkReorder(c *gin.Context) {
 	// Extract userID from context (set by auth middleware)
 	userID := c.GetString("userID")
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, response2.ErrorResponse{Error: "unauthorized: missing user ID"})
+		respondUnauthenticated(c)
 		return
 	}
 
 	// Bind and validate HTTP request DTO
 	var req request.BulkReorderSectionsRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: err.Error()})
+		respondBindingError(c, err)
 		return
 	}
 
@@ -334,8 +394,7 @@ func (ctrl *SectionController) BulkReorder(c *gin.Context) {
 
 	// Execute use case
 	if err := ctrl.bulkReorderUseCase.Execute(c.Request.Context(), input); err != nil {
-		status := pkgerrors.ToHTTPStatus(err)
-		c.JSON(status, response2.ErrorResponse{Error: err.Error()})
+		respondError(c, err)
 		return
 	}
 
@@ -346,12 +405,66 @@ func (ctrl *SectionController) BulkReorder(c *gin.Context) {
 	})
 }
 
+// Swap handles POST /api/sections/own/swap
+// Exchanges the positions of two sections of the same portfolio atomically
+func (ctrl *SectionController) Swap(c *gin.Context) {
+	// Extract userID from context (set by auth middleware)
+	userID := c.GetString("userID")
+	if userID == "" {
+		respondUnauthenticated(c)
+		return
+	}
+
+	// Bind and validate HTTP request DTO
+	var req request.SwapPositionsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err)
+		return
+	}
+
+	// Execute use case
+	swapped, err := ctrl.swapUseCase.Execute(c.Request.Context(), dto.SwapPositionsInput{
+		FirstID:  req.FirstID,
+		SecondID: req.SecondID,
+		OwnerID:  userID,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// Map to HTTP response DTOs
+	resp := make([]response2.SectionResponse, len(swapped))
+	for i, item := range swapped {
+		resp[i] = response2.SectionResponse{
+			ID:          item.ID,
+			Title:       item.Title,
+			Slug:        item.Slug,
+			Description: item.Description,
+			Position:    item.Position,
+			Type:        item.Type,
+			OwnerID:     item.OwnerID,
+			CreatedBy:   item.CreatedBy,
+			UpdatedBy:   item.UpdatedBy,
+			PortfolioID: item.PortfolioID,
+			CreatedAt:   item.CreatedAt,
+			UpdatedAt:   item.UpdatedAt,
+		}
+	}
+
+	// Return HTTP response with API_OVERVIEW.md format
+	c.JSON(http.StatusOK, response2.DataResponse{
+		Data:    resp,
+		Message: "Sections swapped successfully",
+	})
+}
+
 // Delete handles DELETE /api/sections/own/:id
 func (ctrl *SectionController) Delete(c *gin.Context) {
 	// Extract userID from context (set by auth middleware)
 	userID := c.GetString("userID")
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, response2.ErrorResponse{Error: "unauthorized: missing user ID"})
+		respondUnauthenticated(c)
 		return
 	}
 
@@ -359,15 +472,14 @@ func (ctrl *SectionController) Delete(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := strconv.ParseUint(idStr, 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: "invalid section ID"})
+		respondInvalidID(c, "section")
 		return
 	}
 
 	// Execute use case
 	err = ctrl.deleteUseCase.Execute(c.Request.Context(), uint(id), userID)
 	if err != nil {
-		status := pkgerrors.ToHTTPStatus(err)
-		c.JSON(status, response2.ErrorResponse{Error: err.Error()})
+		respondOwnItemError(c, ctrl.findDeletedUseCase, err, dto.TrashResourceSection, uint(id))
 		return
 	}
 
@@ -384,15 +496,17 @@ func (ctrl *SectionController) GetPublicByID(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := strconv.ParseUint(idStr, 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: "invalid section ID"})
+		respondInvalidID(c, "section")
 		return
 	}
 
 	// Execute use case (no auth required for public access)
 	sectionDTO, err := ctrl.getPublicUseCase.Execute(c.Request.Context(), uint(id))
 	if err != nil {
-		status := pkgerrors.ToHTTPStatus(err)
-		c.JSON(status, response2.ErrorResponse{Error: err.Error()})
+		respondError(c, err)
+		return
+	}
+	if respondNotModified(c, weakETag(sectionDTO.ID, sectionDTO.UpdatedAt)) {
 		return
 	}
 
@@ -400,6 +514,7 @@ func (ctrl *SectionController) GetPublicByID(c *gin.Context) {
 	resp := response2.SectionResponse{
 		ID:          sectionDTO.ID,
 		Title:       sectionDTO.Title,
+		Slug:        sectionDTO.Slug,
 		Description: sectionDTO.Description,
 		Position:    sectionDTO.Position,
 		Type:        sectionDTO.Type,
@@ -422,10 +537,10 @@ func (ctrl *SectionController) GetPublicSectionContents(c *gin.Context) {
 	idStr := c.Param("id")
 	_, err := strconv.ParseUint(idStr, 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: "invalid section ID"})
+		respondInvalidID(c, "section")
 		return
 	}
 
 	// TODO: Implement section contents retrieval when SectionContent domain is ready
-	c.JSON(http.StatusNotImplemented, response2.ErrorResponse{Error: "not implemented yet"})
+	respondErrorCode(c, http.StatusNotImplemented, apperrors.CodeNotImplemented, "not implemented yet")
 }
@@ -0,0 +1,63 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/usecases/title"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/interfaces/dto/request"
+	response2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/interfaces/dto/response"
+	"github.com/gin-gonic/gin"
+)
+
+// TitleController handles the as-you-type title availability checks of every resource
+type TitleController struct {
+	checkUseCase *title.CheckTitleAvailabilityUseCase
+}
+
+// NewTitleController creates a new title controller instance
+func NewTitleController(checkUC *title.CheckTitleAvailabilityUseCase) *TitleController {
+	return &TitleController{checkUseCase: checkUC}
+}
+
+// CheckTitle returns the handler of GET /api/{resource}/own/check-title?title=&parent_id=&exclude_id=
+// resource is one of the dto.TitleResource* constants
+func (ctrl *TitleController) CheckTitle(resource string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// Extract userID from context (set by auth middleware)
+		userID := c.GetString("userID")
+		if userID == "" {
+			respondUnauthenticated(c)
+			return
+		}
+
+		// Bind and validate query parameters
+		var req request.CheckTitleRequest
+		if err := c.ShouldBindQuery(&req); err != nil {
+			respondBindingError(c, err)
+			return
+		}
+
+		// Execute use case
+		availability, err := ctrl.checkUseCase.Execute(c.Request.Context(), dto.CheckTitleInput{
+			Resource:  resource,
+			Title:     req.Title,
+			ParentID:  req.ParentID,
+			ExcludeID: req.ExcludeID,
+			OwnerID:   userID,
+		})
+		if err != nil {
+			respondError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, response2.DataResponse{
+			Data: response2.TitleAvailabilityResponse{
+				Available:     availability.Available,
+				ConflictingID: availability.ConflictingID,
+				Suggested:     availability.Suggested,
+			},
+			Message: "Success",
+		})
+	}
+}
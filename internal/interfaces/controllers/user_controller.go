@@ -1,30 +1,45 @@
 package controllers
 
 import (
+	"fmt"
 	"net/http"
 
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/dto"
 	user2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/usecases/user"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/interfaces/dto/request"
 	response2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/interfaces/dto/response"
 	"github.com/gin-gonic/gin"
-
-	pkgerrors "github.com/JorgeSaicoski/portfolio-manager/backend/pkg/errors"
 )
 
 // UserController handles HTTP requests for user operations
 type UserController struct {
 	getCurrentUserUC *user2.GetCurrentUserUseCase
 	updateUserUC     *user2.UpdateCurrentUserUseCase
+	getSettingsUC    *user2.GetUserSettingsUseCase
+	updateSettingsUC *user2.UpdateUserSettingsUseCase
+	updateDefaultsUC *user2.UpdateProjectDefaultsUseCase
+	resolveDefaultUC *user2.ResolveDefaultPortfolioUseCase
+	getBootstrapUC   *user2.GetBootstrapUseCase
 }
 
 // NewUserController creates a new user controller instance
 func NewUserController(
 	getCurrentUserUC *user2.GetCurrentUserUseCase,
 	updateUserUC *user2.UpdateCurrentUserUseCase,
+	getSettingsUC *user2.GetUserSettingsUseCase,
+	updateSettingsUC *user2.UpdateUserSettingsUseCase,
+	updateDefaultsUC *user2.UpdateProjectDefaultsUseCase,
+	resolveDefaultUC *user2.ResolveDefaultPortfolioUseCase,
+	getBootstrapUC *user2.GetBootstrapUseCase,
 ) *UserController {
 	return &UserController{
 		getCurrentUserUC: getCurrentUserUC,
 		updateUserUC:     updateUserUC,
+		getSettingsUC:    getSettingsUC,
+		updateSettingsUC: updateSettingsUC,
+		updateDefaultsUC: updateDefaultsUC,
+		resolveDefaultUC: resolveDefaultUC,
+		getBootstrapUC:   getBootstrapUC,
 	}
 }
 
@@ -33,15 +48,14 @@ func (ctrl *UserController) GetMe(c *gin.Context) {
 	// Extract userID from context (set by auth middleware)
 	userID := c.GetString("userID")
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, response2.ErrorResponse{Error: "unauthorized"})
+		respondUnauthenticated(c)
 		return
 	}
 
 	// Execute use case
 	userDTO, err := ctrl.getCurrentUserUC.Execute(c.Request.Context(), userID)
 	if err != nil {
-		status := pkgerrors.ToHTTPStatus(err)
-		c.JSON(status, response2.ErrorResponse{Error: err.Error()})
+		respondError(c, err)
 		return
 	}
 
@@ -66,14 +80,14 @@ func (ctrl *UserController) UpdateMe(c *gin.Context) {
 	// Extract userID from context (set by auth middleware)
 	userID := c.GetString("userID")
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, response2.ErrorResponse{Error: "unauthorized"})
+		respondUnauthenticated(c)
 		return
 	}
 
 	// Bind and validate HTTP request DTO
 	var req request.UpdateUserRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, response2.ErrorResponse{Error: err.Error()})
+		respondBindingError(c, err)
 		return
 	}
 
@@ -86,8 +100,7 @@ func (ctrl *UserController) UpdateMe(c *gin.Context) {
 	// Execute use case
 	userDTO, err := ctrl.updateUserUC.Execute(c.Request.Context(), input)
 	if err != nil {
-		status := pkgerrors.ToHTTPStatus(err)
-		c.JSON(status, response2.ErrorResponse{Error: err.Error()})
+		respondError(c, err)
 		return
 	}
 
@@ -106,3 +119,254 @@ func (ctrl *UserController) UpdateMe(c *gin.Context) {
 		Message: "Success",
 	})
 }
+
+// GetSettings handles GET /api/users/me/settings
+func (ctrl *UserController) GetSettings(c *gin.Context) {
+	// Extract userID from context (set by auth middleware)
+	userID := c.GetString("userID")
+	if userID == "" {
+		respondUnauthenticated(c)
+		return
+	}
+
+	// Execute use case
+	settings, err := ctrl.getSettingsUC.Execute(c.Request.Context(), userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// Return HTTP response
+	c.JSON(http.StatusOK, response2.DataResponse{
+		Data:    ctrl.toUserSettingsResponse(c, settings),
+		Message: "Success",
+	})
+}
+
+// UpdateSettings handles PATCH /api/users/me/settings
+func (ctrl *UserController) UpdateSettings(c *gin.Context) {
+	// Extract userID from context (set by auth middleware)
+	userID := c.GetString("userID")
+	if userID == "" {
+		respondUnauthenticated(c)
+		return
+	}
+
+	// Bind and validate HTTP request DTO
+	var req request.UpdateUserSettingsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err)
+		return
+	}
+
+	// Execute use case
+	settings, err := ctrl.updateSettingsUC.Execute(c.Request.Context(), dto.UpdateUserSettingsInput{
+		UserID:             userID,
+		DefaultPortfolioID: req.DefaultPortfolioID,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// Return HTTP response
+	c.JSON(http.StatusOK, response2.DataResponse{
+		Data:    ctrl.toUserSettingsResponse(c, settings),
+		Message: "Settings updated successfully",
+	})
+}
+
+// GetProjectDefaults handles GET /api/users/me/settings/project-defaults
+func (ctrl *UserController) GetProjectDefaults(c *gin.Context) {
+	// Extract userID from context (set by auth middleware)
+	userID := c.GetString("userID")
+	if userID == "" {
+		respondUnauthenticated(c)
+		return
+	}
+
+	// Execute use case
+	settings, err := ctrl.getSettingsUC.Execute(c.Request.Context(), userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// Return HTTP response
+	c.JSON(http.StatusOK, response2.DataResponse{
+		Data:    toProjectDefaultsResponse(settings.ProjectDefaults),
+		Message: "Success",
+	})
+}
+
+// UpdateProjectDefaults handles PATCH /api/users/me/settings/project-defaults
+func (ctrl *UserController) UpdateProjectDefaults(c *gin.Context) {
+	// Extract userID from context (set by auth middleware)
+	userID := c.GetString("userID")
+	if userID == "" {
+		respondUnauthenticated(c)
+		return
+	}
+
+	// Bind and validate HTTP request DTO
+	var req request.UpdateProjectDefaultsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err)
+		return
+	}
+
+	// Execute use case
+	settings, err := ctrl.updateDefaultsUC.Execute(c.Request.Context(), dto.UpdateProjectDefaultsInput{
+		UserID:     userID,
+		Client:     req.Client,
+		Skills:     req.Skills,
+		Categories: req.Categories,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// Return HTTP response
+	c.JSON(http.StatusOK, response2.DataResponse{
+		Data:    toProjectDefaultsResponse(settings.ProjectDefaults),
+		Message: "Project defaults updated successfully",
+	})
+}
+
+// GetBootstrap handles GET /api/users/me/bootstrap
+// Replaces the login-time waterfall: a section that fails is marked with an error instead of failing the request
+func (ctrl *UserController) GetBootstrap(c *gin.Context) {
+	// Extract userID from context (set by auth middleware)
+	userID := c.GetString("userID")
+	if userID == "" {
+		respondUnauthenticated(c)
+		return
+	}
+
+	// Execute use case
+	bootstrap, err := ctrl.getBootstrapUC.Execute(c.Request.Context(), userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	var userResp interface{}
+	if bootstrap.User != nil {
+		userResp = response2.UserResponse{
+			ID:        bootstrap.User.ID,
+			Email:     bootstrap.User.Email,
+			Name:      bootstrap.User.Name,
+			CreatedAt: bootstrap.User.CreatedAt,
+			UpdatedAt: bootstrap.User.UpdatedAt,
+		}
+	}
+
+	var settingsResp interface{}
+	if bootstrap.Settings != nil {
+		settings := ctrl.toUserSettingsResponse(c, bootstrap.Settings)
+		settingsResp = response2.BootstrapSettingsResponse{
+			UserSettingsResponse: settings,
+			ProjectDefaults:      toProjectDefaultsResponse(bootstrap.Settings.ProjectDefaults),
+		}
+	}
+
+	portfolios := make([]response2.PortfolioSummaryResponse, len(bootstrap.Portfolios))
+	for i, summary := range bootstrap.Portfolios {
+		portfolios[i] = response2.PortfolioSummaryResponse{
+			ID:         summary.ID,
+			Title:      summary.Title,
+			UpdatedAt:  summary.UpdatedAt,
+			Categories: summary.Categories,
+			Sections:   summary.Sections,
+			Projects:   summary.Projects,
+		}
+	}
+
+	activity := make([]response2.ChangeEventResponse, len(bootstrap.Activity))
+	for i, event := range bootstrap.Activity {
+		activity[i] = response2.ChangeEventResponse{
+			Resource:   event.Resource,
+			ResourceID: event.ResourceID,
+			Action:     event.Action,
+			UpdatedAt:  event.UpdatedAt,
+		}
+	}
+
+	// Return HTTP response
+	c.JSON(http.StatusOK, response2.DataResponse{
+		Data: response2.BootstrapResponse{
+			User:       toBootstrapSection(bootstrap, dto.BootstrapSectionUser, userResp),
+			Settings:   toBootstrapSection(bootstrap, dto.BootstrapSectionSettings, settingsResp),
+			Portfolios: toBootstrapSection(bootstrap, dto.BootstrapSectionPortfolios, portfolios),
+			Activity:   toBootstrapSection(bootstrap, dto.BootstrapSectionActivity, activity),
+		},
+		Message: "Success",
+	})
+}
+
+// GetPublicDefaultPortfolio handles GET /api/users/public/:userId/default-portfolio
+// Lets the frontend router resolve a profile URL without knowing the portfolio ID
+func (ctrl *UserController) GetPublicDefaultPortfolio(c *gin.Context) {
+	userID := c.Param("userId")
+	if userID == "" {
+		respondInvalidID(c, "user")
+		return
+	}
+
+	// Execute use case (no auth required for public access)
+	resolved, err := ctrl.resolveDefaultUC.Execute(c.Request.Context(), userID)
+	if err == nil && !resolved.Portfolio.IsPublished {
+		// A draft default stays private; the owner still sees it in their settings
+		err = fmt.Errorf("default portfolio not found")
+	}
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response2.DataResponse{
+		Data: response2.DefaultPortfolioResponse{
+			ID:       resolved.Portfolio.ID,
+			Title:    resolved.Portfolio.Title,
+			Explicit: resolved.Explicit,
+		},
+		Message: "Success",
+	})
+}
+
+// toUserSettingsResponse maps settings to their HTTP response, resolving the effective default
+// A user without a resolvable default (several portfolios, none chosen) gets a null resolved ID
+func (ctrl *UserController) toUserSettingsResponse(c *gin.Context, settings *dto.UserSettingsDTO) response2.UserSettingsResponse {
+	resp := response2.UserSettingsResponse{DefaultPortfolioID: settings.DefaultPortfolioID}
+	if resolved, err := ctrl.resolveDefaultUC.Execute(c.Request.Context(), settings.UserID); err == nil {
+		resp.ResolvedPortfolioID = &resolved.Portfolio.ID
+	}
+	return resp
+}
+
+// toProjectDefaultsResponse maps project defaults to their HTTP response (empty collections, never null)
+func toProjectDefaultsResponse(defaults dto.ProjectDefaultsDTO) response2.ProjectDefaultsResponse {
+	resp := response2.ProjectDefaultsResponse{
+		Client:     defaults.Client,
+		Skills:     defaults.Skills,
+		Categories: defaults.Categories,
+	}
+	if resp.Skills == nil {
+		resp.Skills = []string{}
+	}
+	if resp.Categories == nil {
+		resp.Categories = map[uint]uint{}
+	}
+	return resp
+}
+
+// toBootstrapSection wraps section data with its freshness, nulling the data of a failed section
+// The cause is logged by the use case; clients only learn that the section is unavailable.
+func toBootstrapSection(bootstrap *dto.BootstrapDTO, section string, data interface{}) response2.BootstrapSectionResponse {
+	status := bootstrap.Sections[section]
+	if status.Err != nil {
+		return response2.BootstrapSectionResponse{FetchedAt: status.FetchedAt, Error: "unavailable"}
+	}
+	return response2.BootstrapSectionResponse{Data: data, FetchedAt: status.FetchedAt}
+}
@@ -0,0 +1,62 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	"github.com/gin-gonic/gin"
+)
+
+func TestUserController_RejectsBadDefaultPortfolioIDs(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name      string
+		path      string
+		body      string
+		wantCode  string
+		wantError string
+	}{
+		{name: "fractional default", path: "/settings", body: `{"default_portfolio_id": 2.5}`, wantCode: apperrors.CodeValidationInteger, wantError: "default_portfolio_id must be a whole number within range"},
+		{name: "negative default", path: "/settings", body: `{"default_portfolio_id": -1}`, wantCode: apperrors.CodeValidationInteger, wantError: "default_portfolio_id must be a whole number within range"},
+		{name: "default above uint64", path: "/settings", body: `{"default_portfolio_id": 18446744073709551616}`, wantCode: apperrors.CodeValidationInteger, wantError: "default_portfolio_id must be a whole number within range"},
+		{name: "zero default", path: "/settings", body: `{"default_portfolio_id": 0}`, wantCode: apperrors.CodeValidationMin, wantError: "default_portfolio_id must be at least 1"},
+		{name: "fractional pinned category", path: "/project-defaults", body: `{"categories": {"1": 3.5}}`, wantCode: apperrors.CodeValidationInteger, wantError: "categories.1 must be a whole number within range"},
+		{name: "negative portfolio key", path: "/project-defaults", body: `{"categories": {"-1": 3}}`, wantCode: apperrors.CodeValidationInteger},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The body is rejected before any use case runs, so the controller needs no dependencies
+			ctrl := &UserController{}
+			router := gin.New()
+			me := router.Group("", func(c *gin.Context) { c.Set("userID", "user-1") })
+			me.PATCH("/settings", ctrl.UpdateSettings)
+			me.PATCH("/project-defaults", ctrl.UpdateProjectDefaults)
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodPatch, tt.path, strings.NewReader(tt.body)))
+
+			if w.Code != http.StatusBadRequest {
+				t.Fatalf("status = %d, want 400 (%s)", w.Code, w.Body.String())
+			}
+			var body struct {
+				Error string `json:"error"`
+				Code  string `json:"code"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if body.Code != tt.wantCode {
+				t.Errorf("code = %q, want %q", body.Code, tt.wantCode)
+			}
+			if tt.wantError != "" && body.Error != tt.wantError {
+				t.Errorf("error = %q, want %q", body.Error, tt.wantError)
+			}
+		})
+	}
+}
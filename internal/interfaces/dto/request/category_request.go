@@ -15,11 +15,25 @@ type UpdateCategoryRequest struct {
 	Position    uint    `json:"position" binding:"omitempty"`
 }
 
+// PatchCategoryRequest represents HTTP request for partially updating a category
+// Omitted (or null) fields are kept; "" clears the description, while an empty title is rejected.
+type PatchCategoryRequest struct {
+	Title       *string `json:"title" binding:"omitempty,min=1,max=255"`
+	Description *string `json:"description" binding:"omitempty,max=1000"`
+	Position    *uint   `json:"position"`
+}
+
 // UpdateCategoryPositionRequest represents HTTP request for updating a category's position
 type UpdateCategoryPositionRequest struct {
 	Position uint `json:"position" binding:"required"`
 }
 
+// SwapPositionsRequest represents HTTP request for swapping the positions of two items
+type SwapPositionsRequest struct {
+	FirstID  uint `json:"first_id" binding:"required,min=1"`
+	SecondID uint `json:"second_id" binding:"required,min=1"`
+}
+
 // BulkUpdatePositionItemRequest represents a single position update in bulk operation
 type BulkUpdatePositionItemRequest struct {
 	ID       uint `json:"id" binding:"required"`
@@ -33,6 +47,18 @@ type BulkReorderCategoriesRequest struct {
 
 // ListCategoriesRequest represents HTTP request for listing categories
 type ListCategoriesRequest struct {
-	Page  int `form:"page" binding:"omitempty,min=1"`
-	Limit int `form:"limit" binding:"omitempty,min=1,max=100"`
+	PaginationQuery
+}
+
+// GetCategoryDetailRequest represents HTTP request for the owner category detail
+// Page and Limit paginate the category's projects
+type GetCategoryDetailRequest struct {
+	PaginationQuery
+}
+
+// DeleteCategoryRequest represents the optional query parameters of a category delete
+// MoveProjectsTo keeps the projects by moving them to that category instead of deleting them
+type DeleteCategoryRequest struct {
+	MoveProjectsTo      *uint `form:"move_projects_to" binding:"omitempty,min=1"`
+	AllowOtherPortfolio bool  `form:"allow_other_portfolio"`
 }
@@ -0,0 +1,42 @@
+package request
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// MaxID is the largest resource ID accepted from clients (IDs are parsed as 32-bit in paths)
+const MaxID = math.MaxUint32
+
+// DecodeID decodes a resource ID from a raw JSON value without going through float64,
+// so large IDs can't be silently rounded and fractional ones are rejected.
+// Use it wherever an ID is read from loosely typed JSON (map[string]json.RawMessage,
+// documents whose references are remapped...) instead of a typed request DTO.
+func DecodeID(raw json.RawMessage) (uint, error) {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return 0, fmt.Errorf("invalid ID: %w", err)
+	}
+
+	number, ok := value.(json.Number)
+	if !ok {
+		return 0, fmt.Errorf("invalid ID: expected a number")
+	}
+	return ParseID(number.String())
+}
+
+// ParseID parses a decimal resource ID, rejecting signs, fractions, exponents, zero and
+// values above MaxID
+func ParseID(s string) (uint, error) {
+	id, err := strconv.ParseUint(s, 10, 32)
+	if err != nil || id == 0 || id > MaxID {
+		return 0, fmt.Errorf("invalid ID %q", s)
+	}
+	return uint(id), nil
+}
@@ -0,0 +1,54 @@
+package request
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDecodeID(t *testing.T) {
+	tests := []struct {
+		raw     string
+		want    uint
+		wantErr bool
+	}{
+		{raw: `42`, want: 42},
+		{raw: `4294967295`, want: MaxID},
+		{raw: `4294967296`, wantErr: true},
+		{raw: `18446744073709551616`, wantErr: true},
+		{raw: `9007199254740993`, wantErr: true}, // above 2^53: rejected, not rounded
+		{raw: `3.7`, wantErr: true},
+		{raw: `3.0`, wantErr: true},
+		{raw: `1e3`, wantErr: true},
+		{raw: `-1`, wantErr: true},
+		{raw: `0`, wantErr: true},
+		{raw: `"42"`, wantErr: true},
+		{raw: `null`, wantErr: true},
+		{raw: ``, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			got, err := DecodeID(json.RawMessage(tt.raw))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("DecodeID(%s) = %d, want an error", tt.raw, got)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Fatalf("DecodeID(%s) = %d, %v, want %d", tt.raw, got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseID(t *testing.T) {
+	for _, s := range []string{"", "+1", " 1", "0x10", "3.5", "-0"} {
+		if id, err := ParseID(s); err == nil {
+			t.Errorf("ParseID(%q) = %d, want an error", s, id)
+		}
+	}
+	if id, err := ParseID("7"); err != nil || id != 7 {
+		t.Errorf("ParseID(%q) = %d, %v, want 7", "7", id, err)
+	}
+}
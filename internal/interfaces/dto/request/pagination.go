@@ -0,0 +1,28 @@
+package request
+
+// DefaultPageLimit is the page size of list requests without a limit
+const DefaultPageLimit = 10
+
+// MaxPageLimit is the largest page size accepted by list requests
+const MaxPageLimit = 100
+
+// PaginationQuery holds the page and limit query parameters shared by the paginated lists
+// Omitted parameters default to page 1 and DefaultPageLimit. Explicit values out of range
+// (page < 1, limit < 1 or > MaxPageLimit, including page=0 and limit=0) fail binding with a
+// 400 naming the parameter, rather than being clamped, so clients notice.
+type PaginationQuery struct {
+	Page  *int `form:"page" binding:"omitempty,min=1"`
+	Limit *int `form:"limit" binding:"omitempty,min=1,max=100"` // max = MaxPageLimit
+}
+
+// Resolve returns the requested page and limit with the defaults applied
+func (q PaginationQuery) Resolve() (page, limit int) {
+	page, limit = 1, DefaultPageLimit
+	if q.Page != nil {
+		page = *q.Page
+	}
+	if q.Limit != nil {
+		limit = *q.Limit
+	}
+	return page, limit
+}
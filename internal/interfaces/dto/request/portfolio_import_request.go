@@ -0,0 +1,82 @@
+package request
+
+// ImportPortfolioRequest represents the HTTP request body for importing a portfolio
+// It is the document GET /api/portfolios/own/:id/export produces; entries are checked by the
+// use case so an invalid one is skipped instead of failing the whole import.
+type ImportPortfolioRequest struct {
+	ExportVersion int                     `json:"export_version" binding:"required"`
+	BaseURL       string                  `json:"base_url" binding:"omitempty,url,max=500"`
+	Portfolio     ImportPortfolioDocument `json:"portfolio" binding:"required"`
+}
+
+// ImportPortfolioQuery represents the HTTP query parameters for importing a portfolio
+type ImportPortfolioQuery struct {
+	DownloadImages bool `form:"download_images"`
+}
+
+// ImportPortfolioDocument is the imported portfolio with everything under it
+type ImportPortfolioDocument struct {
+	Title               string                `json:"title"`
+	Description         string                `json:"description"`
+	Slug                string                `json:"slug"`
+	EndorsementsEnabled bool                  `json:"endorsements_enabled"`
+	CustomCSS           string                `json:"custom_css"`
+	Links               []ImportPortfolioLink `json:"links"`
+	Categories          []ImportCategory      `json:"categories"`
+	Sections            []ImportSection       `json:"sections"`
+}
+
+// ImportPortfolioLink is an imported portfolio link
+type ImportPortfolioLink struct {
+	Kind     string `json:"kind"`
+	Label    string `json:"label"`
+	URL      string `json:"url"`
+	Position uint   `json:"position"`
+}
+
+// ImportCategory is an imported category with its projects
+type ImportCategory struct {
+	Title       string          `json:"title"`
+	Description *string         `json:"description"`
+	Position    uint            `json:"position"`
+	Projects    []ImportProject `json:"projects"`
+}
+
+// ImportProject is an imported project with its collaborators
+type ImportProject struct {
+	Title         string                      `json:"title"`
+	Description   string                      `json:"description"`
+	MainImage     *string                     `json:"main_image"`
+	Images        []string                    `json:"images"`
+	Skills        []string                    `json:"skills"`
+	Client        *string                     `json:"client"`
+	Link          *string                     `json:"link"`
+	Position      uint                        `json:"position"`
+	Hidden        bool                        `json:"hidden"`
+	Collaborators []ImportProjectCollaborator `json:"collaborators"`
+}
+
+// ImportProjectCollaborator is an imported project collaborator
+type ImportProjectCollaborator struct {
+	Name     string  `json:"name"`
+	Role     string  `json:"role"`
+	URL      *string `json:"url"`
+	Position uint    `json:"position"`
+}
+
+// ImportSection is an imported section with its contents
+type ImportSection struct {
+	Title       string                 `json:"title"`
+	Slug        string                 `json:"slug"`
+	Description *string                `json:"description"`
+	Type        string                 `json:"type"`
+	Position    uint                   `json:"position"`
+	Contents    []ImportSectionContent `json:"contents"`
+}
+
+// ImportSectionContent is an imported section content
+type ImportSectionContent struct {
+	Type     string  `json:"type"`
+	Content  *string `json:"content"`
+	Position uint    `json:"position"`
+}
@@ -0,0 +1,23 @@
+package request
+
+// CreatePortfolioLinkRequest represents HTTP request for adding a link to a portfolio
+// URL holds the address for the email kind
+type CreatePortfolioLinkRequest struct {
+	Kind     string `json:"kind" binding:"required,oneof=github linkedin email website twitter custom"`
+	Label    string `json:"label" binding:"omitempty,max=100"`
+	URL      string `json:"url" binding:"required,max=500"`
+	Position uint   `json:"position" binding:"omitempty"`
+}
+
+// UpdatePortfolioLinkRequest represents HTTP request for updating a portfolio link
+type UpdatePortfolioLinkRequest struct {
+	Kind     string `json:"kind" binding:"required,oneof=github linkedin email website twitter custom"`
+	Label    string `json:"label" binding:"omitempty,max=100"`
+	URL      string `json:"url" binding:"required,max=500"`
+	Position uint   `json:"position" binding:"omitempty"`
+}
+
+// ReorderPortfolioLinksRequest represents HTTP request for reordering portfolio links
+type ReorderPortfolioLinksRequest struct {
+	Items []BulkUpdatePositionItemRequest `json:"items" binding:"required,min=1,max=10,dive"`
+}
@@ -10,10 +10,62 @@ type CreatePortfolioRequest struct {
 type UpdatePortfolioRequest struct {
 	Title       string `json:"title,omitempty" binding:"omitempty,min=1,max=255"`
 	Description string `json:"description,omitempty" binding:"omitempty,max=1000"`
+
+	EndorsementsEnabled *bool `json:"endorsements_enabled,omitempty"` // Omit to keep the current setting
+
+	// Regenerate the public slug from the title (the slug is stable across title changes otherwise)
+	RegenerateSlug bool `json:"regenerate_slug,omitempty"`
+}
+
+// PatchPortfolioRequest represents the HTTP request body for partially updating a portfolio
+// Omitted (or null) fields are kept; "" clears the description, while an empty title is rejected.
+type PatchPortfolioRequest struct {
+	Title               *string `json:"title" binding:"omitempty,min=1,max=255"`
+	Description         *string `json:"description" binding:"omitempty,max=1000"`
+	EndorsementsEnabled *bool   `json:"endorsements_enabled"`
+
+	// Regenerate the public slug from the title (the slug is stable across title changes otherwise)
+	RegenerateSlug bool `json:"regenerate_slug,omitempty"`
+}
+
+// SetPortfolioPublishedRequest represents the HTTP request body for publishing or unpublishing a portfolio
+type SetPortfolioPublishedRequest struct {
+	IsPublished *bool `json:"is_published" binding:"required"`
 }
 
 // ListPortfoliosRequest represents the HTTP query parameters for listing portfolios
 type ListPortfoliosRequest struct {
-	Page  int `form:"page" binding:"omitempty,min=1"`
-	Limit int `form:"limit" binding:"omitempty,min=1,max=100"`
+	PaginationQuery
+}
+
+// ListPublicPortfoliosRequest represents the HTTP query parameters for the public portfolio list
+type ListPublicPortfoliosRequest struct {
+	PaginationQuery
+	Query string `form:"q" binding:"omitempty,max=100"`
+}
+
+// SearchPortfolioRequest represents the HTTP query parameters for searching one public portfolio
+type SearchPortfolioRequest struct {
+	Query string `form:"q" binding:"required,min=2,max=100"`
+}
+
+// GetAccessibilityReportRequest represents query parameters for the accessibility report
+// The theme colors live in the frontend, so the dashboard passes the ones it renders with
+type GetAccessibilityReportRequest struct {
+	TextColor       string `form:"text_color" binding:"omitempty,hexcolor"`
+	BackgroundColor string `form:"background_color" binding:"omitempty,hexcolor"`
+	AccentColor     string `form:"accent_color" binding:"omitempty,hexcolor"`
+}
+
+// UpdatePortfolioCustomCSSRequest represents HTTP request for setting a portfolio's custom stylesheet
+// An empty css removes it; the 50 KB limit is checked by the use case
+type UpdatePortfolioCustomCSSRequest struct {
+	CSS *string `json:"css" binding:"required"`
+}
+
+// GetPortfolioAnalyticsRequest represents the HTTP query parameters for a portfolio's view analytics
+// Dates are UTC days (YYYY-MM-DD), both inclusive; omitted ones default to the last 30 days
+type GetPortfolioAnalyticsRequest struct {
+	From string `form:"from" binding:"omitempty,datetime=2006-01-02"`
+	To   string `form:"to" binding:"omitempty,datetime=2006-01-02"`
 }
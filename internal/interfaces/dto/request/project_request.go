@@ -1,6 +1,8 @@
 package request
 
 // CreateProjectRequest represents HTTP request for creating a project
+// Omitted client/skills fall back to the user's project defaults (an explicit "" or [] is kept);
+// category_id may be omitted when portfolio_id has a pinned default category.
 type CreateProjectRequest struct {
 	Title       string   `json:"title" binding:"required,min=1,max=255"`
 	Description string   `json:"description" binding:"required,min=1"`
@@ -9,7 +11,9 @@ type CreateProjectRequest struct {
 	Skills      []string `json:"skills,omitempty"`
 	Client      *string  `json:"client,omitempty" binding:"omitempty,max=255"`
 	Link        *string  `json:"link,omitempty" binding:"omitempty,url"`
-	CategoryID  uint     `json:"category_id" binding:"required,min=1"`
+	CategoryID  uint     `json:"category_id" binding:"omitempty,min=1"`
+	PortfolioID uint     `json:"portfolio_id,omitempty" binding:"omitempty,min=1"`
+	Hidden      bool     `json:"hidden,omitempty"`
 }
 
 // UpdateProjectRequest represents HTTP request for updating a project
@@ -21,12 +25,38 @@ type UpdateProjectRequest struct {
 	Skills      []string `json:"skills,omitempty"`
 	Client      *string  `json:"client,omitempty" binding:"omitempty,max=255"`
 	Link        *string  `json:"link,omitempty" binding:"omitempty,url"`
+	Hidden      *bool    `json:"hidden,omitempty"` // Omit to keep the current setting
+}
+
+// PatchProjectRequest represents HTTP request for partially updating a project
+// Omitted (or null) fields are kept; "" clears main_image, client and link, [] clears images and skills.
+type PatchProjectRequest struct {
+	Title       *string   `json:"title" binding:"omitempty,min=1,max=255"`
+	Description *string   `json:"description" binding:"omitempty,min=1"`
+	MainImage   *string   `json:"main_image" binding:"omitempty,url"`
+	Images      *[]string `json:"images" binding:"omitempty,dive,url"`
+	Skills      *[]string `json:"skills" binding:"omitempty,dive,max=100"`
+	Client      *string   `json:"client" binding:"omitempty,max=255"`
+	Link        *string   `json:"link" binding:"omitempty,url"`
+	CategoryID  *uint     `json:"category_id" binding:"omitempty,min=1"`
+	Hidden      *bool     `json:"hidden"`
+}
+
+// BulkReorderProjectsRequest represents HTTP request for bulk reordering the projects of a category
+type BulkReorderProjectsRequest struct {
+	Items []BulkUpdatePositionItemRequest `json:"items" binding:"required,min=1,dive"`
 }
 
 // ListProjectsRequest represents HTTP request for listing projects
 type ListProjectsRequest struct {
-	Page  int `form:"page" binding:"omitempty,min=1"`
-	Limit int `form:"limit" binding:"omitempty,min=1,max=100"`
+	PaginationQuery
+	Include string `form:"include" binding:"omitempty,oneof=context"`
+	Sort    string `form:"sort" binding:"omitempty,oneof=recent least_viewed"`
+
+	// Optional filters, combined with AND
+	CategoryID uint   `form:"category_id" binding:"omitempty,min=1"`
+	Client     string `form:"client" binding:"omitempty,max=255"`
+	Skill      string `form:"skill" binding:"omitempty,max=100"`
 }
 
 // SearchProjectsBySkillsRequest represents HTTP request for searching projects by skills
@@ -38,3 +68,39 @@ type SearchProjectsBySkillsRequest struct {
 type SearchProjectsByClientRequest struct {
 	Client string `form:"client" binding:"required,min=1"`
 }
+
+// SearchPublicProjectsRequest represents HTTP request for the public project discovery search
+type SearchPublicProjectsRequest struct {
+	Query string `form:"q" binding:"omitempty,max=100"`
+	Skill string `form:"skill" binding:"omitempty,max=100"`
+	Sort  string `form:"sort" binding:"omitempty,oneof=recent relevant"`
+	Page  int    `form:"page" binding:"omitempty,min=1"`
+	Limit int    `form:"limit" binding:"omitempty,min=1,max=50"`
+}
+
+// CompareProjectsRequest represents HTTP request for comparing two projects
+type CompareProjectsRequest struct {
+	Left  uint `form:"left" binding:"required,min=1"`
+	Right uint `form:"right" binding:"required,min=1"`
+}
+
+// CreateProjectCollaboratorRequest represents HTTP request for crediting a collaborator on a project
+type CreateProjectCollaboratorRequest struct {
+	Name     string  `json:"name" binding:"required,max=100"`
+	Role     string  `json:"role" binding:"omitempty,max=100"`
+	URL      *string `json:"url" binding:"omitempty,max=500"`
+	Position uint    `json:"position" binding:"omitempty"`
+}
+
+// UpdateProjectCollaboratorRequest represents HTTP request for updating a project collaborator
+type UpdateProjectCollaboratorRequest struct {
+	Name     string  `json:"name" binding:"required,max=100"`
+	Role     string  `json:"role" binding:"omitempty,max=100"`
+	URL      *string `json:"url" binding:"omitempty,max=500"`
+	Position uint    `json:"position" binding:"omitempty"`
+}
+
+// ReorderProjectCollaboratorsRequest represents HTTP request for reordering project collaborators
+type ReorderProjectCollaboratorsRequest struct {
+	Items []BulkUpdatePositionItemRequest `json:"items" binding:"required,min=1,max=20,dive"`
+}
@@ -29,6 +29,11 @@ type BulkReorderSectionsRequest struct {
 
 // ListSectionsRequest represents HTTP request for listing sections
 type ListSectionsRequest struct {
-	Page  int `form:"page" binding:"omitempty,min=1"`
-	Limit int `form:"limit" binding:"omitempty,min=1,max=100"`
+	PaginationQuery
+}
+
+// ListSectionsByTypeRequest represents HTTP request for listing the caller's sections of one type
+type ListSectionsByTypeRequest struct {
+	PortfolioID *uint `form:"portfolio_id" binding:"omitempty,min=1"`
+	PaginationQuery
 }
@@ -0,0 +1,9 @@
+package request
+
+// CheckTitleRequest represents HTTP request for checking title availability
+// ParentID is the portfolio (categories, sections) or category (projects); ignored for portfolios
+type CheckTitleRequest struct {
+	Title     string `form:"title" binding:"required,max=255"`
+	ParentID  uint   `form:"parent_id"`
+	ExcludeID uint   `form:"exclude_id"`
+}
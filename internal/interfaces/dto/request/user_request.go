@@ -4,3 +4,18 @@ package request
 type UpdateUserRequest struct {
 	Name string `json:"name" binding:"required,min=1,max=255"`
 }
+
+// UpdateUserSettingsRequest represents the HTTP request body for updating user settings
+// A null default_portfolio_id clears the explicit default (the only portfolio is then used)
+type UpdateUserSettingsRequest struct {
+	DefaultPortfolioID *uint `json:"default_portfolio_id" binding:"omitempty,min=1"`
+}
+
+// UpdateProjectDefaultsRequest represents the HTTP request body for updating new-project defaults
+// Omitted fields are kept; "" / [] / {} clear a field and a 0 category unpins that portfolio.
+// Categories is keyed by portfolio ID.
+type UpdateProjectDefaultsRequest struct {
+	Client     *string       `json:"client" binding:"omitempty,max=255"`
+	Skills     []string      `json:"skills" binding:"omitempty,max=50,dive,max=100"`
+	Categories map[uint]uint `json:"categories"`
+}
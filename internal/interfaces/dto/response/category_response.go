@@ -12,6 +12,8 @@ type CategoryResponse struct {
 	PortfolioID uint      `json:"portfolio_id"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
+	CreatedBy   string    `json:"created_by,omitempty"` // Owner-facing only
+	UpdatedBy   string    `json:"updated_by,omitempty"` // Owner-facing only
 }
 
 // ListCategoriesResponse represents the response for listing categories
@@ -19,3 +21,23 @@ type ListCategoriesResponse struct {
 	Categories []CategoryResponse `json:"categories"`
 	Pagination PaginationResponse `json:"pagination"`
 }
+
+// CategoryDetailProjectResponse is the list-level view of a project in a category detail
+type CategoryDetailProjectResponse struct {
+	ID        uint      `json:"id"`
+	Title     string    `json:"title"`
+	MainImage *string   `json:"main_image,omitempty"`
+	Skills    []string  `json:"skills,omitempty"`
+	Client    *string   `json:"client,omitempty"`
+	Position  uint      `json:"position"`
+	Hidden    bool      `json:"hidden"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CategoryDetailResponse represents a category with one page of its projects
+type CategoryDetailResponse struct {
+	Category   CategoryResponse                `json:"category"`
+	Projects   []CategoryDetailProjectResponse `json:"projects"`
+	Pagination PaginationResponse              `json:"pagination"`
+}
@@ -1,8 +1,21 @@
 package response
 
 // ErrorResponse represents a standard error response
+// Error is human-readable (localized when a code is known); Code is the stable machine-readable code
+// Violations lists every failing field when the use case validated the input as a whole
 type ErrorResponse struct {
-	Error string `json:"error"`
+	Error      string                   `json:"error"`
+	Code       string                   `json:"code,omitempty"`
+	Violations []FieldViolationResponse `json:"violations,omitempty"`
+	Details    map[string]interface{}   `json:"details,omitempty"`
+}
+
+// FieldViolationResponse is a single failed validation rule on a field
+type FieldViolationResponse struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
 }
 
 // SuccessResponse represents a standard success response
@@ -19,10 +32,18 @@ type PaginationResponse struct {
 }
 
 // DataResponse represents a response with data and message (API_OVERVIEW.md format)
-// Used for single resource responses
+// Used for single resource responses. Warnings is only present when a write neared a quota
 type DataResponse struct {
-	Data    interface{} `json:"data"`
-	Message string      `json:"message"`
+	Data     interface{}            `json:"data"`
+	Message  string                 `json:"message"`
+	Warnings []QuotaWarningResponse `json:"warnings,omitempty"`
+}
+
+// QuotaWarningResponse reports a quota past its soft threshold after a successful write
+type QuotaWarningResponse struct {
+	Quota string `json:"quota"`
+	Usage int64  `json:"usage"`
+	Limit int64  `json:"limit"`
 }
 
 // PaginatedDataResponse represents a paginated response (API_OVERVIEW.md format)
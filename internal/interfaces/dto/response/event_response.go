@@ -0,0 +1,11 @@
+package response
+
+import "time"
+
+// ChangeEventResponse is the data of a "change" event on the own-content stream
+type ChangeEventResponse struct {
+	Resource   string    `json:"resource"`
+	ResourceID uint      `json:"resource_id"`
+	Action     string    `json:"action"` // created, updated or deleted
+	UpdatedAt  time.Time `json:"updated_at"`
+}
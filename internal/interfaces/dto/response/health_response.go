@@ -4,14 +4,14 @@ import "time"
 
 // HealthResponse represents basic health check response
 type HealthResponse struct {
-	Status    string    `json:"status"`   // "healthy" or "unhealthy"
+	Status    string    `json:"status"`   // "healthy", "degraded" or "unhealthy"
 	Database  string    `json:"database"` // "connected" or "disconnected"
 	Timestamp time.Time `json:"timestamp"`
 }
 
 // DatabaseHealthResponse represents detailed database health with connection pool stats
 type DatabaseHealthResponse struct {
-	Status    string         `json:"status"` // "healthy" or "unhealthy"
+	Status    string         `json:"status"` // "healthy", "degraded" or "unhealthy"
 	Database  DatabaseStatus `json:"database"`
 	Timestamp time.Time      `json:"timestamp"`
 }
@@ -28,3 +28,22 @@ type DatabaseStatus struct {
 	MaxIdleClosed     int64  `json:"max_idle_closed"`
 	MaxLifetimeClosed int64  `json:"max_lifetime_closed"`
 }
+
+// LivenessResponse represents the liveness probe response
+type LivenessResponse struct {
+	Status    string    `json:"status"` // Always "alive"
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// ReadinessResponse represents the readiness probe response with the result of every check
+type ReadinessResponse struct {
+	Status    string                            `json:"status"` // "ready" or "not_ready"
+	Checks    map[string]ReadinessCheckResponse `json:"checks"`
+	Timestamp time.Time                         `json:"timestamp"`
+}
+
+// ReadinessCheckResponse represents the result of one readiness check
+type ReadinessCheckResponse struct {
+	Status string `json:"status"` // "ok", "degraded" or "failed"
+	Error  string `json:"error,omitempty"`
+}
@@ -0,0 +1,34 @@
+package response
+
+// CompletenessCheckResponse is a single checklist item of the completeness report
+type CompletenessCheckResponse struct {
+	Name         string `json:"name"`
+	Passed       bool   `json:"passed"`
+	Weight       int    `json:"weight"`
+	Message      string `json:"message"`
+	ResourceType string `json:"resource_type,omitempty"`
+	ResourceIDs  []uint `json:"resource_ids,omitempty"`
+}
+
+// PortfolioCompletenessResponse is the completeness score of a portfolio
+type PortfolioCompletenessResponse struct {
+	PortfolioID uint                        `json:"portfolio_id"`
+	Score       int                         `json:"score"`
+	Checks      []CompletenessCheckResponse `json:"checks"`
+}
+
+// AccessibilityFindingResponse is a single finding of the accessibility report
+type AccessibilityFindingResponse struct {
+	Name         string            `json:"name"`
+	Severity     string            `json:"severity"`
+	Message      string            `json:"message"`
+	ResourceType string            `json:"resource_type,omitempty"`
+	ResourceIDs  []uint            `json:"resource_ids,omitempty"`
+	Values       map[string]string `json:"values,omitempty"`
+}
+
+// PortfolioAccessibilityReportResponse is the accessibility report of a portfolio
+type PortfolioAccessibilityReportResponse struct {
+	PortfolioID uint                           `json:"portfolio_id"`
+	Findings    []AccessibilityFindingResponse `json:"findings"`
+}
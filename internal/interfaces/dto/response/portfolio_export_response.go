@@ -0,0 +1,80 @@
+package response
+
+import "time"
+
+// PortfolioExportResponse is the downloadable backup of a portfolio
+// Image paths are written as stored; relative ones resolve against BaseURL, so an import on
+// another instance can rewrite them.
+type PortfolioExportResponse struct {
+	ExportVersion int                     `json:"export_version"`
+	ExportedAt    time.Time               `json:"exported_at"`
+	BaseURL       string                  `json:"base_url,omitempty"`
+	Portfolio     PortfolioExportDocument `json:"portfolio"`
+}
+
+// PortfolioExportDocument is the exported portfolio with everything under it
+type PortfolioExportDocument struct {
+	Title               string                `json:"title"`
+	Description         string                `json:"description"`
+	Slug                string                `json:"slug"`
+	EndorsementsEnabled bool                  `json:"endorsements_enabled"`
+	CustomCSS           string                `json:"custom_css"`
+	Links               []PortfolioLinkExport `json:"links"`
+	Categories          []CategoryExport      `json:"categories"`
+	Sections            []SectionExport       `json:"sections"`
+}
+
+// PortfolioLinkExport is an exported portfolio link
+type PortfolioLinkExport struct {
+	Kind     string `json:"kind"`
+	Label    string `json:"label"`
+	URL      string `json:"url"`
+	Position uint   `json:"position"`
+}
+
+// CategoryExport is an exported category with its projects
+type CategoryExport struct {
+	Title       string          `json:"title"`
+	Description *string         `json:"description"`
+	Position    uint            `json:"position"`
+	Projects    []ProjectExport `json:"projects"`
+}
+
+// ProjectExport is an exported project with its collaborators
+type ProjectExport struct {
+	Title         string                      `json:"title"`
+	Description   string                      `json:"description"`
+	MainImage     *string                     `json:"main_image"`
+	Images        []string                    `json:"images"`
+	Skills        []string                    `json:"skills"`
+	Client        *string                     `json:"client"`
+	Link          *string                     `json:"link"`
+	Position      uint                        `json:"position"`
+	Hidden        bool                        `json:"hidden"`
+	Collaborators []ProjectCollaboratorExport `json:"collaborators"`
+}
+
+// ProjectCollaboratorExport is an exported project collaborator
+type ProjectCollaboratorExport struct {
+	Name     string  `json:"name"`
+	Role     string  `json:"role"`
+	URL      *string `json:"url"`
+	Position uint    `json:"position"`
+}
+
+// SectionExport is an exported section with its contents
+type SectionExport struct {
+	Title       string                 `json:"title"`
+	Slug        string                 `json:"slug"`
+	Description *string                `json:"description"`
+	Type        string                 `json:"type"`
+	Position    uint                   `json:"position"`
+	Contents    []SectionContentExport `json:"contents"`
+}
+
+// SectionContentExport is an exported section content
+type SectionContentExport struct {
+	Type     string  `json:"type"`
+	Content  *string `json:"content"`
+	Position uint    `json:"position"`
+}
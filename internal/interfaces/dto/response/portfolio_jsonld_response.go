@@ -0,0 +1,33 @@
+package response
+
+// PortfolioJSONLDResponse is the schema.org ProfilePage document describing a public portfolio
+type PortfolioJSONLDResponse struct {
+	Context      string               `json:"@context"`
+	Type         string               `json:"@type"`
+	Name         string               `json:"name"`
+	Description  string               `json:"description,omitempty"`
+	DateCreated  string               `json:"dateCreated"`
+	DateModified string               `json:"dateModified"`
+	MainEntity   PersonJSONLD         `json:"mainEntity"`
+	HasPart      []CreativeWorkJSONLD `json:"hasPart"`
+}
+
+// PersonJSONLD is the schema.org Person the portfolio is about
+type PersonJSONLD struct {
+	Type        string   `json:"@type"`
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Email       string   `json:"email,omitempty"`
+	SameAs      []string `json:"sameAs,omitempty"` // Profile and website URLs
+}
+
+// CreativeWorkJSONLD is a single project described as a schema.org CreativeWork
+type CreativeWorkJSONLD struct {
+	Type        string   `json:"@type"`
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	DateCreated string   `json:"dateCreated"`
+	Image       string   `json:"image,omitempty"`
+	URL         string   `json:"url,omitempty"`
+	Keywords    []string `json:"keywords,omitempty"`
+}
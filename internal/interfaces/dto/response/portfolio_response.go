@@ -7,9 +7,125 @@ type PortfolioResponse struct {
 	ID          uint      `json:"id"`
 	Title       string    `json:"title"`
 	Description string    `json:"description"`
+	Slug        string    `json:"slug,omitempty"`
 	OwnerID     string    `json:"owner_id,omitempty"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
+	CreatedBy   string    `json:"created_by,omitempty"` // Owner-facing only
+	UpdatedBy   string    `json:"updated_by,omitempty"` // Owner-facing only
+
+	IsPublished         bool `json:"is_published"`
+	EndorsementsEnabled bool `json:"endorsements_enabled"`
+
+	// Contact/social links (public responses)
+	Links []PortfolioLinkResponse `json:"links,omitempty"`
+
+	// Sanitized custom stylesheet (public responses)
+	CustomCSS string `json:"custom_css,omitempty"`
+}
+
+// PortfolioCloneResponse is the portfolio created by a clone with the number of children copied
+type PortfolioCloneResponse struct {
+	ID       uint                         `json:"id"`
+	Title    string                       `json:"title"`
+	Slug     string                       `json:"slug"`
+	SourceID uint                         `json:"source_id"`
+	Copied   PortfolioChildCountsResponse `json:"copied"`
+}
+
+// PortfolioImportResponse is the portfolio created by an import with the number of children
+// created, how many relative image paths now point at the exporting instance, and the entries
+// of the document that were left out
+type PortfolioImportResponse struct {
+	ID             uint                         `json:"id"`
+	Title          string                       `json:"title"`
+	Slug           string                       `json:"slug"`
+	Created        PortfolioChildCountsResponse `json:"created"`
+	ExternalImages int                          `json:"external_images"`
+	Skipped        []ImportSkipResponse         `json:"skipped"`
+}
+
+// ImportSkipResponse is a document entry an import left out
+type ImportSkipResponse struct {
+	Path   string `json:"path"`
+	Reason string `json:"reason"`
+}
+
+// DeletedPortfolioResponse is a delete batch in the owner's trash: a portfolio and the
+// children deleted with it
+type DeletedPortfolioResponse struct {
+	ID          uint                         `json:"id"`
+	Title       string                       `json:"title"`
+	Description string                       `json:"description"`
+	Slug        string                       `json:"slug,omitempty"`
+	CreatedAt   time.Time                    `json:"created_at"`
+	DeletedAt   time.Time                    `json:"deleted_at"`
+	BatchID     string                       `json:"batch_id,omitempty"`
+	DeletedWith PortfolioChildCountsResponse `json:"deleted_with"`
+}
+
+// PortfolioRestoreResponse is a portfolio brought back from the trash with the number of children restored
+type PortfolioRestoreResponse struct {
+	Portfolio   PortfolioResponse            `json:"portfolio"`
+	RenamedFrom string                       `json:"renamed_from,omitempty"` // Set when the title collided with a live portfolio
+	Restored    PortfolioChildCountsResponse `json:"restored"`
+}
+
+// PortfolioChildCountsResponse counts the child rows copied by a portfolio clone or restore
+type PortfolioChildCountsResponse struct {
+	Links                int `json:"links"`
+	Categories           int `json:"categories"`
+	Projects             int `json:"projects"`
+	ProjectCollaborators int `json:"project_collaborators"`
+	Sections             int `json:"sections"`
+	SectionContents      int `json:"section_contents"`
+}
+
+// PortfolioSearchResponse groups the matches of a public portfolio search, best matches first
+type PortfolioSearchResponse struct {
+	Projects []ProjectResponse          `json:"projects"`
+	Sections []SectionSearchHitResponse `json:"sections"`
+}
+
+// SectionSearchHitResponse is a section matching a portfolio search with its matching contents
+type SectionSearchHitResponse struct {
+	SectionResponse
+	MatchedContentIDs []uint `json:"matched_content_ids"` // Empty when only the section's title or description matched
+}
+
+// PortfolioCustomCSSResponse is a portfolio's custom stylesheet as written and as served
+type PortfolioCustomCSSResponse struct {
+	CSS          string               `json:"css"`
+	SanitizedCSS string               `json:"sanitized_css"`
+	Removed      []CSSRemovalResponse `json:"removed,omitempty"` // Only on updates
+	Saved        bool                 `json:"saved,omitempty"`
+}
+
+// CSSRemovalResponse is a construct the sanitizer stripped from a custom stylesheet
+type CSSRemovalResponse struct {
+	Kind    string `json:"kind"`    // import, at_rule, url, expression, behavior, markup, malformed, nested_rule
+	Snippet string `json:"snippet"` // The offending source, truncated
+}
+
+// PortfolioAvailabilityResponse is the public availability probe of a portfolio
+type PortfolioAvailabilityResponse struct {
+	Status        string `json:"status"`
+	RequiresToken bool   `json:"requires_token"`
+}
+
+// PortfolioAnalyticsResponse is the daily public views of a portfolio over a range
+type PortfolioAnalyticsResponse struct {
+	PortfolioID uint                          `json:"portfolio_id"`
+	From        string                        `json:"from"`
+	To          string                        `json:"to"`
+	Total       uint                          `json:"total"`
+	Days        []PortfolioViewsOnDayResponse `json:"days"`
+}
+
+// PortfolioViewsOnDayResponse is the number of counted views of a portfolio on one day
+type PortfolioViewsOnDayResponse struct {
+	Day   string `json:"day"`
+	Views uint   `json:"views"`
 }
 
 // ListPortfoliosResponse represents the response for listing portfolios
@@ -17,3 +133,12 @@ type ListPortfoliosResponse struct {
 	Portfolios []PortfolioResponse `json:"portfolios"`
 	Pagination PaginationResponse  `json:"pagination"`
 }
+
+// PortfolioLinkResponse represents a portfolio contact/social link in API responses
+type PortfolioLinkResponse struct {
+	ID       uint   `json:"id"`
+	Kind     string `json:"kind"`
+	Label    string `json:"label,omitempty"`
+	URL      string `json:"url"`
+	Position uint   `json:"position"`
+}
@@ -12,10 +12,86 @@ type ProjectResponse struct {
 	Skills      []string  `json:"skills,omitempty"`
 	Client      *string   `json:"client,omitempty"`
 	Link        *string   `json:"link,omitempty"`
+	Position    uint      `json:"position"`
 	CategoryID  uint      `json:"category_id"`
 	OwnerID     string    `json:"owner_id,omitempty"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
+	CreatedBy   string    `json:"created_by,omitempty"` // Owner-facing only
+	UpdatedBy   string    `json:"updated_by,omitempty"` // Owner-facing only
+	Hidden      bool      `json:"hidden,omitempty"`     // Owner-facing only (public reads never return hidden projects)
+
+	// Public detail only, when the portfolio allows endorsements: skill -> count
+	Endorsements map[string]uint `json:"endorsements,omitempty"`
+
+	// Detail responses (own and public), ordered by position
+	Collaborators []ProjectCollaboratorResponse `json:"collaborators,omitempty"`
+
+	// Owner list and detail only: public views as of the last flush (last_viewed_at is absent
+	// when the project was never viewed)
+	LastViewedAt *time.Time `json:"last_viewed_at,omitempty"`
+	Views30d     *uint      `json:"views_30d,omitempty"`
+
+	// Create only: fields filled from the user's project defaults
+	DefaultedFields []string `json:"defaulted_fields,omitempty"`
+
+	// Breadcrumb context (detail responses, or lists with ?include=context)
+	Category  *ProjectCategoryContextResponse  `json:"category,omitempty"`
+	Portfolio *ProjectPortfolioContextResponse `json:"portfolio,omitempty"`
+}
+
+// ProjectCollaboratorResponse is a person credited on a project
+// Public responses only carry name, role and url; id and position are owner-facing
+type ProjectCollaboratorResponse struct {
+	ID       uint    `json:"id,omitempty"`
+	Name     string  `json:"name"`
+	Role     string  `json:"role,omitempty"`
+	URL      *string `json:"url,omitempty"`
+	Position uint    `json:"position,omitempty"`
+}
+
+// ProjectCategoryContextResponse is the category a project belongs to
+type ProjectCategoryContextResponse struct {
+	ID          uint   `json:"id"`
+	Title       string `json:"title"`
+	PortfolioID uint   `json:"portfolio_id"`
+}
+
+// ProjectPortfolioContextResponse is the portfolio a project belongs to
+type ProjectPortfolioContextResponse struct {
+	ID    uint   `json:"id"`
+	Title string `json:"title"`
+}
+
+// ProjectSearchResponse is the paginated result of the public project search
+// Total stops counting at the cap; TotalCapped tells clients to display it as "1000+".
+type ProjectSearchResponse struct {
+	Data        []ProjectResponse `json:"data"`
+	Page        int               `json:"page"`
+	Limit       int               `json:"limit"`
+	Total       int64             `json:"total"`
+	TotalCapped bool              `json:"total_capped"`
+	Message     string            `json:"message"`
+}
+
+// SkillEndorsementResponse is the endorsement count of one project skill
+type SkillEndorsementResponse struct {
+	Skill string `json:"skill"`
+	Count uint   `json:"count"`
+}
+
+// EndorseSkillResponse is the result of a visitor endorsement
+type EndorseSkillResponse struct {
+	Skill   string `json:"skill"`
+	Count   uint   `json:"count"`
+	Counted bool   `json:"counted"` // false when this visitor already endorsed the skill today
+}
+
+// ProjectEndorsementsResponse is the owner view of a project's endorsements
+type ProjectEndorsementsResponse struct {
+	ProjectID           uint                       `json:"project_id"`
+	EndorsementsEnabled bool                       `json:"endorsements_enabled"`
+	Skills              []SkillEndorsementResponse `json:"skills"`
 }
 
 // ListProjectsResponse represents the response for listing projects
@@ -23,3 +99,25 @@ type ListProjectsResponse struct {
 	Projects   []ProjectResponse  `json:"projects"`
 	Pagination PaginationResponse `json:"pagination"`
 }
+
+// ProjectComparisonResponse is the field-by-field difference between two projects
+type ProjectComparisonResponse struct {
+	LeftID  uint                  `json:"left_id"`
+	RightID uint                  `json:"right_id"`
+	Fields  []FieldChangeResponse `json:"fields"` // Only the fields that differ
+	Skills  SetChangeResponse     `json:"skills"`
+	Images  SetChangeResponse     `json:"images"`
+}
+
+// FieldChangeResponse is a field with different values on each side
+type FieldChangeResponse struct {
+	Field string      `json:"field"`
+	Left  interface{} `json:"left"`
+	Right interface{} `json:"right"`
+}
+
+// SetChangeResponse is the difference between two sets of values
+type SetChangeResponse struct {
+	Added   []string `json:"added"`
+	Removed []string `json:"removed"`
+}
@@ -13,9 +13,24 @@ type SectionContentResponse struct {
 	OwnerID   string    `json:"owner_id,omitempty"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
+	CreatedBy string    `json:"created_by,omitempty"` // Owner-facing only
+	UpdatedBy string    `json:"updated_by,omitempty"` // Owner-facing only
 }
 
 // ListSectionContentsResponse represents the response for listing section contents
 type ListSectionContentsResponse struct {
 	Contents []SectionContentResponse `json:"contents"`
 }
+
+// SectionContentRevisionResponse represents a section content revision in HTTP responses
+// Content is only included when a single revision is requested
+type SectionContentRevisionResponse struct {
+	ID               uint      `json:"id"`
+	SectionContentID uint      `json:"section_content_id"`
+	Type             string    `json:"type"`
+	Content          *string   `json:"content,omitempty"`
+	ImageID          *uint     `json:"image_id,omitempty"`
+	Size             int       `json:"size"`
+	SizeDelta        int       `json:"size_delta"`
+	CreatedAt        time.Time `json:"created_at"`
+}
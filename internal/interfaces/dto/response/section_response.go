@@ -6,6 +6,7 @@ import "time"
 type SectionResponse struct {
 	ID          uint      `json:"id"`
 	Title       string    `json:"title"`
+	Slug        string    `json:"slug"`
 	Description *string   `json:"description,omitempty"`
 	Position    uint      `json:"position"`
 	Type        string    `json:"type"`
@@ -13,6 +14,8 @@ type SectionResponse struct {
 	PortfolioID uint      `json:"portfolio_id"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
+	CreatedBy   string    `json:"created_by,omitempty"` // Owner-facing only
+	UpdatedBy   string    `json:"updated_by,omitempty"` // Owner-facing only
 }
 
 // ListSectionsResponse represents the response for listing sections
@@ -20,3 +23,18 @@ type ListSectionsResponse struct {
 	Sections   []SectionResponse  `json:"sections"`
 	Pagination PaginationResponse `json:"pagination"`
 }
+
+// PortfolioTOCResponse is the table of contents of a public portfolio
+type PortfolioTOCResponse struct {
+	Sections  []TOCEntryResponse `json:"sections"`
+	Redirects map[string]string  `json:"redirects"` // Former slug -> current slug
+}
+
+// TOCEntryResponse is one section of a table of contents
+type TOCEntryResponse struct {
+	ID       uint   `json:"id"`
+	Slug     string `json:"slug"`
+	Title    string `json:"title"`
+	Type     string `json:"type"`
+	Position uint   `json:"position"`
+}
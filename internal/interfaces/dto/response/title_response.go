@@ -0,0 +1,8 @@
+package response
+
+// TitleAvailabilityResponse represents the result of a title availability check
+type TitleAvailabilityResponse struct {
+	Available     bool    `json:"available"`
+	ConflictingID *uint   `json:"conflicting_id,omitempty"`
+	Suggested     *string `json:"suggested,omitempty"`
+}
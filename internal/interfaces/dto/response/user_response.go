@@ -10,3 +10,57 @@ type UserResponse struct {
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
+
+// UserSettingsResponse represents the current user's settings in HTTP responses
+// ResolvedPortfolioID is the portfolio the profile currently resolves to (explicit or automatic)
+type UserSettingsResponse struct {
+	DefaultPortfolioID  *uint `json:"default_portfolio_id"`
+	ResolvedPortfolioID *uint `json:"resolved_portfolio_id"`
+}
+
+// ProjectDefaultsResponse represents the current user's new-project defaults
+type ProjectDefaultsResponse struct {
+	Client     *string       `json:"client"`
+	Skills     []string      `json:"skills"`
+	Categories map[uint]uint `json:"categories"` // portfolio ID -> pinned category ID
+}
+
+// DefaultPortfolioResponse is the portfolio a public profile resolves to
+type DefaultPortfolioResponse struct {
+	ID       uint   `json:"id"`
+	Title    string `json:"title"`
+	Explicit bool   `json:"explicit"` // false when picked automatically (the user's only portfolio)
+}
+
+// BootstrapResponse is everything the app shell needs after login
+// Each section carries its own freshness so the client can refresh them separately.
+type BootstrapResponse struct {
+	User       BootstrapSectionResponse `json:"user"`
+	Settings   BootstrapSectionResponse `json:"settings"`
+	Portfolios BootstrapSectionResponse `json:"portfolios"`
+	Activity   BootstrapSectionResponse `json:"activity"`
+}
+
+// BootstrapSectionResponse is one bootstrap section
+// Error is set (and Data null) when the section could not be read.
+type BootstrapSectionResponse struct {
+	Data      interface{} `json:"data"`
+	FetchedAt time.Time   `json:"fetched_at"`
+	Error     string      `json:"error,omitempty"`
+}
+
+// BootstrapSettingsResponse is the settings section of the bootstrap, project defaults included
+type BootstrapSettingsResponse struct {
+	UserSettingsResponse
+	ProjectDefaults ProjectDefaultsResponse `json:"project_defaults"`
+}
+
+// PortfolioSummaryResponse is a portfolio with its item counts
+type PortfolioSummaryResponse struct {
+	ID         uint      `json:"id"`
+	Title      string    `json:"title"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	Categories int64     `json:"categories"`
+	Sections   int64     `json:"sections"`
+	Projects   int64     `json:"projects"`
+}
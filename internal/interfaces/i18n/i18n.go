@@ -0,0 +1,131 @@
+// Package i18n renders error codes as localized messages.
+// Catalogs are embedded JSON files (code -> template) under locales/; templates
+// interpolate parameters written as {name}.
+package i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DefaultLocale is used when the client accepts none of the supported locales
+const DefaultLocale = "en"
+
+//go:embed locales/*.json
+var localeFiles embed.FS
+
+// catalogs maps a locale tag (e.g. "pt-BR") to its code -> template catalog
+var catalogs = mustLoadCatalogs()
+
+func mustLoadCatalogs() map[string]map[string]string {
+	entries, err := localeFiles.ReadDir("locales")
+	if err != nil {
+		panic(fmt.Sprintf("i18n: failed to read embedded locales: %v", err))
+	}
+
+	loaded := make(map[string]map[string]string, len(entries))
+	for _, entry := range entries {
+		data, err := localeFiles.ReadFile(path.Join("locales", entry.Name()))
+		if err != nil {
+			panic(fmt.Sprintf("i18n: failed to read %s: %v", entry.Name(), err))
+		}
+
+		var catalog map[string]string
+		if err := json.Unmarshal(data, &catalog); err != nil {
+			panic(fmt.Sprintf("i18n: invalid catalog %s: %v", entry.Name(), err))
+		}
+
+		loaded[strings.TrimSuffix(entry.Name(), ".json")] = catalog
+	}
+
+	return loaded
+}
+
+// Localize renders the template for code in locale, falling back to the default
+// locale. ok is false when no catalog knows the code.
+func Localize(locale, code string, params map[string]interface{}) (message string, ok bool) {
+	template, ok := catalogs[locale][code]
+	if !ok {
+		template, ok = catalogs[DefaultLocale][code]
+	}
+	if !ok {
+		return "", false
+	}
+
+	for name, value := range params {
+		template = strings.ReplaceAll(template, "{"+name+"}", fmt.Sprint(value))
+	}
+
+	return template, true
+}
+
+// MatchLocale picks the best supported locale for an Accept-Language header value
+// Exact tags win over base-language matches ("pt" or "pt-PT" both select "pt-BR");
+// the header's q-values decide between candidates.
+func MatchLocale(acceptLanguage string) string {
+	type candidate struct {
+		tag     string
+		quality float64
+	}
+
+	var candidates []candidate
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		fields := strings.Split(strings.TrimSpace(part), ";")
+		tag := strings.TrimSpace(fields[0])
+		if tag == "" {
+			continue
+		}
+
+		quality := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if q, err := strconv.ParseFloat(strings.TrimPrefix(param, "q="), 64); err == nil {
+					quality = q
+				}
+			}
+		}
+		if quality > 0 {
+			candidates = append(candidates, candidate{tag: tag, quality: quality})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].quality > candidates[j].quality
+	})
+
+	for _, c := range candidates {
+		if locale, ok := supportedLocale(c.tag); ok {
+			return locale
+		}
+	}
+
+	return DefaultLocale
+}
+
+// supportedLocale maps a language tag to a loaded catalog, first exactly
+// (case-insensitive) and then by base language
+func supportedLocale(tag string) (string, bool) {
+	if tag == "*" {
+		return DefaultLocale, true
+	}
+
+	base := strings.ToLower(strings.SplitN(tag, "-", 2)[0])
+	var baseMatch string
+
+	for locale := range catalogs {
+		if strings.EqualFold(locale, tag) {
+			return locale, true
+		}
+		if baseMatch == "" && strings.ToLower(strings.SplitN(locale, "-", 2)[0]) == base {
+			baseMatch = locale
+		}
+	}
+
+	return baseMatch, baseMatch != ""
+}
@@ -0,0 +1,84 @@
+package i18n
+
+import "testing"
+
+func TestMatchLocale(t *testing.T) {
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		want           string
+	}{
+		{name: "no header", acceptLanguage: "", want: DefaultLocale},
+		{name: "exact tag", acceptLanguage: "pt-BR", want: "pt-BR"},
+		{name: "tag case", acceptLanguage: "PT-br", want: "pt-BR"},
+		{name: "base language", acceptLanguage: "pt", want: "pt-BR"},
+		{name: "other region of a base language", acceptLanguage: "pt-PT", want: "pt-BR"},
+		{name: "region of the default", acceptLanguage: "en-GB", want: "en"},
+		{name: "unknown locale", acceptLanguage: "fr-FR", want: DefaultLocale},
+		{name: "unknown first, known after", acceptLanguage: "fr-FR, pt;q=0.8", want: "pt-BR"},
+		{name: "higher q-value wins over order", acceptLanguage: "en;q=0.4, pt-BR;q=0.9", want: "pt-BR"},
+		{name: "missing q-value is 1", acceptLanguage: "pt-BR;q=0.9, en", want: "en"},
+		{name: "refused with q=0", acceptLanguage: "pt-BR;q=0, en;q=0.1", want: "en"},
+		{name: "wildcard", acceptLanguage: "fr, *;q=0.5", want: DefaultLocale},
+		{name: "malformed q-value counts as 1", acceptLanguage: "en;q=0.5, pt-BR;q=abc", want: "pt-BR"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MatchLocale(tt.acceptLanguage); got != tt.want {
+				t.Errorf("MatchLocale(%q) = %q, want %q", tt.acceptLanguage, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLocalize(t *testing.T) {
+	tests := []struct {
+		name   string
+		locale string
+		code   string
+		params map[string]interface{}
+		want   string
+		wantOK bool
+	}{
+		{name: "parameters", locale: "en", code: "PORTFOLIO_LINK_LIMIT", params: map[string]interface{}{"max": 10}, want: "a portfolio can have at most 10 links", wantOK: true},
+		{name: "translated", locale: "pt-BR", code: "UNAUTHENTICATED", want: "autenticação necessária", wantOK: true},
+		{name: "unknown locale falls back", locale: "fr", code: "UNAUTHENTICATED", want: "authentication required", wantOK: true},
+		{name: "unknown code", locale: "en", code: "NO_SUCH_CODE"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Localize(tt.locale, tt.code, tt.params)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("Localize() = %q, %v, want %q, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestCatalogsHaveTheSameCodes(t *testing.T) {
+	for locale, catalog := range catalogs {
+		for code := range catalogs[DefaultLocale] {
+			if _, ok := catalog[code]; !ok {
+				t.Errorf("%s has no message for %s", locale, code)
+			}
+		}
+		for code := range catalog {
+			if _, ok := catalogs[DefaultLocale][code]; !ok {
+				t.Errorf("%s has a message for %s, unknown to %s", locale, code, DefaultLocale)
+			}
+		}
+	}
+}
+
+func TestCatalogsCoverMiddlewareCodes(t *testing.T) {
+	// Written by middleware, which have no message of their own besides the English fallback
+	for _, code := range []string{"UNAUTHENTICATED", "ACCESS_DENIED", "RATE_LIMITED", "TOO_MANY_CONCURRENT_OPERATIONS", "TOO_MANY_EVENT_STREAMS", "DATABASE_UNAVAILABLE"} {
+		for locale, catalog := range catalogs {
+			if catalog[code] == "" {
+				t.Errorf("%s has no message for %s", locale, code)
+			}
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package middleware
+
+import "github.com/gin-gonic/gin"
+
+// Public API versions
+// Unversioned /api routes keep serving the current behavior for existing clients.
+const (
+	APIVersionV1 = "v1"
+	APIVersionV2 = "v2"
+)
+
+// apiVersionKey is the context key holding the API version of the matched route group
+const apiVersionKey = "apiVersion"
+
+// APIVersion returns a Gin middleware that tags requests with the API version
+// of the route group they were registered on
+func APIVersion(version string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(apiVersionKey, version)
+		c.Next()
+	}
+}
+
+// APIVersionFrom returns the API version of the request, or "" for unversioned routes
+func APIVersionFrom(c *gin.Context) string {
+	return c.GetString(apiVersionKey)
+}
@@ -1,22 +1,33 @@
 package middleware
 
 import (
-	"net/http"
+	"errors"
 	"strings"
 
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/actor"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
 	"github.com/gin-gonic/gin"
 )
 
+// APIKeyPrefix starts every API key, telling them apart from JWT access tokens
+const APIKeyPrefix = "pmk_"
+
 // AuthMiddleware handles authentication for v2 API endpoints
 type AuthMiddleware struct {
 	authProvider contracts.AuthProvider
+	apiKeys      contracts.APIKeyValidator
+	respond      ErrorResponder
 }
 
 // NewAuthMiddleware creates a new authentication middleware instance
-func NewAuthMiddleware(authProvider contracts.AuthProvider) *AuthMiddleware {
+// Bearer tokens starting with APIKeyPrefix go to apiKeys (refused when nil), others to
+// authProvider. respond writes the apperrors.Unauthenticated error of rejected requests.
+func NewAuthMiddleware(authProvider contracts.AuthProvider, apiKeys contracts.APIKeyValidator, respond ErrorResponder) *AuthMiddleware {
 	return &AuthMiddleware{
 		authProvider: authProvider,
+		apiKeys:      apiKeys,
+		respond:      respond,
 	}
 }
 
@@ -26,7 +37,7 @@ func (m *AuthMiddleware) Authenticate() gin.HandlerFunc {
 		// Extract Authorization header
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "missing authorization header"})
+			m.respond(c, apperrors.Unauthenticated("missing authorization header"))
 			c.Abort()
 			return
 		}
@@ -34,30 +45,70 @@ func (m *AuthMiddleware) Authenticate() gin.HandlerFunc {
 		// Validate Bearer token format
 		parts := strings.SplitN(authHeader, " ", 2)
 		if len(parts) != 2 || parts[0] != "Bearer" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid authorization header format, expected 'Bearer <token>'"})
+			m.respond(c, apperrors.Unauthenticated("invalid authorization header format, expected 'Bearer <token>'"))
 			c.Abort()
 			return
 		}
 
 		accessToken := parts[1]
 		if accessToken == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "missing access token"})
+			m.respond(c, apperrors.Unauthenticated("missing access token"))
 			c.Abort()
 			return
 		}
 
-		// Validate token using AuthProvider contract
-		userID, err := m.authProvider.ValidateToken(accessToken)
+		// Validate the API key or JWT through its contract
+		userID, credential, err := m.identify(accessToken)
 		if err != nil {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid or expired token"})
+			m.respond(c, apperrors.Unauthenticated(err.Error()))
 			c.Abort()
 			return
 		}
 
-		// Set userID in context for downstream handlers
+		// Set userID and the credential kind in context for downstream handlers
 		c.Set("userID", userID)
+		c.Set(credentialKey, credential)
 
 		// Continue to next handler
 		c.Next()
 	}
 }
+
+// identify resolves the user of a bearer token and the credential discriminator recorded
+// with their changes (actor.CredentialJWT or actor.APIKeyCredential)
+func (m *AuthMiddleware) identify(token string) (userID, credential string, err error) {
+	if strings.HasPrefix(token, APIKeyPrefix) {
+		if m.apiKeys == nil {
+			return "", "", errors.New("API keys are not accepted")
+		}
+		userID, name, err := m.apiKeys.ValidateAPIKey(token)
+		if err != nil {
+			return "", "", errors.New("invalid or revoked API key")
+		}
+		return userID, actor.APIKeyCredential(name), nil
+	}
+
+	// Anything else must be a JWT: header, payload and signature
+	if !isJWTShaped(token) || m.authProvider == nil {
+		return "", "", errors.New("malformed access token")
+	}
+	userID, err = m.authProvider.ValidateToken(token)
+	if err != nil {
+		return "", "", errors.New("invalid or expired token")
+	}
+	return userID, actor.CredentialJWT, nil
+}
+
+// isJWTShaped reports whether token has the three non-empty base64url segments of a JWT
+func isJWTShaped(token string) bool {
+	segments := strings.Split(token, ".")
+	if len(segments) != 3 {
+		return false
+	}
+	for _, segment := range segments {
+		if segment == "" || strings.Trim(segment, "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_") != "" {
+			return false
+		}
+	}
+	return true
+}
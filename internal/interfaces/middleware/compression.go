@@ -0,0 +1,272 @@
+package middleware
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/gin-gonic/gin"
+)
+
+// DefaultCompressionMinSize is the response size (bytes) below which bodies are sent as-is
+const DefaultCompressionMinSize = 1024
+
+// compressibleContentTypes are the media type prefixes worth compressing
+// Images and archives are already compressed; event streams must not be buffered.
+var compressibleContentTypes = []string{
+	"application/json",
+	"application/ld+json",
+	"application/problem+json",
+	"application/xml",
+	"application/javascript",
+	"text/",
+}
+
+var gzipWriterPool = sync.Pool{
+	New: func() interface{} {
+		w, _ := gzip.NewWriterLevel(nil, gzip.DefaultCompression)
+		return w
+	},
+}
+
+// Compression returns a Gin middleware gzip-encoding responses for clients that accept it
+// Only compressible content types at or above minSize bytes are encoded; smaller bodies
+// are buffered and written unchanged. Requests under any of skipPrefixes (file streaming
+// routes) are never touched. Every candidate response carries Vary: Accept-Encoding so
+// caches keep the encodings apart. Encoded responses are reported to metrics (may be nil)
+// with their size before and after encoding.
+func Compression(minSize int, metrics contracts.MetricsCollector, skipPrefixes ...string) gin.HandlerFunc {
+	if minSize <= 0 {
+		minSize = DefaultCompressionMinSize
+	}
+
+	return func(c *gin.Context) {
+		if c.Request.Method == http.MethodHead || hasAnyPrefix(c.Request.URL.Path, skipPrefixes) {
+			c.Next()
+			return
+		}
+
+		c.Writer.Header().Add("Vary", "Accept-Encoding")
+		if !acceptsGzip(c.Request.Header.Get("Accept-Encoding")) {
+			c.Next()
+			return
+		}
+
+		original := c.Writer
+		cw := &compressWriter{ResponseWriter: original, minSize: minSize, status: http.StatusOK}
+		c.Writer = cw
+		defer func() {
+			cw.finish()
+			c.Writer = original
+			if cw.encoded != nil && metrics != nil {
+				metrics.AddCompressedResponse(cw.size, cw.encoded.n)
+			}
+		}()
+
+		c.Next()
+	}
+}
+
+// compressWriter buffers the start of a response until it knows whether to compress it
+type compressWriter struct {
+	gin.ResponseWriter
+	minSize   int
+	status    int
+	buf       bytes.Buffer
+	gz        *gzip.Writer
+	committed bool // headers sent, buffering is over
+	size      int  // uncompressed bytes written by the handler
+	encoded   *countingWriter
+}
+
+// countingWriter counts the gzip output on its way to the client
+type countingWriter struct {
+	w http.ResponseWriter
+	n int
+}
+
+func (c *countingWriter) Write(data []byte) (int, error) {
+	n, err := c.w.Write(data)
+	c.n += n
+	return n, err
+}
+
+func (w *compressWriter) WriteHeader(code int) {
+	if code > 0 && !w.committed {
+		w.status = code
+	}
+}
+
+// WriteHeaderNow is deferred: headers go out once the encoding is decided
+func (w *compressWriter) WriteHeaderNow() {}
+
+func (w *compressWriter) Status() int {
+	return w.status
+}
+
+func (w *compressWriter) Size() int {
+	if !w.committed && w.size == 0 {
+		return -1
+	}
+	return w.size
+}
+
+func (w *compressWriter) Written() bool {
+	return w.committed || w.size > 0
+}
+
+func (w *compressWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+func (w *compressWriter) Write(data []byte) (int, error) {
+	w.size += len(data)
+
+	if !w.committed {
+		w.buf.Write(data)
+		if w.buf.Len() < w.minSize {
+			return len(data), nil
+		}
+		if err := w.commit(true); err != nil {
+			return 0, err
+		}
+		return len(data), nil
+	}
+
+	if w.gz != nil {
+		return w.gz.Write(data)
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+// Flush commits the encoding early (streaming handlers can't wait for the threshold)
+func (w *compressWriter) Flush() {
+	if !w.committed {
+		if err := w.commit(w.buf.Len() > 0); err != nil {
+			return
+		}
+	}
+	if w.gz != nil {
+		_ = w.gz.Flush()
+	}
+	w.ResponseWriter.Flush()
+}
+
+// Unwrap exposes the underlying writer to http.ResponseController (write deadlines)
+func (w *compressWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func (w *compressWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	w.committed = true
+	return w.ResponseWriter.Hijack()
+}
+
+// commit decides the encoding, sends the headers and drains the buffer
+func (w *compressWriter) commit(sizeReached bool) error {
+	w.committed = true
+
+	header := w.ResponseWriter.Header()
+	if sizeReached && w.shouldCompress(header) {
+		header.Set("Content-Encoding", "gzip")
+		header.Del("Content-Length")
+		if etag := header.Get("ETag"); etag != "" && !strings.HasPrefix(etag, "W/") {
+			// The encoded body differs byte-wise from the representation the tag was computed on
+			header.Set("ETag", "W/"+etag)
+		}
+
+		w.encoded = &countingWriter{w: w.ResponseWriter}
+		gz := gzipWriterPool.Get().(*gzip.Writer)
+		gz.Reset(w.encoded)
+		w.gz = gz
+	}
+
+	w.ResponseWriter.WriteHeader(w.status)
+	if w.buf.Len() == 0 {
+		return nil
+	}
+
+	var err error
+	if w.gz != nil {
+		_, err = w.gz.Write(w.buf.Bytes())
+	} else {
+		_, err = w.ResponseWriter.Write(w.buf.Bytes())
+	}
+	w.buf.Reset()
+	return err
+}
+
+// finish writes whatever is still buffered and closes the gzip stream
+func (w *compressWriter) finish() {
+	if !w.committed {
+		if w.buf.Len() == 0 {
+			// Nothing was written; only the status goes out
+			w.committed = true
+			w.ResponseWriter.WriteHeader(w.status)
+			return
+		}
+		w.ResponseWriter.Header().Set("Content-Length", strconv.Itoa(w.buf.Len()))
+		_ = w.commit(false)
+	}
+
+	if w.gz != nil {
+		_ = w.gz.Close()
+		gzipWriterPool.Put(w.gz)
+		w.gz = nil
+	}
+}
+
+func (w *compressWriter) shouldCompress(header http.Header) bool {
+	if header.Get("Content-Encoding") != "" {
+		return false
+	}
+	if w.status < http.StatusOK || w.status == http.StatusNoContent || w.status == http.StatusNotModified {
+		return false
+	}
+
+	contentType := strings.ToLower(header.Get("Content-Type"))
+	if strings.HasPrefix(contentType, "text/event-stream") {
+		return false
+	}
+	for _, prefix := range compressibleContentTypes {
+		if strings.HasPrefix(contentType, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// acceptsGzip reports whether an Accept-Encoding header allows gzip (explicitly or via *)
+func acceptsGzip(acceptEncoding string) bool {
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		coding, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		coding = strings.ToLower(strings.TrimSpace(coding))
+		if coding != "gzip" && coding != "*" {
+			continue
+		}
+
+		params = strings.ReplaceAll(params, " ", "")
+		if q, ok := strings.CutPrefix(params, "q="); ok {
+			if value, err := strconv.ParseFloat(q, 64); err == nil && value == 0 {
+				continue
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func hasAnyPrefix(path string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,191 @@
+package middleware
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/gin-gonic/gin"
+)
+
+type compressionMetrics struct {
+	contracts.MetricsCollector
+	original, encoded int
+}
+
+func (m *compressionMetrics) AddCompressedResponse(originalBytes, encodedBytes int) {
+	m.original += originalBytes
+	m.encoded += encodedBytes
+}
+
+func TestCompression(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	large := `{"data":"` + strings.Repeat("portfolio ", 200) + `"}`
+	small := `{"data":"ok"}`
+
+	tests := []struct {
+		name           string
+		path           string
+		acceptEncoding string
+		contentType    string
+		status         int
+		body           string
+		etag           string
+		wantGzip       bool
+		wantVary       bool
+		wantETag       string
+	}{
+		{
+			name:           "large json",
+			path:           "/api/items",
+			acceptEncoding: "gzip, deflate",
+			contentType:    "application/json; charset=utf-8",
+			status:         http.StatusOK,
+			body:           large,
+			etag:           `"v1"`,
+			wantGzip:       true,
+			wantVary:       true,
+			wantETag:       `W/"v1"`,
+		},
+		{
+			name:           "small json",
+			path:           "/api/items",
+			acceptEncoding: "gzip",
+			contentType:    "application/json; charset=utf-8",
+			status:         http.StatusOK,
+			body:           small,
+			etag:           `"v1"`,
+			wantVary:       true,
+			wantETag:       `"v1"`,
+		},
+		{
+			name:        "gzip not accepted",
+			path:        "/api/items",
+			contentType: "application/json; charset=utf-8",
+			status:      http.StatusOK,
+			body:        large,
+			wantVary:    true,
+		},
+		{
+			name:           "gzip refused with q=0",
+			path:           "/api/items",
+			acceptEncoding: "gzip;q=0, identity",
+			contentType:    "application/json; charset=utf-8",
+			status:         http.StatusOK,
+			body:           large,
+			wantVary:       true,
+		},
+		{
+			name:           "already compressed type",
+			path:           "/api/items",
+			acceptEncoding: "gzip",
+			contentType:    "image/png",
+			status:         http.StatusOK,
+			body:           large,
+			wantVary:       true,
+		},
+		{
+			name:           "skipped prefix",
+			path:           "/uploads/cover.json",
+			acceptEncoding: "gzip",
+			contentType:    "application/json",
+			status:         http.StatusOK,
+			body:           large,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metrics := &compressionMetrics{}
+			router := gin.New()
+			router.Use(Compression(DefaultCompressionMinSize, metrics, "/uploads/"))
+			router.GET("/*path", func(c *gin.Context) {
+				if tt.etag != "" {
+					c.Header("ETag", tt.etag)
+				}
+				c.Data(tt.status, tt.contentType, []byte(tt.body))
+			})
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.acceptEncoding != "" {
+				req.Header.Set("Accept-Encoding", tt.acceptEncoding)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.status {
+				t.Fatalf("status = %d, want %d", w.Code, tt.status)
+			}
+			if got := w.Header().Get("Vary") == "Accept-Encoding"; got != tt.wantVary {
+				t.Errorf("Vary = %q, want Accept-Encoding: %v", w.Header().Get("Vary"), tt.wantVary)
+			}
+			if tt.wantETag != "" && w.Header().Get("ETag") != tt.wantETag {
+				t.Errorf("ETag = %q, want %q", w.Header().Get("ETag"), tt.wantETag)
+			}
+
+			body := w.Body.String()
+			if !tt.wantGzip {
+				if got := w.Header().Get("Content-Encoding"); got != "" {
+					t.Errorf("Content-Encoding = %q, want none", got)
+				}
+				if body != tt.body {
+					t.Errorf("body changed: got %d bytes, want %d", len(body), len(tt.body))
+				}
+				if metrics.original != 0 {
+					t.Errorf("metrics recorded %d bytes for an unencoded response", metrics.original)
+				}
+				return
+			}
+
+			if got := w.Header().Get("Content-Encoding"); got != "gzip" {
+				t.Fatalf("Content-Encoding = %q, want gzip", got)
+			}
+			if got := w.Header().Get("Content-Length"); got != "" {
+				t.Errorf("Content-Length = %q, want none on an encoded body", got)
+			}
+			gz, err := gzip.NewReader(w.Body)
+			if err != nil {
+				t.Fatalf("gzip reader: %v", err)
+			}
+			decoded, err := io.ReadAll(gz)
+			if err != nil {
+				t.Fatalf("gunzip: %v", err)
+			}
+			if string(decoded) != tt.body {
+				t.Errorf("gunzipped body differs: got %d bytes, want %d", len(decoded), len(tt.body))
+			}
+			if metrics.original != len(tt.body) || metrics.encoded != len(body) {
+				t.Errorf("metrics = %d -> %d bytes, want %d -> %d", metrics.original, metrics.encoded, len(tt.body), len(body))
+			}
+		})
+	}
+}
+
+func TestCompressionLeavesNotModifiedAlone(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(Compression(1, nil))
+	router.GET("/items", func(c *gin.Context) {
+		c.Header("ETag", `W/"v1"`)
+		c.Status(http.StatusNotModified)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/items", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotModified {
+		t.Fatalf("status = %d, want 304", w.Code)
+	}
+	if got := w.Header().Get("Content-Encoding"); got != "" {
+		t.Errorf("Content-Encoding = %q, want none", got)
+	}
+	if w.Body.Len() != 0 {
+		t.Errorf("body = %q, want empty", w.Body.String())
+	}
+}
@@ -0,0 +1,179 @@
+package middleware
+
+import (
+	"sync"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/gin-gonic/gin"
+)
+
+// DefaultMaxHeavyOperationsPerUser is the default weight budget of concurrent heavy operations per user
+const DefaultMaxHeavyOperationsPerUser = 2
+
+// concurrencySlotKey is the context key holding the slot acquired for the request
+const concurrencySlotKey = "concurrencySlot"
+
+// RunningOperation describes a heavy operation currently holding a slot
+type RunningOperation struct {
+	Operation string    `json:"operation"`
+	StartedAt time.Time `json:"started_at"`
+}
+
+// ConcurrencyLimiter is a keyed weighted semaphore limiting how many expensive
+// operations (exports, imports, restores...) a single user runs at the same time
+type ConcurrencyLimiter struct {
+	mu       sync.Mutex
+	max      int
+	inFlight map[string][]*concurrencySlot // userID -> held slots
+	metrics  contracts.MetricsCollector
+	respond  ErrorResponder
+}
+
+// concurrencySlot is a unit of the semaphore held by one request (or the job it started)
+type concurrencySlot struct {
+	limiter   *ConcurrencyLimiter
+	userID    string
+	operation string
+	weight    int
+	startedAt time.Time
+	detached  bool
+	once      sync.Once
+}
+
+// NewConcurrencyLimiter creates a limiter allowing maxPerUser weight per user (default when <= 0)
+// respond writes the apperrors.TooManyConcurrentOperations error of rejected requests.
+func NewConcurrencyLimiter(maxPerUser int, metrics contracts.MetricsCollector, respond ErrorResponder) *ConcurrencyLimiter {
+	if maxPerUser <= 0 {
+		maxPerUser = DefaultMaxHeavyOperationsPerUser
+	}
+	return &ConcurrencyLimiter{
+		max:      maxPerUser,
+		inFlight: make(map[string][]*concurrencySlot),
+		metrics:  metrics,
+		respond:  respond,
+	}
+}
+
+// Limit returns a Gin middleware acquiring weight units for the user before the handler runs
+// Requests over the budget get 429 with the operations the user is currently running.
+// The slot is released when the handler returns or panics, unless the handler hands it over to an
+// async job with DetachConcurrencySlot.
+func (l *ConcurrencyLimiter) Limit(operation string, weight int) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID := c.GetString("userID")
+		if userID == "" {
+			c.Next()
+			return
+		}
+
+		slot, running := l.acquire(userID, operation, weight)
+		if slot == nil {
+			if l.metrics != nil {
+				l.metrics.IncrementHeavyOperationRejections(operation)
+			}
+			l.respond(c, apperrors.TooManyConcurrentOperations(running))
+			c.Abort()
+			return
+		}
+
+		c.Set(concurrencySlotKey, slot)
+		// Deferred so a panicking handler (recovered by gin) doesn't keep the slot forever
+		defer func() {
+			if !slot.detached {
+				slot.release()
+			}
+		}()
+		c.Next()
+	}
+}
+
+// DetachConcurrencySlot hands the request's slot over to an async job
+// The caller must invoke the returned function once the job completes; it is safe to call
+// more than once. Returns a no-op when the route is not limited.
+func DetachConcurrencySlot(c *gin.Context) func() {
+	value, ok := c.Get(concurrencySlotKey)
+	if !ok {
+		return func() {}
+	}
+
+	slot := value.(*concurrencySlot)
+	slot.detached = true
+	return slot.release
+}
+
+// Running returns the heavy operations the user is currently running
+func (l *ConcurrencyLimiter) Running(userID string) []RunningOperation {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.runningLocked(userID)
+}
+
+// acquire reserves weight units for the user, or returns nil and the running operations
+func (l *ConcurrencyLimiter) acquire(userID, operation string, weight int) (*concurrencySlot, []RunningOperation) {
+	if weight <= 0 {
+		weight = 1
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	used := 0
+	for _, slot := range l.inFlight[userID] {
+		used += slot.weight
+	}
+	if used+weight > l.max {
+		return nil, l.runningLocked(userID)
+	}
+
+	slot := &concurrencySlot{
+		limiter:   l,
+		userID:    userID,
+		operation: operation,
+		weight:    weight,
+		startedAt: time.Now(),
+	}
+	l.inFlight[userID] = append(l.inFlight[userID], slot)
+
+	if l.metrics != nil {
+		l.metrics.AddHeavyOperationsInFlight(operation, 1)
+	}
+
+	return slot, nil
+}
+
+// release returns the slot to the semaphore (only the first call has an effect)
+func (s *concurrencySlot) release() {
+	s.once.Do(func() {
+		l := s.limiter
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		slots := l.inFlight[s.userID]
+		for i, held := range slots {
+			if held == s {
+				slots = append(slots[:i], slots[i+1:]...)
+				break
+			}
+		}
+		if len(slots) == 0 {
+			delete(l.inFlight, s.userID)
+		} else {
+			l.inFlight[s.userID] = slots
+		}
+
+		if l.metrics != nil {
+			l.metrics.AddHeavyOperationsInFlight(s.operation, -1)
+		}
+	})
+}
+
+func (l *ConcurrencyLimiter) runningLocked(userID string) []RunningOperation {
+	running := make([]RunningOperation, 0, len(l.inFlight[userID]))
+	for _, slot := range l.inFlight[userID] {
+		running = append(running, RunningOperation{Operation: slot.operation, StartedAt: slot.startedAt})
+	}
+	return running
+}
@@ -0,0 +1,113 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	"github.com/gin-gonic/gin"
+)
+
+func TestConcurrencyLimiterReleasesSlotWhenHandlerPanics(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	limiter := NewConcurrencyLimiter(1, nil, respondWithCode)
+
+	router := gin.New()
+	router.Use(gin.CustomRecovery(func(c *gin.Context, _ interface{}) {
+		c.AbortWithStatus(http.StatusInternalServerError)
+	}))
+	router.Use(func(c *gin.Context) { c.Set("userID", "user-1") })
+	router.GET("/panic", limiter.Limit("export", 1), func(c *gin.Context) { panic("boom") })
+	router.GET("/ok", limiter.Limit("export", 1), func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/panic", nil))
+	if w.Code != http.StatusInternalServerError {
+		t.Fatalf("panicking handler: status = %d, want 500", w.Code)
+	}
+
+	if running := limiter.Running("user-1"); len(running) != 0 {
+		t.Fatalf("slot still held after the panic: %v", running)
+	}
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/ok", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("next request: status = %d, want 200", w.Code)
+	}
+}
+
+func TestConcurrencyLimiterKeepsDetachedSlot(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	limiter := NewConcurrencyLimiter(1, nil, respondWithCode)
+
+	var release func()
+	router := gin.New()
+	router.Use(func(c *gin.Context) { c.Set("userID", "user-1") })
+	router.POST("/job", limiter.Limit("import", 1), func(c *gin.Context) {
+		release = DetachConcurrencySlot(c)
+		c.Status(http.StatusAccepted)
+	})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/job", nil))
+	if w.Code != http.StatusAccepted {
+		t.Fatalf("status = %d, want 202", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/job", nil))
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("while the job runs: status = %d, want 429", w.Code)
+	}
+
+	release()
+	if running := limiter.Running("user-1"); len(running) != 0 {
+		t.Fatalf("slot still held after release: %v", running)
+	}
+}
+
+func TestConcurrencyLimiterRejectsThroughResponder(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	var rejected error
+	limiter := NewConcurrencyLimiter(2, nil, func(c *gin.Context, err error) {
+		rejected = err
+		respondWithCode(c, err)
+	})
+
+	release := make(chan struct{})
+	started := make(chan struct{})
+	router := gin.New()
+	router.Use(func(c *gin.Context) { c.Set("userID", "user-1") })
+	router.GET("/export", limiter.Limit("export", 2), func(c *gin.Context) {
+		close(started)
+		<-release
+		c.Status(http.StatusOK)
+	})
+	router.GET("/import", limiter.Limit("import", 1), func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/export", nil))
+	}()
+	<-started
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/import", nil))
+	close(release)
+	<-done
+
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("status = %d, want 429", w.Code)
+	}
+	appErr, ok := apperrors.As(rejected)
+	if !ok || appErr.Kind != apperrors.KindRateLimited || appErr.Code != apperrors.CodeTooManyConcurrentOperations {
+		t.Fatalf("responder got %v, want a rate limited %s error", rejected, apperrors.CodeTooManyConcurrentOperations)
+	}
+	running, _ := appErr.Details["running"].([]RunningOperation)
+	if len(running) != 1 || running[0].Operation != "export" {
+		t.Errorf("details running = %v, want the export", appErr.Details["running"])
+	}
+}
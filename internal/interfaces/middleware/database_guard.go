@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/gin-gonic/gin"
+)
+
+// DatabaseRetryAfterSeconds is the Retry-After sent with 503s caused by an unreachable database
+const DatabaseRetryAfterSeconds = "5"
+
+// DatabaseAvailability reports whether the database currently accepts connections
+type DatabaseAvailability interface {
+	Available() bool
+}
+
+// RequireDatabaseForWrites rejects writes with 503 while the database is unreachable,
+// instead of letting them queue on connection attempts until the failover is over.
+// Reads still go through: the repositories retry them once on a fresh connection.
+func RequireDatabaseForWrites(db DatabaseAvailability) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			c.Next()
+			return
+		}
+
+		if db.Available() {
+			c.Next()
+			return
+		}
+
+		c.Header("Retry-After", DatabaseRetryAfterSeconds)
+		c.JSON(http.StatusServiceUnavailable, gin.H{
+			"error": contracts.ErrDatabaseUnavailable.Error(),
+			"code":  apperrors.CodeDatabaseUnavailable,
+		})
+		c.Abort()
+	}
+}
@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+type databaseAvailability bool
+
+func (a databaseAvailability) Available() bool { return bool(a) }
+
+func TestRequireDatabaseForWrites(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name      string
+		available bool
+		method    string
+		want      int
+	}{
+		{name: "read while up", available: true, method: http.MethodGet, want: http.StatusOK},
+		{name: "write while up", available: true, method: http.MethodPost, want: http.StatusOK},
+		{name: "read while down", available: false, method: http.MethodGet, want: http.StatusOK},
+		{name: "head while down", available: false, method: http.MethodHead, want: http.StatusOK},
+		{name: "post while down", available: false, method: http.MethodPost, want: http.StatusServiceUnavailable},
+		{name: "delete while down", available: false, method: http.MethodDelete, want: http.StatusServiceUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.Use(RequireDatabaseForWrites(databaseAvailability(tt.available)))
+			router.Handle(tt.method, "/items", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(tt.method, "/items", nil))
+
+			if w.Code != tt.want {
+				t.Fatalf("status = %d, want %d", w.Code, tt.want)
+			}
+			if tt.want == http.StatusServiceUnavailable && w.Header().Get("Retry-After") != DatabaseRetryAfterSeconds {
+				t.Errorf("Retry-After = %q, want %q", w.Header().Get("Retry-After"), DatabaseRetryAfterSeconds)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package middleware
+
+import (
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/contracts"
+	"github.com/gin-gonic/gin"
+)
+
+// Defaults of the as-you-type check limiter: a fast typist stays well below them
+const (
+	DefaultTypingChecksPerSecond = 5
+	DefaultTypingChecksBurst     = 20
+)
+
+// Defaults of the public route limiter: a page load fans out to a handful of requests,
+// so the burst leaves room for several quick navigations
+const (
+	DefaultPublicRequestsPerSecond = 10
+	DefaultPublicRequestsBurst     = 40
+)
+
+// Defaults of the per-user limiter of authenticated routes: well above what the editor sends,
+// low enough to stop a runaway script or client loop
+const (
+	DefaultUserRequestsPerSecond = 20
+	DefaultUserRequestsBurst     = 60
+)
+
+// rateLimiterIdleTTL is how long a full, unused bucket is kept before being swept
+const rateLimiterIdleTTL = 10 * time.Minute
+
+// RateLimitKey picks the bucket of a request; an empty key lets the request through unlimited
+type RateLimitKey func(c *gin.Context) string
+
+// RateLimitByUser keys requests by the authenticated user (set by the auth middleware)
+func RateLimitByUser(c *gin.Context) string {
+	return c.GetString("userID")
+}
+
+// RateLimitByClientIP keys requests by client IP, for routes without authentication
+// The IP comes from X-Forwarded-For only when the engine trusts the connecting proxy
+// (gin.Engine.SetTrustedProxies); otherwise it is the address of the connection.
+func RateLimitByClientIP(c *gin.Context) string {
+	return c.ClientIP()
+}
+
+// ErrorResponder writes an error response; the rate limiter renders its 429s through it,
+// so they go through the same localized error envelope as the handlers' errors
+type ErrorResponder func(c *gin.Context, err error)
+
+// RateLimitStore holds the token buckets of rate limiters
+// The in-memory store limits each API instance on its own; a shared store (e.g. Redis)
+// would make the limits hold across instances.
+type RateLimitStore interface {
+	// Take consumes a token of the key's bucket, refilled at rate tokens per second up to burst,
+	// or returns how long until one is available
+	Take(key string, rate, burst float64, now time.Time) time.Duration
+}
+
+// RateLimiter is a keyed token bucket for cheap but chatty endpoints (keystroke checks, public reads...)
+// Unlike ConcurrencyLimiter it bounds the request rate, not the requests in flight.
+type RateLimiter struct {
+	name    string // Metrics label and bucket key prefix
+	rate    float64
+	burst   float64
+	key     RateLimitKey
+	store   RateLimitStore
+	metrics contracts.MetricsCollector
+	respond ErrorResponder
+}
+
+// NewRateLimiter creates a limiter allowing perSecond requests per key with bursts of burst
+// perSecond below 1 is raised to 1 and burst below perSecond to perSecond.
+// respond writes the apperrors.RateLimited error of rejected requests.
+func NewRateLimiter(name string, perSecond, burst int, key RateLimitKey, store RateLimitStore, metrics contracts.MetricsCollector, respond ErrorResponder) *RateLimiter {
+	if perSecond < 1 {
+		perSecond = 1
+	}
+	if burst < perSecond {
+		burst = perSecond
+	}
+	return &RateLimiter{
+		name:    name,
+		rate:    float64(perSecond),
+		burst:   float64(burst),
+		key:     key,
+		store:   store,
+		metrics: metrics,
+		respond: respond,
+	}
+}
+
+// Limit returns a Gin middleware taking one token per request; requests without a token get 429
+// with a Retry-After header
+func (l *RateLimiter) Limit() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := l.key(c)
+		if key == "" {
+			c.Next()
+			return
+		}
+
+		if wait := l.store.Take(l.name+":"+key, l.rate, l.burst, time.Now()); wait > 0 {
+			if l.metrics != nil {
+				l.metrics.IncrementRateLimitRejections(l.name)
+			}
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+			l.respond(c, apperrors.RateLimited())
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// memoryRateLimitStore is the in-memory RateLimitStore of one API instance
+type memoryRateLimitStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// NewMemoryRateLimitStore creates an empty in-memory bucket store
+func NewMemoryRateLimitStore() RateLimitStore {
+	return &memoryRateLimitStore{
+		buckets:   make(map[string]*tokenBucket),
+		lastSweep: time.Now(),
+	}
+}
+
+// Take consumes a token of the key, or returns how long until one is available
+func (s *memoryRateLimitStore) Take(key string, rate, burst float64, now time.Time) time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweepLocked(now)
+
+	bucket, ok := s.buckets[key]
+	if !ok {
+		bucket = &tokenBucket{tokens: burst, last: now}
+		s.buckets[key] = bucket
+	}
+
+	bucket.tokens = math.Min(burst, bucket.tokens+now.Sub(bucket.last).Seconds()*rate)
+	bucket.last = now
+
+	if bucket.tokens < 1 {
+		return time.Duration((1 - bucket.tokens) / rate * float64(time.Second))
+	}
+	bucket.tokens--
+	return 0
+}
+
+// sweepLocked drops the buckets idle long enough to be full again, at most once per TTL
+func (s *memoryRateLimitStore) sweepLocked(now time.Time) {
+	if now.Sub(s.lastSweep) < rateLimiterIdleTTL {
+		return
+	}
+	s.lastSweep = now
+
+	for key, bucket := range s.buckets {
+		if now.Sub(bucket.last) >= rateLimiterIdleTTL {
+			delete(s.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	"github.com/gin-gonic/gin"
+)
+
+// kindStatuses are the statuses controllers.RespondError writes for the kinds middleware rejects with
+var kindStatuses = map[apperrors.Kind]int{
+	apperrors.KindRateLimited:     http.StatusTooManyRequests,
+	apperrors.KindUnauthenticated: http.StatusUnauthorized,
+}
+
+// respondWithCode writes the status of the error kind and its code, standing in for controllers.RespondError
+func respondWithCode(c *gin.Context, err error) {
+	appErr, ok := apperrors.As(err)
+	if !ok || kindStatuses[appErr.Kind] == 0 {
+		c.Status(http.StatusInternalServerError)
+		return
+	}
+	c.JSON(kindStatuses[appErr.Kind], gin.H{"code": appErr.Code})
+}
+
+func TestRateLimiterRejectsPastBurst(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	limiter := NewRateLimiter("test", 1, 2, RateLimitByUser, NewMemoryRateLimitStore(), nil, respondWithCode)
+
+	router := gin.New()
+	router.Use(func(c *gin.Context) { c.Set("userID", c.GetHeader("X-User")) })
+	router.GET("/items", limiter.Limit(), func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	get := func(userID string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/items", nil)
+		req.Header.Set("X-User", userID)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	for i := 0; i < 2; i++ {
+		if w := get("user-1"); w.Code != http.StatusOK {
+			t.Fatalf("request %d within the burst: status = %d, want 200", i+1, w.Code)
+		}
+	}
+
+	w := get("user-1")
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("request past the burst: status = %d, want 429", w.Code)
+	}
+	if got := w.Header().Get("Retry-After"); got != "1" {
+		t.Errorf("Retry-After = %q, want %q", got, "1")
+	}
+	if want := `{"code":"` + apperrors.CodeRateLimited + `"}`; w.Body.String() != want {
+		t.Errorf("body = %s, want %s", w.Body.String(), want)
+	}
+
+	if w := get("user-2"); w.Code != http.StatusOK {
+		t.Errorf("other user: status = %d, want 200 (buckets are per key)", w.Code)
+	}
+	for i := 0; i < 5; i++ {
+		if w := get(""); w.Code != http.StatusOK {
+			t.Fatalf("request without a key: status = %d, want 200", w.Code)
+		}
+	}
+}
+
+func TestRateLimitByClientIPTrustedProxies(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name    string
+		trusted []string
+		want    string
+	}{
+		{name: "no trusted proxy ignores X-Forwarded-For", trusted: nil, want: "10.0.0.1"},
+		{name: "trusted proxy forwards the client IP", trusted: []string{"10.0.0.0/8"}, want: "203.0.113.7"},
+		{name: "untrusted proxy ignores X-Forwarded-For", trusted: []string{"192.168.0.1"}, want: "10.0.0.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			if err := router.SetTrustedProxies(tt.trusted); err != nil {
+				t.Fatalf("SetTrustedProxies: %v", err)
+			}
+			var key string
+			router.GET("/items", func(c *gin.Context) { key = RateLimitByClientIP(c) })
+
+			req := httptest.NewRequest(http.MethodGet, "/items", nil)
+			req.RemoteAddr = "10.0.0.1:41000"
+			req.Header.Set("X-Forwarded-For", "203.0.113.7")
+			router.ServeHTTP(httptest.NewRecorder(), req)
+
+			if key != tt.want {
+				t.Errorf("key = %q, want %q", key, tt.want)
+			}
+		})
+	}
+}
+
+func TestRateLimiterSpoofedForwardedForSharesBucket(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	limiter := NewRateLimiter("public", 1, 1, RateLimitByClientIP, NewMemoryRateLimitStore(), nil, respondWithCode)
+
+	router := gin.New()
+	if err := router.SetTrustedProxies(nil); err != nil {
+		t.Fatalf("SetTrustedProxies: %v", err)
+	}
+	router.GET("/items", limiter.Limit(), func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	codes := make([]int, 0, 2)
+	for _, forwarded := range []string{"203.0.113.1", "203.0.113.2"} {
+		req := httptest.NewRequest(http.MethodGet, "/items", nil)
+		req.RemoteAddr = "198.51.100.9:41000"
+		req.Header.Set("X-Forwarded-For", forwarded)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		codes = append(codes, w.Code)
+	}
+
+	if codes[0] != http.StatusOK || codes[1] != http.StatusTooManyRequests {
+		t.Errorf("statuses = %v, want [200 429]: a new X-Forwarded-For must not get a fresh bucket", codes)
+	}
+}
@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"fmt"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/requestid"
+	"github.com/gin-gonic/gin"
+)
+
+// maxRequestIDLength bounds client-supplied request IDs (they end up in every audit entry)
+const maxRequestIDLength = 128
+
+// RequestID returns a Gin middleware giving every request an ID: the client's X-Request-ID
+// when it is a sane token, a new UUID otherwise
+// The ID is echoed in the response header, stored as "requestID" in the Gin context and
+// carried by the request context, where the audit logger picks it up.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(requestid.Header)
+		if !validRequestID(id) {
+			id = newRequestID()
+		}
+
+		c.Set("requestID", id)
+		c.Header(requestid.Header, id)
+		c.Request = c.Request.WithContext(requestid.WithRequestID(c.Request.Context(), id))
+
+		c.Next()
+	}
+}
+
+// validRequestID accepts IDs made of letters, digits and -_.:/ (UUIDs, trace IDs...)
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, r := range id {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case r == '-', r == '_', r == '.', r == ':', r == '/':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// newRequestID returns a random (version 4) UUID
+func newRequestID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
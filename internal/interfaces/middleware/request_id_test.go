@@ -0,0 +1,80 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/requestid"
+	"github.com/gin-gonic/gin"
+)
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+func TestRequestID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name     string
+		header   string
+		wantKept bool
+	}{
+		{name: "client uuid kept", header: "3f2b8c1e-7a4d-4e2f-9b1c-0d5e6f7a8b9c", wantKept: true},
+		{name: "trace id kept", header: "00-abc.def_ghi:1/2", wantKept: true},
+		{name: "missing header replaced", header: ""},
+		{name: "spaces replaced", header: "abc def"},
+		{name: "newline replaced", header: "abc\nforged: entry"},
+		{name: "too long replaced", header: strings.Repeat("a", maxRequestIDLength+1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ginID, ctxID string
+			router := gin.New()
+			router.Use(RequestID())
+			router.GET("/", func(c *gin.Context) {
+				ginID = c.GetString("requestID")
+				ctxID = requestid.FromContext(c.Request.Context())
+				c.Status(http.StatusNoContent)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.header != "" {
+				req.Header.Set(requestid.Header, tt.header)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			got := w.Header().Get(requestid.Header)
+			if tt.wantKept && got != tt.header {
+				t.Errorf("response %s = %q, want the client's %q", requestid.Header, got, tt.header)
+			}
+			if !tt.wantKept && !uuidPattern.MatchString(got) {
+				t.Errorf("response %s = %q, want a generated UUID", requestid.Header, got)
+			}
+			if ginID != got || ctxID != got {
+				t.Errorf("gin context = %q, request context = %q, want both %q", ginID, ctxID, got)
+			}
+		})
+	}
+}
+
+func TestRequestID_GeneratedIDsDiffer(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(RequestID())
+	router.GET("/", func(c *gin.Context) { c.Status(http.StatusNoContent) })
+
+	seen := make(map[string]bool)
+	for i := 0; i < 10; i++ {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+		id := w.Header().Get(requestid.Header)
+		if seen[id] {
+			t.Fatalf("request ID %q generated twice", id)
+		}
+		seen[id] = true
+	}
+}
@@ -0,0 +1,171 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"reflect"
+	"runtime"
+	"strings"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/actor"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/apperrors"
+	"github.com/gin-gonic/gin"
+)
+
+// Bounds of a valid userID claim (owner_id columns are varchar(255))
+const (
+	minUserIDLength = 1
+	maxUserIDLength = 255
+)
+
+// credentialKey is the context key holding the kind of credential that authenticated the request
+const credentialKey = "credential"
+
+// RequireUserID returns a Gin middleware that guards authenticated routes:
+// it rejects requests whose userID (set by Authenticate) is missing or malformed
+// and stores the normalized value back in the context, along with the request actor
+// (user ID plus credential discriminator) used to stamp created_by / updated_by.
+// respond writes the apperrors.Unauthenticated error of rejected requests.
+func RequireUserID(respond ErrorResponder) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID := strings.TrimSpace(c.GetString("userID"))
+		if userID == "" {
+			respond(c, apperrors.Unauthenticated("unauthorized: missing user ID"))
+			c.Abort()
+			return
+		}
+
+		if err := validateUserID(userID); err != nil {
+			respond(c, apperrors.Unauthenticated("unauthorized: "+err.Error()))
+			c.Abort()
+			return
+		}
+
+		c.Set("userID", userID)
+		c.Request = c.Request.WithContext(
+			actor.WithActor(c.Request.Context(), actor.Format(userID, c.GetString(credentialKey))),
+		)
+		c.Next()
+	}
+}
+
+// validateUserID checks the length bounds and character set of a userID claim
+func validateUserID(userID string) error {
+	if len(userID) < minUserIDLength || len(userID) > maxUserIDLength {
+		return fmt.Errorf("user ID must be between %d and %d characters", minUserIDLength, maxUserIDLength)
+	}
+
+	for _, r := range userID {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case r == '-', r == '_', r == '.', r == ':', r == '@', r == '|':
+		default:
+			return fmt.Errorf("user ID contains invalid characters")
+		}
+	}
+
+	return nil
+}
+
+// Protected creates a router group that requires authentication and a valid userID
+// handlers run after the guard, with the userID set (e.g. a per-user rate limit).
+func (m *AuthMiddleware) Protected(parent *gin.RouterGroup, relativePath string, handlers ...gin.HandlerFunc) *gin.RouterGroup {
+	return parent.Group(relativePath, append([]gin.HandlerFunc{m.Authenticate(), RequireUserID(m.respond)}, handlers...)...)
+}
+
+// routeProbeKey marks the requests AssertProtected sends through the engine
+type routeProbeKey struct{}
+
+// routeProbe receives the handler chain of the route a probe request reached
+type routeProbe struct {
+	handlers []string
+}
+
+// RouteProbe returns a Gin middleware that lets AssertProtected read the handler chain of
+// every route without running it. It must be the engine's first middleware; other requests
+// go straight through.
+func (m *AuthMiddleware) RouteProbe() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		probe, ok := c.Request.Context().Value(routeProbeKey{}).(*routeProbe)
+		if !ok {
+			c.Next()
+			return
+		}
+		probe.handlers = c.HandlerNames()
+		c.Abort()
+	}
+}
+
+// AssertProtected walks the route table of engine and returns an error listing every
+// owner-scoped route (a path with an "own" or "me" segment) whose handler chain doesn't
+// run Authenticate then RequireUserID before the handler, e.g. a route added to the parent
+// group instead of the one returned by Protected. The chains are read through RouteProbe.
+func (m *AuthMiddleware) AssertProtected(engine *gin.Engine) error {
+	authenticate := handlerName(m.Authenticate())
+	requireUserID := handlerName(RequireUserID(m.respond))
+
+	var unprotected []string
+	for _, route := range engine.Routes() {
+		if !isOwnerScopedPath(route.Path) {
+			continue
+		}
+
+		probe := &routeProbe{}
+		req := httptest.NewRequest(route.Method, probePath(route.Path), nil)
+		engine.ServeHTTP(httptest.NewRecorder(), req.WithContext(context.WithValue(req.Context(), routeProbeKey{}, probe)))
+		if probe.handlers == nil {
+			return fmt.Errorf("route %s %s wasn't reached by the route probe; install RouteProbe first", route.Method, route.Path)
+		}
+
+		if !guardedChain(probe.handlers, authenticate, requireUserID) {
+			unprotected = append(unprotected, route.Method+" "+route.Path)
+		}
+	}
+
+	if len(unprotected) > 0 {
+		return fmt.Errorf("routes registered without auth and userID guard: %s", strings.Join(unprotected, ", "))
+	}
+
+	return nil
+}
+
+// guardedChain reports whether authenticate and then requireUserID run before the last handler
+func guardedChain(handlers []string, authenticate, requireUserID string) bool {
+	authenticated := false
+	for _, name := range handlers[:len(handlers)-1] {
+		switch {
+		case name == authenticate:
+			authenticated = true
+		case name == requireUserID && authenticated:
+			return true
+		}
+	}
+	return false
+}
+
+// handlerName is the name Gin reports for handler in a chain
+func handlerName(handler gin.HandlerFunc) string {
+	return runtime.FuncForPC(reflect.ValueOf(handler).Pointer()).Name()
+}
+
+// probePath fills the parameters of a route path so a request matches it
+func probePath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, ":") || strings.HasPrefix(segment, "*") {
+			segments[i] = "probe"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// isOwnerScopedPath reports whether a route path acts on the caller's own resources
+func isOwnerScopedPath(path string) bool {
+	for _, segment := range strings.Split(path, "/") {
+		if segment == "own" || segment == "me" {
+			return true
+		}
+	}
+	return false
+}
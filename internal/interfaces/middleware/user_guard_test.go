@@ -0,0 +1,85 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestAssertProtected(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	handler := func(c *gin.Context) { c.Status(http.StatusOK) }
+
+	tests := []struct {
+		name     string
+		register func(auth *AuthMiddleware, api *gin.RouterGroup)
+		wantErr  string // substring of the error; empty for none
+	}{
+		{
+			name: "owner routes on the protected group",
+			register: func(auth *AuthMiddleware, api *gin.RouterGroup) {
+				portfolios := api.Group("/portfolios")
+				own := auth.Protected(portfolios, "/own")
+				own.GET("", handler)
+				own.PUT("/:id", handler)
+				portfolios.GET("/public/:id", handler)
+			},
+		},
+		{
+			name: "extra middleware after the guard",
+			register: func(auth *AuthMiddleware, api *gin.RouterGroup) {
+				me := auth.Protected(api.Group("/users"), "/me", func(c *gin.Context) { c.Next() })
+				me.GET("/settings", handler)
+			},
+		},
+		{
+			name: "owner route added to the parent group",
+			register: func(auth *AuthMiddleware, api *gin.RouterGroup) {
+				portfolios := api.Group("/portfolios")
+				own := auth.Protected(portfolios, "/own")
+				own.GET("", handler)
+				portfolios.GET("/own/x", handler)
+			},
+			wantErr: "GET /api/portfolios/own/x",
+		},
+		{
+			name: "guard only partly applied",
+			register: func(auth *AuthMiddleware, api *gin.RouterGroup) {
+				api.Group("/sections/own", RequireUserID(respondWithCode)).DELETE("/:id", handler)
+			},
+			wantErr: "DELETE /api/sections/own/:id",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auth := NewAuthMiddleware(nil, nil, respondWithCode)
+			router := gin.New()
+			router.Use(auth.RouteProbe())
+			tt.register(auth, router.Group("/api"))
+
+			err := auth.AssertProtected(router)
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Fatalf("AssertProtected: %v", err)
+			case tt.wantErr != "" && err == nil:
+				t.Fatalf("AssertProtected passed, want an error naming %s", tt.wantErr)
+			case tt.wantErr != "" && !strings.Contains(err.Error(), tt.wantErr):
+				t.Fatalf("AssertProtected error = %v, want it to name %s", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAssertProtectedRequiresRouteProbe(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	auth := NewAuthMiddleware(nil, nil, respondWithCode)
+	router := gin.New()
+	auth.Protected(router.Group("/api"), "/own").GET("", func(c *gin.Context) {})
+
+	if err := auth.AssertProtected(router); err == nil {
+		t.Fatal("AssertProtected passed without RouteProbe installed")
+	}
+}
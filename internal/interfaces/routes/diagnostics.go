@@ -0,0 +1,97 @@
+// Package routes reports what the router actually mounted: route counts per group, and the
+// differences with the expected-routes manifest (manifest.txt, one "METHOD /path" per line).
+// Update the manifest in the same change that adds or removes a route; a mismatch at
+// startup means a route appeared or vanished without anyone deciding it should.
+package routes
+
+import (
+	_ "embed"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Route groups of the startup summary
+const (
+	GroupPublic = "public" // Unauthenticated API routes
+	GroupOwn    = "own"    // Routes acting on the caller's own resources (/own, /me)
+	GroupAdmin  = "admin"  // Operator routes (/admin)
+	GroupMeta   = "meta"   // Health checks and other non-API routes
+)
+
+// Groups lists the route groups in summary order
+var Groups = []string{GroupPublic, GroupOwn, GroupAdmin, GroupMeta}
+
+//go:embed manifest.txt
+var manifestFile string
+
+// GroupOf classifies a route path
+func GroupOf(path string) string {
+	if !strings.HasPrefix(path, "/api/") {
+		return GroupMeta
+	}
+
+	for _, segment := range strings.Split(path, "/") {
+		switch segment {
+		case "admin":
+			return GroupAdmin
+		case "own", "me":
+			return GroupOwn
+		}
+	}
+	return GroupPublic
+}
+
+// Summarize counts the routes of every group (groups without routes are included with 0)
+func Summarize(routes gin.RoutesInfo) map[string]int {
+	counts := make(map[string]int, len(Groups))
+	for _, group := range Groups {
+		counts[group] = 0
+	}
+	for _, route := range routes {
+		counts[GroupOf(route.Path)]++
+	}
+	return counts
+}
+
+// Manifest returns the expected routes as "METHOD /path" entries
+// Blank lines and lines starting with # are ignored.
+func Manifest() []string {
+	var entries []string
+	for _, line := range strings.Split(manifestFile, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		entries = append(entries, strings.Join(strings.Fields(line), " "))
+	}
+	return entries
+}
+
+// Compare returns the mounted routes missing from expected and the expected routes that
+// were not mounted, both sorted
+func Compare(routes gin.RoutesInfo, expected []string) (unexpected, missing []string) {
+	mounted := make(map[string]bool, len(routes))
+	for _, route := range routes {
+		mounted[route.Method+" "+route.Path] = true
+	}
+
+	wanted := make(map[string]bool, len(expected))
+	for _, entry := range expected {
+		wanted[entry] = true
+		if !mounted[entry] {
+			missing = append(missing, entry)
+		}
+	}
+
+	for entry := range mounted {
+		if !wanted[entry] {
+			unexpected = append(unexpected, entry)
+		}
+	}
+
+	sort.Strings(unexpected)
+	sort.Strings(missing)
+	return unexpected, missing
+}
@@ -0,0 +1,74 @@
+package routes
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestGroupOf(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "/api/portfolios/public/:id", want: GroupPublic},
+		{path: "/api/v2/sections/portfolio/:id", want: GroupPublic},
+		{path: "/api/portfolios/own/:id", want: GroupOwn},
+		{path: "/api/v1/users/me", want: GroupOwn},
+		{path: "/api/admin/users", want: GroupAdmin},
+		{path: "/api/owner/list", want: GroupPublic},
+		{path: "/health", want: GroupMeta},
+		{path: "/metrics", want: GroupMeta},
+	}
+
+	for _, tt := range tests {
+		if got := GroupOf(tt.path); got != tt.want {
+			t.Errorf("GroupOf(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	got := Summarize(gin.RoutesInfo{
+		{Method: "GET", Path: "/api/portfolios/public/:id"},
+		{Method: "GET", Path: "/api/portfolios/own"},
+		{Method: "POST", Path: "/api/portfolios/own"},
+		{Method: "GET", Path: "/health"},
+	})
+	want := map[string]int{GroupPublic: 1, GroupOwn: 2, GroupAdmin: 0, GroupMeta: 1}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Summarize = %v, want %v", got, want)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	mounted := gin.RoutesInfo{
+		{Method: "GET", Path: "/health"},
+		{Method: "POST", Path: "/api/portfolios/own"},
+		{Method: "DELETE", Path: "/api/portfolios/own/:id"},
+	}
+	expected := []string{"GET /health", "GET /api/portfolios/own", "POST /api/portfolios/own"}
+
+	unexpected, missing := Compare(mounted, expected)
+	if want := []string{"DELETE /api/portfolios/own/:id"}; !reflect.DeepEqual(unexpected, want) {
+		t.Errorf("unexpected = %v, want %v", unexpected, want)
+	}
+	if want := []string{"GET /api/portfolios/own"}; !reflect.DeepEqual(missing, want) {
+		t.Errorf("missing = %v, want %v", missing, want)
+	}
+}
+
+func TestManifest(t *testing.T) {
+	entries := Manifest()
+	if len(entries) == 0 {
+		t.Fatal("manifest is empty")
+	}
+	seen := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		if seen[entry] {
+			t.Errorf("manifest lists %q twice", entry)
+		}
+		seen[entry] = true
+	}
+}
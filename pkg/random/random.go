@@ -0,0 +1,72 @@
+// Package random generates cryptographically secure random identifiers
+// (slugs suffixes, share tokens, API keys, idempotency keys).
+package random
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+)
+
+// URLSafeAlphabet contains only characters that never need escaping in URLs
+const URLSafeAlphabet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789-_"
+
+// LowerAlphanumeric is suited to identifiers that end up in slugs
+const LowerAlphanumeric = "abcdefghijklmnopqrstuvwxyz0123456789"
+
+// Generator draws random strings from an alphabet using a byte source
+// The source is crypto/rand by default and can be injected for deterministic tests
+type Generator struct {
+	source   io.Reader
+	alphabet string
+}
+
+// NewGenerator creates a generator over the given alphabet
+// A nil source means crypto/rand; an empty alphabet means URLSafeAlphabet
+func NewGenerator(source io.Reader, alphabet string) *Generator {
+	if source == nil {
+		source = rand.Reader
+	}
+	if alphabet == "" {
+		alphabet = URLSafeAlphabet
+	}
+	return &Generator{source: source, alphabet: alphabet}
+}
+
+// String returns a random string of the given length
+// Bytes that would bias the distribution are rejected instead of wrapped with a modulo
+func (g *Generator) String(length int) (string, error) {
+	if length <= 0 {
+		return "", fmt.Errorf("random string length must be positive, got %d", length)
+	}
+	if len(g.alphabet) > 256 {
+		return "", fmt.Errorf("random alphabet must have at most 256 characters")
+	}
+
+	// Largest multiple of the alphabet size that fits in a byte
+	limit := 256 - (256 % len(g.alphabet))
+	out := make([]byte, 0, length)
+	buf := make([]byte, length)
+
+	for len(out) < length {
+		if _, err := io.ReadFull(g.source, buf); err != nil {
+			return "", fmt.Errorf("failed to read random bytes: %w", err)
+		}
+		for _, b := range buf {
+			if int(b) >= limit {
+				continue
+			}
+			out = append(out, g.alphabet[int(b)%len(g.alphabet)])
+			if len(out) == length {
+				break
+			}
+		}
+	}
+
+	return string(out), nil
+}
+
+// String returns a URL-safe random string of the given length using crypto/rand
+func String(length int) (string, error) {
+	return NewGenerator(nil, URLSafeAlphabet).String(length)
+}
@@ -0,0 +1,58 @@
+package random
+
+import (
+	"bytes"
+	mathrand "math/rand"
+	"strings"
+	"testing"
+)
+
+func TestGeneratorSeededSourceIsDeterministic(t *testing.T) {
+	first, err := NewGenerator(mathrand.New(mathrand.NewSource(42)), LowerAlphanumeric).String(16)
+	if err != nil {
+		t.Fatalf("String: %v", err)
+	}
+	second, err := NewGenerator(mathrand.New(mathrand.NewSource(42)), LowerAlphanumeric).String(16)
+	if err != nil {
+		t.Fatalf("String: %v", err)
+	}
+
+	if first != second {
+		t.Errorf("same seed gave %q and %q", first, second)
+	}
+	if len(first) != 16 || strings.Trim(first, LowerAlphanumeric) != "" {
+		t.Errorf("String(16) = %q, want 16 characters of the alphabet", first)
+	}
+}
+
+func TestGeneratorRejectsBiasedBytes(t *testing.T) {
+	// 36 characters: bytes from 252 (7*36) up would favor the start of the alphabet
+	source := bytes.NewReader([]byte{255, 252, 0, 253, 37, 71})
+	got, err := NewGenerator(source, LowerAlphanumeric).String(3)
+	if err != nil {
+		t.Fatalf("String: %v", err)
+	}
+	if got != "ab9" {
+		t.Errorf("String(3) = %q, want \"ab9\" from bytes 0, 37 and 71", got)
+	}
+}
+
+func TestGeneratorErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		gen    *Generator
+		length int
+	}{
+		{name: "zero length", gen: NewGenerator(nil, ""), length: 0},
+		{name: "exhausted source", gen: NewGenerator(bytes.NewReader([]byte{1, 2}), ""), length: 4},
+		{name: "oversized alphabet", gen: NewGenerator(nil, strings.Repeat("a", 257)), length: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, err := tt.gen.String(tt.length); err == nil {
+				t.Fatalf("String(%d) = %q, want an error", tt.length, got)
+			}
+		})
+	}
+}